@@ -4,19 +4,30 @@ import (
 	"context"
 	"errors"
 	"log"
+	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/DanilNaum/SnipURL/internal/app/config"
+	appmetrics "github.com/DanilNaum/SnipURL/internal/app/metrics"
+	"github.com/DanilNaum/SnipURL/internal/app/repository/url/instrumented"
 	"github.com/DanilNaum/SnipURL/internal/app/repository/url/memory"
 	"github.com/DanilNaum/SnipURL/internal/app/repository/url/psql"
 	"github.com/DanilNaum/SnipURL/internal/app/service/private"
+	"github.com/DanilNaum/SnipURL/internal/app/service/tagging"
 	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
 	"github.com/DanilNaum/SnipURL/internal/app/transport/grpc"
 	rest "github.com/DanilNaum/SnipURL/internal/app/transport/rest"
 	"github.com/DanilNaum/SnipURL/pkg/cookie"
+	"github.com/DanilNaum/SnipURL/pkg/fanout"
+	"github.com/DanilNaum/SnipURL/pkg/geoip"
+	"github.com/DanilNaum/SnipURL/pkg/logging"
 	"github.com/DanilNaum/SnipURL/pkg/migration"
 	"github.com/DanilNaum/SnipURL/pkg/pg"
+	"github.com/DanilNaum/SnipURL/pkg/qrcode"
+	"github.com/DanilNaum/SnipURL/pkg/realip"
+	"github.com/DanilNaum/SnipURL/pkg/tracing"
 	"github.com/DanilNaum/SnipURL/pkg/utils/dumper"
 	"github.com/DanilNaum/SnipURL/pkg/utils/hash"
 	"github.com/DanilNaum/SnipURL/pkg/utils/httpserver"
@@ -24,9 +35,49 @@ import (
 
 	_ "net/http/pprof"
 
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	auditmemory "github.com/DanilNaum/SnipURL/internal/app/repository/audit/memory"
+	auditpsql "github.com/DanilNaum/SnipURL/internal/app/repository/audit/psql"
+	clickstorage "github.com/DanilNaum/SnipURL/internal/app/repository/click"
+	clickmemory "github.com/DanilNaum/SnipURL/internal/app/repository/click/memory"
+	clickpsql "github.com/DanilNaum/SnipURL/internal/app/repository/click/psql"
+	outboxstorage "github.com/DanilNaum/SnipURL/internal/app/repository/outbox"
+	outboxmemory "github.com/DanilNaum/SnipURL/internal/app/repository/outbox/memory"
+	outboxpsql "github.com/DanilNaum/SnipURL/internal/app/repository/outbox/psql"
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	rulememory "github.com/DanilNaum/SnipURL/internal/app/repository/rule/memory"
+	rulepsql "github.com/DanilNaum/SnipURL/internal/app/repository/rule/psql"
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	templatememory "github.com/DanilNaum/SnipURL/internal/app/repository/template/memory"
+	templatepsql "github.com/DanilNaum/SnipURL/internal/app/repository/template/psql"
 	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	userstorage "github.com/DanilNaum/SnipURL/internal/app/repository/user"
+	usermemory "github.com/DanilNaum/SnipURL/internal/app/repository/user/memory"
+	userpsql "github.com/DanilNaum/SnipURL/internal/app/repository/user/psql"
+	variantstorage "github.com/DanilNaum/SnipURL/internal/app/repository/variant"
+	variantmemory "github.com/DanilNaum/SnipURL/internal/app/repository/variant/memory"
+	variantpsql "github.com/DanilNaum/SnipURL/internal/app/repository/variant/psql"
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	webhookmemory "github.com/DanilNaum/SnipURL/internal/app/repository/webhook/memory"
+	webhookpsql "github.com/DanilNaum/SnipURL/internal/app/repository/webhook/psql"
+	"github.com/DanilNaum/SnipURL/internal/app/service/audit"
 	deleteurl "github.com/DanilNaum/SnipURL/internal/app/service/delete"
-	"go.uber.org/zap"
+	"github.com/DanilNaum/SnipURL/internal/app/service/erasure"
+	"github.com/DanilNaum/SnipURL/internal/app/service/healthcheck"
+	"github.com/DanilNaum/SnipURL/internal/app/service/relay"
+	"github.com/DanilNaum/SnipURL/internal/app/service/webhook"
+)
+
+const (
+	// qrCodeCacheSize is the number of rendered QR codes kept in memory.
+	qrCodeCacheSize = 1024
+	// clickStreamBufferSize is the number of clicks buffered for every watcher of a link,
+	// the clicks recorded while the buffer is full are dropped for it.
+	clickStreamBufferSize = 64
+	// tracingShutdownTimeout bounds the export of the spans left on shutdown.
+	tracingShutdownTimeout = 5 * time.Second
+	// windowEndInterval is the time between two runs emitting the ends of the links' active windows.
+	windowEndInterval = time.Minute
 )
 
 var (
@@ -36,41 +87,75 @@ var (
 )
 
 func main() {
-	logger, err := zap.NewDevelopment()
-	if err != nil {
-		log.Fatalf("error create logger %s", err.Error())
+	// run logs its own failure with the configured logger.
+	if err := run(); err != nil && !errors.Is(err, context.Canceled) {
+		os.Exit(1)
 	}
+}
 
-	sugarLogger := logger.Sugar()
-
-	sugarLogger.Info("Build version: ", buildVersion, "\nBuild date: ", buildDate, "\nBuild commit: ", buildCommit)
-
-	err = run(sugarLogger)
+func run() (err error) {
+	conf := config.NewConfig(log.Default())
 
-	if err != nil && !errors.Is(err, context.Canceled) {
-		logger.Sync()
-		sugarLogger.Fatalf("App fail with error %s", err.Error())
+	logger, err := logging.New(logging.Config{
+		Format:   conf.LoggingConfig().GetFormat(),
+		Level:    conf.LoggingConfig().GetLevel(),
+		Sampling: conf.LoggingConfig().GetSampling(),
+	})
+	if err != nil {
+		log.Fatalf("error create logger %s", err.Error())
 	}
+	defer logger.Sync()
 
-	sugarLogger.Info("App is gracefully shutdown")
-	logger.Sync()
-
-}
+	logger.Info("Build version: ", buildVersion, "\nBuild date: ", buildDate, "\nBuild commit: ", buildCommit)
 
-func run(log *zap.SugaredLogger) error {
-
-	conf := config.NewConfig(log)
+	defer func() {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			logger.Errorf("App fail with error %s", err.Error())
+			return
+		}
+		logger.Info("App is gracefully shutdown")
+	}()
 
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
 
 	defer cancel()
 
-	dump, err := dumper.NewDumper(conf.DumpConfig().GetPath(), log)
+	metrics := appmetrics.New()
+
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		ServiceName: "snipurl",
+		Version:     buildVersion,
+		Exporter:    conf.TraceConfig().GetExporter(),
+		File:        conf.TraceConfig().GetFile(),
+		Endpoint:    conf.TraceConfig().GetEndpoint(),
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancelShutdown()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			logger.Errorf("failed to shut down tracing: %v", err)
+		}
+	}()
+
+	dump, err := dumper.NewDumper(conf.DumpConfig().GetPath(), logger, dumper.WithOnWriteError(func(error) {
+		metrics.IncDumperWriteErrors()
+	}))
 	if err != nil {
 		return err
 	}
 
 	var urlStorage urlstorage.URLStorage
+	var templateStorage templatestorage.TemplateStorage
+	var ruleStorage rulestorage.RuleStorage
+	var variantStorage variantstorage.VariantStorage
+	var clickStorage clickstorage.ClickStorage
+	var userStorage userstorage.UserStorage
+	var webhookStorage webhookstorage.WebhookStorage
+	var outboxStorage outboxstorage.OutboxStorage
+	var auditStorage auditstorage.AuditStorage
 
 	if conf.DBConfig().GetDSN() != "" {
 		migrator := migration.NewMigrator(conf.DBConfig().GetDSN(), migration.WithRelativePath("migrations"))
@@ -81,34 +166,110 @@ func run(log *zap.SugaredLogger) error {
 
 		pgConf := pg.NewConnConfigFromDsnString(conf.DBConfig().GetDSN())
 
-		pgConn := pg.NewConnection(ctx, pgConf, log)
+		pgConn := pg.NewConnection(ctx, pgConf, logger)
 		if pgConn == nil {
 			return errors.New("pg connection is nil")
 		}
 		defer pgConn.Close()
-		urlStorage = psql.NewStorage(pgConn)
+		urlStorage = instrumented.NewStorage(psql.NewStorage(pgConn, logger), "postgres", metrics)
+		templateStorage = templatepsql.NewStorage(pgConn)
+		ruleStorage = rulepsql.NewStorage(pgConn)
+		variantStorage = variantpsql.NewStorage(pgConn)
+		clickStorage = clickpsql.NewStorage(pgConn)
+		userStorage = userpsql.NewStorage(pgConn)
+		webhookStorage = webhookpsql.NewStorage(pgConn)
+		outboxStorage = outboxpsql.NewStorage(pgConn)
+		auditStorage = auditpsql.NewStorage(pgConn)
 	} else {
-		storage := memory.NewStorage()
+		outbox, err := outboxmemory.NewStorage(dump)
+		if err != nil {
+			return err
+		}
+		storage := memory.NewStorage(memory.WithOutbox(outbox))
 
 		err = storage.RestoreStorage(dump)
 		if err != nil {
 			return err
 		}
-		urlStorage = storage
+		urlStorage = instrumented.NewStorage(storage, "memory", metrics)
+		outboxStorage = outbox
+		templateStorage, err = templatememory.NewStorage(dump)
+		if err != nil {
+			return err
+		}
+		ruleStorage, err = rulememory.NewStorage(dump)
+		if err != nil {
+			return err
+		}
+		variantStorage, err = variantmemory.NewStorage(dump)
+		if err != nil {
+			return err
+		}
+		clickStorage, err = clickmemory.NewStorage(dump)
+		if err != nil {
+			return err
+		}
+		userStorage, err = usermemory.NewStorage(dump)
+		if err != nil {
+			return err
+		}
+		webhookStorage, err = webhookmemory.NewStorage(dump)
+		if err != nil {
+			return err
+		}
 		defer dump.Close()
+
+		auditLog, err := auditmemory.NewStorage(conf.DumpConfig().GetAuditPath())
+		if err != nil {
+			return err
+		}
+		defer auditLog.Close()
+		auditStorage = auditLog
 	}
 
+	ipResolver, err := realip.NewResolver(conf.GeoConfig().GetTrustedProxies())
+	if err != nil {
+		return err
+	}
+
+	geoReader, err := geoip.NewReader(conf.GeoConfig().GetDBPath())
+	if err != nil {
+		return err
+	}
+	defer geoReader.Close()
+
 	hash := hash.NewHasher(8)
 
-	deleteService := deleteurl.NewDeleteService(ctx, urlStorage)
-	urlSnipperService := urlsnipper.NewURLSnipperService(urlStorage, hash, dump, deleteService, log)
+	webhookService := webhook.NewWebhookService(ctx, webhookStorage, urlStorage, conf.ServerConfig().GetBaseURL(), logger)
+	relay.NewRelay(ctx, outboxStorage, logger, relay.WithSubscriber("webhooks", webhookService))
+	auditService := audit.NewAuditService(auditStorage, logger)
+	deleteService := deleteurl.NewDeleteService(ctx, urlStorage, auditService)
+	clickHub := fanout.NewHub[string, *urlsnipper.ClickEvent](ctx, clickStreamBufferSize)
+	urlSnipperService := urlsnipper.NewURLSnipperService(urlStorage, templateStorage, ruleStorage, variantStorage, clickStorage, webhookStorage, auditStorage, hash, dump, deleteService, webhookService, clickHub, metrics, auditService, logger)
+	go urlSnipperService.RunWindowEnds(ctx, windowEndInterval)
 	internalService := private.NewInternalService(urlStorage)
+	taggingService := tagging.NewTaggingService(templateStorage)
+	erasureService := erasure.NewErasureService(ctx, urlStorage, templateStorage, webhookStorage, ruleStorage, variantStorage, clickStorage, auditStorage, userStorage, dump, logger)
+	healthChecker := healthcheck.NewHealthChecker(ctx, urlStorage, logger)
+
+	metrics.RegisterQueue("webhook", webhookService.QueueDepth)
+	metrics.RegisterQueue("delete", deleteService.QueueDepth)
+	metrics.RegisterQueue("erasure", erasureService.QueueDepth)
+	metrics.RegisterQueue("healthcheck", healthChecker.QueueDepth)
+
+	qrRenderer := qrcode.NewRenderer(qrCodeCacheSize)
 
 	mux := chi.NewRouter()
 
 	cookieManager := cookie.NewCookieManager([]byte(conf.CookieConfig().GetSecret()), cookie.WithName("user"))
+	unlockCookieManager := cookie.NewCookieManager(
+		[]byte(conf.CookieConfig().GetSecret()),
+		cookie.WithName("snipurl_unlock"),
+		cookie.WithHTTPOnly(true),
+		cookie.WithMaxAge(int(time.Hour/time.Second)),
+	)
 
-	controller, err := rest.NewController(mux, conf.ServerConfig(), urlSnipperService, internalService, urlStorage, cookieManager, log)
+	controller, err := rest.NewController(mux, conf.ServerConfig(), urlSnipperService, taggingService, webhookService, erasureService, auditService, internalService, urlStorage, cookieManager, unlockCookieManager, geoReader, ipResolver, qrRenderer, metrics, logger)
 
 	if err != nil {
 		return err
@@ -119,12 +280,20 @@ func run(log *zap.SugaredLogger) error {
 	grpcCookieManager := grpc.NewCookieManager()
 	grpcController, err := grpc.NewController(
 		urlSnipperService,
+		taggingService,
+		webhookService,
+		erasureService,
 		internalService,
+		auditService,
 		urlStorage,
 		conf.ServerConfig(),
 		grpcCookieManager,
-		log,
+		logger,
 		conf.ServerConfig().GetTrustedSubNet(),
+		geoReader,
+		ipResolver,
+		qrRenderer,
+		metrics,
 	)
 	if err != nil {
 		return err
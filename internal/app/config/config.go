@@ -7,7 +7,10 @@ import (
 	"github.com/DanilNaum/SnipURL/internal/app/config/cookie"
 	"github.com/DanilNaum/SnipURL/internal/app/config/db"
 	"github.com/DanilNaum/SnipURL/internal/app/config/dump"
+	"github.com/DanilNaum/SnipURL/internal/app/config/geo"
+	"github.com/DanilNaum/SnipURL/internal/app/config/logging"
 	"github.com/DanilNaum/SnipURL/internal/app/config/server"
+	"github.com/DanilNaum/SnipURL/internal/app/config/trace"
 )
 
 type logger interface {
@@ -20,10 +23,12 @@ type serverConfig interface {
 	GetPrefix() (string, error)
 	GetEnableHTTPS() bool
 	GetTrustedSubNet() string
+	GetRedirectType() int
 }
 
 type dumpConfig interface {
 	GetPath() string
+	GetAuditPath() string
 }
 
 type dbConfig interface {
@@ -33,11 +38,31 @@ type cookieConfig interface {
 	GetSecret() string
 }
 
+type geoConfig interface {
+	GetDBPath() string
+	GetTrustedProxies() []string
+}
+
+type traceConfig interface {
+	GetExporter() string
+	GetFile() string
+	GetEndpoint() string
+}
+
+type loggingConfig interface {
+	GetFormat() string
+	GetLevel() string
+	GetSampling() bool
+}
+
 type config struct {
-	serverConfig serverConfig
-	dumpConfig   dumpConfig
-	dbConfig     dbConfig
-	cookieConfig cookieConfig
+	serverConfig  serverConfig
+	dumpConfig    dumpConfig
+	dbConfig      dbConfig
+	cookieConfig  cookieConfig
+	geoConfig     geoConfig
+	traceConfig   traceConfig
+	loggingConfig loggingConfig
 }
 
 // NewConfig creates a new configuration by merging configuration values from flags, environment variables, and applying default settings.
@@ -48,6 +73,9 @@ func NewConfig(log logger) *config {
 	dbConfigFlag := db.DBConfigFromFlags()
 	dumpConfigFlags := dump.DumpConfigFromFlags()
 	serverConfigFlags := server.ServerConfigFromFlags()
+	geoConfigFlags := geo.GeoConfigFromFlags()
+	traceConfigFlags := trace.TraceConfigFromFlags()
+	loggingConfigFlags := logging.LoggingConfigFromFlags()
 
 	var configFile string
 	flag.StringVar(&configFile, "c", "", "config file name")
@@ -59,6 +87,9 @@ func NewConfig(log logger) *config {
 	dumpConfigEnv := dump.DumpConfigFromEnv(log)
 	serverConfigEnv := server.ServerConfigFromEnv(log)
 	cookieConfigEnv := cookie.CookieConfigFromEnv(log)
+	geoConfigEnv := geo.GeoConfigFromEnv(log)
+	traceConfigEnv := trace.TraceConfigFromEnv(log)
+	loggingConfigEnv := logging.LoggingConfigFromEnv(log)
 
 	if configFile == "" {
 		configFile = os.Getenv("CONFIG")
@@ -67,16 +98,25 @@ func NewConfig(log logger) *config {
 	dbConfigFile := db.DBConfigFromJSONFile(configFile, log)
 	dumpConfigFile := dump.DumpConfigFromJSONFile(configFile, log)
 	serverConfigFile := server.ServerConfigFromJSONFile(configFile, log)
+	geoConfigFile := geo.GeoConfigFromJSONFile(configFile, log)
+	traceConfigFile := trace.TraceConfigFromJSONFile(configFile, log)
+	loggingConfigFile := logging.LoggingConfigFromJSONFile(configFile, log)
 
 	serverConfig := server.MergeServerConfigs(serverConfigEnv, serverConfigFlags, serverConfigFile, log)
 	dumpConfig := dump.MergeDumpConfigs(dumpConfigEnv, dumpConfigFlags, dumpConfigFile, log)
 	dbConfig := db.MergeDBConfigs(dbConfigEnv, dbConfigFlag, dbConfigFile, log)
+	geoConfig := geo.MergeGeoConfigs(geoConfigEnv, geoConfigFlags, geoConfigFile, log)
+	traceConfig := trace.MergeTraceConfigs(traceConfigEnv, traceConfigFlags, traceConfigFile, log)
+	loggingConfig := logging.MergeLoggingConfigs(loggingConfigEnv, loggingConfigFlags, loggingConfigFile, log)
 
 	return &config{
-		serverConfig: serverConfig,
-		dumpConfig:   dumpConfig,
-		dbConfig:     dbConfig,
-		cookieConfig: cookieConfigEnv,
+		serverConfig:  serverConfig,
+		dumpConfig:    dumpConfig,
+		dbConfig:      dbConfig,
+		cookieConfig:  cookieConfigEnv,
+		geoConfig:     geoConfig,
+		traceConfig:   traceConfig,
+		loggingConfig: loggingConfig,
 	}
 }
 
@@ -103,3 +143,21 @@ func (c *config) DBConfig() dbConfig {
 func (c *config) CookieConfig() cookieConfig {
 	return c.cookieConfig
 }
+
+// GeoConfig returns the geo configuration for the current config instance.
+// It provides access to the geoConfig field, which contains GeoIP and trusted proxy settings.
+func (c *config) GeoConfig() geoConfig {
+	return c.geoConfig
+}
+
+// TraceConfig returns the trace configuration for the current config instance.
+// It provides access to the traceConfig field, which contains span exporter settings.
+func (c *config) TraceConfig() traceConfig {
+	return c.traceConfig
+}
+
+// LoggingConfig returns the logging configuration for the current config instance.
+// It provides access to the loggingConfig field, which contains the log format, level and sampling.
+func (c *config) LoggingConfig() loggingConfig {
+	return c.loggingConfig
+}
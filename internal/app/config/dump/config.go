@@ -7,23 +7,34 @@ import (
 	"github.com/caarlos0/env/v6"
 )
 
-var defaultVal = "storage.json"
+var (
+	defaultVal       = "storage.json"
+	defaultAuditPath = "audit.jsonl"
+)
 
 //go:generate moq -out logger_moq_test.go . logger
 type logger interface {
 	Fatalf(format string, v ...any)
 }
 
+// dumpConfig configures the files kept when the storage is kept in memory, that is
+// without a database DSN. The dump file keeps the links, the pending outbox events,
+// tagging templates, routing rules, A/B counters, click analytics, webhooks, revoked
+// users and erasure jobs; the audit log is written to a file of its own.
 type dumpConfig struct {
-	Path *string `json:"file_storage_path" env:"FILE_STORAGE_PATH"`
+	Path      *string `json:"file_storage_path" env:"FILE_STORAGE_PATH"`
+	AuditPath *string `json:"audit_file_path" env:"AUDIT_FILE_PATH"`
 }
 
 // DumpConfigFromFlags creates a dumpConfig with a default storage path from command-line flags.
-// It sets the default dump file path to "storage.json" if not specified.
+// It sets the default dump file path to "storage.json" and the default audit log path
+// to "audit.jsonl" if not specified.
 func DumpConfigFromFlags() *dumpConfig {
-	path := flag.String("f", "", "path to dump file")
+	path := flag.String("f", "", "path to dump file, used without a database")
+	auditPath := flag.String("audit-file", "", "path to audit log file, used without a database")
 	return &dumpConfig{
-		Path: path,
+		Path:      path,
+		AuditPath: auditPath,
 	}
 }
 
@@ -67,16 +78,21 @@ func MergeDumpConfigs(envConfig, flagsConfig, fileConfig *dumpConfig, log logger
 	if *flagsConfig.Path == "" {
 		flagsConfig.Path = nil
 	}
+	if flagsConfig.AuditPath != nil && *flagsConfig.AuditPath == "" {
+		flagsConfig.AuditPath = nil
+	}
 
 	if fileConfig == nil {
 		return &dumpConfig{
-			Path: utils.Merge(envConfig.Path, flagsConfig.Path, &defaultVal),
+			Path:      utils.Merge(envConfig.Path, flagsConfig.Path, &defaultVal),
+			AuditPath: utils.Merge(envConfig.AuditPath, flagsConfig.AuditPath, &defaultAuditPath),
 		}
 
 	}
 
 	return &dumpConfig{
-		Path: utils.Merge(envConfig.Path, flagsConfig.Path, fileConfig.Path, &defaultVal),
+		Path:      utils.Merge(envConfig.Path, flagsConfig.Path, fileConfig.Path, &defaultVal),
+		AuditPath: utils.Merge(envConfig.AuditPath, flagsConfig.AuditPath, fileConfig.AuditPath, &defaultAuditPath),
 	}
 }
 
@@ -85,3 +101,9 @@ func MergeDumpConfigs(envConfig, flagsConfig, fileConfig *dumpConfig, log logger
 func (c *dumpConfig) GetPath() string {
 	return *c.Path
 }
+
+// GetAuditPath returns the configured path of the audit log file, used when the
+// storage is kept in memory.
+func (c *dumpConfig) GetAuditPath() string {
+	return *c.AuditPath
+}
@@ -0,0 +1,107 @@
+package geo
+
+import (
+	"flag"
+	"strings"
+
+	"github.com/DanilNaum/SnipURL/internal/app/config/utils"
+	"github.com/caarlos0/env/v6"
+)
+
+var (
+	defaultDBPath         = ""
+	defaultTrustedProxies = ""
+)
+
+type logger interface {
+	Fatalf(format string, v ...any)
+}
+
+type geoConfig struct {
+	DBPath         *string `json:"geoip_db" env:"GEOIP_DB"`
+	TrustedProxies *string `json:"trusted_proxies" env:"TRUSTED_PROXIES"`
+}
+
+// GeoConfigFromFlags creates a geoConfig from command-line flags.
+// It registers the GeoIP database path and the trusted proxy list flags.
+func GeoConfigFromFlags() *geoConfig {
+	dbPath := flag.String("g", "", "path to GeoIP .mmdb database")
+	trustedProxies := flag.String("trusted-proxies", "", "comma-separated trusted proxy CIDRs")
+	return &geoConfig{
+		DBPath:         dbPath,
+		TrustedProxies: trustedProxies,
+	}
+}
+
+// GeoConfigFromEnv parses environment variables to configure the geo configuration.
+// It logs a fatal error if parsing the environment configuration fails.
+func GeoConfigFromEnv(log logger) *geoConfig {
+	c := &geoConfig{}
+	err := env.Parse(c)
+	if err != nil {
+		log.Fatalf("error parse config from Env: %s", err)
+	}
+	return c
+}
+
+// GeoConfigFromJSONFile loads configuration from a JSON file into a geoConfig struct.
+// Logs a fatal error and exits if loading fails.
+func GeoConfigFromJSONFile(jsonFileName string, log logger) *geoConfig {
+	var config geoConfig
+	if jsonFileName != "" {
+		if err := utils.LoadConfigFromFile(jsonFileName, &config); err != nil {
+			log.Fatalf(err.Error())
+		}
+	}
+	return &config
+}
+
+// MergeGeoConfigs combines environment, flag and file configurations for geo settings.
+// It prioritizes environment configuration, then flags, then the file.
+// Logs a fatal error if either environment or flag configuration is nil.
+func MergeGeoConfigs(envConfig, flagsConfig, fileConfig *geoConfig, log logger) *geoConfig {
+	if envConfig == nil {
+		log.Fatalf("error env config is nil")
+		return nil
+	}
+
+	if flagsConfig == nil {
+		log.Fatalf("error flags config is nil")
+		return nil
+	}
+
+	if *flagsConfig.DBPath == "" {
+		flagsConfig.DBPath = nil
+	}
+
+	if *flagsConfig.TrustedProxies == "" {
+		flagsConfig.TrustedProxies = nil
+	}
+
+	if fileConfig == nil {
+		return &geoConfig{
+			DBPath:         utils.Merge(envConfig.DBPath, flagsConfig.DBPath, &defaultDBPath),
+			TrustedProxies: utils.Merge(envConfig.TrustedProxies, flagsConfig.TrustedProxies, &defaultTrustedProxies),
+		}
+	}
+
+	return &geoConfig{
+		DBPath:         utils.Merge(envConfig.DBPath, flagsConfig.DBPath, fileConfig.DBPath, &defaultDBPath),
+		TrustedProxies: utils.Merge(envConfig.TrustedProxies, flagsConfig.TrustedProxies, fileConfig.TrustedProxies, &defaultTrustedProxies),
+	}
+}
+
+// GetDBPath returns the configured GeoIP database path.
+// Returns an empty string if geo lookups are disabled.
+func (c *geoConfig) GetDBPath() string {
+	return *c.DBPath
+}
+
+// GetTrustedProxies returns the configured trusted proxy networks.
+// Returns nil if no proxies are trusted.
+func (c *geoConfig) GetTrustedProxies() []string {
+	if *c.TrustedProxies == "" {
+		return nil
+	}
+	return strings.Split(*c.TrustedProxies, ",")
+}
@@ -0,0 +1,116 @@
+package logging
+
+import (
+	"flag"
+
+	"github.com/DanilNaum/SnipURL/internal/app/config/utils"
+	"github.com/caarlos0/env/v6"
+)
+
+var (
+	defaultFormat   = "console"
+	defaultLevel    = "info"
+	defaultSampling = false
+)
+
+type logger interface {
+	Fatalf(format string, v ...any)
+}
+
+type loggingConfig struct {
+	Format   *string `json:"log_format" env:"LOG_FORMAT"`
+	Level    *string `json:"log_level" env:"LOG_LEVEL"`
+	Sampling *bool   `json:"log_sampling" env:"LOG_SAMPLING"`
+}
+
+// LoggingConfigFromFlags creates a loggingConfig from command-line flags.
+// It registers the log format, level and sampling flags.
+func LoggingConfigFromFlags() *loggingConfig {
+	format := flag.String("log-format", "", "log format: console or json")
+	level := flag.String("log-level", "", "minimal log level: debug, info, warn or error")
+	sampling := flag.Bool("log-sampling", false, "sample repeated log records")
+	return &loggingConfig{
+		Format:   format,
+		Level:    level,
+		Sampling: sampling,
+	}
+}
+
+// LoggingConfigFromEnv parses environment variables to configure the logging configuration.
+// It logs a fatal error if parsing the environment configuration fails.
+func LoggingConfigFromEnv(log logger) *loggingConfig {
+	c := &loggingConfig{}
+	err := env.Parse(c)
+	if err != nil {
+		log.Fatalf("error parse config from Env: %s", err)
+	}
+	return c
+}
+
+// LoggingConfigFromJSONFile loads configuration from a JSON file into a loggingConfig struct.
+// Logs a fatal error and exits if loading fails.
+func LoggingConfigFromJSONFile(jsonFileName string, log logger) *loggingConfig {
+	var config loggingConfig
+	if jsonFileName != "" {
+		if err := utils.LoadConfigFromFile(jsonFileName, &config); err != nil {
+			log.Fatalf(err.Error())
+		}
+	}
+	return &config
+}
+
+// MergeLoggingConfigs combines environment, flag and file configurations for logging settings.
+// It prioritizes environment configuration, then flags, then the file.
+// Logs a fatal error if either environment or flag configuration is nil.
+func MergeLoggingConfigs(envConfig, flagsConfig, fileConfig *loggingConfig, log logger) *loggingConfig {
+	if envConfig == nil {
+		log.Fatalf("error env config is nil")
+		return nil
+	}
+
+	if flagsConfig == nil {
+		log.Fatalf("error flags config is nil")
+		return nil
+	}
+
+	if *flagsConfig.Format == "" {
+		flagsConfig.Format = nil
+	}
+
+	if *flagsConfig.Level == "" {
+		flagsConfig.Level = nil
+	}
+
+	if !*flagsConfig.Sampling {
+		flagsConfig.Sampling = nil
+	}
+
+	if fileConfig == nil {
+		return &loggingConfig{
+			Format:   utils.Merge(envConfig.Format, flagsConfig.Format, &defaultFormat),
+			Level:    utils.Merge(envConfig.Level, flagsConfig.Level, &defaultLevel),
+			Sampling: utils.Merge(envConfig.Sampling, flagsConfig.Sampling, &defaultSampling),
+		}
+	}
+
+	return &loggingConfig{
+		Format:   utils.Merge(envConfig.Format, flagsConfig.Format, fileConfig.Format, &defaultFormat),
+		Level:    utils.Merge(envConfig.Level, flagsConfig.Level, fileConfig.Level, &defaultLevel),
+		Sampling: utils.Merge(envConfig.Sampling, flagsConfig.Sampling, fileConfig.Sampling, &defaultSampling),
+	}
+}
+
+// GetFormat returns the configured log format, console or json.
+func (c *loggingConfig) GetFormat() string {
+	return *c.Format
+}
+
+// GetLevel returns the configured minimal log level.
+func (c *loggingConfig) GetLevel() string {
+	return *c.Level
+}
+
+// GetSampling reports whether repeated log records are sampled.
+func (c *loggingConfig) GetSampling() bool {
+	return *c.Sampling
+}
@@ -3,10 +3,12 @@ package server
 import (
 	"flag"
 
+	"net/http"
 	"net/url"
 	"strings"
 
 	"github.com/DanilNaum/SnipURL/internal/app/config/utils"
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
 	"github.com/caarlos0/env/v6"
 )
 
@@ -15,6 +17,7 @@ var (
 	defaultBaseURL       = "http://localhost:8080"
 	defaultEnableHTTPS   = false
 	defaultTrustedSubNet = ""
+	defaultRedirectType  = http.StatusTemporaryRedirect
 )
 
 //go:generate moq -out logger_moq_test.go . logger
@@ -27,6 +30,7 @@ type serverConfig struct {
 	BaseURL       *string `json:"base_url" env:"BASE_URL"`
 	EnableHTTPS   *bool   `json:"enable_https" env:"ENABLE_HTTPS"`
 	TrustedSubNet *string `json:"trusted_subnet" env:"TRUSTED_SUBNET"`
+	RedirectType  *int    `json:"redirect_type" env:"REDIRECT_TYPE"`
 }
 
 // ServerConfigFromFlags parses command-line flags to configure server settings.
@@ -41,11 +45,14 @@ func ServerConfigFromFlags() *serverConfig {
 
 	trustedSubNet := flag.String("t", "", "trusted Sub Net")
 
+	redirectType := flag.Int("r", 0, "default redirect status code")
+
 	return &serverConfig{
 		Host:          host,
 		BaseURL:       baseURL,
 		EnableHTTPS:   enableHTTPS,
 		TrustedSubNet: trustedSubNet,
+		RedirectType:  redirectType,
 	}
 }
 
@@ -105,20 +112,36 @@ func MergeServerConfigs(envConfig, flagsConfig, fileConfig *serverConfig, log lo
 		flagsConfig.TrustedSubNet = nil
 	}
 
+	if *flagsConfig.RedirectType == 0 {
+		flagsConfig.RedirectType = nil
+	}
+
+	var merged *serverConfig
 	if fileConfig == nil {
-		return &serverConfig{
+		merged = &serverConfig{
 			Host:          utils.Merge(envConfig.Host, flagsConfig.Host, &defaultHost),
 			BaseURL:       utils.Merge(envConfig.BaseURL, flagsConfig.BaseURL, &defaultBaseURL),
 			EnableHTTPS:   utils.Merge(envConfig.EnableHTTPS, flagsConfig.EnableHTTPS, &defaultEnableHTTPS),
 			TrustedSubNet: utils.Merge(envConfig.TrustedSubNet, flagsConfig.TrustedSubNet, &defaultTrustedSubNet),
+			RedirectType:  utils.Merge(envConfig.RedirectType, flagsConfig.RedirectType, &defaultRedirectType),
+		}
+	} else {
+		merged = &serverConfig{
+			Host:          utils.Merge(envConfig.Host, flagsConfig.Host, fileConfig.Host, &defaultHost),
+			BaseURL:       utils.Merge(envConfig.BaseURL, flagsConfig.BaseURL, fileConfig.BaseURL, &defaultBaseURL),
+			EnableHTTPS:   utils.Merge(envConfig.EnableHTTPS, flagsConfig.EnableHTTPS, fileConfig.EnableHTTPS, &defaultEnableHTTPS),
+			TrustedSubNet: utils.Merge(envConfig.TrustedSubNet, flagsConfig.TrustedSubNet, fileConfig.TrustedSubNet, &defaultTrustedSubNet),
+			RedirectType:  utils.Merge(envConfig.RedirectType, flagsConfig.RedirectType, fileConfig.RedirectType, &defaultRedirectType),
 		}
 	}
-	return &serverConfig{
-		Host:          utils.Merge(envConfig.Host, flagsConfig.Host, fileConfig.Host, &defaultHost),
-		BaseURL:       utils.Merge(envConfig.BaseURL, flagsConfig.BaseURL, fileConfig.BaseURL, &defaultBaseURL),
-		EnableHTTPS:   utils.Merge(envConfig.EnableHTTPS, flagsConfig.EnableHTTPS, fileConfig.EnableHTTPS, &defaultEnableHTTPS),
-		TrustedSubNet: utils.Merge(envConfig.TrustedSubNet, flagsConfig.TrustedSubNet, fileConfig.TrustedSubNet, &defaultTrustedSubNet),
+
+	// Zero means "use the default" on a link, so it cannot be the default itself.
+	if *merged.RedirectType == 0 || !urlsnipper.IsValidRedirectType(*merged.RedirectType) {
+		log.Fatalf("invalid redirect type: %d", *merged.RedirectType)
+		return nil
 	}
+
+	return merged
 }
 
 // ValidateServerConfig checks the server configuration for valid host and base URL values.
@@ -170,6 +193,11 @@ func (c *serverConfig) GetTrustedSubNet() string {
 	return *c.TrustedSubNet
 }
 
+// GetRedirectType returns the HTTP status code used for links without their own redirect type.
+func (c *serverConfig) GetRedirectType() int {
+	return *c.RedirectType
+}
+
 // GetPrefix extracts and returns the path prefix from the base URL.
 // If the base URL has no path, it returns "/". If parsing fails, it returns an error.
 // The returned path is trimmed of any trailing slash.
@@ -0,0 +1,118 @@
+package trace
+
+import (
+	"flag"
+
+	"github.com/DanilNaum/SnipURL/internal/app/config/utils"
+	"github.com/caarlos0/env/v6"
+)
+
+var (
+	defaultExporter = "none"
+	defaultFile     = ""
+	defaultEndpoint = "localhost:4317"
+)
+
+type logger interface {
+	Fatalf(format string, v ...any)
+}
+
+type traceConfig struct {
+	Exporter *string `json:"trace_exporter" env:"TRACE_EXPORTER"`
+	File     *string `json:"trace_file" env:"TRACE_FILE"`
+	Endpoint *string `json:"trace_endpoint" env:"TRACE_OTLP_ENDPOINT"`
+}
+
+// TraceConfigFromFlags creates a traceConfig from command-line flags.
+// It registers the span exporter, the exporter file and the collector endpoint flags.
+func TraceConfigFromFlags() *traceConfig {
+	exporter := flag.String("trace-exporter", "", "span exporter: none, stdout or otlp")
+	file := flag.String("trace-file", "", "file of the stdout span exporter, standard output if empty")
+	endpoint := flag.String("trace-endpoint", "", "OTLP gRPC collector endpoint")
+	return &traceConfig{
+		Exporter: exporter,
+		File:     file,
+		Endpoint: endpoint,
+	}
+}
+
+// TraceConfigFromEnv parses environment variables to configure the trace configuration.
+// It logs a fatal error if parsing the environment configuration fails.
+func TraceConfigFromEnv(log logger) *traceConfig {
+	c := &traceConfig{}
+	err := env.Parse(c)
+	if err != nil {
+		log.Fatalf("error parse config from Env: %s", err)
+	}
+	return c
+}
+
+// TraceConfigFromJSONFile loads configuration from a JSON file into a traceConfig struct.
+// Logs a fatal error and exits if loading fails.
+func TraceConfigFromJSONFile(jsonFileName string, log logger) *traceConfig {
+	var config traceConfig
+	if jsonFileName != "" {
+		if err := utils.LoadConfigFromFile(jsonFileName, &config); err != nil {
+			log.Fatalf(err.Error())
+		}
+	}
+	return &config
+}
+
+// MergeTraceConfigs combines environment, flag and file configurations for trace settings.
+// It prioritizes environment configuration, then flags, then the file.
+// Logs a fatal error if either environment or flag configuration is nil.
+func MergeTraceConfigs(envConfig, flagsConfig, fileConfig *traceConfig, log logger) *traceConfig {
+	if envConfig == nil {
+		log.Fatalf("error env config is nil")
+		return nil
+	}
+
+	if flagsConfig == nil {
+		log.Fatalf("error flags config is nil")
+		return nil
+	}
+
+	if *flagsConfig.Exporter == "" {
+		flagsConfig.Exporter = nil
+	}
+
+	if *flagsConfig.File == "" {
+		flagsConfig.File = nil
+	}
+
+	if *flagsConfig.Endpoint == "" {
+		flagsConfig.Endpoint = nil
+	}
+
+	if fileConfig == nil {
+		return &traceConfig{
+			Exporter: utils.Merge(envConfig.Exporter, flagsConfig.Exporter, &defaultExporter),
+			File:     utils.Merge(envConfig.File, flagsConfig.File, &defaultFile),
+			Endpoint: utils.Merge(envConfig.Endpoint, flagsConfig.Endpoint, &defaultEndpoint),
+		}
+	}
+
+	return &traceConfig{
+		Exporter: utils.Merge(envConfig.Exporter, flagsConfig.Exporter, fileConfig.Exporter, &defaultExporter),
+		File:     utils.Merge(envConfig.File, flagsConfig.File, fileConfig.File, &defaultFile),
+		Endpoint: utils.Merge(envConfig.Endpoint, flagsConfig.Endpoint, fileConfig.Endpoint, &defaultEndpoint),
+	}
+}
+
+// GetExporter returns the configured span exporter.
+// Returns "none" if tracing is disabled.
+func (c *traceConfig) GetExporter() string {
+	return *c.Exporter
+}
+
+// GetFile returns the file the stdout span exporter writes to.
+// Returns an empty string for the standard output.
+func (c *traceConfig) GetFile() string {
+	return *c.File
+}
+
+// GetEndpoint returns the configured OTLP collector endpoint.
+func (c *traceConfig) GetEndpoint() string {
+	return *c.Endpoint
+}
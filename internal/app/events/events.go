@@ -0,0 +1,57 @@
+// Package events defines the domain events emitted on changes of the short links.
+// Creations, updates, deletions and the ends of the active windows are written to the
+// outbox by the URL storage together with the change and relayed from there; the other
+// events are published by the services directly. Whatever reacts to them, such as webhooks, receives both.
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of the domain events.
+const (
+	// TypeLinkCreated is emitted for every link created, one by one or in a batch.
+	TypeLinkCreated = "link.created"
+	// TypeLinkUpdated is emitted for every change of the settings of a link by its owner.
+	TypeLinkUpdated = "link.updated"
+	// TypeLinkDeleted is emitted for every link deleted by its owner.
+	TypeLinkDeleted = "link.deleted"
+	// TypeLinkExpired is emitted when a link stops redirecting to its original URL,
+	// because its click limit is used up or its active window is over.
+	TypeLinkExpired = "link.expired"
+	// TypeLinkClicked is emitted for every counted redirect, with the clicks so far.
+	TypeLinkClicked = "link.clicked"
+)
+
+// Reasons of a TypeLinkExpired event.
+const (
+	ReasonClickLimit = "click_limit"
+	ReasonEnded      = "ended"
+)
+
+// Event is a change of a short link.
+// UserID is the owner of the link, empty when the emitter does not know it.
+// Clicks is set on TypeLinkClicked and Reason on TypeLinkExpired.
+type Event struct {
+	ID          string
+	Type        string
+	UserID      string
+	ShortURL    string
+	OriginalURL string
+	Clicks      int
+	Reason      string
+	OccurredAt  time.Time
+}
+
+// New returns an event of the given type for the short link, with a fresh ID
+// and the current time.
+func New(eventType, shortURL string) *Event {
+	return &Event{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		ShortURL:   shortURL,
+		OccurredAt: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,146 @@
+// Package metrics collects the metrics of the service and exposes them in the
+// Prometheus text format.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "snipurl"
+
+// Results of a redirect, see ObserveRedirect.
+const (
+	RedirectHit  = "hit"
+	RedirectMiss = "miss"
+	RedirectGone = "gone"
+)
+
+// Metrics holds the collectors of the service in a registry of its own.
+type Metrics struct {
+	registry          *prometheus.Registry
+	httpRequests      *prometheus.CounterVec
+	httpDuration      *prometheus.HistogramVec
+	grpcRequests      *prometheus.CounterVec
+	grpcDuration      *prometheus.HistogramVec
+	redirects         *prometheus.CounterVec
+	storageDuration   *prometheus.HistogramVec
+	dumperWriteErrors prometheus.Counter
+}
+
+// New creates the collectors of the service and registers them together with
+// the Go runtime and process collectors.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Number of HTTP requests handled, by route, method and status code.",
+		}, []string{"route", "method", "code"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the HTTP requests, by route and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+		grpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of gRPC calls handled, by method and status code.",
+		}, []string{"method", "code"}),
+		grpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the gRPC calls, by method. Streams last until they end.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		redirects: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "redirects_total",
+			Help:      "Number of short links resolved, by result: hit, miss or gone.",
+		}, []string{"result"}),
+		storageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "operation_duration_seconds",
+			Help:      "Latency of the URL storage operations, by backend and operation.",
+			Buckets:   []float64{.0001, .0005, .001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"backend", "operation"}),
+		dumperWriteErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "dumper",
+			Name:      "write_errors_total",
+			Help:      "Number of failed writes to the dump file.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.grpcRequests,
+		m.grpcDuration,
+		m.redirects,
+		m.storageDuration,
+		m.dumperWriteErrors,
+	)
+	return m
+}
+
+// Handler returns the handler serving the metrics in the Prometheus text format.
+// The handler does not compress them itself, the HTTP middlewares do.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{DisableCompression: true})
+}
+
+// ObserveHTTPRequest counts an HTTP request of the route pattern and records its latency.
+func (m *Metrics) ObserveHTTPRequest(route, method string, code int, duration time.Duration) {
+	m.httpRequests.WithLabelValues(route, method, strconv.Itoa(code)).Inc()
+	m.httpDuration.WithLabelValues(route, method).Observe(duration.Seconds())
+}
+
+// ObserveGRPCRequest counts a gRPC call of the full method name and records its latency.
+func (m *Metrics) ObserveGRPCRequest(method, code string, duration time.Duration) {
+	m.grpcRequests.WithLabelValues(method, code).Inc()
+	m.grpcDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// ObserveRedirect counts a short link resolved with one of the Redirect results.
+func (m *Metrics) ObserveRedirect(result string) {
+	m.redirects.WithLabelValues(result).Inc()
+}
+
+// ObserveStorage records the latency of a URL storage operation of the backend.
+func (m *Metrics) ObserveStorage(backend, operation string, duration time.Duration) {
+	m.storageDuration.WithLabelValues(backend, operation).Observe(duration.Seconds())
+}
+
+// IncDumperWriteErrors counts a failed write to the dump file.
+func (m *Metrics) IncDumperWriteErrors() {
+	m.dumperWriteErrors.Inc()
+}
+
+// RegisterQueue exposes the number of tasks waiting in the queue of the named worker pool.
+// The depth is read on every scrape.
+func (m *Metrics) RegisterQueue(pool string, depth func() int) {
+	m.registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Subsystem:   "workerpool",
+		Name:        "queue_depth",
+		Help:        "Number of tasks waiting in the queue of the worker pool.",
+		ConstLabels: prometheus.Labels{"pool": pool},
+	}, func() float64 {
+		return float64(depth())
+	}))
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics_Handler(t *testing.T) {
+	m := New()
+	m.ObserveHTTPRequest("/{id}", http.MethodGet, http.StatusTemporaryRedirect, 5*time.Millisecond)
+	m.ObserveGRPCRequest("/snipurl.SnipURLService/GetOriginalURL", "OK", time.Millisecond)
+	m.ObserveRedirect(RedirectHit)
+	m.ObserveRedirect(RedirectGone)
+	m.ObserveStorage("memory", "GetURL", time.Microsecond)
+	m.IncDumperWriteErrors()
+	m.RegisterQueue("delete", func() int { return 3 })
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Header().Get("Content-Type"), "text/plain")
+	body := w.Body.String()
+	for _, want := range []string{
+		`snipurl_http_requests_total{code="307",method="GET",route="/{id}"} 1`,
+		`snipurl_http_request_duration_seconds_count{method="GET",route="/{id}"} 1`,
+		`snipurl_grpc_requests_total{code="OK",method="/snipurl.SnipURLService/GetOriginalURL"} 1`,
+		`snipurl_redirects_total{result="hit"} 1`,
+		`snipurl_redirects_total{result="gone"} 1`,
+		`snipurl_storage_operation_duration_seconds_count{backend="memory",operation="GetURL"} 1`,
+		`snipurl_dumper_write_errors_total 1`,
+		`snipurl_workerpool_queue_depth{pool="delete"} 3`,
+		`go_goroutines`,
+	} {
+		require.Contains(t, body, want)
+	}
+}
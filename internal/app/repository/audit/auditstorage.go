@@ -0,0 +1,13 @@
+package audit
+
+import "context"
+
+// AuditStorage defines the interface for the append-only audit log. AddEntries numbers
+// the entries in the order they are added; entries are never removed, and only changed
+// by AnonymizeEntries, which replaces the user ID of the user's entries with the pseudonym
+// and clears their IP when the user's data is erased.
+type AuditStorage interface {
+	AddEntries(ctx context.Context, entries ...*Entry) error
+	GetEntries(ctx context.Context, filter *Filter) ([]*Entry, error)
+	AnonymizeEntries(ctx context.Context, userID, pseudonym string) error
+}
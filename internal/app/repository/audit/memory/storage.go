@@ -0,0 +1,221 @@
+package memory
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+)
+
+// maxLineSize bounds the length of a line of the audit log file.
+const maxLineSize = 64 * 1024
+
+// line is an entry of the audit log as a line of the file.
+type line struct {
+	ID         int64     `json:"id"`
+	UserID     string    `json:"user_id"`
+	Action     string    `json:"action"`
+	ShortURL   string    `json:"short_url"`
+	IP         string    `json:"ip,omitempty"`
+	AuthMethod string    `json:"auth_method,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type storage struct {
+	mu      sync.RWMutex
+	path    string
+	file    *os.File
+	entries []*auditstorage.Entry
+}
+
+// NewStorage opens the JSON Lines file of the audit log at the path, creating it if needed,
+// and reads the entries written to it before. Every entry is appended to the file before
+// it is kept; the file is only rewritten to anonymise the entries of a user.
+func NewStorage(path string) (*storage, error) {
+	file, err := openFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &storage{path: path, file: file}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxLineSize)
+	for n := 1; scanner.Scan(); n++ {
+		var l line
+		if err := json.Unmarshal(scanner.Bytes(), &l); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("audit log line %d: %w", n, err)
+		}
+		s.entries = append(s.entries, entryFromLine(&l))
+	}
+	if err := scanner.Err(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// AddEntries numbers the entries and appends them to the file with a single write.
+func (s *storage) AddEntries(_ context.Context, entries ...*auditstorage.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var seq int64
+	if len(s.entries) > 0 {
+		seq = s.entries[len(s.entries)-1].ID
+	}
+
+	added := make([]*auditstorage.Entry, 0, len(entries))
+	var data []byte
+	for i, entry := range entries {
+		e := *entry
+		e.ID = seq + int64(i) + 1
+		l, err := json.Marshal(lineFromEntry(&e))
+		if err != nil {
+			return err
+		}
+		data = append(append(data, l...), '\n')
+		added = append(added, &e)
+	}
+	if _, err := s.file.Write(data); err != nil {
+		return err
+	}
+	s.entries = append(s.entries, added...)
+	return nil
+}
+
+// AnonymizeEntries replaces the user ID of the user's entries with the pseudonym and clears
+// their IP. The file is rewritten through a synced temporary file renamed over it, so that
+// a crash leaves either the old or the new log in place.
+func (s *storage) AnonymizeEntries(_ context.Context, userID, pseudonym string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := make([]*auditstorage.Entry, len(s.entries))
+	changed := false
+	for i, entry := range s.entries {
+		e := *entry
+		if e.UserID == userID {
+			e.UserID = pseudonym
+			e.IP = ""
+			changed = true
+		}
+		entries[i] = &e
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := s.rewrite(entries); err != nil {
+		return err
+	}
+	s.entries = entries
+	return nil
+}
+
+// rewrite replaces the file with the entries. The caller holds s.mu.
+func (s *storage) rewrite(entries []*auditstorage.Entry) (err error) {
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	writer := bufio.NewWriter(tmp)
+	for _, entry := range entries {
+		l, err := json.Marshal(lineFromEntry(entry))
+		if err != nil {
+			return err
+		}
+		if _, err := writer.Write(append(l, '\n')); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	// The old handle refers to the replaced file, so later entries go to the new one.
+	file, err := openFile(s.path)
+	if err != nil {
+		return err
+	}
+	s.file.Close()
+	s.file = file
+	return nil
+}
+
+// GetEntries returns copies of the entries selected by the filter, newest first.
+func (s *storage) GetEntries(_ context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var entries []*auditstorage.Entry
+	for i := len(s.entries) - 1; i >= 0; i-- {
+		if filter.Limit > 0 && len(entries) == filter.Limit {
+			break
+		}
+		if filter.Match(s.entries[i]) {
+			e := *s.entries[i]
+			entries = append(entries, &e)
+		}
+	}
+	return entries, nil
+}
+
+// Close closes the file of the audit log.
+func (s *storage) Close() error {
+	return s.file.Close()
+}
+
+func openFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_APPEND|os.O_RDWR|os.O_CREATE, 0600)
+}
+
+func entryFromLine(l *line) *auditstorage.Entry {
+	return &auditstorage.Entry{
+		ID:         l.ID,
+		UserID:     l.UserID,
+		Action:     l.Action,
+		ShortURL:   l.ShortURL,
+		IP:         l.IP,
+		AuthMethod: l.AuthMethod,
+		CreatedAt:  l.CreatedAt,
+	}
+}
+
+func lineFromEntry(entry *auditstorage.Entry) *line {
+	return &line{
+		ID:         entry.ID,
+		UserID:     entry.UserID,
+		Action:     entry.Action,
+		ShortURL:   entry.ShortURL,
+		IP:         entry.IP,
+		AuthMethod: entry.AuthMethod,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
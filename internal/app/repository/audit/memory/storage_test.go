@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetEntries(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	s, err := NewStorage(path)
+	require.NoError(t, err)
+	require.NoError(t, s.AddEntries(ctx,
+		&auditstorage.Entry{UserID: "alice", Action: auditstorage.ActionCreate, ShortURL: "a", IP: "10.0.0.1", AuthMethod: auditstorage.AuthCookie, CreatedAt: start},
+		&auditstorage.Entry{UserID: "alice", Action: auditstorage.ActionCreate, ShortURL: "b", CreatedAt: start.Add(time.Minute)},
+	))
+	require.NoError(t, s.AddEntries(ctx,
+		&auditstorage.Entry{UserID: "bob", Action: auditstorage.ActionCreate, ShortURL: "c", CreatedAt: start.Add(2 * time.Minute)},
+		&auditstorage.Entry{UserID: "alice", Action: auditstorage.ActionDelete, ShortURL: "a", CreatedAt: start.Add(3 * time.Minute)},
+	))
+	require.NoError(t, s.Close())
+
+	// The entries are restored from the file.
+	s, err = NewStorage(path)
+	require.NoError(t, err)
+	defer s.Close()
+
+	tests := []struct {
+		name    string
+		filter  *auditstorage.Filter
+		wantIDs []int64
+	}{
+		{
+			name:    "all newest first",
+			filter:  &auditstorage.Filter{},
+			wantIDs: []int64{4, 3, 2, 1},
+		},
+		{
+			name:    "user",
+			filter:  &auditstorage.Filter{UserID: "alice"},
+			wantIDs: []int64{4, 2, 1},
+		},
+		{
+			name:    "link and action",
+			filter:  &auditstorage.Filter{ShortURL: "a", Action: auditstorage.ActionCreate},
+			wantIDs: []int64{1},
+		},
+		{
+			name:    "time range",
+			filter:  &auditstorage.Filter{From: start.Add(time.Minute), To: start.Add(3 * time.Minute)},
+			wantIDs: []int64{3, 2},
+		},
+		{
+			name:    "page",
+			filter:  &auditstorage.Filter{Before: 4, Limit: 2},
+			wantIDs: []int64{3, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := s.GetEntries(ctx, tt.filter)
+			require.NoError(t, err)
+			ids := make([]int64, 0, len(entries))
+			for _, entry := range entries {
+				ids = append(ids, entry.ID)
+			}
+			require.Equal(t, tt.wantIDs, ids)
+		})
+	}
+
+	entries, err := s.GetEntries(ctx, &auditstorage.Filter{Limit: 1, Before: 2})
+	require.NoError(t, err)
+	require.Equal(t, &auditstorage.Entry{
+		ID:         1,
+		UserID:     "alice",
+		Action:     auditstorage.ActionCreate,
+		ShortURL:   "a",
+		IP:         "10.0.0.1",
+		AuthMethod: auditstorage.AuthCookie,
+		CreatedAt:  start,
+	}, entries[0])
+
+	// The numbering goes on after a restart.
+	require.NoError(t, s.AddEntries(ctx, &auditstorage.Entry{UserID: "bob", Action: auditstorage.ActionUpdate, ShortURL: "c", CreatedAt: start.Add(4 * time.Minute)}))
+	entries, err = s.GetEntries(ctx, &auditstorage.Filter{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), entries[0].ID)
+}
+
+func TestNewStorage_Corrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("{\"id\":1}\nnot json\n"), 0600))
+
+	_, err := NewStorage(path)
+	require.ErrorContains(t, err, "audit log line 2")
+}
+
+func TestStorage_AnonymizeEntries(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	s, err := NewStorage(path)
+	require.NoError(t, err)
+	require.NoError(t, s.AddEntries(ctx,
+		&auditstorage.Entry{UserID: "alice", Action: auditstorage.ActionCreate, ShortURL: "a", IP: "10.0.0.1", AuthMethod: auditstorage.AuthCookie, CreatedAt: start},
+		&auditstorage.Entry{UserID: "bob", Action: auditstorage.ActionCreate, ShortURL: "b", IP: "10.0.0.2", CreatedAt: start},
+	))
+
+	require.NoError(t, s.AnonymizeEntries(ctx, "alice", "erased-1"))
+	require.NoError(t, s.AddEntries(ctx, &auditstorage.Entry{UserID: "bob", Action: auditstorage.ActionDelete, ShortURL: "b", CreatedAt: start}))
+	require.NoError(t, s.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "alice")
+	require.NotContains(t, string(data), "10.0.0.1")
+
+	// The anonymised entries and the ones added after are restored from the file.
+	s, err = NewStorage(path)
+	require.NoError(t, err)
+	defer s.Close()
+
+	entries, err := s.GetEntries(ctx, &auditstorage.Filter{})
+	require.NoError(t, err)
+	require.Equal(t, []*auditstorage.Entry{
+		{ID: 3, UserID: "bob", Action: auditstorage.ActionDelete, ShortURL: "b", CreatedAt: start},
+		{ID: 2, UserID: "bob", Action: auditstorage.ActionCreate, ShortURL: "b", IP: "10.0.0.2", CreatedAt: start},
+		{ID: 1, UserID: "erased-1", Action: auditstorage.ActionCreate, ShortURL: "a", AuthMethod: auditstorage.AuthCookie, CreatedAt: start},
+	}, entries)
+}
@@ -0,0 +1,63 @@
+package audit
+
+import "time"
+
+// Actions recorded in the audit log.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Authentication methods of the users acting on links.
+const (
+	// AuthCookie identifies a user by the signed cookie of the HTTP API.
+	AuthCookie = "cookie"
+	// AuthMetadata identifies a user by the signed token in the gRPC metadata.
+	AuthMetadata = "metadata"
+	// AuthIssued is a user whose ID was issued with the request, as it carried none
+	// or a revoked one.
+	AuthIssued = "issued"
+)
+
+// PseudonymPrefix starts the pseudonyms that replace the user IDs of anonymised entries.
+const PseudonymPrefix = "erased-"
+
+// Entry records an action of a user on a link: who did it, when, from which IP and
+// with which authentication method. ID orders the entries in the order they were added.
+type Entry struct {
+	ID         int64
+	UserID     string
+	Action     string
+	ShortURL   string
+	IP         string
+	AuthMethod string
+	CreatedAt  time.Time
+}
+
+// Filter selects entries of the audit log. Empty fields match every entry; From and To
+// bound the time of the entries, To exclusive. Entries are returned newest first, those
+// older than the entry with the ID Before when it is set, at most Limit of them.
+type Filter struct {
+	UserID   string
+	ShortURL string
+	Action   string
+	From     time.Time
+	To       time.Time
+	Before   int64
+	Limit    int
+}
+
+// Match reports whether the entry is selected by the filter, regardless of Limit.
+func (f *Filter) Match(entry *Entry) bool {
+	switch {
+	case f.UserID != "" && entry.UserID != f.UserID,
+		f.ShortURL != "" && entry.ShortURL != f.ShortURL,
+		f.Action != "" && entry.Action != f.Action,
+		!f.From.IsZero() && entry.CreatedAt.Before(f.From),
+		!f.To.IsZero() && !entry.CreatedAt.Before(f.To),
+		f.Before > 0 && entry.ID >= f.Before:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	"github.com/DanilNaum/SnipURL/pkg/utils/placeholder"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// insertColumnNum is the number of columns written per entry.
+const insertColumnNum = 6
+
+type storage struct {
+	conn *pgxpool.Pool
+}
+
+// NewStorage creates a new storage of the audit log with the provided database connection pool.
+// The table rejects updates and deletions of its rows, except the anonymisation of a user's rows.
+func NewStorage(conn *pgxpool.Pool) *storage {
+	return &storage{
+		conn: conn,
+	}
+}
+
+// AddEntries inserts the entries with a single statement, numbering them by the sequence
+// of the table.
+func (s *storage) AddEntries(ctx context.Context, entries ...*auditstorage.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO audit_log (user_uuid, action, short_url, ip, auth_method, created_at) VALUES ` +
+		placeholder.MakeDollars(placeholder.WithColumnNumAndRowNum(insertColumnNum, len(entries)))
+	args := make([]interface{}, 0, insertColumnNum*len(entries))
+	for _, entry := range entries {
+		args = append(args, entry.UserID, entry.Action, entry.ShortURL, entry.IP, entry.AuthMethod, entry.CreatedAt)
+	}
+
+	_, err := s.conn.Exec(ctx, query, args...)
+	return err
+}
+
+// AnonymizeEntries replaces the user ID of the user's entries with the pseudonym and
+// clears their IP. The table lets only this update through.
+func (s *storage) AnonymizeEntries(ctx context.Context, userID, pseudonym string) error {
+	_, err := s.conn.Exec(ctx, `UPDATE audit_log SET user_uuid = $2, ip = '' WHERE user_uuid = $1`, userID, pseudonym)
+	return err
+}
+
+// GetEntries returns the entries selected by the filter, newest first.
+func (s *storage) GetEntries(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error) {
+	args := []interface{}{filter.UserID, filter.ShortURL, filter.Action}
+	query := `SELECT id, user_uuid, action, short_url, ip, auth_method, created_at FROM audit_log
+	WHERE ($1 = '' OR user_uuid = $1) AND ($2 = '' OR short_url = $2) AND ($3 = '' OR action = $3)`
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		query += fmt.Sprintf(` AND created_at >= $%d`, len(args))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		query += fmt.Sprintf(` AND created_at < $%d`, len(args))
+	}
+	if filter.Before > 0 {
+		args = append(args, filter.Before)
+		query += fmt.Sprintf(` AND id < $%d`, len(args))
+	}
+	query += ` ORDER BY id DESC`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+
+	rows, err := s.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*auditstorage.Entry
+	for rows.Next() {
+		var entry auditstorage.Entry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.UserID,
+			&entry.Action,
+			&entry.ShortURL,
+			&entry.IP,
+			&entry.AuthMethod,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,62 @@
+package psql
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	"github.com/DanilNaum/SnipURL/pkg/migration"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// testDSNEnv names the variable with the DSN of a PostgreSQL database for the tests
+// of this package. The tests are skipped when it is not set.
+const testDSNEnv = "TEST_DATABASE_DSN"
+
+func TestStorage_AnonymizeEntries(t *testing.T) {
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+	migrations, err := filepath.Abs("../../../../../migrations")
+	require.NoError(t, err)
+	require.NoError(t, migration.NewMigrator(dsn, migration.WithAbsolutePath(migrations)).Migrate())
+
+	ctx := context.Background()
+	pool, err := pgxpool.Connect(ctx, dsn)
+	require.NoError(t, err)
+	defer pool.Close()
+	s := NewStorage(pool)
+
+	userID := uuid.NewString()
+	pseudonym := auditstorage.PseudonymPrefix + uuid.NewString()
+	require.NoError(t, s.AddEntries(ctx, &auditstorage.Entry{
+		UserID:     userID,
+		Action:     auditstorage.ActionCreate,
+		ShortURL:   "a",
+		IP:         "10.0.0.1",
+		AuthMethod: auditstorage.AuthCookie,
+		CreatedAt:  time.Now(),
+	}))
+
+	require.NoError(t, s.AnonymizeEntries(ctx, userID, pseudonym))
+
+	entries, err := s.GetEntries(ctx, &auditstorage.Filter{UserID: userID})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+	entries, err = s.GetEntries(ctx, &auditstorage.Filter{UserID: pseudonym})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Empty(t, entries[0].IP)
+
+	// Any other change of the log is still rejected.
+	_, err = pool.Exec(ctx, `UPDATE audit_log SET action = 'delete' WHERE user_uuid = $1`, pseudonym)
+	require.Error(t, err)
+	_, err = pool.Exec(ctx, `DELETE FROM audit_log WHERE user_uuid = $1`, pseudonym)
+	require.Error(t, err)
+}
@@ -0,0 +1,13 @@
+package click
+
+import "context"
+
+// ClickStorage defines the interface for click analytics storage operations.
+// It records redirects of short URLs and aggregates them per country.
+// AnonymizeClicks detaches the clicks of the short URLs from them, so that they
+// no longer tell whose links were visited.
+type ClickStorage interface {
+	AddClick(ctx context.Context, click *Click) error
+	GetClickStats(ctx context.Context, shortURL string) (*ClickStats, error)
+	AnonymizeClicks(ctx context.Context, shortURLs []string) error
+}
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	clickstorage "github.com/DanilNaum/SnipURL/internal/app/repository/click"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+)
+
+// clicksKind is the kind of the states written to the dumper log.
+const clicksKind = "clicks"
+
+type dumper interface {
+	AddStates(states ...*dump.State) error
+	ReadStates(kind string) ([]*dump.State, error)
+}
+
+type storage struct {
+	mu        sync.RWMutex
+	dumper    dumper
+	byCountry map[string]map[string]int
+}
+
+// NewStorage creates an in-memory click analytics storage backed by the dumper log,
+// restoring the counters written to it before. Only per-country counters are kept;
+// every click appends the short URL's counters to the log before it is applied.
+func NewStorage(dumper dumper) (*storage, error) {
+	s := &storage{
+		dumper:    dumper,
+		byCountry: make(map[string]map[string]int),
+	}
+
+	states, err := dumper.ReadStates(clicksKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range states {
+		var counters map[string]int
+		if err := json.Unmarshal(state.Value, &counters); err != nil {
+			return nil, err
+		}
+		s.byCountry[state.Key] = counters
+	}
+	return s, nil
+}
+
+// AddClick counts the click under its short URL and country.
+func (s *storage) AddClick(_ context.Context, click *clickstorage.Click) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counters := make(map[string]int, len(s.byCountry[click.ShortURL])+1)
+	for country, clicks := range s.byCountry[click.ShortURL] {
+		counters[country] = clicks
+	}
+	counters[click.Country]++
+
+	state, err := dump.NewState(clicksKind, click.ShortURL, counters)
+	if err != nil {
+		return err
+	}
+	if err := s.dumper.AddStates(state); err != nil {
+		return err
+	}
+	s.byCountry[click.ShortURL] = counters
+	return nil
+}
+
+// GetClickStats returns a copy of the short URL's click counters.
+func (s *storage) GetClickStats(_ context.Context, shortURL string) (*clickstorage.ClickStats, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := &clickstorage.ClickStats{
+		ByCountry: make(map[string]int, len(s.byCountry[shortURL])),
+	}
+	for country, clicks := range s.byCountry[shortURL] {
+		stats.ByCountry[country] = clicks
+		stats.Total += clicks
+	}
+	return stats, nil
+}
+
+// AnonymizeClicks drops the counters of the short URLs. Only the counters are kept
+// in memory, and they are of no use once detached from their short URL.
+func (s *storage) AnonymizeClicks(_ context.Context, shortURLs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted []*dump.State
+	for _, shortURL := range shortURLs {
+		if _, ok := s.byCountry[shortURL]; ok {
+			deleted = append(deleted, dump.DeletedState(clicksKind, shortURL))
+		}
+	}
+	if len(deleted) > 0 {
+		if err := s.dumper.AddStates(deleted...); err != nil {
+			return err
+		}
+	}
+
+	for _, shortURL := range shortURLs {
+		delete(s.byCountry, shortURL)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package click
+
+import "time"
+
+// Click is a single redirect of a short URL.
+// Country is the ISO code of the client's location, empty if unknown.
+type Click struct {
+	ShortURL string
+	Time     time.Time
+	Country  string
+	Variant  string
+}
+
+// ClickStats aggregates the clicks of a short URL.
+type ClickStats struct {
+	Total     int
+	ByCountry map[string]int
+}
@@ -0,0 +1,60 @@
+package psql
+
+import (
+	"context"
+
+	clickstorage "github.com/DanilNaum/SnipURL/internal/app/repository/click"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type storage struct {
+	conn *pgxpool.Pool
+}
+
+// NewStorage creates a new click analytics storage with the provided database connection pool.
+func NewStorage(conn *pgxpool.Pool) *storage {
+	return &storage{
+		conn: conn,
+	}
+}
+
+// AddClick stores the click.
+func (s *storage) AddClick(ctx context.Context, click *clickstorage.Click) error {
+	query := `INSERT INTO url_click (url_id, clicked_at, country, variant) VALUES ($1, $2, $3, $4)`
+
+	_, err := s.conn.Exec(ctx, query, click.ShortURL, click.Time, click.Country, click.Variant)
+	return err
+}
+
+// GetClickStats returns the total and per-country click counts of the short URL.
+func (s *storage) GetClickStats(ctx context.Context, shortURL string) (*clickstorage.ClickStats, error) {
+	query := `SELECT country, count(*) FROM url_click WHERE url_id = $1 GROUP BY country`
+
+	rows, err := s.conn.Query(ctx, query, shortURL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := &clickstorage.ClickStats{
+		ByCountry: make(map[string]int),
+	}
+	for rows.Next() {
+		var country string
+		var clicks int
+		if err := rows.Scan(&country, &clicks); err != nil {
+			return nil, err
+		}
+		stats.ByCountry[country] = clicks
+		stats.Total += clicks
+	}
+	return stats, rows.Err()
+}
+
+// AnonymizeClicks detaches the clicks of the short URLs from them and from the
+// variants they were served, keeping only their time and country.
+func (s *storage) AnonymizeClicks(ctx context.Context, shortURLs []string) error {
+	query := `UPDATE url_click SET url_id = NULL, variant = '' WHERE url_id = ANY($1)`
+	_, err := s.conn.Exec(ctx, query, shortURLs)
+	return err
+}
@@ -0,0 +1,164 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	outboxstorage "github.com/DanilNaum/SnipURL/internal/app/repository/outbox"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+)
+
+type dumper interface {
+	AddEvents(events ...*dump.Event) error
+	AddCheckpoint(checkpoint *dump.Checkpoint) error
+	ReadEvents() ([]*dump.Event, []*dump.Checkpoint, error)
+	RemoveEvents(upTo int64, checkpoints []*dump.Checkpoint) error
+}
+
+type storage struct {
+	mu          sync.RWMutex
+	dumper      dumper
+	records     []*outboxstorage.Record
+	seq         int64
+	checkpoints map[string]int64
+}
+
+// NewStorage creates an in-memory outbox backed by the dumper log, restoring the events
+// and checkpoints written to it before. Every event is appended to the log before it is
+// added to the outbox, so that the events not yet handled survive a restart.
+func NewStorage(dumper dumper) (*storage, error) {
+	dumped, checkpoints, err := dumper.ReadEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &storage{
+		dumper:      dumper,
+		records:     make([]*outboxstorage.Record, 0, len(dumped)),
+		checkpoints: make(map[string]int64, len(checkpoints)),
+	}
+	for _, event := range dumped {
+		s.records = append(s.records, recordFromDump(event))
+		s.seq = max(s.seq, event.Seq)
+	}
+	for _, checkpoint := range checkpoints {
+		s.checkpoints[checkpoint.Subscriber] = checkpoint.Seq
+		s.seq = max(s.seq, checkpoint.Seq)
+	}
+	return s, nil
+}
+
+// AddEvents numbers the events and appends them to the outbox. The URL storage calls it
+// under its own lock, so that the events are numbered in the order of the changes.
+func (s *storage) AddEvents(evs ...*events.Event) error {
+	if len(evs) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := make([]*outboxstorage.Record, 0, len(evs))
+	dumped := make([]*dump.Event, 0, len(evs))
+	for i, event := range evs {
+		e := *event
+		record := &outboxstorage.Record{Seq: s.seq + int64(i) + 1, Event: &e}
+		records = append(records, record)
+		dumped = append(dumped, recordToDump(record))
+	}
+	if err := s.dumper.AddEvents(dumped...); err != nil {
+		return err
+	}
+	s.records = append(s.records, records...)
+	s.seq += int64(len(records))
+	return nil
+}
+
+// GetEvents returns copies of at most limit events following the given sequence number.
+func (s *storage) GetEvents(_ context.Context, after int64, limit int) ([]*outboxstorage.Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	i := s.indexAfter(after)
+	records := make([]*outboxstorage.Record, 0, min(len(s.records)-i, limit))
+	for ; i < len(s.records) && len(records) < limit; i++ {
+		e := *s.records[i].Event
+		records = append(records, &outboxstorage.Record{Seq: s.records[i].Seq, Event: &e})
+	}
+	return records, nil
+}
+
+// GetCheckpoint returns the checkpoint of the subscriber, zero if it has none.
+func (s *storage) GetCheckpoint(_ context.Context, subscriber string) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.checkpoints[subscriber], nil
+}
+
+// SetCheckpoint writes the checkpoint of the subscriber to the dumper log and keeps it.
+func (s *storage) SetCheckpoint(_ context.Context, subscriber string, seq int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.dumper.AddCheckpoint(&dump.Checkpoint{Subscriber: subscriber, Seq: seq}); err != nil {
+		return err
+	}
+	s.checkpoints[subscriber] = seq
+	return nil
+}
+
+// DeleteEvents removes the events up to the given sequence number and rewrites the
+// dumper log without them, keeping only the current checkpoints.
+func (s *storage) DeleteEvents(_ context.Context, upTo int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints := make([]*dump.Checkpoint, 0, len(s.checkpoints))
+	for subscriber, seq := range s.checkpoints {
+		checkpoints = append(checkpoints, &dump.Checkpoint{Subscriber: subscriber, Seq: seq})
+	}
+	if err := s.dumper.RemoveEvents(upTo, checkpoints); err != nil {
+		return err
+	}
+	s.records = append([]*outboxstorage.Record(nil), s.records[s.indexAfter(upTo):]...)
+	return nil
+}
+
+// indexAfter returns the index of the first record following the sequence number.
+// The records are ordered by their sequence numbers.
+func (s *storage) indexAfter(seq int64) int {
+	return sort.Search(len(s.records), func(i int) bool { return s.records[i].Seq > seq })
+}
+
+func recordFromDump(event *dump.Event) *outboxstorage.Record {
+	return &outboxstorage.Record{
+		Seq: event.Seq,
+		Event: &events.Event{
+			ID:          event.ID,
+			Type:        event.Type,
+			UserID:      event.UserID,
+			ShortURL:    event.ShortURL,
+			OriginalURL: event.OriginalURL,
+			Clicks:      event.Clicks,
+			Reason:      event.Reason,
+			OccurredAt:  event.OccurredAt,
+		},
+	}
+}
+
+func recordToDump(record *outboxstorage.Record) *dump.Event {
+	return &dump.Event{
+		Seq:         record.Seq,
+		ID:          record.Event.ID,
+		Type:        record.Event.Type,
+		UserID:      record.Event.UserID,
+		ShortURL:    record.Event.ShortURL,
+		OriginalURL: record.Event.OriginalURL,
+		Clicks:      record.Event.Clicks,
+		Reason:      record.Event.Reason,
+		OccurredAt:  record.Event.OccurredAt,
+	}
+}
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "dump.json")
+	d, err := dump.NewDumper(path, nil)
+	require.NoError(t, err)
+	s, err := NewStorage(d)
+	require.NoError(t, err)
+
+	require.NoError(t, s.AddEvents(events.New(events.TypeLinkCreated, "a"), events.New(events.TypeLinkCreated, "b")))
+	require.NoError(t, s.AddEvents(events.New(events.TypeLinkDeleted, "a")))
+
+	records, err := s.GetEvents(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, int64(2), records[0].Seq)
+	require.Equal(t, "b", records[0].Event.ShortURL)
+	require.Equal(t, int64(3), records[1].Seq)
+	require.Equal(t, events.TypeLinkDeleted, records[1].Event.Type)
+
+	records, err = s.GetEvents(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(1), records[0].Seq)
+
+	require.NoError(t, s.SetCheckpoint(ctx, "fast", 1))
+	require.NoError(t, s.SetCheckpoint(ctx, "fast", 3))
+	require.NoError(t, s.SetCheckpoint(ctx, "slow", 2))
+	require.NoError(t, s.DeleteEvents(ctx, 2))
+	require.NoError(t, d.Close())
+
+	// The events not deleted and the checkpoints are restored, and the numbering goes on.
+	d, err = dump.NewDumper(path, nil)
+	require.NoError(t, err)
+	defer d.Close()
+	s, err = NewStorage(d)
+	require.NoError(t, err)
+
+	records, err = s.GetEvents(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(3), records[0].Seq)
+	require.Equal(t, "a", records[0].Event.ShortURL)
+
+	checkpoint, err := s.GetCheckpoint(ctx, "fast")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), checkpoint)
+	checkpoint, err = s.GetCheckpoint(ctx, "slow")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), checkpoint)
+	checkpoint, err = s.GetCheckpoint(ctx, "new")
+	require.NoError(t, err)
+	require.Zero(t, checkpoint)
+
+	require.NoError(t, s.AddEvents(events.New(events.TypeLinkUpdated, "c")))
+	records, err = s.GetEvents(ctx, 3, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(4), records[0].Seq)
+}
@@ -0,0 +1,10 @@
+package outbox
+
+import "github.com/DanilNaum/SnipURL/internal/app/events"
+
+// Record is a domain event stored in the outbox. Seq orders the records in the order
+// their changes were committed and identifies them to the checkpoints.
+type Record struct {
+	Seq   int64
+	Event *events.Event
+}
@@ -0,0 +1,13 @@
+package outbox
+
+import "context"
+
+// OutboxStorage defines the interface for reading the outbox. The events are written by
+// the URL storage together with the changes they describe. Every subscriber of the
+// outbox keeps a checkpoint, the sequence number of the last event it has handled.
+type OutboxStorage interface {
+	GetEvents(ctx context.Context, after int64, limit int) ([]*Record, error)
+	GetCheckpoint(ctx context.Context, subscriber string) (int64, error)
+	SetCheckpoint(ctx context.Context, subscriber string, seq int64) error
+	DeleteEvents(ctx context.Context, upTo int64) error
+}
@@ -0,0 +1,125 @@
+package psql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	outboxstorage "github.com/DanilNaum/SnipURL/internal/app/repository/outbox"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// outboxLock is the key of the transaction-level advisory lock taken by AddEvents.
+const outboxLock = 0x5e7e17
+
+type storage struct {
+	conn *pgxpool.Pool
+}
+
+// NewStorage creates a new storage reading the outbox with the provided database connection pool.
+func NewStorage(conn *pgxpool.Pool) *storage {
+	return &storage{
+		conn: conn,
+	}
+}
+
+// AddEvents writes the events to the outbox within the transaction of the change they
+// describe. The transaction holds an advisory lock from then until it ends, so that the
+// events are committed in the order of their sequence numbers and a reader never passes
+// an event that is committed later with a smaller one.
+func AddEvents(ctx context.Context, tx pgx.Tx, evs ...*events.Event) error {
+	if len(evs) == 0 {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, outboxLock); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO outbox_event (id, type, user_uuid, short_url, original_url, clicks, reason, occurred_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	batch := &pgx.Batch{}
+	for _, event := range evs {
+		batch.Queue(query,
+			event.ID,
+			event.Type,
+			event.UserID,
+			event.ShortURL,
+			event.OriginalURL,
+			event.Clicks,
+			event.Reason,
+			event.OccurredAt,
+		)
+	}
+	results := tx.SendBatch(ctx, batch)
+	for range evs {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			return err
+		}
+	}
+	return results.Close()
+}
+
+// GetEvents returns at most limit events following the given sequence number.
+func (s *storage) GetEvents(ctx context.Context, after int64, limit int) ([]*outboxstorage.Record, error) {
+	query := `SELECT seq, id, type, user_uuid, short_url, original_url, clicks, reason, occurred_at
+	FROM outbox_event WHERE seq > $1 ORDER BY seq LIMIT $2`
+
+	rows, err := s.conn.Query(ctx, query, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]*outboxstorage.Record, 0, limit)
+	for rows.Next() {
+		var record outboxstorage.Record
+		var event events.Event
+		err := rows.Scan(
+			&record.Seq,
+			&event.ID,
+			&event.Type,
+			&event.UserID,
+			&event.ShortURL,
+			&event.OriginalURL,
+			&event.Clicks,
+			&event.Reason,
+			&event.OccurredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		record.Event = &event
+		records = append(records, &record)
+	}
+	return records, rows.Err()
+}
+
+// GetCheckpoint returns the checkpoint of the subscriber, zero if it has none.
+func (s *storage) GetCheckpoint(ctx context.Context, subscriber string) (int64, error) {
+	query := `SELECT seq FROM outbox_checkpoint WHERE subscriber = $1`
+
+	var seq int64
+	err := s.conn.QueryRow(ctx, query, subscriber).Scan(&seq)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	return seq, err
+}
+
+// SetCheckpoint stores the checkpoint of the subscriber.
+func (s *storage) SetCheckpoint(ctx context.Context, subscriber string, seq int64) error {
+	query := `INSERT INTO outbox_checkpoint (subscriber, seq) VALUES ($1, $2)
+	ON CONFLICT (subscriber) DO UPDATE SET seq = EXCLUDED.seq, updated_at = now()`
+
+	_, err := s.conn.Exec(ctx, query, subscriber, seq)
+	return err
+}
+
+// DeleteEvents removes the events up to the given sequence number.
+func (s *storage) DeleteEvents(ctx context.Context, upTo int64) error {
+	query := `DELETE FROM outbox_event WHERE seq <= $1`
+	_, err := s.conn.Exec(ctx, query, upTo)
+	return err
+}
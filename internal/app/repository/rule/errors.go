@@ -0,0 +1,6 @@
+package rule
+
+import "errors"
+
+// ErrNotFound indicates that the requested rule does not exist for the short URL.
+var ErrNotFound = errors.New("rule not found")
@@ -0,0 +1,187 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+)
+
+// Kinds of the states written to the dumper log. The last rule ID is kept apart from
+// the rules, so that the IDs of deleted rules are not reused after a restart.
+const (
+	ruleKind   = "rule"
+	lastIDKind = "rule_last_id"
+)
+
+type dumper interface {
+	AddStates(states ...*dump.State) error
+	ReadStates(kind string) ([]*dump.State, error)
+}
+
+type storage struct {
+	mu     sync.RWMutex
+	dumper dumper
+	lastID int
+	rules  map[string][]*rulestorage.Rule
+}
+
+// NewStorage creates an in-memory storage for routing rules backed by the dumper log,
+// restoring the rules written to it before. Every change is appended to the log before
+// it is applied, so that the rules survive a restart.
+func NewStorage(dumper dumper) (*storage, error) {
+	s := &storage{
+		dumper: dumper,
+		rules:  make(map[string][]*rulestorage.Rule),
+	}
+
+	lastID, err := dumper.ReadStates(lastIDKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range lastID {
+		if err := json.Unmarshal(state.Value, &s.lastID); err != nil {
+			return nil, err
+		}
+	}
+
+	rules, err := dumper.ReadStates(ruleKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range rules {
+		var rule rulestorage.Rule
+		if err := json.Unmarshal(state.Value, &rule); err != nil {
+			return nil, err
+		}
+		s.rules[rule.ShortURL] = append(s.rules[rule.ShortURL], &rule)
+		s.lastID = max(s.lastID, rule.ID)
+	}
+	for _, rules := range s.rules {
+		sortRules(rules)
+	}
+	return s, nil
+}
+
+// SetRule adds the rule to its short URL and returns the assigned rule ID.
+func (s *storage) SetRule(_ context.Context, rule *rulestorage.Rule) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := *rule
+	r.ID = s.lastID + 1
+	state, err := ruleState(&r)
+	if err != nil {
+		return 0, err
+	}
+	lastID, err := dump.NewState(lastIDKind, "", r.ID)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.dumper.AddStates(state, lastID); err != nil {
+		return 0, err
+	}
+
+	s.lastID = r.ID
+	s.rules[rule.ShortURL] = append(s.rules[rule.ShortURL], &r)
+	sortRules(s.rules[rule.ShortURL])
+
+	return r.ID, nil
+}
+
+// GetRules returns copies of the short URL's rules in evaluation order.
+func (s *storage) GetRules(_ context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rules := make([]*rulestorage.Rule, 0, len(s.rules[shortURL]))
+	for _, rule := range s.rules[shortURL] {
+		r := *rule
+		rules = append(rules, &r)
+	}
+	return rules, nil
+}
+
+// UpdateRule replaces the conditions, position and target of an existing rule.
+// Returns ErrNotFound if the short URL has no rule with that ID.
+func (s *storage) UpdateRule(_ context.Context, rule *rulestorage.Rule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules := s.rules[rule.ShortURL]
+	for i, existing := range rules {
+		if existing.ID == rule.ID {
+			r := *rule
+			state, err := ruleState(&r)
+			if err != nil {
+				return err
+			}
+			if err := s.dumper.AddStates(state); err != nil {
+				return err
+			}
+			rules[i] = &r
+			sortRules(rules)
+			return nil
+		}
+	}
+	return rulestorage.ErrNotFound
+}
+
+// DeleteRule removes the rule from its short URL.
+// Returns ErrNotFound if the short URL has no rule with that ID.
+func (s *storage) DeleteRule(_ context.Context, shortURL string, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules := s.rules[shortURL]
+	for i, existing := range rules {
+		if existing.ID == id {
+			if err := s.dumper.AddStates(dump.DeletedState(ruleKind, strconv.Itoa(id))); err != nil {
+				return err
+			}
+			s.rules[shortURL] = append(rules[:i], rules[i+1:]...)
+			return nil
+		}
+	}
+	return rulestorage.ErrNotFound
+}
+
+func sortRules(rules []*rulestorage.Rule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Position != rules[j].Position {
+			return rules[i].Position < rules[j].Position
+		}
+		return rules[i].ID < rules[j].ID
+	})
+}
+
+// DeleteRules removes all the rules of the short URLs.
+func (s *storage) DeleteRules(_ context.Context, shortURLs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted []*dump.State
+	for _, shortURL := range shortURLs {
+		for _, rule := range s.rules[shortURL] {
+			deleted = append(deleted, dump.DeletedState(ruleKind, strconv.Itoa(rule.ID)))
+		}
+	}
+	if len(deleted) > 0 {
+		if err := s.dumper.AddStates(deleted...); err != nil {
+			return err
+		}
+	}
+
+	for _, shortURL := range shortURLs {
+		delete(s.rules, shortURL)
+	}
+	return nil
+}
+
+func ruleState(rule *rulestorage.Rule) (*dump.State, error) {
+	return dump.NewState(ruleKind, strconv.Itoa(rule.ID), rule)
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_Rules(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "dump.json")
+	d, err := dump.NewDumper(path, nil)
+	require.NoError(t, err)
+	s, err := NewStorage(d)
+	require.NoError(t, err)
+
+	first, err := s.SetRule(ctx, &rulestorage.Rule{ShortURL: "abc", Position: 1, TargetURL: "https://a.example"})
+	require.NoError(t, err)
+	second, err := s.SetRule(ctx, &rulestorage.Rule{ShortURL: "abc", Position: 0, TargetURL: "https://b.example"})
+	require.NoError(t, err)
+	third, err := s.SetRule(ctx, &rulestorage.Rule{ShortURL: "other", TargetURL: "https://c.example"})
+	require.NoError(t, err)
+
+	rules, err := s.GetRules(ctx, "abc")
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	require.Equal(t, second, rules[0].ID)
+	require.Equal(t, first, rules[1].ID)
+
+	err = s.UpdateRule(ctx, &rulestorage.Rule{ID: first, ShortURL: "abc", Position: -1, TargetURL: "https://d.example"})
+	require.NoError(t, err)
+	rules, err = s.GetRules(ctx, "abc")
+	require.NoError(t, err)
+	require.Equal(t, "https://d.example", rules[0].TargetURL)
+
+	err = s.UpdateRule(ctx, &rulestorage.Rule{ID: first, ShortURL: "other"})
+	require.ErrorIs(t, err, rulestorage.ErrNotFound)
+
+	require.NoError(t, s.DeleteRule(ctx, "abc", first))
+	require.ErrorIs(t, s.DeleteRule(ctx, "abc", first), rulestorage.ErrNotFound)
+	rules, err = s.GetRules(ctx, "abc")
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+
+	require.NoError(t, s.DeleteRules(ctx, []string{"other"}))
+	require.NoError(t, d.Close())
+
+	// The remaining rules are restored, and the IDs of deleted rules are not reused.
+	d, err = dump.NewDumper(path, nil)
+	require.NoError(t, err)
+	defer d.Close()
+	s, err = NewStorage(d)
+	require.NoError(t, err)
+
+	restored, err := s.GetRules(ctx, "abc")
+	require.NoError(t, err)
+	require.Equal(t, rules, restored)
+	rules, err = s.GetRules(ctx, "other")
+	require.NoError(t, err)
+	require.Empty(t, rules)
+
+	id, err := s.SetRule(ctx, &rulestorage.Rule{ShortURL: "abc", TargetURL: "https://e.example"})
+	require.NoError(t, err)
+	require.Equal(t, third+1, id)
+}
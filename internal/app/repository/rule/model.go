@@ -0,0 +1,17 @@
+package rule
+
+// Rule is a routing rule of a short URL.
+// Empty conditions match any request. Country is a comma-separated list of
+// ISO 3166-1 alpha-2 codes where "EU" stands for any EU member. TimeFrom and TimeTo are "HH:MM" in UTC;
+// a window with TimeFrom after TimeTo spans midnight.
+type Rule struct {
+	ID        int
+	ShortURL  string
+	Position  int
+	Platform  string
+	Language  string
+	Country   string
+	TimeFrom  string
+	TimeTo    string
+	TargetURL string
+}
@@ -0,0 +1,125 @@
+package psql
+
+import (
+	"context"
+
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const (
+	expectedNumberOfRules = 10
+)
+
+type storage struct {
+	conn *pgxpool.Pool
+}
+
+// NewStorage creates a new routing rule storage with the provided database connection pool.
+func NewStorage(conn *pgxpool.Pool) *storage {
+	return &storage{
+		conn: conn,
+	}
+}
+
+// SetRule adds the rule to its short URL and returns the assigned rule ID.
+func (s *storage) SetRule(ctx context.Context, rule *rulestorage.Rule) (int, error) {
+	query := `INSERT INTO url_rule (url_id, position, platform, language, country, time_from, time_to, target_url)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
+
+	var id int
+	err := s.conn.QueryRow(ctx, query,
+		rule.ShortURL,
+		rule.Position,
+		rule.Platform,
+		rule.Language,
+		rule.Country,
+		rule.TimeFrom,
+		rule.TimeTo,
+		rule.TargetURL,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// GetRules returns the short URL's rules in evaluation order.
+func (s *storage) GetRules(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+	query := `SELECT id, url_id, position, platform, language, country, time_from, time_to, target_url
+	FROM url_rule WHERE url_id = $1 ORDER BY position, id`
+
+	rows, err := s.conn.Query(ctx, query, shortURL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]*rulestorage.Rule, 0, expectedNumberOfRules)
+	for rows.Next() {
+		var rule rulestorage.Rule
+		err := rows.Scan(
+			&rule.ID,
+			&rule.ShortURL,
+			&rule.Position,
+			&rule.Platform,
+			&rule.Language,
+			&rule.Country,
+			&rule.TimeFrom,
+			&rule.TimeTo,
+			&rule.TargetURL,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+	return rules, rows.Err()
+}
+
+// UpdateRule replaces the conditions, position and target of an existing rule.
+// Returns ErrNotFound if the short URL has no rule with that ID.
+func (s *storage) UpdateRule(ctx context.Context, rule *rulestorage.Rule) error {
+	query := `UPDATE url_rule SET position = $3, platform = $4, language = $5, country = $6, time_from = $7, time_to = $8, target_url = $9
+	WHERE id = $1 AND url_id = $2`
+
+	tag, err := s.conn.Exec(ctx, query,
+		rule.ID,
+		rule.ShortURL,
+		rule.Position,
+		rule.Platform,
+		rule.Language,
+		rule.Country,
+		rule.TimeFrom,
+		rule.TimeTo,
+		rule.TargetURL,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return rulestorage.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteRule removes the rule from its short URL.
+// Returns ErrNotFound if the short URL has no rule with that ID.
+func (s *storage) DeleteRule(ctx context.Context, shortURL string, id int) error {
+	query := `DELETE FROM url_rule WHERE id = $1 AND url_id = $2`
+	tag, err := s.conn.Exec(ctx, query, id, shortURL)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return rulestorage.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteRules removes all the rules of the short URLs.
+func (s *storage) DeleteRules(ctx context.Context, shortURLs []string) error {
+	query := `DELETE FROM url_rule WHERE url_id = ANY($1)`
+	_, err := s.conn.Exec(ctx, query, shortURLs)
+	return err
+}
@@ -0,0 +1,14 @@
+package rule
+
+import "context"
+
+// RuleStorage defines the interface for routing rule storage operations.
+// Rules are scoped by short URL and returned in evaluation order:
+// by position, then by creation.
+type RuleStorage interface {
+	SetRule(ctx context.Context, rule *Rule) (id int, err error)
+	GetRules(ctx context.Context, shortURL string) ([]*Rule, error)
+	UpdateRule(ctx context.Context, rule *Rule) error
+	DeleteRule(ctx context.Context, shortURL string, id int) error
+	DeleteRules(ctx context.Context, shortURLs []string) error
+}
@@ -0,0 +1,6 @@
+package template
+
+import "errors"
+
+// ErrNotFound indicates that the requested template does not exist for the user.
+var ErrNotFound = errors.New("template not found")
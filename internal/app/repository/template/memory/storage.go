@@ -0,0 +1,144 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+)
+
+// templateKind is the kind of the states written to the dumper log.
+const templateKind = "template"
+
+type dumper interface {
+	AddStates(states ...*dump.State) error
+	ReadStates(kind string) ([]*dump.State, error)
+}
+
+type storage struct {
+	mu        sync.RWMutex
+	dumper    dumper
+	templates map[string]map[string]*templatestorage.Template
+}
+
+// NewStorage creates an in-memory storage for tagging templates backed by the dumper log,
+// restoring the templates written to it before. Every change is appended to the log
+// before it is applied, so that the templates survive a restart.
+func NewStorage(dumper dumper) (*storage, error) {
+	s := &storage{
+		dumper:    dumper,
+		templates: make(map[string]map[string]*templatestorage.Template),
+	}
+
+	states, err := dumper.ReadStates(templateKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range states {
+		var template templatestorage.Template
+		if err := json.Unmarshal(state.Value, &template); err != nil {
+			return nil, err
+		}
+		s.userTemplates(template.UserID)[template.Name] = &template
+	}
+	return s, nil
+}
+
+// SetTemplate creates the template or replaces an existing one with the same name for the user.
+func (s *storage) SetTemplate(_ context.Context, template *templatestorage.Template) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := dump.NewState(templateKind, templateKey(template.UserID, template.Name), template)
+	if err != nil {
+		return err
+	}
+	if err := s.dumper.AddStates(state); err != nil {
+		return err
+	}
+
+	t := *template
+	s.userTemplates(template.UserID)[template.Name] = &t
+	return nil
+}
+
+// GetTemplate returns a copy of the user's template with the given name.
+// Returns ErrNotFound if there is no such template.
+func (s *storage) GetTemplate(_ context.Context, userID, name string) (*templatestorage.Template, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	template, ok := s.templates[userID][name]
+	if !ok {
+		return nil, templatestorage.ErrNotFound
+	}
+	t := *template
+	return &t, nil
+}
+
+// GetTemplates returns copies of all templates of the user.
+func (s *storage) GetTemplates(_ context.Context, userID string) ([]*templatestorage.Template, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	templates := make([]*templatestorage.Template, 0, len(s.templates[userID]))
+	for _, template := range s.templates[userID] {
+		t := *template
+		templates = append(templates, &t)
+	}
+	return templates, nil
+}
+
+// DeleteTemplate removes the user's template with the given name.
+// Returns ErrNotFound if there is no such template.
+func (s *storage) DeleteTemplate(_ context.Context, userID, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.templates[userID][name]; !ok {
+		return templatestorage.ErrNotFound
+	}
+	if err := s.dumper.AddStates(dump.DeletedState(templateKind, templateKey(userID, name))); err != nil {
+		return err
+	}
+	delete(s.templates[userID], name)
+	return nil
+}
+
+// DeleteTemplates removes all the templates of the user.
+func (s *storage) DeleteTemplates(_ context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := make([]*dump.State, 0, len(s.templates[userID]))
+	for name := range s.templates[userID] {
+		deleted = append(deleted, dump.DeletedState(templateKind, templateKey(userID, name)))
+	}
+	if len(deleted) > 0 {
+		if err := s.dumper.AddStates(deleted...); err != nil {
+			return err
+		}
+	}
+
+	delete(s.templates, userID)
+	return nil
+}
+
+// userTemplates returns the user's templates, creating the map on first use.
+// The caller must hold the lock.
+func (s *storage) userTemplates(userID string) map[string]*templatestorage.Template {
+	userTemplates, ok := s.templates[userID]
+	if !ok {
+		userTemplates = make(map[string]*templatestorage.Template)
+		s.templates[userID] = userTemplates
+	}
+	return userTemplates
+}
+
+// templateKey identifies the template in the dumper log. User IDs never contain a slash,
+// so the key is unique even if the name does.
+func templateKey(userID, name string) string {
+	return userID + "/" + name
+}
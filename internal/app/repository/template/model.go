@@ -0,0 +1,13 @@
+package template
+
+// Template is a named set of UTM parameters owned by a user.
+// Links that reference a template get these parameters added to their target URL on redirect.
+type Template struct {
+	Name     string
+	UserID   string
+	Source   string
+	Medium   string
+	Campaign string
+	Term     string
+	Content  string
+}
@@ -0,0 +1,125 @@
+package psql
+
+import (
+	"context"
+	"errors"
+
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const (
+	expectedNumberOfTemplates = 10
+)
+
+type storage struct {
+	conn *pgxpool.Pool
+}
+
+// NewStorage creates a new template storage with the provided database connection pool.
+func NewStorage(conn *pgxpool.Pool) *storage {
+	return &storage{
+		conn: conn,
+	}
+}
+
+// SetTemplate creates the template or replaces an existing one with the same name for the user.
+func (s *storage) SetTemplate(ctx context.Context, template *templatestorage.Template) error {
+	query := `INSERT INTO url_template (user_uuid, name, utm_source, utm_medium, utm_campaign, utm_term, utm_content)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (user_uuid, name) DO UPDATE SET
+		utm_source = EXCLUDED.utm_source,
+		utm_medium = EXCLUDED.utm_medium,
+		utm_campaign = EXCLUDED.utm_campaign,
+		utm_term = EXCLUDED.utm_term,
+		utm_content = EXCLUDED.utm_content`
+
+	_, err := s.conn.Exec(ctx, query,
+		template.UserID,
+		template.Name,
+		template.Source,
+		template.Medium,
+		template.Campaign,
+		template.Term,
+		template.Content,
+	)
+	return err
+}
+
+// GetTemplate returns the user's template with the given name.
+// Returns ErrNotFound if there is no such template.
+func (s *storage) GetTemplate(ctx context.Context, userID, name string) (*templatestorage.Template, error) {
+	query := `SELECT user_uuid, name, utm_source, utm_medium, utm_campaign, utm_term, utm_content
+	FROM url_template WHERE user_uuid = $1 AND name = $2`
+
+	var template templatestorage.Template
+	err := s.conn.QueryRow(ctx, query, userID, name).Scan(
+		&template.UserID,
+		&template.Name,
+		&template.Source,
+		&template.Medium,
+		&template.Campaign,
+		&template.Term,
+		&template.Content,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, templatestorage.ErrNotFound
+		}
+		return nil, err
+	}
+	return &template, nil
+}
+
+// GetTemplates returns all templates of the user ordered by name.
+func (s *storage) GetTemplates(ctx context.Context, userID string) ([]*templatestorage.Template, error) {
+	query := `SELECT user_uuid, name, utm_source, utm_medium, utm_campaign, utm_term, utm_content
+	FROM url_template WHERE user_uuid = $1 ORDER BY name`
+
+	rows, err := s.conn.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := make([]*templatestorage.Template, 0, expectedNumberOfTemplates)
+	for rows.Next() {
+		var template templatestorage.Template
+		err := rows.Scan(
+			&template.UserID,
+			&template.Name,
+			&template.Source,
+			&template.Medium,
+			&template.Campaign,
+			&template.Term,
+			&template.Content,
+		)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, &template)
+	}
+	return templates, rows.Err()
+}
+
+// DeleteTemplate removes the user's template with the given name.
+// Returns ErrNotFound if there is no such template.
+func (s *storage) DeleteTemplate(ctx context.Context, userID, name string) error {
+	query := `DELETE FROM url_template WHERE user_uuid = $1 AND name = $2`
+	tag, err := s.conn.Exec(ctx, query, userID, name)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return templatestorage.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteTemplates removes all the templates of the user.
+func (s *storage) DeleteTemplates(ctx context.Context, userID string) error {
+	query := `DELETE FROM url_template WHERE user_uuid = $1`
+	_, err := s.conn.Exec(ctx, query, userID)
+	return err
+}
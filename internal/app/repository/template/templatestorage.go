@@ -0,0 +1,13 @@
+package template
+
+import "context"
+
+// TemplateStorage defines the interface for tagging template storage operations.
+// Templates are scoped by user ID, and the name is unique within a user.
+type TemplateStorage interface {
+	SetTemplate(ctx context.Context, template *Template) error
+	GetTemplate(ctx context.Context, userID, name string) (*Template, error)
+	GetTemplates(ctx context.Context, userID string) ([]*Template, error)
+	DeleteTemplate(ctx context.Context, userID, name string) error
+	DeleteTemplates(ctx context.Context, userID string) error
+}
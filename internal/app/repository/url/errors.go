@@ -11,6 +11,8 @@ var (
 	ErrIDIsBusy = errors.New("id is busy")
 	// ErrDeleted indicates that the resource has been previously deleted
 	ErrDeleted = errors.New("deleted")
+	// ErrExhausted indicates that a click-limited link has no redirects left
+	ErrExhausted = errors.New("exhausted")
 	// ErrConflict indicates a conflict occurred, typically due to a concurrent modification or constraint violation
 	ErrConflict = errors.New("conflict")
 )
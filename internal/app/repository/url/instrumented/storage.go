@@ -0,0 +1,108 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+type observer interface {
+	ObserveStorage(backend, operation string, duration time.Duration)
+}
+
+type storage struct {
+	next     urlstorage.URLStorage
+	backend  string
+	observer observer
+}
+
+// NewStorage wraps the URL storage so that the latency of every operation is
+// recorded under the name of its backend. Only opening is timed for IterateURLs,
+// as the iteration is paced by the caller.
+func NewStorage(next urlstorage.URLStorage, backend string, observer observer) *storage {
+	return &storage{
+		next:     next,
+		backend:  backend,
+		observer: observer,
+	}
+}
+
+func (s *storage) observe(operation string, start time.Time) {
+	s.observer.ObserveStorage(s.backend, operation, time.Since(start))
+}
+
+func (s *storage) Ping(ctx context.Context) error {
+	defer s.observe("Ping", time.Now())
+	return s.next.Ping(ctx)
+}
+
+func (s *storage) GetURL(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+	defer s.observe("GetURL", time.Now())
+	return s.next.GetURL(ctx, id)
+}
+
+func (s *storage) SetURL(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
+	defer s.observe("SetURL", time.Now())
+	return s.next.SetURL(ctx, record)
+}
+
+func (s *storage) SetURLs(ctx context.Context, urls []*urlstorage.URLRecord) ([]*urlstorage.URLRecord, error) {
+	defer s.observe("SetURLs", time.Now())
+	return s.next.SetURLs(ctx, urls)
+}
+
+func (s *storage) UpdateURL(ctx context.Context, record *urlstorage.URLRecord) error {
+	defer s.observe("UpdateURL", time.Now())
+	return s.next.UpdateURL(ctx, record)
+}
+
+func (s *storage) ConsumeClick(ctx context.Context, id string) (int, error) {
+	defer s.observe("ConsumeClick", time.Now())
+	return s.next.ConsumeClick(ctx, id)
+}
+
+func (s *storage) IncrementClicks(ctx context.Context, id string) (int, error) {
+	defer s.observe("IncrementClicks", time.Now())
+	return s.next.IncrementClicks(ctx, id)
+}
+
+func (s *storage) GetURLs(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error) {
+	defer s.observe("GetURLs", time.Now())
+	return s.next.GetURLs(ctx, filter)
+}
+
+func (s *storage) IterateURLs(ctx context.Context) (urlstorage.URLIterator, error) {
+	defer s.observe("IterateURLs", time.Now())
+	return s.next.IterateURLs(ctx)
+}
+
+func (s *storage) GetURLsToCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+	defer s.observe("GetURLsToCheck", time.Now())
+	return s.next.GetURLsToCheck(ctx, checkedBefore, limit)
+}
+
+func (s *storage) SetHealth(ctx context.Context, id string, health *urlstorage.Health) error {
+	defer s.observe("SetHealth", time.Now())
+	return s.next.SetHealth(ctx, id, health)
+}
+
+func (s *storage) EndWindows(ctx context.Context, now time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+	defer s.observe("EndWindows", time.Now())
+	return s.next.EndWindows(ctx, now, limit)
+}
+
+func (s *storage) DeleteURLs(userID string, ids []string) ([]string, error) {
+	defer s.observe("DeleteURLs", time.Now())
+	return s.next.DeleteURLs(userID, ids)
+}
+
+func (s *storage) EraseURLs(ctx context.Context, userID string) error {
+	defer s.observe("EraseURLs", time.Now())
+	return s.next.EraseURLs(ctx, userID)
+}
+
+func (s *storage) GetState(ctx context.Context) (*urlstorage.State, error) {
+	defer s.observe("GetState", time.Now())
+	return s.next.GetState(ctx)
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// GetURLsToCheck returns up to limit non-deleted links of all users that were never
+// checked or were last checked before checkedBefore, the least recently checked first.
+func (s *storage) GetURLsToCheck(_ context.Context, checkedBefore time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	urls := make([]*urlstorage.URLRecord, 0, min(len(s.urls), limit))
+	for _, url := range s.urls {
+		if url.Deleted || url.Health != nil && !url.Health.CheckedAt.Before(checkedBefore) {
+			continue
+		}
+		record := *url
+		urls = append(urls, &record)
+	}
+	sort.Slice(urls, func(i, j int) bool {
+		a, b := urls[i].Health, urls[j].Health
+		switch {
+		case a == nil && b == nil:
+			return urls[i].ShortURL < urls[j].ShortURL
+		case a == nil || b == nil:
+			return a == nil
+		case !a.CheckedAt.Equal(b.CheckedAt):
+			return a.CheckedAt.Before(b.CheckedAt)
+		default:
+			return urls[i].ShortURL < urls[j].ShortURL
+		}
+	})
+	if len(urls) > limit {
+		urls = urls[:limit]
+	}
+	return urls, nil
+}
+
+// SetHealth stores the result of the latest check of the link.
+// Returns ErrNotFound if the link does not exist.
+func (s *storage) SetHealth(_ context.Context, id string, health *urlstorage.Health) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	url, ok := s.urls[id]
+	if !ok {
+		return urlstorage.ErrNotFound
+	}
+	stored := *health
+	url.Health = &stored
+	return nil
+}
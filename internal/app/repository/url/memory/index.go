@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"sort"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// userIndex keeps the links of one user ordered by creation time and by clicks,
+// so that a page of GetURLs is found with a binary search instead of a scan of
+// every stored link.
+type userIndex struct {
+	byCreated []*urlstorage.URLRecord
+	byClicks  []*urlstorage.URLRecord
+}
+
+func createdLess(a, b *urlstorage.URLRecord) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.ShortURL < b.ShortURL
+}
+
+func clicksLess(a, b *urlstorage.URLRecord) bool {
+	if a.Clicks != b.Clicks {
+		return a.Clicks < b.Clicks
+	}
+	return a.ShortURL < b.ShortURL
+}
+
+func (idx *userIndex) add(record *urlstorage.URLRecord) {
+	idx.byCreated = insertSorted(idx.byCreated, record, createdLess)
+	idx.byClicks = insertSorted(idx.byClicks, record, clicksLess)
+}
+
+func (idx *userIndex) remove(record *urlstorage.URLRecord) {
+	idx.byCreated = removeSorted(idx.byCreated, record, createdLess)
+	idx.byClicks = removeSorted(idx.byClicks, record, clicksLess)
+}
+
+// incrementClicks counts a redirect of the record and moves it to its new place
+// in the clicks order.
+func (idx *userIndex) incrementClicks(record *urlstorage.URLRecord) {
+	i := sort.Search(len(idx.byClicks), func(i int) bool { return !clicksLess(idx.byClicks[i], record) })
+	record.Clicks++
+	if i == len(idx.byClicks) || idx.byClicks[i] != record {
+		return
+	}
+	for ; i+1 < len(idx.byClicks) && clicksLess(idx.byClicks[i+1], record); i++ {
+		idx.byClicks[i], idx.byClicks[i+1] = idx.byClicks[i+1], idx.byClicks[i]
+	}
+}
+
+// ordered returns the links in the order requested by sortBy together with its comparison.
+func (idx *userIndex) ordered(sortBy string) ([]*urlstorage.URLRecord, func(a, b *urlstorage.URLRecord) bool) {
+	if sortBy == urlstorage.SortClicks {
+		return idx.byClicks, clicksLess
+	}
+	return idx.byCreated, createdLess
+}
+
+func insertSorted(records []*urlstorage.URLRecord, record *urlstorage.URLRecord, less func(a, b *urlstorage.URLRecord) bool) []*urlstorage.URLRecord {
+	i := sort.Search(len(records), func(i int) bool { return less(record, records[i]) })
+	records = append(records, nil)
+	copy(records[i+1:], records[i:])
+	records[i] = record
+	return records
+}
+
+func removeSorted(records []*urlstorage.URLRecord, record *urlstorage.URLRecord, less func(a, b *urlstorage.URLRecord) bool) []*urlstorage.URLRecord {
+	i := sort.Search(len(records), func(i int) bool { return !less(records[i], record) })
+	if i == len(records) || records[i] != record {
+		return records
+	}
+	return append(records[:i], records[i+1:]...)
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// iterateBatchSize is the number of records an iterator copies per lock of the storage.
+const iterateBatchSize = 100
+
+// urlIterator walks the links of a user in creation order. The records are copied
+// in batches, and each batch resumes after the last record copied, so links added
+// or deleted meanwhile do not disturb the iteration.
+type urlIterator struct {
+	ctx     context.Context
+	storage *storage
+	userID  string
+	batch   []*urlstorage.URLRecord
+	last    *urlstorage.URLRecord
+	record  *urlstorage.URLRecord
+	done    bool
+	err     error
+}
+
+// IterateURLs returns an iterator over all the URL records of the user from the
+// context, the deleted ones included, ordered by creation time.
+// Requires a user ID in the context. Returns an error if no user ID is found.
+func (s *storage) IterateURLs(ctx context.Context) (urlstorage.URLIterator, error) {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return nil, errors.New("userID not found in context")
+	}
+	return &urlIterator{ctx: ctx, storage: s, userID: userID}, nil
+}
+
+// Next advances to the next record.
+func (it *urlIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+	if len(it.batch) == 0 && !it.done {
+		it.fill()
+	}
+	if len(it.batch) == 0 {
+		return false
+	}
+	it.record, it.batch = it.batch[0], it.batch[1:]
+	return true
+}
+
+func (it *urlIterator) fill() {
+	it.storage.mu.RLock()
+	defer it.storage.mu.RUnlock()
+
+	idx, ok := it.storage.index[it.userID]
+	if !ok {
+		it.done = true
+		return
+	}
+	start := 0
+	if it.last != nil {
+		start = sort.Search(len(idx.byCreated), func(i int) bool { return createdLess(it.last, idx.byCreated[i]) })
+	}
+	end := min(start+iterateBatchSize, len(idx.byCreated))
+	for _, url := range idx.byCreated[start:end] {
+		record := *url
+		it.batch = append(it.batch, &record)
+	}
+	if end == len(idx.byCreated) {
+		it.done = true
+	}
+	if len(it.batch) > 0 {
+		it.last = it.batch[len(it.batch)-1]
+	}
+}
+
+// Record returns the current record.
+func (it *urlIterator) Record() *urlstorage.URLRecord {
+	return it.record
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *urlIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration.
+func (it *urlIterator) Close() {
+	it.batch = nil
+	it.done = true
+}
@@ -0,0 +1,75 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package memory
+
+import (
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	"sync"
+)
+
+// Ensure, that outboxMock does implement outbox.
+// If this is not the case, regenerate this file with moq.
+var _ outbox = &outboxMock{}
+
+// outboxMock is a mock implementation of outbox.
+//
+//	func TestSomethingThatUsesoutbox(t *testing.T) {
+//
+//		// make and configure a mocked outbox
+//		mockedoutbox := &outboxMock{
+//			AddEventsFunc: func(eventsMoqParam ...*events.Event) error {
+//				panic("mock out the AddEvents method")
+//			},
+//		}
+//
+//		// use mockedoutbox in code that requires outbox
+//		// and then make assertions.
+//
+//	}
+type outboxMock struct {
+	// AddEventsFunc mocks the AddEvents method.
+	AddEventsFunc func(eventsMoqParam ...*events.Event) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddEvents holds details about calls to the AddEvents method.
+		AddEvents []struct {
+			// EventsMoqParam is the eventsMoqParam argument value.
+			EventsMoqParam []*events.Event
+		}
+	}
+	lockAddEvents sync.RWMutex
+}
+
+// AddEvents calls AddEventsFunc.
+func (mock *outboxMock) AddEvents(eventsMoqParam ...*events.Event) error {
+	if mock.AddEventsFunc == nil {
+		panic("outboxMock.AddEventsFunc: method is nil but outbox.AddEvents was just called")
+	}
+	callInfo := struct {
+		EventsMoqParam []*events.Event
+	}{
+		EventsMoqParam: eventsMoqParam,
+	}
+	mock.lockAddEvents.Lock()
+	mock.calls.AddEvents = append(mock.calls.AddEvents, callInfo)
+	mock.lockAddEvents.Unlock()
+	return mock.AddEventsFunc(eventsMoqParam...)
+}
+
+// AddEventsCalls gets all the calls that were made to AddEvents.
+// Check the length with:
+//
+//	len(mockedoutbox.AddEventsCalls())
+func (mock *outboxMock) AddEventsCalls() []struct {
+	EventsMoqParam []*events.Event
+} {
+	var calls []struct {
+		EventsMoqParam []*events.Event
+	}
+	mock.lockAddEvents.RLock()
+	calls = mock.calls.AddEvents
+	mock.lockAddEvents.RUnlock()
+	return calls
+}
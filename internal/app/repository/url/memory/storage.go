@@ -3,8 +3,13 @@ package memory
 import (
 	"context"
 	"errors"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/DanilNaum/SnipURL/internal/app/events"
 	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
 	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/middlewares"
 	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
@@ -20,17 +25,71 @@ type dumper interface {
 	ReadAll() (chan dump.URLRecord, error)
 }
 
+//go:generate moq -out mock_outbox_moq_test.go . outbox
+type outbox interface {
+	AddEvents(events ...*events.Event) error
+}
+
 type storage struct {
 	mu   sync.RWMutex
 	urls map[string]*urlstorage.URLRecord
+	// index orders the links of every user for GetURLs.
+	index  map[string]*userIndex
+	outbox outbox
+}
+
+// Option configures the in-memory storage.
+type Option func(s *storage)
+
+// WithOutbox sets the outbox receiving the creations, updates and deletions of the links.
+// The events are added under the storage lock before the change is applied, and a
+// change whose events cannot be added fails. Without an outbox no events are emitted.
+func WithOutbox(outbox outbox) Option {
+	return func(s *storage) {
+		s.outbox = outbox
+	}
 }
 
 // NewStorage creates and returns a new in-memory storage for URL records.
 // It initializes an empty map to store URL records with thread-safe access.
-func NewStorage() *storage {
-	return &storage{
-		urls: make(map[string]*urlstorage.URLRecord),
+func NewStorage(opts ...Option) *storage {
+	s := &storage{
+		urls:  make(map[string]*urlstorage.URLRecord),
+		index: make(map[string]*userIndex),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// emit adds the events to the outbox, if any. The caller holds s.mu.
+func (s *storage) emit(evs ...*events.Event) error {
+	if s.outbox == nil || len(evs) == 0 {
+		return nil
+	}
+	return s.outbox.AddEvents(evs...)
+}
+
+// newEvent returns an outbox event of the user's link.
+func newEvent(eventType, userID, shortURL, originalURL string) *events.Event {
+	event := events.New(eventType, shortURL)
+	event.UserID = userID
+	event.OriginalURL = originalURL
+	return event
+}
+
+// indexFor returns the index of the user's links, creating it if needed.
+func (s *storage) indexFor(userID string) *userIndex {
+	if s.index == nil {
+		s.index = make(map[string]*userIndex)
+	}
+	idx, ok := s.index[userID]
+	if !ok {
+		idx = &userIndex{}
+		s.index[userID] = idx
 	}
+	return idx
 }
 
 // Ping checks the availability of the storage service.
@@ -42,81 +101,254 @@ func (s *storage) Ping(ctx context.Context) error {
 
 // SetURL adds a new URL record to the in-memory storage with thread-safe synchronization.
 // It locks the mutex, calls the internal setURL method, and returns the total number of URLs or an error.
-func (s *storage) SetURL(ctx context.Context, id, url string) (int, error) {
+// A zero record.CreatedAt is set to the current time. The creation of the link is added to the outbox.
+func (s *storage) SetURL(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.setURL(ctx, id, url)
 
+	userID, _ := ctx.Value(key).(string)
+	if err := s.checkID(record); err != nil {
+		return 0, err
+	}
+	if err := s.emit(newEvent(events.TypeLinkCreated, userID, record.ShortURL, record.OriginalURL)); err != nil {
+		return 0, err
+	}
+	return s.setURL(ctx, record)
 }
 
-func (s *storage) setURL(ctx context.Context, id, url string) (int, error) {
+// checkID returns ErrConflict if the short URL is taken by the same original URL
+// and ErrIDIsBusy if it is taken by another one. The caller holds s.mu.
+func (s *storage) checkID(record *urlstorage.URLRecord) error {
+	if oldURL, ok := s.urls[record.ShortURL]; ok && oldURL.OriginalURL != record.OriginalURL {
+		return urlstorage.ErrIDIsBusy
+	} else if ok {
+		return urlstorage.ErrConflict
+	}
+	return nil
+}
+
+func (s *storage) setURL(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 	userID, ok := ctx.Value(key).(string)
 	if !ok {
 		userID = ""
 	}
 
-	if oldURL, ok := s.urls[id]; ok && oldURL.OriginalURL != url {
-		return 0, urlstorage.ErrIDIsBusy
-	} else if ok {
-		return 0, urlstorage.ErrConflict
+	if err := s.checkID(record); err != nil {
+		return 0, err
 	}
 
-	s.urls[id] = &urlstorage.URLRecord{
-		ShortURL:    id,
-		OriginalURL: url,
-		UserID:      userID,
-		Deleted:     false,
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now()
 	}
+
+	url := &urlstorage.URLRecord{
+		ShortURL:     record.ShortURL,
+		OriginalURL:  record.OriginalURL,
+		UserID:       userID,
+		Deleted:      false,
+		Alias:        record.Alias,
+		RedirectType: record.RedirectType,
+		Passthrough:  record.Passthrough,
+		QueryMode:    record.QueryMode,
+		Template:     record.Template,
+		Variants:     append([]urlstorage.Variant(nil), record.Variants...),
+		PasswordHash: record.PasswordHash,
+		MaxClicks:    record.MaxClicks,
+		ClicksLeft:   record.MaxClicks,
+		NotBefore:    record.NotBefore,
+		NotAfter:     record.NotAfter,
+		FallbackURL:  record.FallbackURL,
+		Title:        record.Title,
+		Notes:        record.Notes,
+		Tags:         append([]string(nil), record.Tags...),
+		CreatedAt:    record.CreatedAt,
+	}
+	s.urls[record.ShortURL] = url
+	s.indexFor(userID).add(url)
 	return len(s.urls), nil
 }
 
-// GetURL retrieves the original URL for a given short URL ID.
+// GetURL retrieves the URL record for a given short URL ID.
 // It uses a read lock to ensure thread-safe access to the in-memory storage.
-// Returns the original URL if found, or an error if the URL is not found or has been deleted.
-func (s *storage) GetURL(_ context.Context, id string) (string, error) {
+// Returns a copy of the record if found, or an error if the URL is not found or has been deleted.
+func (s *storage) GetURL(_ context.Context, id string) (*urlstorage.URLRecord, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	url, ok := s.urls[id]
 	if !ok {
-		return "", urlstorage.ErrNotFound
+		return nil, urlstorage.ErrNotFound
 	}
 	if url.Deleted {
-		return "", urlstorage.ErrDeleted
+		return nil, urlstorage.ErrDeleted
+	}
+	record := *url
+	record.Variants = append([]urlstorage.Variant(nil), url.Variants...)
+	record.Tags = append([]string(nil), url.Tags...)
+	return &record, nil
+
+}
+
+// UpdateURL overwrites the mutable attributes of an existing URL record and adds the update to the outbox.
+// Only non-deleted records owned by record.UserID are updated; otherwise ErrNotFound is returned.
+func (s *storage) UpdateURL(_ context.Context, record *urlstorage.URLRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	url, ok := s.urls[record.ShortURL]
+	if !ok || url.Deleted || url.UserID != record.UserID {
+		return urlstorage.ErrNotFound
+	}
+	if err := s.emit(newEvent(events.TypeLinkUpdated, url.UserID, url.ShortURL, url.OriginalURL)); err != nil {
+		return err
+	}
+
+	url.RedirectType = record.RedirectType
+	url.Passthrough = record.Passthrough
+	url.QueryMode = record.QueryMode
+	url.Template = record.Template
+	url.Variants = append([]urlstorage.Variant(nil), record.Variants...)
+	url.PasswordHash = record.PasswordHash
+	url.ClicksLeft = clicksLeftAfterUpdate(url, record.MaxClicks)
+	url.MaxClicks = record.MaxClicks
+	url.NotBefore = record.NotBefore
+	url.NotAfter = record.NotAfter
+	url.FallbackURL = record.FallbackURL
+	url.Title = record.Title
+	url.Notes = record.Notes
+	url.Tags = append([]string(nil), record.Tags...)
+	return nil
+}
+
+// clicksLeftAfterUpdate keeps the number of redirects already made when the
+// click limit of a link changes.
+func clicksLeftAfterUpdate(url *urlstorage.URLRecord, maxClicks int) int {
+	used := url.MaxClicks - url.ClicksLeft
+	return max(maxClicks-used, 0)
+}
+
+// ConsumeClick takes one redirect from a click-limited link under the storage lock,
+// so concurrent redirects can never exceed the limit.
+// Returns the number of redirects left, ErrExhausted if none were left,
+// ErrNotFound or ErrDeleted if the link cannot be redirected.
+func (s *storage) ConsumeClick(_ context.Context, id string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	url, ok := s.urls[id]
+	if !ok {
+		return 0, urlstorage.ErrNotFound
+	}
+	if url.Deleted {
+		return 0, urlstorage.ErrDeleted
+	}
+	if url.MaxClicks == 0 {
+		return 0, nil
 	}
-	return url.OriginalURL, nil
+	if url.ClicksLeft <= 0 {
+		return 0, urlstorage.ErrExhausted
+	}
+	url.ClicksLeft--
+	return url.ClicksLeft, nil
+}
 
+// IncrementClicks counts one redirect made through the link and returns its clicks so far.
+// Returns ErrNotFound if the link does not exist.
+func (s *storage) IncrementClicks(_ context.Context, id string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	url, ok := s.urls[id]
+	if !ok {
+		return 0, urlstorage.ErrNotFound
+	}
+	s.indexFor(url.UserID).incrementClicks(url)
+	return url.Clicks, nil
 }
 
 // RestoreStorage populates the in-memory storage with URL records from a dumper.
-// It reads all records and attempts to set them in the storage,
-// returning an error if any record fails to be added.
+// Records are applied in the order they were dumped, so a later record for the
+// same short URL (written on edit) replaces the earlier one.
 func (s *storage) RestoreStorage(dumper dumper) error {
 	records, err := dumper.ReadAll()
 	if err != nil {
 		return err
 	}
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	for record := range records {
-		_, err := s.SetURL(context.Background(), record.ShortURL, record.OriginalURL)
-		if err != nil {
-			return err
+		if old, ok := s.urls[record.ShortURL]; ok {
+			s.indexFor(old.UserID).remove(old)
+		}
+		url := &urlstorage.URLRecord{
+			ID:           record.UUID,
+			ShortURL:     record.ShortURL,
+			OriginalURL:  record.OriginalURL,
+			UserID:       record.UserID,
+			RedirectType: record.RedirectType,
+			Passthrough:  record.Passthrough,
+			QueryMode:    record.QueryMode,
+			Template:     record.Template,
+			Variants:     variantsFromDump(record.Variants),
+			PasswordHash: record.PasswordHash,
+			MaxClicks:    record.MaxClicks,
+			ClicksLeft:   record.ClicksLeft,
+			NotBefore:    record.NotBefore,
+			NotAfter:     record.NotAfter,
+			EndEmitted:   record.EndEmitted,
+			FallbackURL:  record.FallbackURL,
+			Title:        record.Title,
+			Notes:        record.Notes,
+			Tags:         record.Tags,
+			CreatedAt:    record.CreatedAt,
 		}
+		s.urls[record.ShortURL] = url
+		s.indexFor(url.UserID).add(url)
 	}
 	return nil
 }
 
+func variantsFromDump(variants []dump.URLVariant) []urlstorage.Variant {
+	if len(variants) == 0 {
+		return nil
+	}
+	output := make([]urlstorage.Variant, 0, len(variants))
+	for _, v := range variants {
+		output = append(output, urlstorage.Variant{Name: v.Name, URL: v.URL, Weight: v.Weight})
+	}
+	return output
+}
+
 // SetURLs adds multiple URL records to the storage.
-// It attempts to insert each URL, skipping URLs that would cause ID conflicts.
+// It attempts to insert each URL, skipping URLs whose ID is already taken, whether by
+// another or the same original URL, like ON CONFLICT DO NOTHING of the PostgreSQL storage.
+// Aliases may point to an original URL that already has other short URLs.
+// The creations of the inserted links are added to the outbox.
 // Returns a slice of successfully inserted URLs and any error encountered during insertion.
 func (s *storage) SetURLs(ctx context.Context, urls []*urlstorage.URLRecord) (insertedURLs []*urlstorage.URLRecord, err error) {
 	inserted := make([]*urlstorage.URLRecord, 0, len(urls))
 	s.mu.Lock()
 	defer s.mu.Unlock()
+
+	userID, _ := ctx.Value(key).(string)
+	created := make([]*events.Event, 0, len(urls))
+	taken := make(map[string]struct{}, len(urls))
 	for _, url := range urls {
-		_, err := s.setURL(ctx, url.ShortURL, url.OriginalURL)
+		if _, ok := taken[url.ShortURL]; ok || s.checkID(url) != nil {
+			continue
+		}
+		taken[url.ShortURL] = struct{}{}
+		created = append(created, newEvent(events.TypeLinkCreated, userID, url.ShortURL, url.OriginalURL))
+	}
+	if err := s.emit(created...); err != nil {
+		return nil, err
+	}
+
+	for _, url := range urls {
+		_, err := s.setURL(ctx, url)
 		if err != nil {
-			if errors.Is(err, urlstorage.ErrIDIsBusy) {
+			if errors.Is(err, urlstorage.ErrIDIsBusy) || errors.Is(err, urlstorage.ErrConflict) {
 				continue
 			}
 			return nil, err
@@ -128,22 +360,59 @@ func (s *storage) SetURLs(ctx context.Context, urls []*urlstorage.URLRecord) (in
 	return inserted, nil
 }
 
-// GetURLs retrieves all non-deleted URL records for a specific user.
+// GetURLs retrieves the non-deleted URL records of a specific user that match the filter,
+// in the order and page it requests.
 // Requires a user ID in the context. Returns an error if no user ID is found.
-// Returns a slice of URL records belonging to the user.
-func (s *storage) GetURLs(ctx context.Context) ([]*urlstorage.URLRecord, error) {
+// The page starts at the cursor found in the user's index, so only the user's links
+// are visited and, without tag or search filters, only as many as the limit.
+func (s *storage) GetURLs(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error) {
 	userID, ok := ctx.Value(key).(string)
 	if !ok {
 		return nil, errors.New("userID not found in context")
 	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	urls := make([]*urlstorage.URLRecord, 0, expectedNumberOfURLs)
-	for _, url := range s.urls {
-		if url.UserID == userID {
-			if !url.Deleted {
-				urls = append(urls, url)
-			}
+
+	idx, ok := s.index[userID]
+	if !ok {
+		return []*urlstorage.URLRecord{}, nil
+	}
+	ordered, less := idx.ordered(filter.Sort)
+
+	var after *urlstorage.URLRecord
+	if filter.After != nil {
+		after = &urlstorage.URLRecord{
+			CreatedAt: filter.After.CreatedAt,
+			Clicks:    filter.After.Clicks,
+			ShortURL:  filter.After.ShortURL,
+		}
+	}
+
+	start, step := 0, 1
+	switch {
+	case filter.Desc && after != nil:
+		start, step = sort.Search(len(ordered), func(i int) bool { return !less(ordered[i], after) })-1, -1
+	case filter.Desc:
+		start, step = len(ordered)-1, -1
+	case after != nil:
+		start = sort.Search(len(ordered), func(i int) bool { return less(after, ordered[i]) })
+	}
+
+	capacity := min(len(ordered), expectedNumberOfURLs)
+	if filter.Limit > 0 {
+		capacity = min(capacity, filter.Limit)
+	}
+	urls := make([]*urlstorage.URLRecord, 0, capacity)
+	search := strings.ToLower(filter.Search)
+	for i := start; i >= 0 && i < len(ordered); i += step {
+		url := ordered[i]
+		if url.Deleted || !matchesFilter(url, filter.Tag, search) || filter.Broken && (url.Health == nil || !url.Health.Broken) {
+			continue
+		}
+		record := *url
+		urls = append(urls, &record)
+		if filter.Limit > 0 && len(urls) == filter.Limit {
+			break
 		}
 	}
 	return urls, nil
@@ -151,21 +420,51 @@ func (s *storage) GetURLs(ctx context.Context) ([]*urlstorage.URLRecord, error)
 
 // DeleteURLs marks specified URL records as deleted for a given user.
 // Only deletes URLs that belong to the specified user.
-// Silently skips URLs that do not exist or belong to a different user.
-func (s *storage) DeleteURLs(userID string, ids []string) error {
+// Silently skips URLs that do not exist, belong to a different user or were deleted before.
+// The deletions are added to the outbox. Returns the short URLs of the deleted URLs.
+func (s *storage) DeleteURLs(userID string, ids []string) ([]string, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	deleted := make([]*urlstorage.URLRecord, 0, len(ids))
 	for _, id := range ids {
 		url, ok := s.urls[id]
 
 		if !ok {
 			continue
 		}
-		if url.UserID != userID {
+		if url.UserID != userID || url.Deleted || slices.Contains(deleted, url) {
 			continue
 		}
 
+		deleted = append(deleted, url)
+	}
+
+	deletions := make([]*events.Event, 0, len(deleted))
+	for _, url := range deleted {
+		deletions = append(deletions, newEvent(events.TypeLinkDeleted, userID, url.ShortURL, url.OriginalURL))
+	}
+	if err := s.emit(deletions...); err != nil {
+		return nil, err
+	}
+	deletedIDs := make([]string, 0, len(deleted))
+	for _, url := range deleted {
 		url.Deleted = true
+		deletedIDs = append(deletedIDs, url.ShortURL)
+	}
+	return deletedIDs, nil
+}
+
+// EraseURLs removes all the URL records of the user, the deleted ones included,
+// so that unlike DeleteURLs nothing of them is kept.
+func (s *storage) EraseURLs(_ context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if idx, ok := s.index[userID]; ok {
+		for _, url := range idx.byCreated {
+			delete(s.urls, url.ShortURL)
+		}
+		delete(s.index, userID)
 	}
 	return nil
 }
@@ -191,3 +490,17 @@ func (s *storage) GetState(ctx context.Context) (*urlstorage.State, error) {
 		UsersNum: len(users),
 	}, nil
 }
+
+// matchesFilter reports whether the record has the tag and contains the lowercased
+// search string in its original URL or title. Empty values match any record.
+func matchesFilter(url *urlstorage.URLRecord, tag, search string) bool {
+	if tag != "" && !slices.Contains(url.Tags, tag) {
+		return false
+	}
+	if search != "" &&
+		!strings.Contains(strings.ToLower(url.OriginalURL), search) &&
+		!strings.Contains(strings.ToLower(url.Title), search) {
+		return false
+	}
+	return true
+}
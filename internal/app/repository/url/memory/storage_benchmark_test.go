@@ -12,7 +12,7 @@ import (
 func BenchmarkStorage_SetURL(b *testing.B) {
 	s := NewStorage()
 	for i := 0; i < b.N; i++ {
-		_, err := s.SetURL(context.Background(), "url"+strconv.Itoa(i), "https://example.com")
+		_, err := s.SetURL(context.Background(), &urlstorage.URLRecord{ShortURL: "url" + strconv.Itoa(i), OriginalURL: "https://example.com"})
 		if err != nil {
 			b.Fatalf("unexpected error: %v", err)
 		}
@@ -22,7 +22,7 @@ func BenchmarkStorage_SetURL(b *testing.B) {
 func BenchmarkStorage_GetURL(b *testing.B) {
 	s := NewStorage()
 	for i := 0; i < 1000; i++ {
-		s.SetURL(context.Background(), "url"+strconv.Itoa(i), "https://example.com")
+		s.SetURL(context.Background(), &urlstorage.URLRecord{ShortURL: "url" + strconv.Itoa(i), OriginalURL: "https://example.com"})
 	}
 
 	b.ResetTimer()
@@ -52,12 +52,12 @@ func BenchmarkStorage_GetURLs(b *testing.B) {
 	s := NewStorage()
 	ctx := context.WithValue(context.Background(), key, "userID")
 	for i := 0; i < 1000; i++ {
-		s.SetURL(ctx, "url"+strconv.Itoa(i), "https://example.com")
+		s.SetURL(ctx, &urlstorage.URLRecord{ShortURL: "url" + strconv.Itoa(i), OriginalURL: "https://example.com"})
 	}
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, err := s.GetURLs(ctx)
+		_, err := s.GetURLs(ctx, &urlstorage.URLFilter{})
 		if err != nil {
 			b.Fatalf("unexpected error: %v", err)
 		}
@@ -67,7 +67,7 @@ func BenchmarkStorage_GetURLs(b *testing.B) {
 func BenchmarkStorage_DeleteURLs(b *testing.B) {
 	s := NewStorage()
 	for i := 0; i < 1000; i++ {
-		s.SetURL(context.Background(), "url"+strconv.Itoa(i), "https://example.com")
+		s.SetURL(context.Background(), &urlstorage.URLRecord{ShortURL: "url" + strconv.Itoa(i), OriginalURL: "https://example.com"})
 	}
 
 	ids := make([]string, 0, 1000)
@@ -77,7 +77,7 @@ func BenchmarkStorage_DeleteURLs(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		err := s.DeleteURLs("userID", ids)
+		_, err := s.DeleteURLs("userID", ids)
 		if err != nil {
 			b.Fatalf("unexpected error: %v", err)
 		}
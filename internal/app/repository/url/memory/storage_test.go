@@ -2,9 +2,16 @@ package memory
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/DanilNaum/SnipURL/internal/app/events"
 	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
 
 	"github.com/stretchr/testify/require"
 )
@@ -80,7 +87,7 @@ func TestStorage_SetURL(t *testing.T) {
 				urls: tt.startStorageState,
 			}
 
-			length, err := s.SetURL(context.Background(), tt.args.id, tt.args.url)
+			length, err := s.SetURL(context.Background(), &urlstorage.URLRecord{ShortURL: tt.args.id, OriginalURL: tt.args.url})
 			require.ErrorIs(t, err, tt.wantErr)
 			if err == nil {
 				require.Equal(t, length, len(tt.storageStateAfter))
@@ -154,7 +161,516 @@ func TestStorage_GetURL(t *testing.T) {
 
 			got, err := s.GetURL(context.Background(), tt.id)
 			require.ErrorIs(t, err, tt.wantErr)
+			if err == nil {
+				require.Equal(t, tt.want, got.OriginalURL)
+			}
+		})
+	}
+}
+
+func TestStorage_UpdateURL(t *testing.T) {
+	tests := []struct {
+		name              string
+		startStorageState map[string]*urlstorage.URLRecord
+		record            *urlstorage.URLRecord
+		wantRedirectType  int
+		wantErr           error
+	}{
+		{
+			name: "success_update",
+			startStorageState: map[string]*urlstorage.URLRecord{
+				"abc123": {ShortURL: "abc123", OriginalURL: "https://example.com", UserID: "user"},
+			},
+			record:           &urlstorage.URLRecord{ShortURL: "abc123", UserID: "user", RedirectType: 301},
+			wantRedirectType: 301,
+		},
+		{
+			name: "error_other_user",
+			startStorageState: map[string]*urlstorage.URLRecord{
+				"abc123": {ShortURL: "abc123", OriginalURL: "https://example.com", UserID: "user"},
+			},
+			record:  &urlstorage.URLRecord{ShortURL: "abc123", UserID: "other", RedirectType: 301},
+			wantErr: urlstorage.ErrNotFound,
+		},
+		{
+			name: "error_deleted",
+			startStorageState: map[string]*urlstorage.URLRecord{
+				"abc123": {ShortURL: "abc123", OriginalURL: "https://example.com", UserID: "user", Deleted: true},
+			},
+			record:  &urlstorage.URLRecord{ShortURL: "abc123", UserID: "user", RedirectType: 301},
+			wantErr: urlstorage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &storage{
+				urls: tt.startStorageState,
+			}
+
+			err := s.UpdateURL(context.Background(), tt.record)
+			require.ErrorIs(t, err, tt.wantErr)
+			if err == nil {
+				require.Equal(t, tt.wantRedirectType, s.urls[tt.record.ShortURL].RedirectType)
+			}
+		})
+	}
+}
+
+func TestStorage_ConsumeClick(t *testing.T) {
+	tests := []struct {
+		name           string
+		record         *urlstorage.URLRecord
+		wantClicksLeft int
+		wantErr        error
+	}{
+		{
+			name:           "success_consume",
+			record:         &urlstorage.URLRecord{ShortURL: "abc123", MaxClicks: 2, ClicksLeft: 2},
+			wantClicksLeft: 1,
+		},
+		{
+			name:   "success_unlimited",
+			record: &urlstorage.URLRecord{ShortURL: "abc123"},
+		},
+		{
+			name:    "error_exhausted",
+			record:  &urlstorage.URLRecord{ShortURL: "abc123", MaxClicks: 1},
+			wantErr: urlstorage.ErrExhausted,
+		},
+		{
+			name:    "error_deleted",
+			record:  &urlstorage.URLRecord{ShortURL: "abc123", MaxClicks: 1, ClicksLeft: 1, Deleted: true},
+			wantErr: urlstorage.ErrDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &storage{
+				urls: map[string]*urlstorage.URLRecord{tt.record.ShortURL: tt.record},
+			}
+
+			clicksLeft, err := s.ConsumeClick(context.Background(), tt.record.ShortURL)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.wantClicksLeft, clicksLeft)
+		})
+	}
+}
+
+func TestStorage_ConsumeClickConcurrent(t *testing.T) {
+	const (
+		maxClicks = 10
+		requests  = 100
+	)
+	s := NewStorage()
+	_, err := s.SetURL(context.Background(), &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "https://example.com", MaxClicks: maxClicks})
+	require.NoError(t, err)
+
+	var (
+		wg       sync.WaitGroup
+		consumed atomic.Int32
+	)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := s.ConsumeClick(context.Background(), "abc123"); err == nil {
+				consumed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(maxClicks), consumed.Load())
+	_, err = s.ConsumeClick(context.Background(), "abc123")
+	require.ErrorIs(t, err, urlstorage.ErrExhausted)
+}
+
+func TestStorage_GetURLs(t *testing.T) {
+	startStorageState := map[string]*urlstorage.URLRecord{
+		"abc123": {ShortURL: "abc123", UserID: "user", Tags: []string{"launch", "q3"}},
+		"def456": {ShortURL: "def456", UserID: "user"},
+		"ghi789": {ShortURL: "ghi789", UserID: "user", Tags: []string{"launch"}, Deleted: true},
+		"jkl012": {ShortURL: "jkl012", UserID: "other", Tags: []string{"launch"}},
+	}
+
+	tests := []struct {
+		name   string
+		filter *urlstorage.URLFilter
+		want   []string
+	}{
+		{name: "success_all", filter: &urlstorage.URLFilter{}, want: []string{"abc123", "def456"}},
+		{name: "success_by_tag", filter: &urlstorage.URLFilter{Tag: "launch"}, want: []string{"abc123"}},
+		{name: "success_unknown_tag", filter: &urlstorage.URLFilter{Tag: "q4"}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newIndexedStorage(startStorageState)
+
+			ctx := context.WithValue(context.Background(), key, "user")
+			urls, err := s.GetURLs(ctx, tt.filter)
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(urls))
+			for _, url := range urls {
+				got = append(got, url.ShortURL)
+			}
+			require.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestStorage_GetURLsPage(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := newIndexedStorage(map[string]*urlstorage.URLRecord{
+		"a": {ShortURL: "a", UserID: "user", OriginalURL: "https://example.com/spring", CreatedAt: base, Clicks: 5},
+		"b": {ShortURL: "b", UserID: "user", OriginalURL: "https://example.com/summer", CreatedAt: base.Add(time.Hour), Clicks: 1},
+		"c": {ShortURL: "c", UserID: "user", OriginalURL: "https://example.com/autumn", Title: "Spring teaser", CreatedAt: base.Add(2 * time.Hour), Clicks: 5},
+		"d": {ShortURL: "d", UserID: "user", OriginalURL: "https://example.com/winter", CreatedAt: base.Add(3 * time.Hour), Deleted: true},
+	})
+
+	tests := []struct {
+		name   string
+		filter *urlstorage.URLFilter
+		want   []string
+	}{
+		{name: "created_asc", filter: &urlstorage.URLFilter{}, want: []string{"a", "b", "c"}},
+		{name: "created_desc_limit", filter: &urlstorage.URLFilter{Desc: true, Limit: 2}, want: []string{"c", "b"}},
+		{
+			name:   "created_desc_after_cursor",
+			filter: &urlstorage.URLFilter{Desc: true, After: &urlstorage.URLCursor{CreatedAt: base.Add(2 * time.Hour), ShortURL: "c"}},
+			want:   []string{"b", "a"},
+		},
+		{name: "clicks_desc", filter: &urlstorage.URLFilter{Sort: urlstorage.SortClicks, Desc: true}, want: []string{"c", "a", "b"}},
+		{
+			name:   "clicks_asc_after_cursor",
+			filter: &urlstorage.URLFilter{Sort: urlstorage.SortClicks, After: &urlstorage.URLCursor{Clicks: 5, ShortURL: "a"}},
+			want:   []string{"c"},
+		},
+		{name: "search_url_and_title", filter: &urlstorage.URLFilter{Search: "SPRING"}, want: []string{"a", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), key, "user")
+			urls, err := s.GetURLs(ctx, tt.filter)
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(urls))
+			for _, url := range urls {
+				got = append(got, url.ShortURL)
+			}
 			require.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func TestStorage_IncrementClicks(t *testing.T) {
+	s := newIndexedStorage(map[string]*urlstorage.URLRecord{
+		"a": {ShortURL: "a", UserID: "user", Clicks: 1},
+		"b": {ShortURL: "b", UserID: "user", Clicks: 2},
+	})
+
+	clicks, err := s.IncrementClicks(context.Background(), "a")
+	require.NoError(t, err)
+	require.Equal(t, 2, clicks)
+	clicks, err = s.IncrementClicks(context.Background(), "a")
+	require.NoError(t, err)
+	require.Equal(t, 3, clicks)
+	_, err = s.IncrementClicks(context.Background(), "missing")
+	require.ErrorIs(t, err, urlstorage.ErrNotFound)
+
+	ctx := context.WithValue(context.Background(), key, "user")
+	urls, err := s.GetURLs(ctx, &urlstorage.URLFilter{Sort: urlstorage.SortClicks, Desc: true})
+	require.NoError(t, err)
+	require.Len(t, urls, 2)
+	require.Equal(t, "a", urls[0].ShortURL)
+	require.Equal(t, 3, urls[0].Clicks)
+}
+
+func TestStorage_IterateURLs(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := map[string]*urlstorage.URLRecord{
+		"other": {ShortURL: "other", UserID: "other", CreatedAt: base},
+	}
+	for i := 0; i < iterateBatchSize+5; i++ {
+		id := fmt.Sprintf("u%03d", i)
+		records[id] = &urlstorage.URLRecord{ShortURL: id, UserID: "user", CreatedAt: base.Add(time.Duration(i) * time.Minute), Deleted: i == 1}
+	}
+	s := newIndexedStorage(records)
+
+	ctx := context.WithValue(context.Background(), key, "user")
+	it, err := s.IterateURLs(ctx)
+	require.NoError(t, err)
+	defer it.Close()
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Record().ShortURL)
+		if len(got) == 1 {
+			// Links added during the iteration are reached once their turn comes.
+			_, err := s.SetURL(ctx, &urlstorage.URLRecord{ShortURL: "new", OriginalURL: "https://example.com/new"})
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, it.Err())
+	require.Len(t, got, iterateBatchSize+6)
+	require.Equal(t, "u000", got[0])
+	require.Equal(t, "u001", got[1])
+	require.Equal(t, "new", got[len(got)-1])
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	it, err = s.IterateURLs(canceled)
+	require.NoError(t, err)
+	require.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), context.Canceled)
+}
+
+func TestStorage_EraseURLs(t *testing.T) {
+	s := newIndexedStorage(map[string]*urlstorage.URLRecord{
+		"a":     {ShortURL: "a", UserID: "user"},
+		"b":     {ShortURL: "b", UserID: "user", Deleted: true},
+		"other": {ShortURL: "other", UserID: "other"},
+	})
+
+	require.NoError(t, s.EraseURLs(context.Background(), "user"))
+
+	_, err := s.GetURL(context.Background(), "a")
+	require.ErrorIs(t, err, urlstorage.ErrNotFound)
+	_, err = s.GetURL(context.Background(), "b")
+	require.ErrorIs(t, err, urlstorage.ErrNotFound)
+	_, err = s.GetURL(context.Background(), "other")
+	require.NoError(t, err)
+
+	urls, err := s.GetURLs(context.WithValue(context.Background(), key, "user"), &urlstorage.URLFilter{})
+	require.NoError(t, err)
+	require.Empty(t, urls)
+
+	// The short URL is free again.
+	_, err = s.SetURL(context.WithValue(context.Background(), key, "new"), &urlstorage.URLRecord{ShortURL: "a", OriginalURL: "https://example.com/a"})
+	require.NoError(t, err)
+}
+
+func TestStorage_EraseURLsAfterRestore(t *testing.T) {
+	dumper := &mockDumper{records: make(chan dump.URLRecord, 3)}
+	dumper.records <- dump.URLRecord{UUID: 1, ShortURL: "a", OriginalURL: "https://example.com/a", UserID: "user"}
+	dumper.records <- dump.URLRecord{UUID: 2, ShortURL: "b", OriginalURL: "https://example.com/b", UserID: "user"}
+	dumper.records <- dump.URLRecord{UUID: 3, ShortURL: "other", OriginalURL: "https://example.com/other", UserID: "other"}
+	close(dumper.records)
+
+	s := NewStorage()
+	require.NoError(t, s.RestoreStorage(dumper))
+
+	urls, err := s.GetURLs(context.WithValue(context.Background(), key, "user"), &urlstorage.URLFilter{})
+	require.NoError(t, err)
+	require.Len(t, urls, 2)
+
+	require.NoError(t, s.EraseURLs(context.Background(), "user"))
+
+	_, err = s.GetURL(context.Background(), "a")
+	require.ErrorIs(t, err, urlstorage.ErrNotFound)
+	_, err = s.GetURL(context.Background(), "b")
+	require.ErrorIs(t, err, urlstorage.ErrNotFound)
+	_, err = s.GetURL(context.Background(), "other")
+	require.NoError(t, err)
+}
+
+func TestStorage_Health(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := newIndexedStorage(map[string]*urlstorage.URLRecord{
+		"fresh":   {ShortURL: "fresh", UserID: "user", Health: &urlstorage.Health{StatusCode: 200, CheckedAt: base.Add(2 * time.Hour)}},
+		"stale":   {ShortURL: "stale", UserID: "user", Health: &urlstorage.Health{StatusCode: 200, CheckedAt: base}},
+		"new":     {ShortURL: "new", UserID: "other"},
+		"deleted": {ShortURL: "deleted", UserID: "user", Deleted: true},
+	})
+
+	urls, err := s.GetURLsToCheck(context.Background(), base.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, urls, 2)
+	require.Equal(t, "new", urls[0].ShortURL, "links never checked come first")
+	require.Equal(t, "stale", urls[1].ShortURL)
+
+	urls, err = s.GetURLsToCheck(context.Background(), base.Add(time.Hour), 1)
+	require.NoError(t, err)
+	require.Len(t, urls, 1)
+
+	failingSince := base
+	err = s.SetHealth(context.Background(), "stale", &urlstorage.Health{StatusCode: 404, CheckedAt: base.Add(3 * time.Hour), FailingSince: &failingSince, Broken: true})
+	require.NoError(t, err)
+	require.ErrorIs(t, s.SetHealth(context.Background(), "missing", &urlstorage.Health{}), urlstorage.ErrNotFound)
+
+	urls, err = s.GetURLs(context.WithValue(context.Background(), key, "user"), &urlstorage.URLFilter{Broken: true})
+	require.NoError(t, err)
+	require.Len(t, urls, 1)
+	require.Equal(t, "stale", urls[0].ShortURL)
+	require.Equal(t, 404, urls[0].Health.StatusCode)
+}
+
+func newIndexedStorage(records map[string]*urlstorage.URLRecord) *storage {
+	s := NewStorage()
+	for id, record := range records {
+		s.urls[id] = record
+		s.indexFor(record.UserID).add(record)
+	}
+	return s
+}
+
+func TestStorage_SetURLsAlias(t *testing.T) {
+	s := NewStorage()
+	ctx := context.WithValue(context.Background(), key, "user")
+
+	_, err := s.SetURL(ctx, &urlstorage.URLRecord{ShortURL: "generated", OriginalURL: "https://example.com"})
+	require.NoError(t, err)
+
+	inserted, err := s.SetURLs(ctx, []*urlstorage.URLRecord{
+		{ShortURL: "spring-sale", OriginalURL: "https://example.com", Alias: true},
+		{ShortURL: "summer-sale", OriginalURL: "https://example.com", Alias: true},
+		{ShortURL: "generated", OriginalURL: "https://example.com"},
+	})
+	require.NoError(t, err)
+	require.Len(t, inserted, 2)
+
+	record, err := s.GetURL(ctx, "summer-sale")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com", record.OriginalURL)
+	require.True(t, record.Alias)
+}
+
+func TestStorage_Outbox(t *testing.T) {
+	var emitted []*events.Event
+	outbox := &outboxMock{
+		AddEventsFunc: func(evs ...*events.Event) error {
+			emitted = append(emitted, evs...)
+			return nil
+		},
+	}
+	s := NewStorage(WithOutbox(outbox))
+	ctx := context.WithValue(context.Background(), key, "user")
+
+	_, err := s.SetURL(ctx, &urlstorage.URLRecord{ShortURL: "a", OriginalURL: "https://example.com/a"})
+	require.NoError(t, err)
+	_, err = s.SetURL(ctx, &urlstorage.URLRecord{ShortURL: "a", OriginalURL: "https://example.com/a"})
+	require.ErrorIs(t, err, urlstorage.ErrConflict)
+	_, err = s.SetURLs(ctx, []*urlstorage.URLRecord{
+		{ShortURL: "a", OriginalURL: "https://example.com/a"},
+		{ShortURL: "b", OriginalURL: "https://example.com/b"},
+		{ShortURL: "b", OriginalURL: "https://example.com/b"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateURL(ctx, &urlstorage.URLRecord{ShortURL: "a", UserID: "user", Title: "A"}))
+	require.ErrorIs(t, s.UpdateURL(ctx, &urlstorage.URLRecord{ShortURL: "a", UserID: "other"}), urlstorage.ErrNotFound)
+	deleted, err := s.DeleteURLs("user", []string{"a", "a", "missing"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, deleted)
+	deleted, err = s.DeleteURLs("user", []string{"a"})
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+
+	got := make([]string, 0, len(emitted))
+	for _, event := range emitted {
+		require.Equal(t, "user", event.UserID)
+		got = append(got, event.Type+" "+event.ShortURL+" "+event.OriginalURL)
+	}
+	require.Equal(t, []string{
+		"link.created a https://example.com/a",
+		"link.created b https://example.com/b",
+		"link.updated a https://example.com/a",
+		"link.deleted a https://example.com/a",
+	}, got)
+
+	// A change whose events cannot be added is not applied.
+	outbox.AddEventsFunc = func(evs ...*events.Event) error {
+		return errors.New("disk full")
+	}
+	_, err = s.SetURL(ctx, &urlstorage.URLRecord{ShortURL: "c", OriginalURL: "https://example.com/c"})
+	require.Error(t, err)
+	_, err = s.GetURL(ctx, "c")
+	require.ErrorIs(t, err, urlstorage.ErrNotFound)
+	_, err = s.DeleteURLs("user", []string{"b"})
+	require.Error(t, err)
+	url, err := s.GetURL(ctx, "b")
+	require.NoError(t, err)
+	require.False(t, url.Deleted)
+}
+
+func TestStorage_EndWindows(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := base.Add(d)
+		return &t
+	}
+	var emitted []*events.Event
+	outbox := &outboxMock{
+		AddEventsFunc: func(evs ...*events.Event) error {
+			emitted = append(emitted, evs...)
+			return nil
+		},
+	}
+	s := newIndexedStorage(map[string]*urlstorage.URLRecord{
+		"late":    {ShortURL: "late", UserID: "user", OriginalURL: "https://example.com/late", NotAfter: at(-time.Minute)},
+		"early":   {ShortURL: "early", UserID: "user", OriginalURL: "https://example.com/early", NotAfter: at(-time.Hour)},
+		"now":     {ShortURL: "now", UserID: "user", OriginalURL: "https://example.com/now", NotAfter: at(0)},
+		"running": {ShortURL: "running", UserID: "user", NotAfter: at(time.Hour)},
+		"open":    {ShortURL: "open", UserID: "user"},
+		"deleted": {ShortURL: "deleted", UserID: "user", NotAfter: at(-time.Hour), Deleted: true},
+		"emitted": {ShortURL: "emitted", UserID: "user", NotAfter: at(-time.Hour), EndEmitted: at(-time.Hour)},
+	})
+	s.outbox = outbox
+	ctx := context.WithValue(context.Background(), key, "user")
+
+	ended, err := s.EndWindows(ctx, base, 2)
+	require.NoError(t, err)
+	require.Len(t, ended, 2)
+	require.Equal(t, "early", ended[0].ShortURL, "the earliest ended come first")
+	require.Equal(t, "late", ended[1].ShortURL)
+	require.Equal(t, ended[0].NotAfter, ended[0].EndEmitted)
+
+	ended, err = s.EndWindows(ctx, base, 2)
+	require.NoError(t, err)
+	require.Len(t, ended, 1)
+	require.Equal(t, "now", ended[0].ShortURL)
+
+	ended, err = s.EndWindows(ctx, base, 2)
+	require.NoError(t, err)
+	require.Empty(t, ended)
+
+	require.Len(t, emitted, 3)
+	for i, shortURL := range []string{"early", "late", "now"} {
+		require.Equal(t, events.TypeLinkExpired, emitted[i].Type)
+		require.Equal(t, events.ReasonEnded, emitted[i].Reason)
+		require.Equal(t, shortURL, emitted[i].ShortURL)
+		require.Equal(t, "https://example.com/"+shortURL, emitted[i].OriginalURL)
+		require.Equal(t, "user", emitted[i].UserID)
+	}
+
+	// A new window ends again.
+	record, err := s.GetURL(ctx, "early")
+	require.NoError(t, err)
+	record.NotAfter = at(time.Minute)
+	require.NoError(t, s.UpdateURL(ctx, record))
+	ended, err = s.EndWindows(ctx, base, 2)
+	require.NoError(t, err)
+	require.Empty(t, ended)
+	ended, err = s.EndWindows(ctx, base.Add(time.Minute), 2)
+	require.NoError(t, err)
+	require.Len(t, ended, 1)
+	require.Equal(t, "early", ended[0].ShortURL)
+
+	// Links whose expiries cannot be added are not marked.
+	require.NoError(t, s.UpdateURL(ctx, &urlstorage.URLRecord{ShortURL: "late", UserID: "user", NotAfter: at(2 * time.Minute)}))
+	addEvents := outbox.AddEventsFunc
+	outbox.AddEventsFunc = func(evs ...*events.Event) error {
+		return errors.New("disk full")
+	}
+	_, err = s.EndWindows(ctx, base.Add(time.Hour), 2)
+	require.Error(t, err)
+	outbox.AddEventsFunc = addEvents
+	ended, err = s.EndWindows(ctx, base.Add(time.Hour), 2)
+	require.NoError(t, err)
+	require.Len(t, ended, 2)
+	require.Equal(t, "late", ended[0].ShortURL)
+	require.Equal(t, "running", ended[1].ShortURL)
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// EndWindows marks up to limit non-deleted links whose active window is over at now and
+// whose end was not emitted yet, the earliest ended first, and adds their expiries to
+// the outbox. Returns copies of the marked links.
+func (s *storage) EndWindows(_ context.Context, now time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ended []*urlstorage.URLRecord
+	for _, url := range s.urls {
+		if url.Deleted || url.NotAfter == nil || url.NotAfter.After(now) || endEmitted(url) {
+			continue
+		}
+		ended = append(ended, url)
+	}
+	sort.Slice(ended, func(i, j int) bool {
+		if !ended[i].NotAfter.Equal(*ended[j].NotAfter) {
+			return ended[i].NotAfter.Before(*ended[j].NotAfter)
+		}
+		return ended[i].ShortURL < ended[j].ShortURL
+	})
+	if len(ended) > limit {
+		ended = ended[:limit]
+	}
+
+	evs := make([]*events.Event, 0, len(ended))
+	for _, url := range ended {
+		event := newEvent(events.TypeLinkExpired, url.UserID, url.ShortURL, url.OriginalURL)
+		event.Reason = events.ReasonEnded
+		evs = append(evs, event)
+	}
+	if err := s.emit(evs...); err != nil {
+		return nil, err
+	}
+
+	output := make([]*urlstorage.URLRecord, 0, len(ended))
+	for _, url := range ended {
+		notAfter := *url.NotAfter
+		url.EndEmitted = &notAfter
+		record := *url
+		record.Variants = append([]urlstorage.Variant(nil), url.Variants...)
+		record.Tags = append([]string(nil), url.Tags...)
+		output = append(output, &record)
+	}
+	return output, nil
+}
+
+// endEmitted reports whether the end of the current window of the link was emitted.
+func endEmitted(url *urlstorage.URLRecord) bool {
+	return url.EndEmitted != nil && url.EndEmitted.Equal(*url.NotAfter)
+}
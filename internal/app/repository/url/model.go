@@ -1,5 +1,7 @@
 package url
 
+import "time"
+
 // URLRecord represents a shortened URL entry in the database.
 // It contains information about the original URL, its shortened version, and associated metadata.
 type URLRecord struct {
@@ -8,6 +10,101 @@ type URLRecord struct {
 	OriginalURL string
 	UserID      string
 	Deleted     bool
+	// Alias reports that ShortURL was chosen by the owner instead of generated from
+	// OriginalURL. Only generated short URLs are unique per original URL.
+	Alias bool
+	// RedirectType is the HTTP status code used to redirect to OriginalURL.
+	// Zero means the service-wide default is used.
+	RedirectType int
+	// Passthrough enables forwarding of the path suffix and query string to OriginalURL.
+	Passthrough bool
+	// QueryMode defines how the request query is merged into OriginalURL on passthrough.
+	QueryMode string
+	// Template is the name of the owner's tagging template applied on redirect, if any.
+	Template string
+	// Variants are weighted alternative targets of an A/B split. Empty for regular links.
+	Variants []Variant
+	// PasswordHash is the bcrypt hash of the link's password. Empty for public links.
+	PasswordHash string
+	// MaxClicks is the number of redirects the link allows. Zero means unlimited.
+	MaxClicks int
+	// ClicksLeft is the number of redirects remaining for a click-limited link.
+	ClicksLeft int
+	// NotBefore and NotAfter bound the window in which the link redirects to OriginalURL.
+	// Nil means the window is open on that side.
+	NotBefore *time.Time
+	NotAfter  *time.Time
+	// EndEmitted is the NotAfter whose end was written to the outbox by EndWindows, nil if
+	// none was. Once NotAfter changes it no longer matches, and the end of the new window
+	// is emitted when it comes.
+	EndEmitted *time.Time
+	// FallbackURL is the redirect target outside the active window. Empty means none.
+	FallbackURL string
+	// Title, Notes and Tags are the owner's metadata, they do not affect redirects.
+	Title string
+	Notes string
+	Tags  []string
+	// CreatedAt is the moment the link was stored; storages set it on insert.
+	CreatedAt time.Time
+	// Clicks is the number of redirects made through the link.
+	Clicks int
+	// Health is the result of the latest check of OriginalURL, nil until the link is checked.
+	// It is replaced as a whole by SetHealth and never modified in place.
+	Health *Health
+}
+
+// Health is the result of a check of a link's OriginalURL by the health checker.
+type Health struct {
+	// StatusCode is the HTTP status of the response, zero if no response was received.
+	StatusCode int
+	Latency    time.Duration
+	// Error explains why no response was received.
+	Error     string
+	CheckedAt time.Time
+	// FailingSince is the time of the first of the consecutive failed checks, nil while the target works.
+	FailingSince *time.Time
+	// Broken reports that the target has been failing for long enough to be reported.
+	Broken bool
+}
+
+// Sort orders of the user's URLs returned by GetURLs.
+const (
+	SortCreated = "created"
+	SortClicks  = "clicks"
+)
+
+// URLFilter narrows down and orders the user's URLs returned by GetURLs.
+// Empty fields do not filter.
+type URLFilter struct {
+	// Tag keeps only the links tagged with it.
+	Tag string
+	// Search keeps only the links whose original URL or title contains it, ignoring case.
+	Search string
+	// Sort is SortCreated or SortClicks; ties are broken by ShortURL. Empty means SortCreated.
+	Sort string
+	// Desc reverses the order.
+	Desc bool
+	// Broken keeps only the links whose latest health check reported them broken.
+	Broken bool
+	// After keeps only the links that follow the cursor in the requested order.
+	After *URLCursor
+	// Limit caps the number of returned links. Zero means no limit.
+	Limit int
+}
+
+// URLCursor is the sort key of the last link of the previous page.
+type URLCursor struct {
+	CreatedAt time.Time
+	Clicks    int
+	ShortURL  string
+}
+
+// Variant is a weighted target of an A/B split link.
+// JSON tags define how variants are stored in the database.
+type Variant struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Weight int    `json:"weight"`
 }
 
 // Package url provides data structures for URL shortening service.
@@ -0,0 +1,89 @@
+package psql
+
+import (
+	"context"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// healthColumns are selected after the other columns of a link and scanned by healthRow.
+const healthColumns = `health_checked_at, health_status, health_latency_ms, health_error, health_failing_since, health_broken`
+
+// healthRow receives the health columns of a link, which are all empty until it is checked.
+type healthRow struct {
+	checkedAt    *time.Time
+	status       int
+	latencyMs    int64
+	err          string
+	failingSince *time.Time
+	broken       bool
+}
+
+func (h *healthRow) dest() []interface{} {
+	return []interface{}{&h.checkedAt, &h.status, &h.latencyMs, &h.err, &h.failingSince, &h.broken}
+}
+
+func (h *healthRow) health() *urlstorage.Health {
+	if h.checkedAt == nil {
+		return nil
+	}
+	return &urlstorage.Health{
+		StatusCode:   h.status,
+		Latency:      time.Duration(h.latencyMs) * time.Millisecond,
+		Error:        h.err,
+		CheckedAt:    *h.checkedAt,
+		FailingSince: h.failingSince,
+		Broken:       h.broken,
+	}
+}
+
+// GetURLsToCheck returns up to limit non-deleted links of all users that were never
+// checked or were last checked before checkedBefore, the least recently checked first.
+func (s *storage) GetURLsToCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+	query := `SELECT id, url, ` + healthColumns + ` 
+	FROM url WHERE deleted = false AND (health_checked_at IS NULL OR health_checked_at < $1) 
+	ORDER BY health_checked_at NULLS FIRST, id LIMIT $2`
+
+	rows, err := s.conn.Query(ctx, query, checkedBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	urls := make([]*urlstorage.URLRecord, 0, limit)
+	for rows.Next() {
+		var urlRecord urlstorage.URLRecord
+		var health healthRow
+		err := rows.Scan(append([]interface{}{&urlRecord.ShortURL, &urlRecord.OriginalURL}, health.dest()...)...)
+		if err != nil {
+			return nil, err
+		}
+		urlRecord.Health = health.health()
+		urls = append(urls, &urlRecord)
+	}
+	return urls, rows.Err()
+}
+
+// SetHealth stores the result of the latest check of the link.
+// Returns ErrNotFound if the link does not exist.
+func (s *storage) SetHealth(ctx context.Context, id string, health *urlstorage.Health) error {
+	query := `UPDATE url SET health_checked_at = $2, health_status = $3, health_latency_ms = $4, 
+	health_error = $5, health_failing_since = $6, health_broken = $7 WHERE id = $1`
+	tag, err := s.conn.Exec(ctx, query,
+		id,
+		health.CheckedAt,
+		health.StatusCode,
+		health.Latency.Milliseconds(),
+		health.Error,
+		health.FailingSince,
+		health.Broken,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return urlstorage.ErrNotFound
+	}
+	return nil
+}
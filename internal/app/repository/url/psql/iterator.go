@@ -0,0 +1,90 @@
+package psql
+
+import (
+	"context"
+	"errors"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/jackc/pgx/v4"
+)
+
+// urlIterator scans the rows of a query one at a time as they arrive from the database.
+type urlIterator struct {
+	rows   pgx.Rows
+	record *urlstorage.URLRecord
+	err    error
+}
+
+// IterateURLs returns an iterator over all the URL records of the user from the
+// context, the deleted ones included, ordered by creation time. The iterator holds
+// a connection of the pool until it is closed.
+func (s *storage) IterateURLs(ctx context.Context) (urlstorage.URLIterator, error) {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return nil, errors.New("error get userID from context")
+	}
+
+	query := `SELECT id, url, deleted, redirect_type, passthrough, query_mode, template, variants, password_hash, max_clicks, clicks_left, not_before, not_after, fallback_url, title, notes, tags, created_at, clicks, ` + healthColumns + ` 
+	FROM url WHERE user_uuid = $1 ORDER BY created_at, id`
+	rows, err := s.conn.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &urlIterator{rows: rows}, nil
+}
+
+// Next advances to the next record.
+func (it *urlIterator) Next() bool {
+	if it.err != nil || !it.rows.Next() {
+		return false
+	}
+	var urlRecord urlstorage.URLRecord
+	var health healthRow
+	err := it.rows.Scan(append([]interface{}{
+		&urlRecord.ShortURL,
+		&urlRecord.OriginalURL,
+		&urlRecord.Deleted,
+		&urlRecord.RedirectType,
+		&urlRecord.Passthrough,
+		&urlRecord.QueryMode,
+		&urlRecord.Template,
+		&urlRecord.Variants,
+		&urlRecord.PasswordHash,
+		&urlRecord.MaxClicks,
+		&urlRecord.ClicksLeft,
+		&urlRecord.NotBefore,
+		&urlRecord.NotAfter,
+		&urlRecord.FallbackURL,
+		&urlRecord.Title,
+		&urlRecord.Notes,
+		&urlRecord.Tags,
+		&urlRecord.CreatedAt,
+		&urlRecord.Clicks,
+	}, health.dest()...)...)
+	if err != nil {
+		it.err = err
+		it.rows.Close()
+		return false
+	}
+	urlRecord.Health = health.health()
+	it.record = &urlRecord
+	return true
+}
+
+// Record returns the current record.
+func (it *urlIterator) Record() *urlstorage.URLRecord {
+	return it.record
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *urlIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.rows.Err()
+}
+
+// Close releases the connection held by the iterator.
+func (it *urlIterator) Close() {
+	it.rows.Close()
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	outboxpsql "github.com/DanilNaum/SnipURL/internal/app/repository/outbox/psql"
 	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
 	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/middlewares"
 	"github.com/DanilNaum/SnipURL/pkg/utils/placeholder"
@@ -17,26 +20,33 @@ import (
 
 const (
 	expectedNumberOfURLs = 20
+	// insertColumnNum is the number of columns written by a batch insert.
+	insertColumnNum = 18
 )
 
 var key = middlewares.Key{Key: "userID"}
 
+type logger interface {
+	ErrorfContext(ctx context.Context, format string, args ...any)
+}
+
 type storage struct {
-	conn *pgxpool.Pool
+	conn *tracedPool
 }
 
 // NewStorage creates a new storage instance with the provided database connection pool.
-// It returns a pointer to the storage struct.
-func NewStorage(conn *pgxpool.Pool) *storage {
+// Every query runs in a span of its own; failed queries are logged with the fields of
+// their context. It returns a pointer to the storage struct.
+func NewStorage(conn *pgxpool.Pool, logger logger) *storage {
 	return &storage{
-		conn: conn,
+		conn: &tracedPool{Pool: conn, logger: logger},
 	}
 }
 
 // Ping checks the database connection by attempting to ping the database.
 // Returns an error if the connection is nil or if the ping fails.
 func (s *storage) Ping(ctx context.Context) error {
-	if s.conn == nil {
+	if s.conn.Pool == nil {
 		return errors.New("connection is nil")
 	}
 	err := s.conn.Ping(ctx)
@@ -47,28 +57,55 @@ func (s *storage) Ping(ctx context.Context) error {
 }
 
 // SetURL inserts a new URL into the database or returns an existing URL's UUID if it already exists.
-// It associates the URL with a user ID from the context (if available).
+// It associates the URL with a user ID from the context (if available) and sets record.CreatedAt.
+// The creation of the link is written to the outbox in the same transaction.
 // Returns the UUID of the inserted or existing URL, with a special ErrConflict error for duplicate entries.
-func (s *storage) SetURL(ctx context.Context, id, url string) (int, error) {
+func (s *storage) SetURL(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 	userID, ok := ctx.Value(key).(string)
 	if !ok {
 		userID = ""
 	}
-	query := `INSERT INTO url (id, url, user_uuid) 
-	VALUES ($1, $2, $3)
-	RETURNING uuid`
+	query := `INSERT INTO url (id, url, user_uuid, redirect_type, passthrough, query_mode, template, variants, password_hash, max_clicks, clicks_left, not_before, not_after, fallback_url, title, notes, tags, alias) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10, $11, $12, $13, $14, $15, $16, $17)
+	RETURNING uuid, created_at`
 
 	var uuid int
+	// The insert is not cancelled with the request, but its queries belong to its trace.
+	ctx = context.WithoutCancel(ctx)
 
-	err := s.conn.QueryRow(context.Background(), query, id, url, userID).Scan(&uuid)
+	err := s.conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, query,
+			record.ShortURL,
+			record.OriginalURL,
+			userID,
+			record.RedirectType,
+			record.Passthrough,
+			record.QueryMode,
+			record.Template,
+			variantsJSON(record.Variants),
+			record.PasswordHash,
+			record.MaxClicks,
+			record.NotBefore,
+			record.NotAfter,
+			record.FallbackURL,
+			record.Title,
+			record.Notes,
+			tagsArray(record.Tags),
+			record.Alias,
+		).Scan(&uuid, &record.CreatedAt)
+		if err != nil {
+			return err
+		}
+		return outboxpsql.AddEvents(ctx, tx, newEvent(events.TypeLinkCreated, userID, record.ShortURL, record.OriginalURL))
+	})
 
 	if err != nil {
 		var pgErr *pgconn.PgError
 
 		if errors.As(err, &pgErr) {
 			if pgErr.Code == pgerrcode.UniqueViolation {
-				query = `SELECT uuid FROM url WHERE url = $1`
-				err = s.conn.QueryRow(context.Background(), query, url).Scan(&uuid)
+				query = `SELECT uuid FROM url WHERE url = $1 AND alias = false`
+				err = s.conn.QueryRow(ctx, query, record.OriginalURL).Scan(&uuid)
 				if err != nil {
 					return 0, err
 				}
@@ -82,28 +119,120 @@ func (s *storage) SetURL(ctx context.Context, id, url string) (int, error) {
 	return uuid, nil
 }
 
-// GetURL retrieves the original URL for a given short URL ID.
-// Returns the original URL or an error if the URL is not found or has been deleted.
-func (s *storage) GetURL(ctx context.Context, id string) (string, error) {
-	query := `SELECT url, deleted FROM url WHERE id = $1`
-	var url string
-	var deleted bool
-	err := s.conn.QueryRow(ctx, query, id).Scan(&url, &deleted)
+// GetURL retrieves the URL record for a given short URL ID.
+// Returns the record or an error if the URL is not found or has been deleted.
+func (s *storage) GetURL(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+	query := `SELECT uuid, id, url, COALESCE(user_uuid, ''), deleted, redirect_type, passthrough, query_mode, template, variants, password_hash, max_clicks, clicks_left, not_before, not_after, fallback_url, title, notes, tags, created_at, clicks 
+	FROM url WHERE id = $1`
+	var urlRecord urlstorage.URLRecord
+	err := s.conn.QueryRow(ctx, query, id).Scan(
+		&urlRecord.ID,
+		&urlRecord.ShortURL,
+		&urlRecord.OriginalURL,
+		&urlRecord.UserID,
+		&urlRecord.Deleted,
+		&urlRecord.RedirectType,
+		&urlRecord.Passthrough,
+		&urlRecord.QueryMode,
+		&urlRecord.Template,
+		&urlRecord.Variants,
+		&urlRecord.PasswordHash,
+		&urlRecord.MaxClicks,
+		&urlRecord.ClicksLeft,
+		&urlRecord.NotBefore,
+		&urlRecord.NotAfter,
+		&urlRecord.FallbackURL,
+		&urlRecord.Title,
+		&urlRecord.Notes,
+		&urlRecord.Tags,
+		&urlRecord.CreatedAt,
+		&urlRecord.Clicks,
+	)
 	if err != nil {
 
 		if errors.Is(err, pgx.ErrNoRows) {
-			return "", urlstorage.ErrNotFound
+			return nil, urlstorage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if urlRecord.Deleted {
+		return nil, urlstorage.ErrDeleted
+	}
+	return &urlRecord, nil
+}
+
+// UpdateURL overwrites the mutable attributes of an existing URL record.
+// A changed click limit keeps the number of redirects already made.
+// Only non-deleted records owned by record.UserID are updated; otherwise ErrNotFound is returned.
+// The update of the link is written to the outbox in the same transaction.
+func (s *storage) UpdateURL(ctx context.Context, record *urlstorage.URLRecord) error {
+	query := `UPDATE url SET redirect_type = $1, passthrough = $2, query_mode = $3, template = $4, variants = $5, password_hash = $6,
+	clicks_left = GREATEST($9 - (max_clicks - clicks_left), 0), max_clicks = $9, not_before = $10, not_after = $11, fallback_url = $12,
+	title = $13, notes = $14, tags = $15 
+	WHERE id = $7 AND user_uuid = $8 AND deleted = false 
+	RETURNING url`
+	return s.conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var originalURL string
+		err := tx.QueryRow(ctx, query,
+			record.RedirectType,
+			record.Passthrough,
+			record.QueryMode,
+			record.Template,
+			variantsJSON(record.Variants),
+			record.PasswordHash,
+			record.ShortURL,
+			record.UserID,
+			record.MaxClicks,
+			record.NotBefore,
+			record.NotAfter,
+			record.FallbackURL,
+			record.Title,
+			record.Notes,
+			tagsArray(record.Tags),
+		).Scan(&originalURL)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return urlstorage.ErrNotFound
+		}
+		if err != nil {
+			return err
 		}
-		return "", err
+		return outboxpsql.AddEvents(ctx, tx, newEvent(events.TypeLinkUpdated, record.UserID, record.ShortURL, originalURL))
+	})
+}
+
+// ConsumeClick takes one redirect from a click-limited link with a single atomic UPDATE,
+// so concurrent redirects can never exceed the limit.
+// Returns the number of redirects left, ErrExhausted if none were left,
+// ErrNotFound or ErrDeleted if the link cannot be redirected.
+func (s *storage) ConsumeClick(ctx context.Context, id string) (int, error) {
+	query := `UPDATE url SET clicks_left = clicks_left - 1 
+	WHERE id = $1 AND deleted = false AND max_clicks > 0 AND clicks_left > 0 
+	RETURNING clicks_left`
+
+	var clicksLeft int
+	err := s.conn.QueryRow(ctx, query, id).Scan(&clicksLeft)
+	if err == nil {
+		return clicksLeft, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return 0, err
 	}
 
-	if deleted {
-		return "", urlstorage.ErrDeleted
+	record, err := s.GetURL(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if record.MaxClicks == 0 {
+		return 0, nil
 	}
-	return url, nil
+	return 0, urlstorage.ErrExhausted
 }
 
-// SetURLs batch inserts multiple URL records for a user.
+// SetURLs batch inserts multiple URL records for a user, skipping records whose ID is
+// already taken or whose original URL already has a generated short URL. Aliases may point
+// to an original URL that already has other short URLs.
+// The creations of the inserted links are written to the outbox in the same transaction.
 // Returns a slice of successfully inserted URL records.
 func (s *storage) SetURLs(ctx context.Context, urls []*urlstorage.URLRecord) (insertedURLs []*urlstorage.URLRecord, err error) {
 	userID, ok := ctx.Value(key).(string)
@@ -111,43 +240,116 @@ func (s *storage) SetURLs(ctx context.Context, urls []*urlstorage.URLRecord) (in
 		return nil, errors.New("error get userID from context")
 	}
 	placeholder := placeholder.MakeDollars(
-		placeholder.WithColumnNumAndRowNum(3, len(urls)),
+		placeholder.WithColumnNumAndRowNum(insertColumnNum, len(urls)),
 	)
-	query := fmt.Sprintf(`INSERT INTO url (id, url, user_uuid) VALUES %s 
-  	ON CONFLICT (id) DO NOTHING
-  	RETURNING uuid, id, url`, placeholder)
+	query := fmt.Sprintf(`INSERT INTO url (id, url, user_uuid, redirect_type, passthrough, query_mode, template, variants, password_hash, max_clicks, clicks_left, not_before, not_after, fallback_url, title, notes, tags, alias) VALUES %s 
+  	ON CONFLICT DO NOTHING
+  	RETURNING uuid, id, url, redirect_type, passthrough, query_mode, template, variants, password_hash, max_clicks, clicks_left, not_before, not_after, fallback_url, title, notes, tags, alias, created_at, clicks`, placeholder)
 
-	rows, err := s.conn.Query(ctx,
-		query,
-		valuesForInsert(userID, urls)...,
-	)
+	err = s.conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+		insertedURLs, err = insertURLs(ctx, tx, query, valuesForInsert(userID, urls), len(urls))
+		if err != nil {
+			return err
+		}
+		created := make([]*events.Event, 0, len(insertedURLs))
+		for _, record := range insertedURLs {
+			created = append(created, newEvent(events.TypeLinkCreated, userID, record.ShortURL, record.OriginalURL))
+		}
+		return outboxpsql.AddEvents(ctx, tx, created...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return insertedURLs, nil
+}
+
+// insertURLs runs the batch insert and scans the records it returns.
+func insertURLs(ctx context.Context, tx pgx.Tx, query string, values []interface{}, capacity int) ([]*urlstorage.URLRecord, error) {
+	rows, err := tx.Query(ctx, query, values...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	insertedURLs = make([]*urlstorage.URLRecord, 0, len(urls))
+	insertedURLs := make([]*urlstorage.URLRecord, 0, capacity)
 	for rows.Next() {
 		var urlRecord urlstorage.URLRecord
-		err := rows.Scan(&urlRecord.ID, &urlRecord.ShortURL, &urlRecord.OriginalURL)
+		err := rows.Scan(
+			&urlRecord.ID,
+			&urlRecord.ShortURL,
+			&urlRecord.OriginalURL,
+			&urlRecord.RedirectType,
+			&urlRecord.Passthrough,
+			&urlRecord.QueryMode,
+			&urlRecord.Template,
+			&urlRecord.Variants,
+			&urlRecord.PasswordHash,
+			&urlRecord.MaxClicks,
+			&urlRecord.ClicksLeft,
+			&urlRecord.NotBefore,
+			&urlRecord.NotAfter,
+			&urlRecord.FallbackURL,
+			&urlRecord.Title,
+			&urlRecord.Notes,
+			&urlRecord.Tags,
+			&urlRecord.Alias,
+			&urlRecord.CreatedAt,
+			&urlRecord.Clicks,
+		)
 		if err != nil {
 			return nil, err
 		}
 		insertedURLs = append(insertedURLs, &urlRecord)
 	}
 
-	return insertedURLs, nil
+	return insertedURLs, rows.Err()
 }
 
-// GetURLs retrieves all non-deleted URL records for a specific user.
+// GetURLs retrieves the non-deleted URL records of a specific user that match the filter,
+// in the order and page it requests. Pages are read with keyset pagination over the
+// (user_uuid, created_at, id) and (user_uuid, clicks, id) indexes; the search uses the
+// trigram indexes on url and title.
 // Returns a slice of URL records associated with the user.
-func (s *storage) GetURLs(ctx context.Context) ([]*urlstorage.URLRecord, error) {
+func (s *storage) GetURLs(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error) {
 	userID, ok := ctx.Value(key).(string)
 	if !ok {
 		return nil, errors.New("error get userID from context")
 	}
-	query := `SELECT id, url FROM url WHERE user_uuid = $1 AND deleted = false`
-	rows, err := s.conn.Query(ctx, query, userID)
+
+	column := "created_at"
+	if filter.Sort == urlstorage.SortClicks {
+		column = "clicks"
+	}
+	direction, comparison := "ASC", ">"
+	if filter.Desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	args := []interface{}{userID, filter.Tag, filter.Broken}
+	query := `SELECT id, url, redirect_type, passthrough, query_mode, template, variants, password_hash, max_clicks, clicks_left, not_before, not_after, fallback_url, title, notes, tags, created_at, clicks, ` + healthColumns + ` 
+	FROM url WHERE user_uuid = $1 AND deleted = false AND ($2 = '' OR $2 = ANY(tags)) 
+	AND ($3 = false OR health_broken)`
+	if filter.Search != "" {
+		// The condition is only added for a search, so that the planner can use
+		// the trigram indexes on url and title.
+		args = append(args, "%"+escapeLike(filter.Search)+"%")
+		query += fmt.Sprintf(` AND (url ILIKE $%d OR title ILIKE $%d)`, len(args), len(args))
+	}
+	if filter.After != nil {
+		var after interface{} = filter.After.CreatedAt
+		if filter.Sort == urlstorage.SortClicks {
+			after = filter.After.Clicks
+		}
+		args = append(args, after, filter.After.ShortURL)
+		query += fmt.Sprintf(` AND (%s, id) %s ($%d, $%d)`, column, comparison, len(args)-1, len(args))
+	}
+	query += fmt.Sprintf(` ORDER BY %s %s, id %s`, column, direction, direction)
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+
+	rows, err := s.conn.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -155,33 +357,165 @@ func (s *storage) GetURLs(ctx context.Context) ([]*urlstorage.URLRecord, error)
 	urls := make([]*urlstorage.URLRecord, 0, expectedNumberOfURLs)
 	for rows.Next() {
 		var urlRecord urlstorage.URLRecord
-		err := rows.Scan(&urlRecord.ShortURL, &urlRecord.OriginalURL)
+		var health healthRow
+		err := rows.Scan(append([]interface{}{
+			&urlRecord.ShortURL,
+			&urlRecord.OriginalURL,
+			&urlRecord.RedirectType,
+			&urlRecord.Passthrough,
+			&urlRecord.QueryMode,
+			&urlRecord.Template,
+			&urlRecord.Variants,
+			&urlRecord.PasswordHash,
+			&urlRecord.MaxClicks,
+			&urlRecord.ClicksLeft,
+			&urlRecord.NotBefore,
+			&urlRecord.NotAfter,
+			&urlRecord.FallbackURL,
+			&urlRecord.Title,
+			&urlRecord.Notes,
+			&urlRecord.Tags,
+			&urlRecord.CreatedAt,
+			&urlRecord.Clicks,
+		}, health.dest()...)...)
 		if err != nil {
 			return nil, err
 		}
+		urlRecord.Health = health.health()
 		urls = append(urls, &urlRecord)
 	}
 	return urls, nil
 }
 
 func valuesForInsert(userID string, urlRecords []*urlstorage.URLRecord) []interface{} {
-	values := make([]interface{}, 0, len(urlRecords)*3)
+	values := make([]interface{}, 0, len(urlRecords)*insertColumnNum)
 
 	for _, urlRecord := range urlRecords {
-		values = append(values, urlRecord.ShortURL, urlRecord.OriginalURL, userID)
+		values = append(values,
+			urlRecord.ShortURL,
+			urlRecord.OriginalURL,
+			userID,
+			urlRecord.RedirectType,
+			urlRecord.Passthrough,
+			urlRecord.QueryMode,
+			urlRecord.Template,
+			variantsJSON(urlRecord.Variants),
+			urlRecord.PasswordHash,
+			urlRecord.MaxClicks,
+			urlRecord.MaxClicks,
+			urlRecord.NotBefore,
+			urlRecord.NotAfter,
+			urlRecord.FallbackURL,
+			urlRecord.Title,
+			urlRecord.Notes,
+			tagsArray(urlRecord.Tags),
+			urlRecord.Alias,
+		)
 	}
 
 	return values
 }
 
-// DeleteURLs marks specified URL records as deleted for a given user.
-func (s *storage) DeleteURLs(userID string, ids []string) error {
-	query := `UPDATE url SET deleted = true WHERE id = ANY($1) AND user_uuid = $2`
-	_, err := s.conn.Exec(context.TODO(), query, ids, userID)
+// newEvent returns an outbox event of the user's link.
+func newEvent(eventType, userID, shortURL, originalURL string) *events.Event {
+	event := events.New(eventType, shortURL)
+	event.UserID = userID
+	event.OriginalURL = originalURL
+	return event
+}
+
+// variantsJSON returns the value written to the variants column.
+// A link without variants is stored as an empty JSON array rather than null.
+func variantsJSON(variants []urlstorage.Variant) []urlstorage.Variant {
+	if variants == nil {
+		return []urlstorage.Variant{}
+	}
+	return variants
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, so that the search string
+// is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// tagsArray returns the value written to the tags column.
+// A link without tags is stored as an empty array rather than null.
+func tagsArray(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// IncrementClicks counts one redirect made through the link and returns its clicks so far.
+// Returns ErrNotFound if the link does not exist.
+func (s *storage) IncrementClicks(ctx context.Context, id string) (int, error) {
+	query := `UPDATE url SET clicks = clicks + 1 WHERE id = $1 RETURNING clicks`
+	var clicks int
+	err := s.conn.QueryRow(ctx, query, id).Scan(&clicks)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, urlstorage.ErrNotFound
+	}
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return clicks, nil
+}
+
+// DeleteURLs marks specified URL records as deleted for a given user and returns the short
+// URLs of the records not deleted before. Their deletions are written to the outbox
+// in the same transaction.
+func (s *storage) DeleteURLs(userID string, ids []string) ([]string, error) {
+	query := `UPDATE url SET deleted = true WHERE id = ANY($1) AND user_uuid = $2 AND deleted = false RETURNING id, url`
+	var deletedIDs []string
+	err := s.conn.BeginFunc(context.TODO(), func(tx pgx.Tx) error {
+		deleted, err := deleteURLs(tx, query, ids, userID)
+		if err != nil {
+			return err
+		}
+		deletedIDs = make([]string, 0, len(deleted))
+		for _, event := range deleted {
+			deletedIDs = append(deletedIDs, event.ShortURL)
+		}
+		return outboxpsql.AddEvents(context.TODO(), tx, deleted...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deletedIDs, nil
+}
+
+// deleteURLs runs the deletion and returns the events of the records it deleted.
+func deleteURLs(tx pgx.Tx, query string, ids []string, userID string) ([]*events.Event, error) {
+	rows, err := tx.Query(context.TODO(), query, ids, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deleted := make([]*events.Event, 0, len(ids))
+	for rows.Next() {
+		var id, originalURL string
+		if err := rows.Scan(&id, &originalURL); err != nil {
+			return nil, err
+		}
+		deleted = append(deleted, newEvent(events.TypeLinkDeleted, userID, id, originalURL))
+	}
+	return deleted, rows.Err()
+}
+
+// EraseURLs removes all the URL records of the user, the deleted ones included.
+// Their rules and variant statistics go with them, and their clicks are kept detached.
+// The user's events still in the outbox are removed too and never relayed.
+func (s *storage) EraseURLs(ctx context.Context, userID string) error {
+	return s.conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM outbox_event WHERE user_uuid = $1`, userID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `DELETE FROM url WHERE user_uuid = $1`, userID)
+		return err
+	})
 }
 
 // GetState retrieves the current state statistics from the database,
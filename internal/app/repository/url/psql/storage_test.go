@@ -0,0 +1,148 @@
+package psql
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/DanilNaum/SnipURL/pkg/migration"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// testDSNEnv names the variable with the DSN of a PostgreSQL database for the tests
+// of this package. The tests are skipped when it is not set.
+const testDSNEnv = "TEST_DATABASE_DSN"
+
+type testLogger struct {
+	t *testing.T
+}
+
+func (l testLogger) ErrorfContext(_ context.Context, format string, args ...any) {
+	l.t.Logf(format, args...)
+}
+
+func newTestStorage(t *testing.T) *storage {
+	t.Helper()
+
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+
+	migrations, err := filepath.Abs("../../../../../migrations")
+	require.NoError(t, err)
+	require.NoError(t, migration.NewMigrator(dsn, migration.WithAbsolutePath(migrations)).Migrate())
+
+	pool, err := pgxpool.Connect(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return NewStorage(pool, testLogger{t: t})
+}
+
+func TestStorage_SetURLsAlias(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.WithValue(context.Background(), key, "user")
+
+	suffix := uuid.NewString()[:8]
+	originalURL := "https://example.com/" + suffix
+	generated := "gen-" + suffix
+
+	_, err := s.SetURL(ctx, &urlstorage.URLRecord{ShortURL: generated, OriginalURL: originalURL})
+	require.NoError(t, err)
+
+	inserted, err := s.SetURLs(ctx, []*urlstorage.URLRecord{
+		{ShortURL: "spring-" + suffix, OriginalURL: originalURL, Alias: true},
+		{ShortURL: "summer-" + suffix, OriginalURL: originalURL, Alias: true},
+		{ShortURL: generated, OriginalURL: originalURL},
+	})
+	require.NoError(t, err)
+	require.Len(t, inserted, 2)
+	for _, record := range inserted {
+		require.True(t, record.Alias)
+	}
+
+	record, err := s.GetURL(ctx, "summer-"+suffix)
+	require.NoError(t, err)
+	require.Equal(t, originalURL, record.OriginalURL)
+
+	// Generated short URLs stay unique per original URL.
+	_, err = s.SetURL(ctx, &urlstorage.URLRecord{ShortURL: "other-" + suffix, OriginalURL: originalURL})
+	require.ErrorIs(t, err, urlstorage.ErrConflict)
+}
+
+func TestStorage_GetURLsSearch(t *testing.T) {
+	s := newTestStorage(t)
+	user := uuid.NewString()
+	ctx := context.WithValue(context.Background(), key, user)
+
+	suffix := uuid.NewString()[:8]
+	records := []*urlstorage.URLRecord{
+		{ShortURL: "a-" + suffix, OriginalURL: "https://example.com/Spring-sale/" + suffix},
+		{ShortURL: "b-" + suffix, OriginalURL: "https://example.com/b/" + suffix, Title: "The spring sale"},
+		{ShortURL: "c-" + suffix, OriginalURL: "https://example.com/100%25/" + suffix, Title: "100% off"},
+	}
+	for _, record := range records {
+		_, err := s.SetURL(ctx, record)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name   string
+		search string
+		want   []string
+	}{
+		{name: "url or title, ignoring case", search: "SPRING", want: []string{"a-" + suffix, "b-" + suffix}},
+		{name: "wildcards are literal", search: "0%", want: []string{"c-" + suffix}},
+		{name: "no search", want: []string{"a-" + suffix, "b-" + suffix, "c-" + suffix}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urls, err := s.GetURLs(ctx, &urlstorage.URLFilter{Search: tt.search, Sort: urlstorage.SortCreated})
+			require.NoError(t, err)
+			got := make([]string, 0, len(urls))
+			for _, url := range urls {
+				got = append(got, url.ShortURL)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStorage_EndWindows(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.WithValue(context.Background(), key, uuid.NewString())
+
+	// Windows ended long ago, so that the links of other tests are not in the way.
+	suffix := uuid.NewString()[:8]
+	ended := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	running := time.Now().Add(time.Hour)
+	for _, record := range []*urlstorage.URLRecord{
+		{ShortURL: "ended-" + suffix, OriginalURL: "https://example.com/ended/" + suffix, NotAfter: &ended},
+		{ShortURL: "running-" + suffix, OriginalURL: "https://example.com/running/" + suffix, NotAfter: &running},
+	} {
+		_, err := s.SetURL(ctx, record)
+		require.NoError(t, err)
+	}
+
+	records, err := s.EndWindows(ctx, ended.Add(time.Second), 10)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "ended-"+suffix, records[0].ShortURL)
+	require.True(t, records[0].EndEmitted.Equal(ended))
+
+	var reason string
+	err = s.conn.QueryRow(ctx, `SELECT reason FROM outbox_event WHERE short_url = $1 AND type = $2`, "ended-"+suffix, events.TypeLinkExpired).Scan(&reason)
+	require.NoError(t, err)
+	require.Equal(t, events.ReasonEnded, reason)
+
+	records, err = s.EndWindows(ctx, ended.Add(time.Second), 10)
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
@@ -0,0 +1,150 @@
+package psql
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/DanilNaum/SnipURL/internal/app/repository/url/psql"
+
+// tracedPool wraps the connection pool so that every query runs in a client span
+// carrying its statement, and failed queries are logged with the request they serve.
+// Queries in transactions are traced the same way.
+type tracedPool struct {
+	*pgxpool.Pool
+	logger logger
+}
+
+// QueryRow starts the span of the query, which ends when the row is scanned.
+func (p *tracedPool) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx, span := startQuerySpan(ctx, sql)
+	return &tracedRow{Row: p.Pool.QueryRow(ctx, sql, args...), ctx: ctx, span: span, logger: p.logger}
+}
+
+// Query starts the span of the query, which ends when the rows are closed.
+func (p *tracedPool) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, span := startQuerySpan(ctx, sql)
+	rows, err := p.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		endQuerySpan(ctx, span, p.logger, err)
+		return nil, err
+	}
+	return &tracedRows{Rows: rows, ctx: ctx, span: span, logger: p.logger}, nil
+}
+
+// Exec runs the statement in a span.
+func (p *tracedPool) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	ctx, span := startQuerySpan(ctx, sql)
+	tag, err := p.Pool.Exec(ctx, sql, args...)
+	endQuerySpan(ctx, span, p.logger, err)
+	return tag, err
+}
+
+// BeginFunc runs f in a transaction whose queries are traced.
+func (p *tracedPool) BeginFunc(ctx context.Context, f func(pgx.Tx) error) error {
+	return p.Pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return f(&tracedTx{Tx: tx, logger: p.logger})
+	})
+}
+
+// tracedTx traces the queries of a transaction like tracedPool.
+type tracedTx struct {
+	pgx.Tx
+	logger logger
+}
+
+func (t *tracedTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx, span := startQuerySpan(ctx, sql)
+	return &tracedRow{Row: t.Tx.QueryRow(ctx, sql, args...), ctx: ctx, span: span, logger: t.logger}
+}
+
+func (t *tracedTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, span := startQuerySpan(ctx, sql)
+	rows, err := t.Tx.Query(ctx, sql, args...)
+	if err != nil {
+		endQuerySpan(ctx, span, t.logger, err)
+		return nil, err
+	}
+	return &tracedRows{Rows: rows, ctx: ctx, span: span, logger: t.logger}, nil
+}
+
+func (t *tracedTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	ctx, span := startQuerySpan(ctx, sql)
+	tag, err := t.Tx.Exec(ctx, sql, args...)
+	endQuerySpan(ctx, span, t.logger, err)
+	return tag, err
+}
+
+type tracedRow struct {
+	pgx.Row
+	ctx    context.Context
+	span   trace.Span
+	logger logger
+}
+
+func (r *tracedRow) Scan(dest ...interface{}) error {
+	err := r.Row.Scan(dest...)
+	endQuerySpan(r.ctx, r.span, r.logger, err)
+	return err
+}
+
+type tracedRows struct {
+	pgx.Rows
+	ctx    context.Context
+	span   trace.Span
+	logger logger
+	closed bool
+}
+
+// Close closes the rows and ends the span of the query once, as iterators may close
+// their rows twice.
+func (r *tracedRows) Close() {
+	r.Rows.Close()
+	if r.closed {
+		return
+	}
+	r.closed = true
+	endQuerySpan(r.ctx, r.span, r.logger, r.Rows.Err())
+}
+
+// startQuerySpan starts the client span of the statement, named after its operation.
+func startQuerySpan(ctx context.Context, sql string) (context.Context, trace.Span) {
+	operation, _, _ := strings.Cut(strings.TrimSpace(sql), " ")
+	operation = strings.ToUpper(operation)
+	return otel.Tracer(tracerName).Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(sql),
+		),
+	)
+}
+
+// endQuerySpan ends the span, recording and logging the error of the query. No rows
+// and unique violations are expected outcomes the storage handles, so they are
+// not logged.
+func endQuerySpan(ctx context.Context, span trace.Span, logger logger, err error) {
+	defer span.End()
+	if err == nil || errors.Is(err, pgx.ErrNoRows) {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+		return
+	}
+	logger.ErrorfContext(ctx, "query failed: %v", err)
+}
@@ -0,0 +1,82 @@
+package psql
+
+import (
+	"context"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	outboxpsql "github.com/DanilNaum/SnipURL/internal/app/repository/outbox/psql"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/jackc/pgx/v4"
+)
+
+// EndWindows marks up to limit non-deleted links whose active window is over at now and
+// whose end was not emitted yet, the earliest ended first, and writes their expiries to
+// the outbox in the same transaction. The links are locked with SKIP LOCKED, so that
+// concurrent instances mark different ones. Returns the marked links.
+func (s *storage) EndWindows(ctx context.Context, now time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+	query := `WITH ended AS (
+		SELECT id FROM url 
+		WHERE deleted = false AND not_after <= $1 AND end_emitted IS DISTINCT FROM not_after 
+		ORDER BY not_after, id LIMIT $2 
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE url SET end_emitted = url.not_after FROM ended WHERE url.id = ended.id 
+	RETURNING url.uuid, url.id, url.url, COALESCE(url.user_uuid, ''), url.redirect_type, url.passthrough, url.query_mode, url.template, url.variants, url.password_hash, 
+	url.max_clicks, url.clicks_left, url.not_before, url.not_after, url.end_emitted, url.fallback_url, url.title, url.notes, url.tags, url.created_at, url.clicks`
+
+	var urls []*urlstorage.URLRecord
+	err := s.conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, query, now, limit)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		urls = make([]*urlstorage.URLRecord, 0, limit)
+		evs := make([]*events.Event, 0, limit)
+		for rows.Next() {
+			var urlRecord urlstorage.URLRecord
+			err := rows.Scan(
+				&urlRecord.ID,
+				&urlRecord.ShortURL,
+				&urlRecord.OriginalURL,
+				&urlRecord.UserID,
+				&urlRecord.RedirectType,
+				&urlRecord.Passthrough,
+				&urlRecord.QueryMode,
+				&urlRecord.Template,
+				&urlRecord.Variants,
+				&urlRecord.PasswordHash,
+				&urlRecord.MaxClicks,
+				&urlRecord.ClicksLeft,
+				&urlRecord.NotBefore,
+				&urlRecord.NotAfter,
+				&urlRecord.EndEmitted,
+				&urlRecord.FallbackURL,
+				&urlRecord.Title,
+				&urlRecord.Notes,
+				&urlRecord.Tags,
+				&urlRecord.CreatedAt,
+				&urlRecord.Clicks,
+			)
+			if err != nil {
+				return err
+			}
+			urls = append(urls, &urlRecord)
+
+			event := newEvent(events.TypeLinkExpired, urlRecord.UserID, urlRecord.ShortURL, urlRecord.OriginalURL)
+			event.Reason = events.ReasonEnded
+			evs = append(evs, event)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		rows.Close()
+		return outboxpsql.AddEvents(ctx, tx, evs...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return urls, nil
+}
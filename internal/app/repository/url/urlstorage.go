@@ -1,15 +1,37 @@
 package url
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // URLStorage defines the interface for URL storage operations.
 // It provides methods for managing and retrieving URL records.
 type URLStorage interface {
 	Ping(ctx context.Context) error
-	GetURL(ctx context.Context, id string) (string, error)
-	SetURL(ctx context.Context, id, url string) (int, error)
+	GetURL(ctx context.Context, id string) (*URLRecord, error)
+	SetURL(ctx context.Context, record *URLRecord) (int, error)
 	SetURLs(ctx context.Context, urls []*URLRecord) ([]*URLRecord, error)
-	GetURLs(ctx context.Context) ([]*URLRecord, error)
-	DeleteURLs(userID string, ids []string) error
+	UpdateURL(ctx context.Context, record *URLRecord) error
+	ConsumeClick(ctx context.Context, id string) (int, error)
+	IncrementClicks(ctx context.Context, id string) (int, error)
+	GetURLs(ctx context.Context, filter *URLFilter) ([]*URLRecord, error)
+	IterateURLs(ctx context.Context) (URLIterator, error)
+	GetURLsToCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*URLRecord, error)
+	SetHealth(ctx context.Context, id string, health *Health) error
+	EndWindows(ctx context.Context, now time.Time, limit int) ([]*URLRecord, error)
+	DeleteURLs(userID string, ids []string) ([]string, error)
+	EraseURLs(ctx context.Context, userID string) error
 	GetState(ctx context.Context) (*State, error)
 }
+
+// URLIterator walks the URL records of a user one at a time, so that they need not be
+// held in memory at once. Next advances to the next record and reports whether there
+// is one, Err reports the error that stopped the iteration, and Close releases the
+// resources held by the iterator; it must be called once the iterator is no longer needed.
+type URLIterator interface {
+	Next() bool
+	Record() *URLRecord
+	Err() error
+	Close()
+}
@@ -0,0 +1,6 @@
+package user
+
+import "errors"
+
+// ErrNotFound indicates that the requested erasure job does not exist.
+var ErrNotFound = errors.New("erasure job not found")
@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	userstorage "github.com/DanilNaum/SnipURL/internal/app/repository/user"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+)
+
+// Kinds of the states written to the dumper log.
+const (
+	revokedKind = "revoked_user"
+	jobKind     = "erasure_job"
+)
+
+type dumper interface {
+	AddStates(states ...*dump.State) error
+	ReadStates(kind string) ([]*dump.State, error)
+}
+
+type storage struct {
+	mu      sync.RWMutex
+	dumper  dumper
+	revoked map[string]struct{}
+	jobs    map[string]*userstorage.ErasureJob
+}
+
+// NewStorage creates an in-memory storage for revoked users and erasure jobs backed by
+// the dumper log, restoring the ones written to it before. Every change is appended to
+// the log before it is applied, so that a revoked user stays revoked after a restart.
+func NewStorage(dumper dumper) (*storage, error) {
+	s := &storage{
+		dumper:  dumper,
+		revoked: make(map[string]struct{}),
+		jobs:    make(map[string]*userstorage.ErasureJob),
+	}
+
+	revoked, err := dumper.ReadStates(revokedKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range revoked {
+		s.revoked[state.Key] = struct{}{}
+	}
+
+	jobs, err := dumper.ReadStates(jobKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range jobs {
+		var job userstorage.ErasureJob
+		if err := json.Unmarshal(state.Value, &job); err != nil {
+			return nil, err
+		}
+		s.jobs[job.ID] = &job
+	}
+	return s, nil
+}
+
+// RevokeUser marks the user ID as revoked.
+func (s *storage) RevokeUser(_ context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.dumper.AddStates(&dump.State{Kind: revokedKind, Key: userID}); err != nil {
+		return err
+	}
+	s.revoked[userID] = struct{}{}
+	return nil
+}
+
+// IsRevoked reports whether the user ID was revoked.
+func (s *storage) IsRevoked(_ context.Context, userID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.revoked[userID]
+	return ok, nil
+}
+
+// SetErasureJob stores a copy of the job, replacing the one with the same ID.
+func (s *storage) SetErasureJob(_ context.Context, job *userstorage.ErasureJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := dump.NewState(jobKind, job.ID, job)
+	if err != nil {
+		return err
+	}
+	if err := s.dumper.AddStates(state); err != nil {
+		return err
+	}
+	j := *job
+	s.jobs[job.ID] = &j
+	return nil
+}
+
+// GetErasureJob returns a copy of the job with the given ID.
+// Returns ErrNotFound if there is no such job.
+func (s *storage) GetErasureJob(_ context.Context, id string) (*userstorage.ErasureJob, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, userstorage.ErrNotFound
+	}
+	j := *job
+	return &j, nil
+}
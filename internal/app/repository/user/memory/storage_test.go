@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	userstorage "github.com/DanilNaum/SnipURL/internal/app/repository/user"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "dump.json")
+	d, err := dump.NewDumper(path, nil)
+	require.NoError(t, err)
+	s, err := NewStorage(d)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	job := &userstorage.ErasureJob{ID: "job", UserID: "user", Status: userstorage.ErasureStatusPending, CreatedAt: createdAt}
+	require.NoError(t, s.RevokeUser(ctx, "user"))
+	require.NoError(t, s.SetErasureJob(ctx, job))
+	finishedAt := createdAt.Add(time.Minute)
+	job.Status = userstorage.ErasureStatusDone
+	job.FinishedAt = &finishedAt
+	require.NoError(t, s.SetErasureJob(ctx, job))
+	require.NoError(t, d.Close())
+
+	// The revoked users and the latest state of the jobs are restored.
+	d, err = dump.NewDumper(path, nil)
+	require.NoError(t, err)
+	defer d.Close()
+	s, err = NewStorage(d)
+	require.NoError(t, err)
+
+	revoked, err := s.IsRevoked(ctx, "user")
+	require.NoError(t, err)
+	require.True(t, revoked)
+	revoked, err = s.IsRevoked(ctx, "other")
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	restored, err := s.GetErasureJob(ctx, "job")
+	require.NoError(t, err)
+	require.Equal(t, job, restored)
+
+	_, err = s.GetErasureJob(ctx, "missing")
+	require.ErrorIs(t, err, userstorage.ErrNotFound)
+}
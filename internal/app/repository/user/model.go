@@ -0,0 +1,21 @@
+package user
+
+import "time"
+
+// Statuses of an erasure job.
+const (
+	ErasureStatusPending = "pending"
+	ErasureStatusDone    = "done"
+	ErasureStatusFailed  = "failed"
+)
+
+// ErasureJob tracks the erasure of a user's data, which runs in the background.
+// FinishedAt is nil while the job is pending.
+type ErasureJob struct {
+	ID         string
+	UserID     string
+	Status     string
+	Error      string
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
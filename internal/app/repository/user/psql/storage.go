@@ -0,0 +1,81 @@
+package psql
+
+import (
+	"context"
+	"errors"
+
+	userstorage "github.com/DanilNaum/SnipURL/internal/app/repository/user"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type storage struct {
+	conn *pgxpool.Pool
+}
+
+// NewStorage creates a new storage for revoked users and erasure jobs with the provided database connection pool.
+func NewStorage(conn *pgxpool.Pool) *storage {
+	return &storage{
+		conn: conn,
+	}
+}
+
+// RevokeUser marks the user ID as revoked. Revoking a user twice keeps the first revocation time.
+func (s *storage) RevokeUser(ctx context.Context, userID string) error {
+	query := `INSERT INTO revoked_user (user_uuid) VALUES ($1) ON CONFLICT (user_uuid) DO NOTHING`
+
+	_, err := s.conn.Exec(ctx, query, userID)
+	return err
+}
+
+// IsRevoked reports whether the user ID was revoked.
+func (s *storage) IsRevoked(ctx context.Context, userID string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM revoked_user WHERE user_uuid = $1)`
+
+	var revoked bool
+	err := s.conn.QueryRow(ctx, query, userID).Scan(&revoked)
+	return revoked, err
+}
+
+// SetErasureJob creates the job or replaces the one with the same ID.
+func (s *storage) SetErasureJob(ctx context.Context, job *userstorage.ErasureJob) error {
+	query := `INSERT INTO user_erasure_job (id, user_uuid, status, error, created_at, finished_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (id) DO UPDATE SET
+		status = EXCLUDED.status,
+		error = EXCLUDED.error,
+		finished_at = EXCLUDED.finished_at`
+
+	_, err := s.conn.Exec(ctx, query,
+		job.ID,
+		job.UserID,
+		job.Status,
+		job.Error,
+		job.CreatedAt,
+		job.FinishedAt,
+	)
+	return err
+}
+
+// GetErasureJob returns the job with the given ID.
+// Returns ErrNotFound if there is no such job.
+func (s *storage) GetErasureJob(ctx context.Context, id string) (*userstorage.ErasureJob, error) {
+	query := `SELECT id, user_uuid, status, error, created_at, finished_at FROM user_erasure_job WHERE id = $1`
+
+	var job userstorage.ErasureJob
+	err := s.conn.QueryRow(ctx, query, id).Scan(
+		&job.ID,
+		&job.UserID,
+		&job.Status,
+		&job.Error,
+		&job.CreatedAt,
+		&job.FinishedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, userstorage.ErrNotFound
+		}
+		return nil, err
+	}
+	return &job, nil
+}
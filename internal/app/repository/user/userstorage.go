@@ -0,0 +1,13 @@
+package user
+
+import "context"
+
+// UserStorage defines the interface for the storage of revoked users and of the
+// jobs erasing their data. A revoked user ID is no longer accepted from clients.
+// SetErasureJob creates the job or replaces the one with the same ID.
+type UserStorage interface {
+	RevokeUser(ctx context.Context, userID string) error
+	IsRevoked(ctx context.Context, userID string) (bool, error)
+	SetErasureJob(ctx context.Context, job *ErasureJob) error
+	GetErasureJob(ctx context.Context, id string) (*ErasureJob, error)
+}
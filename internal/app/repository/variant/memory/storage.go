@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+)
+
+// servedKind is the kind of the states written to the dumper log.
+const servedKind = "variant_served"
+
+type dumper interface {
+	AddStates(states ...*dump.State) error
+	ReadStates(kind string) ([]*dump.State, error)
+}
+
+type storage struct {
+	mu     sync.RWMutex
+	dumper dumper
+	served map[string]map[string]int
+}
+
+// NewStorage creates an in-memory storage for A/B split statistics backed by the dumper
+// log, restoring the counters written to it before. Every increment appends the short
+// URL's counters to the log before it is applied, so that they survive a restart.
+func NewStorage(dumper dumper) (*storage, error) {
+	s := &storage{
+		dumper: dumper,
+		served: make(map[string]map[string]int),
+	}
+
+	states, err := dumper.ReadStates(servedKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range states {
+		var counters map[string]int
+		if err := json.Unmarshal(state.Value, &counters); err != nil {
+			return nil, err
+		}
+		s.served[state.Key] = counters
+	}
+	return s, nil
+}
+
+// IncrementServed increases the served counter of the short URL's variant by one.
+func (s *storage) IncrementServed(_ context.Context, shortURL, variant string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counters := make(map[string]int, len(s.served[shortURL])+1)
+	for v, served := range s.served[shortURL] {
+		counters[v] = served
+	}
+	counters[variant]++
+
+	state, err := dump.NewState(servedKind, shortURL, counters)
+	if err != nil {
+		return err
+	}
+	if err := s.dumper.AddStates(state); err != nil {
+		return err
+	}
+	s.served[shortURL] = counters
+	return nil
+}
+
+// GetServed returns a copy of the served counters of the short URL's variants.
+// Variants that were never served are absent from the result.
+func (s *storage) GetServed(_ context.Context, shortURL string) (map[string]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	output := make(map[string]int, len(s.served[shortURL]))
+	for variant, served := range s.served[shortURL] {
+		output[variant] = served
+	}
+	return output, nil
+}
+
+// DeleteServed removes the served counters of the short URLs.
+func (s *storage) DeleteServed(_ context.Context, shortURLs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted []*dump.State
+	for _, shortURL := range shortURLs {
+		if _, ok := s.served[shortURL]; ok {
+			deleted = append(deleted, dump.DeletedState(servedKind, shortURL))
+		}
+	}
+	if len(deleted) > 0 {
+		if err := s.dumper.AddStates(deleted...); err != nil {
+			return err
+		}
+	}
+
+	for _, shortURL := range shortURLs {
+		delete(s.served, shortURL)
+	}
+	return nil
+}
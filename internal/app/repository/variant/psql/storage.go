@@ -0,0 +1,57 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type storage struct {
+	conn *pgxpool.Pool
+}
+
+// NewStorage creates a new A/B split statistics storage with the provided database connection pool.
+func NewStorage(conn *pgxpool.Pool) *storage {
+	return &storage{
+		conn: conn,
+	}
+}
+
+// IncrementServed increases the served counter of the short URL's variant by one.
+func (s *storage) IncrementServed(ctx context.Context, shortURL, variant string) error {
+	query := `INSERT INTO url_variant_stat (url_id, variant, served) VALUES ($1, $2, 1)
+	ON CONFLICT (url_id, variant) DO UPDATE SET served = url_variant_stat.served + 1`
+
+	_, err := s.conn.Exec(ctx, query, shortURL, variant)
+	return err
+}
+
+// GetServed returns the served counters of the short URL's variants.
+// Variants that were never served are absent from the result.
+func (s *storage) GetServed(ctx context.Context, shortURL string) (map[string]int, error) {
+	query := `SELECT variant, served FROM url_variant_stat WHERE url_id = $1`
+
+	rows, err := s.conn.Query(ctx, query, shortURL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := make(map[string]int)
+	for rows.Next() {
+		var variant string
+		var served int
+		if err := rows.Scan(&variant, &served); err != nil {
+			return nil, err
+		}
+		output[variant] = served
+	}
+	return output, rows.Err()
+}
+
+// DeleteServed removes the served counters of the short URLs.
+func (s *storage) DeleteServed(ctx context.Context, shortURLs []string) error {
+	query := `DELETE FROM url_variant_stat WHERE url_id = ANY($1)`
+	_, err := s.conn.Exec(ctx, query, shortURLs)
+	return err
+}
@@ -0,0 +1,11 @@
+package variant
+
+import "context"
+
+// VariantStorage defines the interface for A/B split statistics storage operations.
+// It counts how many times each variant of a short URL was served.
+type VariantStorage interface {
+	IncrementServed(ctx context.Context, shortURL, variant string) error
+	GetServed(ctx context.Context, shortURL string) (map[string]int, error)
+	DeleteServed(ctx context.Context, shortURLs []string) error
+}
@@ -0,0 +1,6 @@
+package webhook
+
+import "errors"
+
+// ErrNotFound indicates that the user has no webhook with the requested ID.
+var ErrNotFound = errors.New("webhook not found")
@@ -0,0 +1,226 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+)
+
+// maxDeliveries is the number of the latest deliveries kept per webhook.
+const maxDeliveries = 100
+
+// Kinds of the states written to the dumper log.
+const (
+	webhookKind  = "webhook"
+	deliveryKind = "webhook_delivery"
+)
+
+type dumper interface {
+	AddStates(states ...*dump.State) error
+	ReadStates(kind string) ([]*dump.State, error)
+}
+
+type storage struct {
+	mu         sync.RWMutex
+	dumper     dumper
+	webhooks   map[string][]*webhookstorage.Webhook
+	deliveries map[string][]*webhookstorage.Delivery
+}
+
+// NewStorage creates an in-memory storage for webhooks and their deliveries backed by
+// the dumper log, restoring the ones written to it before. Every change is appended to
+// the log before it is applied, and only the latest 100 deliveries of every webhook are kept.
+func NewStorage(dumper dumper) (*storage, error) {
+	s := &storage{
+		dumper:     dumper,
+		webhooks:   make(map[string][]*webhookstorage.Webhook),
+		deliveries: make(map[string][]*webhookstorage.Delivery),
+	}
+
+	// States are read in the order they were first written, which restores
+	// the creation order of the webhooks and of the deliveries.
+	webhooks, err := dumper.ReadStates(webhookKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range webhooks {
+		var webhook webhookstorage.Webhook
+		if err := json.Unmarshal(state.Value, &webhook); err != nil {
+			return nil, err
+		}
+		s.webhooks[webhook.UserID] = append(s.webhooks[webhook.UserID], &webhook)
+	}
+
+	deliveries, err := dumper.ReadStates(deliveryKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range deliveries {
+		var delivery webhookstorage.Delivery
+		if err := json.Unmarshal(state.Value, &delivery); err != nil {
+			return nil, err
+		}
+		s.deliveries[delivery.WebhookID] = append(s.deliveries[delivery.WebhookID], &delivery)
+	}
+	return s, nil
+}
+
+// SetWebhook adds the webhook to its user.
+func (s *storage) SetWebhook(_ context.Context, webhook *webhookstorage.Webhook) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := dump.NewState(webhookKind, webhook.ID, webhook)
+	if err != nil {
+		return err
+	}
+	if err := s.dumper.AddStates(state); err != nil {
+		return err
+	}
+	s.webhooks[webhook.UserID] = append(s.webhooks[webhook.UserID], copyWebhook(webhook))
+	return nil
+}
+
+// GetWebhooks returns copies of the user's webhooks in creation order.
+func (s *storage) GetWebhooks(_ context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	webhooks := make([]*webhookstorage.Webhook, 0, len(s.webhooks[userID]))
+	for _, webhook := range s.webhooks[userID] {
+		webhooks = append(webhooks, copyWebhook(webhook))
+	}
+	return webhooks, nil
+}
+
+// GetWebhook returns a copy of the user's webhook with the given ID.
+// Returns ErrNotFound if the user has no such webhook.
+func (s *storage) GetWebhook(_ context.Context, userID, id string) (*webhookstorage.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, webhook := range s.webhooks[userID] {
+		if webhook.ID == id {
+			return copyWebhook(webhook), nil
+		}
+	}
+	return nil, webhookstorage.ErrNotFound
+}
+
+// GetWebhooksByClickThreshold returns copies of the webhooks of all users whose click
+// threshold is the given number of clicks.
+func (s *storage) GetWebhooksByClickThreshold(_ context.Context, clicks int) ([]*webhookstorage.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var webhooks []*webhookstorage.Webhook
+	for _, userWebhooks := range s.webhooks {
+		for _, webhook := range userWebhooks {
+			if webhook.ClickThreshold > 0 && webhook.ClickThreshold == clicks {
+				webhooks = append(webhooks, copyWebhook(webhook))
+			}
+		}
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook removes the user's webhook together with its deliveries.
+// Returns ErrNotFound if the user has no such webhook.
+func (s *storage) DeleteWebhook(_ context.Context, userID, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	webhooks := s.webhooks[userID]
+	for i, webhook := range webhooks {
+		if webhook.ID == id {
+			if err := s.dumper.AddStates(s.deletedStates(webhook)...); err != nil {
+				return err
+			}
+			s.webhooks[userID] = append(webhooks[:i], webhooks[i+1:]...)
+			delete(s.deliveries, id)
+			return nil
+		}
+	}
+	return webhookstorage.ErrNotFound
+}
+
+// DeleteWebhooks removes all the webhooks of the user together with their deliveries.
+func (s *storage) DeleteWebhooks(_ context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted []*dump.State
+	for _, webhook := range s.webhooks[userID] {
+		deleted = append(deleted, s.deletedStates(webhook)...)
+	}
+	if len(deleted) > 0 {
+		if err := s.dumper.AddStates(deleted...); err != nil {
+			return err
+		}
+	}
+
+	for _, webhook := range s.webhooks[userID] {
+		delete(s.deliveries, webhook.ID)
+	}
+	delete(s.webhooks, userID)
+	return nil
+}
+
+// AddDelivery appends the delivery to the log of its webhook, dropping the oldest
+// delivery once the webhook has 100 of them.
+func (s *storage) AddDelivery(_ context.Context, delivery *webhookstorage.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := dump.NewState(deliveryKind, delivery.ID, delivery)
+	if err != nil {
+		return err
+	}
+	states := []*dump.State{state}
+	deliveries := s.deliveries[delivery.WebhookID]
+	dropped := max(len(deliveries)+1-maxDeliveries, 0)
+	for _, d := range deliveries[:dropped] {
+		states = append(states, dump.DeletedState(deliveryKind, d.ID))
+	}
+	if err := s.dumper.AddStates(states...); err != nil {
+		return err
+	}
+
+	d := *delivery
+	deliveries = append(deliveries[dropped:], &d)
+	s.deliveries[delivery.WebhookID] = deliveries
+	return nil
+}
+
+// GetDeliveries returns copies of at most limit latest deliveries of the webhook, newest first.
+func (s *storage) GetDeliveries(_ context.Context, webhookID string, limit int) ([]*webhookstorage.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	logged := s.deliveries[webhookID]
+	deliveries := make([]*webhookstorage.Delivery, 0, min(len(logged), limit))
+	for i := len(logged) - 1; i >= 0 && len(deliveries) < limit; i-- {
+		d := *logged[i]
+		deliveries = append(deliveries, &d)
+	}
+	return deliveries, nil
+}
+
+// deletedStates returns the states removing the webhook and its deliveries from the dumper log.
+// The caller must hold the lock.
+func (s *storage) deletedStates(webhook *webhookstorage.Webhook) []*dump.State {
+	states := []*dump.State{dump.DeletedState(webhookKind, webhook.ID)}
+	for _, delivery := range s.deliveries[webhook.ID] {
+		states = append(states, dump.DeletedState(deliveryKind, delivery.ID))
+	}
+	return states
+}
+
+func copyWebhook(webhook *webhookstorage.Webhook) *webhookstorage.Webhook {
+	w := *webhook
+	w.Events = append([]string(nil), webhook.Events...)
+	return &w
+}
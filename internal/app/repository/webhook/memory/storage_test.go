@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_Restore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "dump.json")
+	d, err := dump.NewDumper(path, nil)
+	require.NoError(t, err)
+	s, err := NewStorage(d)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	webhooks := []*webhookstorage.Webhook{
+		{ID: "first", UserID: "user", URL: "https://a.example", Secret: "s1", Events: []string{"link.created"}, CreatedAt: createdAt},
+		{ID: "second", UserID: "user", URL: "https://b.example", Secret: "s2", ClickThreshold: 10, CreatedAt: createdAt},
+		{ID: "deleted", UserID: "user", URL: "https://c.example", Secret: "s3", CreatedAt: createdAt},
+		{ID: "other", UserID: "other", URL: "https://d.example", Secret: "s4", CreatedAt: createdAt},
+	}
+	for _, webhook := range webhooks {
+		require.NoError(t, s.SetWebhook(ctx, webhook))
+	}
+	for i := range maxDeliveries + 2 {
+		require.NoError(t, s.AddDelivery(ctx, &webhookstorage.Delivery{
+			ID: strconv.Itoa(i), WebhookID: "first", Attempt: 1, StatusCode: 200, Success: true, CreatedAt: createdAt,
+		}))
+	}
+	require.NoError(t, s.AddDelivery(ctx, &webhookstorage.Delivery{ID: "dropped", WebhookID: "deleted", CreatedAt: createdAt}))
+	require.NoError(t, s.DeleteWebhook(ctx, "user", "deleted"))
+	require.NoError(t, s.DeleteWebhooks(ctx, "other"))
+	deliveries, err := s.GetDeliveries(ctx, "first", maxDeliveries+2)
+	require.NoError(t, err)
+	require.Len(t, deliveries, maxDeliveries)
+	require.NoError(t, d.Close())
+
+	// The remaining webhooks are restored in creation order together with the latest deliveries.
+	d, err = dump.NewDumper(path, nil)
+	require.NoError(t, err)
+	defer d.Close()
+	s, err = NewStorage(d)
+	require.NoError(t, err)
+
+	restored, err := s.GetWebhooks(ctx, "user")
+	require.NoError(t, err)
+	require.Equal(t, webhooks[:2], restored)
+	restored, err = s.GetWebhooks(ctx, "other")
+	require.NoError(t, err)
+	require.Empty(t, restored)
+
+	restoredDeliveries, err := s.GetDeliveries(ctx, "first", maxDeliveries+2)
+	require.NoError(t, err)
+	require.Equal(t, deliveries, restoredDeliveries)
+	restoredDeliveries, err = s.GetDeliveries(ctx, "deleted", maxDeliveries)
+	require.NoError(t, err)
+	require.Empty(t, restoredDeliveries)
+}
@@ -0,0 +1,32 @@
+package webhook
+
+import "time"
+
+// Webhook is an endpoint of a user notified about the events of the user's links.
+// Events lists the webhook event types it is subscribed to. ClickThreshold is the number
+// of clicks of a link at which a clicks threshold event is delivered, zero if none.
+// Secret signs the payloads.
+type Webhook struct {
+	ID             string
+	UserID         string
+	URL            string
+	Secret         string
+	Events         []string
+	ClickThreshold int
+	CreatedAt      time.Time
+}
+
+// Delivery is one attempt to deliver an event to a webhook.
+// StatusCode is zero and Error is set when no response was received.
+type Delivery struct {
+	ID         string
+	WebhookID  string
+	EventID    string
+	Event      string
+	Attempt    int
+	StatusCode int
+	Error      string
+	Success    bool
+	Duration   time.Duration
+	CreatedAt  time.Time
+}
@@ -0,0 +1,180 @@
+package psql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const webhookColumns = `id, user_uuid, url, secret, events, click_threshold, created_at`
+
+type storage struct {
+	conn *pgxpool.Pool
+}
+
+// NewStorage creates a new storage for webhooks and their deliveries with the provided database connection pool.
+func NewStorage(conn *pgxpool.Pool) *storage {
+	return &storage{
+		conn: conn,
+	}
+}
+
+// SetWebhook adds the webhook to its user.
+func (s *storage) SetWebhook(ctx context.Context, webhook *webhookstorage.Webhook) error {
+	query := `INSERT INTO webhook (` + webhookColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := s.conn.Exec(ctx, query,
+		webhook.ID,
+		webhook.UserID,
+		webhook.URL,
+		webhook.Secret,
+		webhook.Events,
+		webhook.ClickThreshold,
+		webhook.CreatedAt,
+	)
+	return err
+}
+
+// GetWebhooks returns the user's webhooks in creation order.
+func (s *storage) GetWebhooks(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook WHERE user_uuid = $1 ORDER BY created_at, id`
+	return s.queryWebhooks(ctx, query, userID)
+}
+
+// GetWebhook returns the user's webhook with the given ID.
+// Returns ErrNotFound if the user has no such webhook.
+func (s *storage) GetWebhook(ctx context.Context, userID, id string) (*webhookstorage.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook WHERE user_uuid = $1 AND id = $2`
+
+	webhook, err := scanWebhook(s.conn.QueryRow(ctx, query, userID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, webhookstorage.ErrNotFound
+		}
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// GetWebhooksByClickThreshold returns the webhooks of all users whose click threshold
+// is the given number of clicks.
+func (s *storage) GetWebhooksByClickThreshold(ctx context.Context, clicks int) ([]*webhookstorage.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook WHERE click_threshold = $1 AND click_threshold > 0`
+	return s.queryWebhooks(ctx, query, clicks)
+}
+
+// DeleteWebhook removes the user's webhook, its deliveries go with it.
+// Returns ErrNotFound if the user has no such webhook.
+func (s *storage) DeleteWebhook(ctx context.Context, userID, id string) error {
+	query := `DELETE FROM webhook WHERE user_uuid = $1 AND id = $2`
+	tag, err := s.conn.Exec(ctx, query, userID, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return webhookstorage.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteWebhooks removes all the webhooks of the user, their deliveries go with them.
+func (s *storage) DeleteWebhooks(ctx context.Context, userID string) error {
+	query := `DELETE FROM webhook WHERE user_uuid = $1`
+	_, err := s.conn.Exec(ctx, query, userID)
+	return err
+}
+
+// AddDelivery appends the delivery to the log of its webhook.
+func (s *storage) AddDelivery(ctx context.Context, delivery *webhookstorage.Delivery) error {
+	query := `INSERT INTO webhook_delivery (id, webhook_id, event_id, event, attempt, status_code, error, success, duration_ms, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	_, err := s.conn.Exec(ctx, query,
+		delivery.ID,
+		delivery.WebhookID,
+		delivery.EventID,
+		delivery.Event,
+		delivery.Attempt,
+		delivery.StatusCode,
+		delivery.Error,
+		delivery.Success,
+		delivery.Duration.Milliseconds(),
+		delivery.CreatedAt,
+	)
+	return err
+}
+
+// GetDeliveries returns at most limit latest deliveries of the webhook, newest first.
+func (s *storage) GetDeliveries(ctx context.Context, webhookID string, limit int) ([]*webhookstorage.Delivery, error) {
+	query := `SELECT id, webhook_id, event_id, event, attempt, status_code, error, success, duration_ms, created_at
+	FROM webhook_delivery WHERE webhook_id = $1 ORDER BY created_at DESC, id LIMIT $2`
+
+	rows, err := s.conn.Query(ctx, query, webhookID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]*webhookstorage.Delivery, 0, limit)
+	for rows.Next() {
+		var delivery webhookstorage.Delivery
+		var durationMs int64
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookID,
+			&delivery.EventID,
+			&delivery.Event,
+			&delivery.Attempt,
+			&delivery.StatusCode,
+			&delivery.Error,
+			&delivery.Success,
+			&durationMs,
+			&delivery.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		delivery.Duration = time.Duration(durationMs) * time.Millisecond
+		deliveries = append(deliveries, &delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func (s *storage) queryWebhooks(ctx context.Context, query string, args ...any) ([]*webhookstorage.Webhook, error) {
+	rows, err := s.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*webhookstorage.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func scanWebhook(row pgx.Row) (*webhookstorage.Webhook, error) {
+	var webhook webhookstorage.Webhook
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.UserID,
+		&webhook.URL,
+		&webhook.Secret,
+		&webhook.Events,
+		&webhook.ClickThreshold,
+		&webhook.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
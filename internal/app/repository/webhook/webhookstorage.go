@@ -0,0 +1,17 @@
+package webhook
+
+import "context"
+
+// WebhookStorage defines the interface for the storage of the users' webhooks and of
+// the log of their deliveries. Webhooks are returned by creation, deliveries newest first.
+// Deleting a webhook deletes its deliveries.
+type WebhookStorage interface {
+	SetWebhook(ctx context.Context, webhook *Webhook) error
+	GetWebhooks(ctx context.Context, userID string) ([]*Webhook, error)
+	GetWebhook(ctx context.Context, userID, id string) (*Webhook, error)
+	GetWebhooksByClickThreshold(ctx context.Context, clicks int) ([]*Webhook, error)
+	DeleteWebhook(ctx context.Context, userID, id string) error
+	DeleteWebhooks(ctx context.Context, userID string) error
+	AddDelivery(ctx context.Context, delivery *Delivery) error
+	GetDeliveries(ctx context.Context, webhookID string, limit int) ([]*Delivery, error)
+}
@@ -0,0 +1,132 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package audit
+
+import (
+	"context"
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	"sync"
+)
+
+// Ensure, that auditStorageMock does implement auditStorage.
+// If this is not the case, regenerate this file with moq.
+var _ auditStorage = &auditStorageMock{}
+
+// auditStorageMock is a mock implementation of auditStorage.
+//
+//	func TestSomethingThatUsesauditStorage(t *testing.T) {
+//
+//		// make and configure a mocked auditStorage
+//		mockedauditStorage := &auditStorageMock{
+//			AddEntriesFunc: func(ctx context.Context, entries ...*auditstorage.Entry) error {
+//				panic("mock out the AddEntries method")
+//			},
+//			GetEntriesFunc: func(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error) {
+//				panic("mock out the GetEntries method")
+//			},
+//		}
+//
+//		// use mockedauditStorage in code that requires auditStorage
+//		// and then make assertions.
+//
+//	}
+type auditStorageMock struct {
+	// AddEntriesFunc mocks the AddEntries method.
+	AddEntriesFunc func(ctx context.Context, entries ...*auditstorage.Entry) error
+
+	// GetEntriesFunc mocks the GetEntries method.
+	GetEntriesFunc func(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddEntries holds details about calls to the AddEntries method.
+		AddEntries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Entries is the entries argument value.
+			Entries []*auditstorage.Entry
+		}
+		// GetEntries holds details about calls to the GetEntries method.
+		GetEntries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter *auditstorage.Filter
+		}
+	}
+	lockAddEntries sync.RWMutex
+	lockGetEntries sync.RWMutex
+}
+
+// AddEntries calls AddEntriesFunc.
+func (mock *auditStorageMock) AddEntries(ctx context.Context, entries ...*auditstorage.Entry) error {
+	if mock.AddEntriesFunc == nil {
+		panic("auditStorageMock.AddEntriesFunc: method is nil but auditStorage.AddEntries was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Entries []*auditstorage.Entry
+	}{
+		Ctx:     ctx,
+		Entries: entries,
+	}
+	mock.lockAddEntries.Lock()
+	mock.calls.AddEntries = append(mock.calls.AddEntries, callInfo)
+	mock.lockAddEntries.Unlock()
+	return mock.AddEntriesFunc(ctx, entries...)
+}
+
+// AddEntriesCalls gets all the calls that were made to AddEntries.
+// Check the length with:
+//
+//	len(mockedauditStorage.AddEntriesCalls())
+func (mock *auditStorageMock) AddEntriesCalls() []struct {
+	Ctx     context.Context
+	Entries []*auditstorage.Entry
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Entries []*auditstorage.Entry
+	}
+	mock.lockAddEntries.RLock()
+	calls = mock.calls.AddEntries
+	mock.lockAddEntries.RUnlock()
+	return calls
+}
+
+// GetEntries calls GetEntriesFunc.
+func (mock *auditStorageMock) GetEntries(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error) {
+	if mock.GetEntriesFunc == nil {
+		panic("auditStorageMock.GetEntriesFunc: method is nil but auditStorage.GetEntries was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter *auditstorage.Filter
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockGetEntries.Lock()
+	mock.calls.GetEntries = append(mock.calls.GetEntries, callInfo)
+	mock.lockGetEntries.Unlock()
+	return mock.GetEntriesFunc(ctx, filter)
+}
+
+// GetEntriesCalls gets all the calls that were made to GetEntries.
+// Check the length with:
+//
+//	len(mockedauditStorage.GetEntriesCalls())
+func (mock *auditStorageMock) GetEntriesCalls() []struct {
+	Ctx    context.Context
+	Filter *auditstorage.Filter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter *auditstorage.Filter
+	}
+	mock.lockGetEntries.RLock()
+	calls = mock.calls.GetEntries
+	mock.lockGetEntries.RUnlock()
+	return calls
+}
@@ -0,0 +1,87 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package audit
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that loggerMock does implement logger.
+// If this is not the case, regenerate this file with moq.
+var _ logger = &loggerMock{}
+
+// loggerMock is a mock implementation of logger.
+//
+//	func TestSomethingThatUseslogger(t *testing.T) {
+//
+//		// make and configure a mocked logger
+//		mockedlogger := &loggerMock{
+//			ErrorfContextFunc: func(ctx context.Context, template string, args ...interface{})  {
+//				panic("mock out the ErrorfContext method")
+//			},
+//		}
+//
+//		// use mockedlogger in code that requires logger
+//		// and then make assertions.
+//
+//	}
+type loggerMock struct {
+	// ErrorfContextFunc mocks the ErrorfContext method.
+	ErrorfContextFunc func(ctx context.Context, template string, args ...interface{})
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ErrorfContext holds details about calls to the ErrorfContext method.
+		ErrorfContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Template is the template argument value.
+			Template string
+			// Args is the args argument value.
+			Args []interface{}
+		}
+	}
+	lockErrorfContext sync.RWMutex
+}
+
+// ErrorfContext calls ErrorfContextFunc.
+func (mock *loggerMock) ErrorfContext(ctx context.Context, template string, args ...interface{}) {
+	if mock.ErrorfContextFunc == nil {
+		panic("loggerMock.ErrorfContextFunc: method is nil but logger.ErrorfContext was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Template string
+		Args     []interface{}
+	}{
+		Ctx:      ctx,
+		Template: template,
+		Args:     args,
+	}
+	mock.lockErrorfContext.Lock()
+	mock.calls.ErrorfContext = append(mock.calls.ErrorfContext, callInfo)
+	mock.lockErrorfContext.Unlock()
+	mock.ErrorfContextFunc(ctx, template, args...)
+}
+
+// ErrorfContextCalls gets all the calls that were made to ErrorfContext.
+// Check the length with:
+//
+//	len(mockedlogger.ErrorfContextCalls())
+func (mock *loggerMock) ErrorfContextCalls() []struct {
+	Ctx      context.Context
+	Template string
+	Args     []interface{}
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Template string
+		Args     []interface{}
+	}
+	mock.lockErrorfContext.RLock()
+	calls = mock.calls.ErrorfContext
+	mock.lockErrorfContext.RUnlock()
+	return calls
+}
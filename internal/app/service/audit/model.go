@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"time"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+)
+
+// Actions recorded in the audit log.
+const (
+	ActionCreate = auditstorage.ActionCreate
+	ActionUpdate = auditstorage.ActionUpdate
+	ActionDelete = auditstorage.ActionDelete
+)
+
+// Authentication methods of the users acting on links.
+const (
+	AuthCookie   = auditstorage.AuthCookie
+	AuthMetadata = auditstorage.AuthMetadata
+	AuthIssued   = auditstorage.AuthIssued
+)
+
+// Entry is an action of a user on a link recorded in the audit log.
+type Entry struct {
+	ID         int64
+	UserID     string
+	Action     string
+	ShortURL   string
+	IP         string
+	AuthMethod string
+	CreatedAt  time.Time
+}
+
+// Filter selects entries of the audit log. Empty fields match every entry; To is
+// exclusive. Before continues a listing from the Next of its previous page.
+type Filter struct {
+	UserID   string
+	ShortURL string
+	Action   string
+	From     time.Time
+	To       time.Time
+	Before   int64
+	Limit    int
+}
+
+// Page is a page of the audit log, newest entries first. Next is the Before of the
+// following page and zero on the last one.
+type Page struct {
+	Entries []*Entry
+	Next    int64
+}
@@ -0,0 +1,174 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/middlewares"
+)
+
+// Predefined errors returned by the audit service.
+var (
+	// ErrInvalidFilter indicates a filter with an unknown action, an inverted time
+	// range or a limit out of range.
+	ErrInvalidFilter = fmt.Errorf("invalid filter")
+
+	// ErrNoUser indicates that the context carries no user ID.
+	ErrNoUser = fmt.Errorf("user not found in context")
+)
+
+// This const allows to configure the size of the audit log pages.
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+//go:generate moq -out mock_audit_storage_moq_test.go . auditStorage
+type auditStorage interface {
+	AddEntries(ctx context.Context, entries ...*auditstorage.Entry) error
+	GetEntries(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error)
+}
+
+//go:generate moq -out mock_logger_moq_test.go . logger
+type logger interface {
+	ErrorfContext(ctx context.Context, template string, args ...interface{})
+}
+
+var (
+	key           = middlewares.Key{Key: "userID"}
+	ipKey         = middlewares.Key{Key: "clientIP"}
+	authMethodKey = middlewares.Key{Key: "authMethod"}
+)
+
+type auditService struct {
+	storage auditStorage
+	logger  logger
+	now     func() time.Time
+}
+
+// NewAuditService creates a new service recording the actions of users on links in
+// the append-only audit log and querying it.
+//
+// Parameters:
+//   - storage: Storage of the audit log
+//   - logger: Logger for recording errors
+//
+// Returns:
+//   - *auditService: a configured audit service
+func NewAuditService(storage auditStorage, logger logger) *auditService {
+	return &auditService{storage: storage, logger: logger, now: time.Now}
+}
+
+// Record appends the action of the user of the context on each of the links to the
+// audit log, with the client IP and the authentication method the HTTP and gRPC APIs
+// put in the context. The action is already done, so a failure to record it is logged
+// rather than returned.
+func (s *auditService) Record(ctx context.Context, action string, shortURLs ...string) {
+	if len(shortURLs) == 0 {
+		return
+	}
+
+	userID, _ := ctx.Value(key).(string)
+	ip, _ := ctx.Value(ipKey).(string)
+	authMethod, _ := ctx.Value(authMethodKey).(string)
+
+	createdAt := s.now().UTC()
+	entries := make([]*auditstorage.Entry, 0, len(shortURLs))
+	for _, shortURL := range shortURLs {
+		entries = append(entries, &auditstorage.Entry{
+			UserID:     userID,
+			Action:     action,
+			ShortURL:   shortURL,
+			IP:         ip,
+			AuthMethod: authMethod,
+			CreatedAt:  createdAt,
+		})
+	}
+
+	if err := s.storage.AddEntries(ctx, entries...); err != nil {
+		s.logger.ErrorfContext(ctx, "failed to record %s of %d links by user %s: %v", action, len(shortURLs), userID, err)
+	}
+}
+
+// GetEntries returns a page of the audit log selected by the filter, newest entries
+// first. A zero Limit returns the default page size.
+// Returns ErrInvalidFilter if the filter is invalid.
+func (s *auditService) GetEntries(ctx context.Context, filter *Filter) (*Page, error) {
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	// One more entry than the page tells whether a next page exists.
+	records, err := s.storage.GetEntries(ctx, &auditstorage.Filter{
+		UserID:   filter.UserID,
+		ShortURL: filter.ShortURL,
+		Action:   filter.Action,
+		From:     filter.From,
+		To:       filter.To,
+		Before:   filter.Before,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit entries: %w", err)
+	}
+
+	page := &Page{Entries: make([]*Entry, 0, min(len(records), limit))}
+	if len(records) > limit {
+		records = records[:limit]
+		page.Next = records[limit-1].ID
+	}
+	for _, record := range records {
+		page.Entries = append(page.Entries, &Entry{
+			ID:         record.ID,
+			UserID:     record.UserID,
+			Action:     record.Action,
+			ShortURL:   record.ShortURL,
+			IP:         record.IP,
+			AuthMethod: record.AuthMethod,
+			CreatedAt:  record.CreatedAt,
+		})
+	}
+
+	return page, nil
+}
+
+// GetUserEntries is GetEntries limited to the entries of the user from the context,
+// whatever UserID the filter has.
+// Returns ErrNoUser if the context carries no user ID.
+func (s *auditService) GetUserEntries(ctx context.Context, filter *Filter) (*Page, error) {
+	userID, ok := ctx.Value(key).(string)
+	if !ok || userID == "" {
+		return nil, ErrNoUser
+	}
+
+	userFilter := *filter
+	userFilter.UserID = userID
+	return s.GetEntries(ctx, &userFilter)
+}
+
+func validateFilter(filter *Filter) error {
+	switch filter.Action {
+	case "", ActionCreate, ActionUpdate, ActionDelete:
+	default:
+		return fmt.Errorf("%w: unknown action %q", ErrInvalidFilter, filter.Action)
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidFilter)
+	}
+	if filter.Limit < 0 || filter.Limit > maxLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidFilter, maxLimit)
+	}
+	if filter.Before < 0 {
+		return fmt.Errorf("%w: before must be positive", ErrInvalidFilter)
+	}
+
+	return nil
+}
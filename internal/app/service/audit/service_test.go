@@ -0,0 +1,158 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditService_Record(t *testing.T) {
+	tests := []struct {
+		name                        string
+		shortURLs                   []string
+		addErr                      error
+		addEntriesFuncNumberOfCalls int
+		errorfContextNumberOfCalls  int
+	}{
+		{
+			name:                        "records every link",
+			shortURLs:                   []string{"abc", "def"},
+			addEntriesFuncNumberOfCalls: 1,
+		},
+		{
+			name: "no links",
+		},
+		{
+			name:                        "storage failure is logged",
+			shortURLs:                   []string{"abc"},
+			addErr:                      errors.New("disk full"),
+			addEntriesFuncNumberOfCalls: 1,
+			errorfContextNumberOfCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added []*auditstorage.Entry
+			mockStorage := &auditStorageMock{
+				AddEntriesFunc: func(ctx context.Context, entries ...*auditstorage.Entry) error {
+					added = entries
+					return tt.addErr
+				},
+			}
+			mockLogger := &loggerMock{
+				ErrorfContextFunc: func(ctx context.Context, template string, args ...interface{}) {},
+			}
+
+			s := NewAuditService(mockStorage, mockLogger)
+
+			ctx := context.WithValue(context.Background(), key, "user")
+			ctx = context.WithValue(ctx, ipKey, "10.0.0.1")
+			ctx = context.WithValue(ctx, authMethodKey, AuthCookie)
+			s.Record(ctx, ActionDelete, tt.shortURLs...)
+
+			require.Equal(t, tt.addEntriesFuncNumberOfCalls, len(mockStorage.AddEntriesCalls()))
+			require.Equal(t, tt.errorfContextNumberOfCalls, len(mockLogger.ErrorfContextCalls()))
+			require.Len(t, added, len(tt.shortURLs))
+			for i, entry := range added {
+				require.Equal(t, "user", entry.UserID)
+				require.Equal(t, ActionDelete, entry.Action)
+				require.Equal(t, tt.shortURLs[i], entry.ShortURL)
+				require.Equal(t, "10.0.0.1", entry.IP)
+				require.Equal(t, AuthCookie, entry.AuthMethod)
+			}
+		})
+	}
+}
+
+func TestAuditService_GetEntries(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	records := []*auditstorage.Entry{
+		{ID: 3, UserID: "user", Action: ActionDelete, ShortURL: "abc", CreatedAt: now},
+		{ID: 2, UserID: "user", Action: ActionUpdate, ShortURL: "abc", CreatedAt: now},
+		{ID: 1, UserID: "user", Action: ActionCreate, ShortURL: "abc", CreatedAt: now},
+	}
+
+	tests := []struct {
+		name        string
+		filter      *Filter
+		wantLimit   int
+		wantEntries int
+		wantNext    int64
+		wantErr     error
+	}{
+		{
+			name:        "default limit",
+			filter:      &Filter{},
+			wantLimit:   defaultLimit + 1,
+			wantEntries: 3,
+		},
+		{
+			name:        "next page",
+			filter:      &Filter{Limit: 2},
+			wantLimit:   3,
+			wantEntries: 2,
+			wantNext:    2,
+		},
+		{
+			name:    "unknown action",
+			filter:  &Filter{Action: "ban"},
+			wantErr: ErrInvalidFilter,
+		},
+		{
+			name:    "inverted time range",
+			filter:  &Filter{From: now, To: now.Add(-time.Hour)},
+			wantErr: ErrInvalidFilter,
+		},
+		{
+			name:    "limit too large",
+			filter:  &Filter{Limit: maxLimit + 1},
+			wantErr: ErrInvalidFilter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage := &auditStorageMock{
+				GetEntriesFunc: func(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error) {
+					require.Equal(t, tt.wantLimit, filter.Limit)
+					return records[:min(len(records), filter.Limit)], nil
+				},
+			}
+
+			s := NewAuditService(mockStorage, &loggerMock{})
+
+			page, err := s.GetEntries(context.Background(), tt.filter)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.Empty(t, mockStorage.GetEntriesCalls())
+				return
+			}
+			require.Len(t, page.Entries, tt.wantEntries)
+			require.Equal(t, tt.wantNext, page.Next)
+		})
+	}
+}
+
+func TestAuditService_GetUserEntries(t *testing.T) {
+	mockStorage := &auditStorageMock{
+		GetEntriesFunc: func(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error) {
+			require.Equal(t, "user", filter.UserID)
+			return nil, nil
+		},
+	}
+
+	s := NewAuditService(mockStorage, &loggerMock{})
+
+	_, err := s.GetUserEntries(context.Background(), &Filter{})
+	require.ErrorIs(t, err, ErrNoUser)
+
+	ctx := context.WithValue(context.Background(), key, "user")
+	_, err = s.GetUserEntries(ctx, &Filter{UserID: "other"})
+	require.NoError(t, err)
+	require.Len(t, mockStorage.GetEntriesCalls(), 1)
+}
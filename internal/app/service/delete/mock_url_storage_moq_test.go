@@ -17,7 +17,7 @@ var _ urlStorage = &urlStorageMock{}
 //
 //		// make and configure a mocked urlStorage
 //		mockedurlStorage := &urlStorageMock{
-//			DeleteURLsFunc: func(userID string, ids []string) error {
+//			DeleteURLsFunc: func(userID string, ids []string) ([]string, error) {
 //				panic("mock out the DeleteURLs method")
 //			},
 //		}
@@ -28,7 +28,7 @@ var _ urlStorage = &urlStorageMock{}
 //	}
 type urlStorageMock struct {
 	// DeleteURLsFunc mocks the DeleteURLs method.
-	DeleteURLsFunc func(userID string, ids []string) error
+	DeleteURLsFunc func(userID string, ids []string) ([]string, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -44,7 +44,7 @@ type urlStorageMock struct {
 }
 
 // DeleteURLs calls DeleteURLsFunc.
-func (mock *urlStorageMock) DeleteURLs(userID string, ids []string) error {
+func (mock *urlStorageMock) DeleteURLs(userID string, ids []string) ([]string, error) {
 	if mock.DeleteURLsFunc == nil {
 		panic("urlStorageMock.DeleteURLsFunc: method is nil but urlStorage.DeleteURLs was just called")
 	}
@@ -3,12 +3,18 @@ package deleteurl
 import (
 	"context"
 
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
 	"github.com/DanilNaum/SnipURL/pkg/workerpool"
 )
 
 //go:generate moq -out mock_url_storage_moq_test.go . urlStorage
 type urlStorage interface {
-	DeleteURLs(userID string, ids []string) error
+	DeleteURLs(userID string, ids []string) ([]string, error)
+}
+
+//go:generate moq -out mock_auditor_moq_test.go . auditor
+type auditor interface {
+	Record(ctx context.Context, action string, shortURLs ...string)
 }
 
 // This const allows to configure delete worker number and batch size
@@ -20,7 +26,10 @@ type workerPool interface {
 	AddTask(task *data)
 }
 
+// data is a deletion requested by a user. ctx is the context of the request without
+// its cancellation, for the audit of the deletion done after the request.
 type data struct {
+	ctx    context.Context
 	userID string
 	ids    []string
 }
@@ -28,6 +37,7 @@ type data struct {
 type deleteService struct {
 	input      chan *data
 	storage    urlStorage
+	auditor    auditor
 	workerPool workerPool
 }
 
@@ -38,13 +48,14 @@ type deleteService struct {
 // Parameters:
 //   - ctx: the context for managing worker pool lifecycle
 //   - storage: the URL storage interface for performing deletion operations
+//   - auditor: the audit log the deletions are recorded in
 //
 // Returns:
 //   - *deleteService: a configured delete service ready to process deletion tasks
-func NewDeleteService(ctx context.Context, storage urlStorage) *deleteService {
+func NewDeleteService(ctx context.Context, storage urlStorage, auditor auditor) *deleteService {
 	input := make(chan *data, workerNum)
 
-	d := &deleteService{storage: storage, input: input}
+	d := &deleteService{storage: storage, auditor: auditor, input: input}
 
 	workerPool := workerpool.NewWorkerPool(ctx, workerNum, input, d.deleteWorker)
 
@@ -56,11 +67,20 @@ func NewDeleteService(ctx context.Context, storage urlStorage) *deleteService {
 // Delete adds a task to delete URLs with the specified IDs for a given user to the worker pool.
 // The deletion is performed asynchronously by worker goroutines.
 //
+// The URLs actually deleted are recorded in the audit log as done by the user of the
+// request ctx belongs to.
+//
 // Parameters:
+//   - ctx: the context of the request asking for the deletion
 //   - userID: the identifier of the user who owns the URLs
 //   - input: a slice of URL IDs to be deleted
-func (d *deleteService) Delete(userID string, input []string) {
-	d.workerPool.AddTask(&data{userID: userID, ids: input})
+func (d *deleteService) Delete(ctx context.Context, userID string, input []string) {
+	d.workerPool.AddTask(&data{ctx: context.WithoutCancel(ctx), userID: userID, ids: input})
+}
+
+// QueueDepth returns the number of deletions waiting for a worker.
+func (d *deleteService) QueueDepth() int {
+	return len(d.input)
 }
 
 func (d *deleteService) deleteWorker(ctx context.Context) error {
@@ -70,10 +90,11 @@ func (d *deleteService) deleteWorker(ctx context.Context) error {
 			if !ok {
 				return nil
 			}
-			err := d.storage.DeleteURLs(data.userID, data.ids)
+			deleted, err := d.storage.DeleteURLs(data.userID, data.ids)
 			if err != nil {
 				return err
 			}
+			d.auditor.Record(data.ctx, auditstorage.ActionDelete, deleted...)
 		case <-ctx.Done():
 			return ctx.Err()
 		}
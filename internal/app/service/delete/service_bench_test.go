@@ -7,15 +7,17 @@ import (
 
 func BenchmarkDelete(b *testing.B) {
 	service := NewDeleteService(context.Background(), &urlStorageMock{
-		DeleteURLsFunc: func(userID string, ids []string) error {
-			return nil
+		DeleteURLsFunc: func(userID string, ids []string) ([]string, error) {
+			return ids, nil
 		},
+	}, &auditorMock{
+		RecordFunc: func(ctx context.Context, action string, shortURLs ...string) {},
 	})
 	userID := "user1"
 	ids := []string{"id1", "id2", "id3"}
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		service.Delete(userID, ids)
+		service.Delete(context.Background(), userID, ids)
 	}
 }
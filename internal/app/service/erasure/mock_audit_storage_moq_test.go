@@ -0,0 +1,87 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package erasure
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that auditStorageMock does implement auditStorage.
+// If this is not the case, regenerate this file with moq.
+var _ auditStorage = &auditStorageMock{}
+
+// auditStorageMock is a mock implementation of auditStorage.
+//
+//	func TestSomethingThatUsesauditStorage(t *testing.T) {
+//
+//		// make and configure a mocked auditStorage
+//		mockedauditStorage := &auditStorageMock{
+//			AnonymizeEntriesFunc: func(ctx context.Context, userID string, pseudonym string) error {
+//				panic("mock out the AnonymizeEntries method")
+//			},
+//		}
+//
+//		// use mockedauditStorage in code that requires auditStorage
+//		// and then make assertions.
+//
+//	}
+type auditStorageMock struct {
+	// AnonymizeEntriesFunc mocks the AnonymizeEntries method.
+	AnonymizeEntriesFunc func(ctx context.Context, userID string, pseudonym string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AnonymizeEntries holds details about calls to the AnonymizeEntries method.
+		AnonymizeEntries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// Pseudonym is the pseudonym argument value.
+			Pseudonym string
+		}
+	}
+	lockAnonymizeEntries sync.RWMutex
+}
+
+// AnonymizeEntries calls AnonymizeEntriesFunc.
+func (mock *auditStorageMock) AnonymizeEntries(ctx context.Context, userID string, pseudonym string) error {
+	if mock.AnonymizeEntriesFunc == nil {
+		panic("auditStorageMock.AnonymizeEntriesFunc: method is nil but auditStorage.AnonymizeEntries was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UserID    string
+		Pseudonym string
+	}{
+		Ctx:       ctx,
+		UserID:    userID,
+		Pseudonym: pseudonym,
+	}
+	mock.lockAnonymizeEntries.Lock()
+	mock.calls.AnonymizeEntries = append(mock.calls.AnonymizeEntries, callInfo)
+	mock.lockAnonymizeEntries.Unlock()
+	return mock.AnonymizeEntriesFunc(ctx, userID, pseudonym)
+}
+
+// AnonymizeEntriesCalls gets all the calls that were made to AnonymizeEntries.
+// Check the length with:
+//
+//	len(mockedauditStorage.AnonymizeEntriesCalls())
+func (mock *auditStorageMock) AnonymizeEntriesCalls() []struct {
+	Ctx       context.Context
+	UserID    string
+	Pseudonym string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UserID    string
+		Pseudonym string
+	}
+	mock.lockAnonymizeEntries.RLock()
+	calls = mock.calls.AnonymizeEntries
+	mock.lockAnonymizeEntries.RUnlock()
+	return calls
+}
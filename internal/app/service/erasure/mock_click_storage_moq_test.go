@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package erasure
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that clickStorageMock does implement clickStorage.
+// If this is not the case, regenerate this file with moq.
+var _ clickStorage = &clickStorageMock{}
+
+// clickStorageMock is a mock implementation of clickStorage.
+//
+//	func TestSomethingThatUsesclickStorage(t *testing.T) {
+//
+//		// make and configure a mocked clickStorage
+//		mockedclickStorage := &clickStorageMock{
+//			AnonymizeClicksFunc: func(ctx context.Context, shortURLs []string) error {
+//				panic("mock out the AnonymizeClicks method")
+//			},
+//		}
+//
+//		// use mockedclickStorage in code that requires clickStorage
+//		// and then make assertions.
+//
+//	}
+type clickStorageMock struct {
+	// AnonymizeClicksFunc mocks the AnonymizeClicks method.
+	AnonymizeClicksFunc func(ctx context.Context, shortURLs []string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AnonymizeClicks holds details about calls to the AnonymizeClicks method.
+		AnonymizeClicks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ShortURLs is the shortURLs argument value.
+			ShortURLs []string
+		}
+	}
+	lockAnonymizeClicks sync.RWMutex
+}
+
+// AnonymizeClicks calls AnonymizeClicksFunc.
+func (mock *clickStorageMock) AnonymizeClicks(ctx context.Context, shortURLs []string) error {
+	if mock.AnonymizeClicksFunc == nil {
+		panic("clickStorageMock.AnonymizeClicksFunc: method is nil but clickStorage.AnonymizeClicks was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ShortURLs []string
+	}{
+		Ctx:       ctx,
+		ShortURLs: shortURLs,
+	}
+	mock.lockAnonymizeClicks.Lock()
+	mock.calls.AnonymizeClicks = append(mock.calls.AnonymizeClicks, callInfo)
+	mock.lockAnonymizeClicks.Unlock()
+	return mock.AnonymizeClicksFunc(ctx, shortURLs)
+}
+
+// AnonymizeClicksCalls gets all the calls that were made to AnonymizeClicks.
+// Check the length with:
+//
+//	len(mockedclickStorage.AnonymizeClicksCalls())
+func (mock *clickStorageMock) AnonymizeClicksCalls() []struct {
+	Ctx       context.Context
+	ShortURLs []string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ShortURLs []string
+	}
+	mock.lockAnonymizeClicks.RLock()
+	calls = mock.calls.AnonymizeClicks
+	mock.lockAnonymizeClicks.RUnlock()
+	return calls
+}
@@ -0,0 +1,74 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package erasure
+
+import (
+	"sync"
+)
+
+// Ensure, that dumperMock does implement dumper.
+// If this is not the case, regenerate this file with moq.
+var _ dumper = &dumperMock{}
+
+// dumperMock is a mock implementation of dumper.
+//
+//	func TestSomethingThatUsesdumper(t *testing.T) {
+//
+//		// make and configure a mocked dumper
+//		mockeddumper := &dumperMock{
+//			RemoveFunc: func(shortURLs []string) error {
+//				panic("mock out the Remove method")
+//			},
+//		}
+//
+//		// use mockeddumper in code that requires dumper
+//		// and then make assertions.
+//
+//	}
+type dumperMock struct {
+	// RemoveFunc mocks the Remove method.
+	RemoveFunc func(shortURLs []string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Remove holds details about calls to the Remove method.
+		Remove []struct {
+			// ShortURLs is the shortURLs argument value.
+			ShortURLs []string
+		}
+	}
+	lockRemove sync.RWMutex
+}
+
+// Remove calls RemoveFunc.
+func (mock *dumperMock) Remove(shortURLs []string) error {
+	if mock.RemoveFunc == nil {
+		panic("dumperMock.RemoveFunc: method is nil but dumper.Remove was just called")
+	}
+	callInfo := struct {
+		ShortURLs []string
+	}{
+		ShortURLs: shortURLs,
+	}
+	mock.lockRemove.Lock()
+	mock.calls.Remove = append(mock.calls.Remove, callInfo)
+	mock.lockRemove.Unlock()
+	return mock.RemoveFunc(shortURLs)
+}
+
+// RemoveCalls gets all the calls that were made to Remove.
+// Check the length with:
+//
+//	len(mockeddumper.RemoveCalls())
+func (mock *dumperMock) RemoveCalls() []struct {
+	ShortURLs []string
+} {
+	var calls []struct {
+		ShortURLs []string
+	}
+	mock.lockRemove.RLock()
+	calls = mock.calls.Remove
+	mock.lockRemove.RUnlock()
+	return calls
+}
@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package erasure
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that ruleStorageMock does implement ruleStorage.
+// If this is not the case, regenerate this file with moq.
+var _ ruleStorage = &ruleStorageMock{}
+
+// ruleStorageMock is a mock implementation of ruleStorage.
+//
+//	func TestSomethingThatUsesruleStorage(t *testing.T) {
+//
+//		// make and configure a mocked ruleStorage
+//		mockedruleStorage := &ruleStorageMock{
+//			DeleteRulesFunc: func(ctx context.Context, shortURLs []string) error {
+//				panic("mock out the DeleteRules method")
+//			},
+//		}
+//
+//		// use mockedruleStorage in code that requires ruleStorage
+//		// and then make assertions.
+//
+//	}
+type ruleStorageMock struct {
+	// DeleteRulesFunc mocks the DeleteRules method.
+	DeleteRulesFunc func(ctx context.Context, shortURLs []string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteRules holds details about calls to the DeleteRules method.
+		DeleteRules []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ShortURLs is the shortURLs argument value.
+			ShortURLs []string
+		}
+	}
+	lockDeleteRules sync.RWMutex
+}
+
+// DeleteRules calls DeleteRulesFunc.
+func (mock *ruleStorageMock) DeleteRules(ctx context.Context, shortURLs []string) error {
+	if mock.DeleteRulesFunc == nil {
+		panic("ruleStorageMock.DeleteRulesFunc: method is nil but ruleStorage.DeleteRules was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ShortURLs []string
+	}{
+		Ctx:       ctx,
+		ShortURLs: shortURLs,
+	}
+	mock.lockDeleteRules.Lock()
+	mock.calls.DeleteRules = append(mock.calls.DeleteRules, callInfo)
+	mock.lockDeleteRules.Unlock()
+	return mock.DeleteRulesFunc(ctx, shortURLs)
+}
+
+// DeleteRulesCalls gets all the calls that were made to DeleteRules.
+// Check the length with:
+//
+//	len(mockedruleStorage.DeleteRulesCalls())
+func (mock *ruleStorageMock) DeleteRulesCalls() []struct {
+	Ctx       context.Context
+	ShortURLs []string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ShortURLs []string
+	}
+	mock.lockDeleteRules.RLock()
+	calls = mock.calls.DeleteRules
+	mock.lockDeleteRules.RUnlock()
+	return calls
+}
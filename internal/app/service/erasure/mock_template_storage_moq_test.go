@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package erasure
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that templateStorageMock does implement templateStorage.
+// If this is not the case, regenerate this file with moq.
+var _ templateStorage = &templateStorageMock{}
+
+// templateStorageMock is a mock implementation of templateStorage.
+//
+//	func TestSomethingThatUsestemplateStorage(t *testing.T) {
+//
+//		// make and configure a mocked templateStorage
+//		mockedtemplateStorage := &templateStorageMock{
+//			DeleteTemplatesFunc: func(ctx context.Context, userID string) error {
+//				panic("mock out the DeleteTemplates method")
+//			},
+//		}
+//
+//		// use mockedtemplateStorage in code that requires templateStorage
+//		// and then make assertions.
+//
+//	}
+type templateStorageMock struct {
+	// DeleteTemplatesFunc mocks the DeleteTemplates method.
+	DeleteTemplatesFunc func(ctx context.Context, userID string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteTemplates holds details about calls to the DeleteTemplates method.
+		DeleteTemplates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+	}
+	lockDeleteTemplates sync.RWMutex
+}
+
+// DeleteTemplates calls DeleteTemplatesFunc.
+func (mock *templateStorageMock) DeleteTemplates(ctx context.Context, userID string) error {
+	if mock.DeleteTemplatesFunc == nil {
+		panic("templateStorageMock.DeleteTemplatesFunc: method is nil but templateStorage.DeleteTemplates was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockDeleteTemplates.Lock()
+	mock.calls.DeleteTemplates = append(mock.calls.DeleteTemplates, callInfo)
+	mock.lockDeleteTemplates.Unlock()
+	return mock.DeleteTemplatesFunc(ctx, userID)
+}
+
+// DeleteTemplatesCalls gets all the calls that were made to DeleteTemplates.
+// Check the length with:
+//
+//	len(mockedtemplateStorage.DeleteTemplatesCalls())
+func (mock *templateStorageMock) DeleteTemplatesCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockDeleteTemplates.RLock()
+	calls = mock.calls.DeleteTemplates
+	mock.lockDeleteTemplates.RUnlock()
+	return calls
+}
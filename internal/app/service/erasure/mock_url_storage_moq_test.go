@@ -0,0 +1,126 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package erasure
+
+import (
+	"context"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"sync"
+)
+
+// Ensure, that urlStorageMock does implement urlStorage.
+// If this is not the case, regenerate this file with moq.
+var _ urlStorage = &urlStorageMock{}
+
+// urlStorageMock is a mock implementation of urlStorage.
+//
+//	func TestSomethingThatUsesurlStorage(t *testing.T) {
+//
+//		// make and configure a mocked urlStorage
+//		mockedurlStorage := &urlStorageMock{
+//			EraseURLsFunc: func(ctx context.Context, userID string) error {
+//				panic("mock out the EraseURLs method")
+//			},
+//			IterateURLsFunc: func(ctx context.Context) (urlstorage.URLIterator, error) {
+//				panic("mock out the IterateURLs method")
+//			},
+//		}
+//
+//		// use mockedurlStorage in code that requires urlStorage
+//		// and then make assertions.
+//
+//	}
+type urlStorageMock struct {
+	// EraseURLsFunc mocks the EraseURLs method.
+	EraseURLsFunc func(ctx context.Context, userID string) error
+
+	// IterateURLsFunc mocks the IterateURLs method.
+	IterateURLsFunc func(ctx context.Context) (urlstorage.URLIterator, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// EraseURLs holds details about calls to the EraseURLs method.
+		EraseURLs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// IterateURLs holds details about calls to the IterateURLs method.
+		IterateURLs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockEraseURLs   sync.RWMutex
+	lockIterateURLs sync.RWMutex
+}
+
+// EraseURLs calls EraseURLsFunc.
+func (mock *urlStorageMock) EraseURLs(ctx context.Context, userID string) error {
+	if mock.EraseURLsFunc == nil {
+		panic("urlStorageMock.EraseURLsFunc: method is nil but urlStorage.EraseURLs was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockEraseURLs.Lock()
+	mock.calls.EraseURLs = append(mock.calls.EraseURLs, callInfo)
+	mock.lockEraseURLs.Unlock()
+	return mock.EraseURLsFunc(ctx, userID)
+}
+
+// EraseURLsCalls gets all the calls that were made to EraseURLs.
+// Check the length with:
+//
+//	len(mockedurlStorage.EraseURLsCalls())
+func (mock *urlStorageMock) EraseURLsCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockEraseURLs.RLock()
+	calls = mock.calls.EraseURLs
+	mock.lockEraseURLs.RUnlock()
+	return calls
+}
+
+// IterateURLs calls IterateURLsFunc.
+func (mock *urlStorageMock) IterateURLs(ctx context.Context) (urlstorage.URLIterator, error) {
+	if mock.IterateURLsFunc == nil {
+		panic("urlStorageMock.IterateURLsFunc: method is nil but urlStorage.IterateURLs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockIterateURLs.Lock()
+	mock.calls.IterateURLs = append(mock.calls.IterateURLs, callInfo)
+	mock.lockIterateURLs.Unlock()
+	return mock.IterateURLsFunc(ctx)
+}
+
+// IterateURLsCalls gets all the calls that were made to IterateURLs.
+// Check the length with:
+//
+//	len(mockedurlStorage.IterateURLsCalls())
+func (mock *urlStorageMock) IterateURLsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockIterateURLs.RLock()
+	calls = mock.calls.IterateURLs
+	mock.lockIterateURLs.RUnlock()
+	return calls
+}
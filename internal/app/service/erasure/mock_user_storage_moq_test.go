@@ -0,0 +1,232 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package erasure
+
+import (
+	"context"
+	userstorage "github.com/DanilNaum/SnipURL/internal/app/repository/user"
+	"sync"
+)
+
+// Ensure, that userStorageMock does implement userStorage.
+// If this is not the case, regenerate this file with moq.
+var _ userStorage = &userStorageMock{}
+
+// userStorageMock is a mock implementation of userStorage.
+//
+//	func TestSomethingThatUsesuserStorage(t *testing.T) {
+//
+//		// make and configure a mocked userStorage
+//		mockeduserStorage := &userStorageMock{
+//			GetErasureJobFunc: func(ctx context.Context, id string) (*userstorage.ErasureJob, error) {
+//				panic("mock out the GetErasureJob method")
+//			},
+//			IsRevokedFunc: func(ctx context.Context, userID string) (bool, error) {
+//				panic("mock out the IsRevoked method")
+//			},
+//			RevokeUserFunc: func(ctx context.Context, userID string) error {
+//				panic("mock out the RevokeUser method")
+//			},
+//			SetErasureJobFunc: func(ctx context.Context, job *userstorage.ErasureJob) error {
+//				panic("mock out the SetErasureJob method")
+//			},
+//		}
+//
+//		// use mockeduserStorage in code that requires userStorage
+//		// and then make assertions.
+//
+//	}
+type userStorageMock struct {
+	// GetErasureJobFunc mocks the GetErasureJob method.
+	GetErasureJobFunc func(ctx context.Context, id string) (*userstorage.ErasureJob, error)
+
+	// IsRevokedFunc mocks the IsRevoked method.
+	IsRevokedFunc func(ctx context.Context, userID string) (bool, error)
+
+	// RevokeUserFunc mocks the RevokeUser method.
+	RevokeUserFunc func(ctx context.Context, userID string) error
+
+	// SetErasureJobFunc mocks the SetErasureJob method.
+	SetErasureJobFunc func(ctx context.Context, job *userstorage.ErasureJob) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetErasureJob holds details about calls to the GetErasureJob method.
+		GetErasureJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// IsRevoked holds details about calls to the IsRevoked method.
+		IsRevoked []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// RevokeUser holds details about calls to the RevokeUser method.
+		RevokeUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// SetErasureJob holds details about calls to the SetErasureJob method.
+		SetErasureJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Job is the job argument value.
+			Job *userstorage.ErasureJob
+		}
+	}
+	lockGetErasureJob sync.RWMutex
+	lockIsRevoked     sync.RWMutex
+	lockRevokeUser    sync.RWMutex
+	lockSetErasureJob sync.RWMutex
+}
+
+// GetErasureJob calls GetErasureJobFunc.
+func (mock *userStorageMock) GetErasureJob(ctx context.Context, id string) (*userstorage.ErasureJob, error) {
+	if mock.GetErasureJobFunc == nil {
+		panic("userStorageMock.GetErasureJobFunc: method is nil but userStorage.GetErasureJob was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetErasureJob.Lock()
+	mock.calls.GetErasureJob = append(mock.calls.GetErasureJob, callInfo)
+	mock.lockGetErasureJob.Unlock()
+	return mock.GetErasureJobFunc(ctx, id)
+}
+
+// GetErasureJobCalls gets all the calls that were made to GetErasureJob.
+// Check the length with:
+//
+//	len(mockeduserStorage.GetErasureJobCalls())
+func (mock *userStorageMock) GetErasureJobCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetErasureJob.RLock()
+	calls = mock.calls.GetErasureJob
+	mock.lockGetErasureJob.RUnlock()
+	return calls
+}
+
+// IsRevoked calls IsRevokedFunc.
+func (mock *userStorageMock) IsRevoked(ctx context.Context, userID string) (bool, error) {
+	if mock.IsRevokedFunc == nil {
+		panic("userStorageMock.IsRevokedFunc: method is nil but userStorage.IsRevoked was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockIsRevoked.Lock()
+	mock.calls.IsRevoked = append(mock.calls.IsRevoked, callInfo)
+	mock.lockIsRevoked.Unlock()
+	return mock.IsRevokedFunc(ctx, userID)
+}
+
+// IsRevokedCalls gets all the calls that were made to IsRevoked.
+// Check the length with:
+//
+//	len(mockeduserStorage.IsRevokedCalls())
+func (mock *userStorageMock) IsRevokedCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockIsRevoked.RLock()
+	calls = mock.calls.IsRevoked
+	mock.lockIsRevoked.RUnlock()
+	return calls
+}
+
+// RevokeUser calls RevokeUserFunc.
+func (mock *userStorageMock) RevokeUser(ctx context.Context, userID string) error {
+	if mock.RevokeUserFunc == nil {
+		panic("userStorageMock.RevokeUserFunc: method is nil but userStorage.RevokeUser was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockRevokeUser.Lock()
+	mock.calls.RevokeUser = append(mock.calls.RevokeUser, callInfo)
+	mock.lockRevokeUser.Unlock()
+	return mock.RevokeUserFunc(ctx, userID)
+}
+
+// RevokeUserCalls gets all the calls that were made to RevokeUser.
+// Check the length with:
+//
+//	len(mockeduserStorage.RevokeUserCalls())
+func (mock *userStorageMock) RevokeUserCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockRevokeUser.RLock()
+	calls = mock.calls.RevokeUser
+	mock.lockRevokeUser.RUnlock()
+	return calls
+}
+
+// SetErasureJob calls SetErasureJobFunc.
+func (mock *userStorageMock) SetErasureJob(ctx context.Context, job *userstorage.ErasureJob) error {
+	if mock.SetErasureJobFunc == nil {
+		panic("userStorageMock.SetErasureJobFunc: method is nil but userStorage.SetErasureJob was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Job *userstorage.ErasureJob
+	}{
+		Ctx: ctx,
+		Job: job,
+	}
+	mock.lockSetErasureJob.Lock()
+	mock.calls.SetErasureJob = append(mock.calls.SetErasureJob, callInfo)
+	mock.lockSetErasureJob.Unlock()
+	return mock.SetErasureJobFunc(ctx, job)
+}
+
+// SetErasureJobCalls gets all the calls that were made to SetErasureJob.
+// Check the length with:
+//
+//	len(mockeduserStorage.SetErasureJobCalls())
+func (mock *userStorageMock) SetErasureJobCalls() []struct {
+	Ctx context.Context
+	Job *userstorage.ErasureJob
+} {
+	var calls []struct {
+		Ctx context.Context
+		Job *userstorage.ErasureJob
+	}
+	mock.lockSetErasureJob.RLock()
+	calls = mock.calls.SetErasureJob
+	mock.lockSetErasureJob.RUnlock()
+	return calls
+}
@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package erasure
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that variantStorageMock does implement variantStorage.
+// If this is not the case, regenerate this file with moq.
+var _ variantStorage = &variantStorageMock{}
+
+// variantStorageMock is a mock implementation of variantStorage.
+//
+//	func TestSomethingThatUsesvariantStorage(t *testing.T) {
+//
+//		// make and configure a mocked variantStorage
+//		mockedvariantStorage := &variantStorageMock{
+//			DeleteServedFunc: func(ctx context.Context, shortURLs []string) error {
+//				panic("mock out the DeleteServed method")
+//			},
+//		}
+//
+//		// use mockedvariantStorage in code that requires variantStorage
+//		// and then make assertions.
+//
+//	}
+type variantStorageMock struct {
+	// DeleteServedFunc mocks the DeleteServed method.
+	DeleteServedFunc func(ctx context.Context, shortURLs []string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteServed holds details about calls to the DeleteServed method.
+		DeleteServed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ShortURLs is the shortURLs argument value.
+			ShortURLs []string
+		}
+	}
+	lockDeleteServed sync.RWMutex
+}
+
+// DeleteServed calls DeleteServedFunc.
+func (mock *variantStorageMock) DeleteServed(ctx context.Context, shortURLs []string) error {
+	if mock.DeleteServedFunc == nil {
+		panic("variantStorageMock.DeleteServedFunc: method is nil but variantStorage.DeleteServed was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ShortURLs []string
+	}{
+		Ctx:       ctx,
+		ShortURLs: shortURLs,
+	}
+	mock.lockDeleteServed.Lock()
+	mock.calls.DeleteServed = append(mock.calls.DeleteServed, callInfo)
+	mock.lockDeleteServed.Unlock()
+	return mock.DeleteServedFunc(ctx, shortURLs)
+}
+
+// DeleteServedCalls gets all the calls that were made to DeleteServed.
+// Check the length with:
+//
+//	len(mockedvariantStorage.DeleteServedCalls())
+func (mock *variantStorageMock) DeleteServedCalls() []struct {
+	Ctx       context.Context
+	ShortURLs []string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ShortURLs []string
+	}
+	mock.lockDeleteServed.RLock()
+	calls = mock.calls.DeleteServed
+	mock.lockDeleteServed.RUnlock()
+	return calls
+}
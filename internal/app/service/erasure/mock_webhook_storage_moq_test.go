@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package erasure
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that webhookStorageMock does implement webhookStorage.
+// If this is not the case, regenerate this file with moq.
+var _ webhookStorage = &webhookStorageMock{}
+
+// webhookStorageMock is a mock implementation of webhookStorage.
+//
+//	func TestSomethingThatUseswebhookStorage(t *testing.T) {
+//
+//		// make and configure a mocked webhookStorage
+//		mockedwebhookStorage := &webhookStorageMock{
+//			DeleteWebhooksFunc: func(ctx context.Context, userID string) error {
+//				panic("mock out the DeleteWebhooks method")
+//			},
+//		}
+//
+//		// use mockedwebhookStorage in code that requires webhookStorage
+//		// and then make assertions.
+//
+//	}
+type webhookStorageMock struct {
+	// DeleteWebhooksFunc mocks the DeleteWebhooks method.
+	DeleteWebhooksFunc func(ctx context.Context, userID string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteWebhooks holds details about calls to the DeleteWebhooks method.
+		DeleteWebhooks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+	}
+	lockDeleteWebhooks sync.RWMutex
+}
+
+// DeleteWebhooks calls DeleteWebhooksFunc.
+func (mock *webhookStorageMock) DeleteWebhooks(ctx context.Context, userID string) error {
+	if mock.DeleteWebhooksFunc == nil {
+		panic("webhookStorageMock.DeleteWebhooksFunc: method is nil but webhookStorage.DeleteWebhooks was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockDeleteWebhooks.Lock()
+	mock.calls.DeleteWebhooks = append(mock.calls.DeleteWebhooks, callInfo)
+	mock.lockDeleteWebhooks.Unlock()
+	return mock.DeleteWebhooksFunc(ctx, userID)
+}
+
+// DeleteWebhooksCalls gets all the calls that were made to DeleteWebhooks.
+// Check the length with:
+//
+//	len(mockedwebhookStorage.DeleteWebhooksCalls())
+func (mock *webhookStorageMock) DeleteWebhooksCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockDeleteWebhooks.RLock()
+	calls = mock.calls.DeleteWebhooks
+	mock.lockDeleteWebhooks.RUnlock()
+	return calls
+}
@@ -0,0 +1,20 @@
+package erasure
+
+import "time"
+
+// Statuses of an erasure job.
+const (
+	StatusPending = "pending"
+	StatusDone    = "done"
+	StatusFailed  = "failed"
+)
+
+// Job is the erasure of a user's data, run in the background.
+// Error explains a failed job, and FinishedAt is nil while the job is pending.
+type Job struct {
+	ID         string
+	Status     string
+	Error      string
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
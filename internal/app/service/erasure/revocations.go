@@ -0,0 +1,70 @@
+package erasure
+
+import (
+	"sync"
+	"time"
+)
+
+// revocationTTL is how long a user ID found not revoked is trusted without asking the
+// storage again. A user revoked by another instance is refused here within this time.
+const revocationTTL = time.Minute
+
+// revocationCache keeps the user IDs known to be revoked, which stay revoked for good,
+// and for revocationTTL the ones found not revoked, so that authenticating a request
+// does not query the storage every time.
+type revocationCache struct {
+	mu      sync.Mutex
+	revoked map[string]struct{}
+	allowed map[string]time.Time
+	sweptAt time.Time
+	now     func() time.Time
+}
+
+func newRevocationCache() *revocationCache {
+	return &revocationCache{
+		revoked: make(map[string]struct{}),
+		allowed: make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+// get returns whether the user ID is revoked and whether that is known.
+func (c *revocationCache) get(userID string) (revoked, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.revoked[userID]; ok {
+		return true, true
+	}
+	expiresAt, ok := c.allowed[userID]
+	return false, ok && c.now().Before(expiresAt)
+}
+
+// set records whether the user ID is revoked.
+func (c *revocationCache) set(userID string, revoked bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if revoked {
+		c.revoked[userID] = struct{}{}
+		delete(c.allowed, userID)
+		return
+	}
+	now := c.now()
+	c.sweep(now)
+	c.allowed[userID] = now.Add(revocationTTL)
+}
+
+// sweep drops the expired user IDs found not revoked, at most once per revocationTTL,
+// so that the cache does not grow with every user ever seen. The caller holds c.mu.
+func (c *revocationCache) sweep(now time.Time) {
+	if now.Sub(c.sweptAt) < revocationTTL {
+		return
+	}
+	c.sweptAt = now
+	for userID, expiresAt := range c.allowed {
+		if !now.Before(expiresAt) {
+			delete(c.allowed, userID)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package erasure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	userstorage "github.com/DanilNaum/SnipURL/internal/app/repository/user"
+	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/middlewares"
+	"github.com/DanilNaum/SnipURL/pkg/workerpool"
+	"github.com/google/uuid"
+)
+
+// Predefined errors returned by the erasure service.
+var (
+	// ErrNotFound indicates that the erasure job does not exist.
+	ErrNotFound = fmt.Errorf("not found")
+
+	// ErrNoUser indicates that the context carries no user ID.
+	ErrNoUser = fmt.Errorf("user not found in context")
+)
+
+// This const allows to configure the erasure workers and how often a failed erasure is attempted.
+const (
+	workerNum   = 2
+	maxAttempts = 3
+)
+
+//go:generate moq -out mock_url_storage_moq_test.go . urlStorage
+type urlStorage interface {
+	IterateURLs(ctx context.Context) (urlstorage.URLIterator, error)
+	EraseURLs(ctx context.Context, userID string) error
+}
+
+//go:generate moq -out mock_template_storage_moq_test.go . templateStorage
+type templateStorage interface {
+	DeleteTemplates(ctx context.Context, userID string) error
+}
+
+//go:generate moq -out mock_webhook_storage_moq_test.go . webhookStorage
+type webhookStorage interface {
+	DeleteWebhooks(ctx context.Context, userID string) error
+}
+
+//go:generate moq -out mock_rule_storage_moq_test.go . ruleStorage
+type ruleStorage interface {
+	DeleteRules(ctx context.Context, shortURLs []string) error
+}
+
+//go:generate moq -out mock_variant_storage_moq_test.go . variantStorage
+type variantStorage interface {
+	DeleteServed(ctx context.Context, shortURLs []string) error
+}
+
+//go:generate moq -out mock_click_storage_moq_test.go . clickStorage
+type clickStorage interface {
+	AnonymizeClicks(ctx context.Context, shortURLs []string) error
+}
+
+//go:generate moq -out mock_audit_storage_moq_test.go . auditStorage
+type auditStorage interface {
+	AnonymizeEntries(ctx context.Context, userID, pseudonym string) error
+}
+
+//go:generate moq -out mock_user_storage_moq_test.go . userStorage
+type userStorage interface {
+	RevokeUser(ctx context.Context, userID string) error
+	IsRevoked(ctx context.Context, userID string) (bool, error)
+	SetErasureJob(ctx context.Context, job *userstorage.ErasureJob) error
+	GetErasureJob(ctx context.Context, id string) (*userstorage.ErasureJob, error)
+}
+
+//go:generate moq -out mock_dumper_moq_test.go . dumper
+type dumper interface {
+	Remove(shortURLs []string) error
+}
+
+//go:generate moq -out mock_logger_moq_test.go . logger
+type logger interface {
+	Errorf(string, ...interface{})
+}
+
+type workerPool interface {
+	AddTask(task *userstorage.ErasureJob)
+}
+
+var key = middlewares.Key{Key: "userID"}
+
+type erasureService struct {
+	input           chan *userstorage.ErasureJob
+	storage         urlStorage
+	templateStorage templateStorage
+	webhookStorage  webhookStorage
+	ruleStorage     ruleStorage
+	variantStorage  variantStorage
+	clickStorage    clickStorage
+	auditStorage    auditStorage
+	userStorage     userStorage
+	dumper          dumper
+	logger          logger
+	workerPool      workerPool
+	revocations     *revocationCache
+}
+
+// NewErasureService creates a new service erasing the data of users on their request.
+// The erasures run in a worker pool that stops with the context.
+//
+// Parameters:
+//   - ctx: the context for managing worker pool lifecycle
+//   - storage: Storage of the links
+//   - templateStorage: Storage of the users' tagging templates
+//   - webhookStorage: Storage of the users' webhooks and their deliveries
+//   - ruleStorage: Storage of the links' routing rules
+//   - variantStorage: Storage of the A/B split served counters
+//   - clickStorage: Storage of the click analytics
+//   - auditStorage: Audit log, whose entries of the user are anonymised
+//   - userStorage: Storage of the revoked users and the erasure jobs
+//   - dumper: URL record dumper, from which the erased links are removed
+//   - logger: Logger for recording errors
+//
+// Returns:
+//   - *erasureService: a configured erasure service ready to process erasure jobs
+func NewErasureService(ctx context.Context, storage urlStorage, templateStorage templateStorage, webhookStorage webhookStorage, ruleStorage ruleStorage, variantStorage variantStorage, clickStorage clickStorage, auditStorage auditStorage, userStorage userStorage, dumper dumper, logger logger) *erasureService {
+	input := make(chan *userstorage.ErasureJob, workerNum)
+	s := &erasureService{
+		input:           input,
+		storage:         storage,
+		templateStorage: templateStorage,
+		webhookStorage:  webhookStorage,
+		ruleStorage:     ruleStorage,
+		variantStorage:  variantStorage,
+		clickStorage:    clickStorage,
+		auditStorage:    auditStorage,
+		userStorage:     userStorage,
+		dumper:          dumper,
+		logger:          logger,
+		revocations:     newRevocationCache(),
+	}
+	s.workerPool = workerpool.NewWorkerPool(ctx, workerNum, input, s.eraseWorker)
+	return s
+}
+
+// Erase revokes the user from the context and starts erasing their data: their links are
+// deleted for good together with their rules, variant statistics, tagging templates and
+// webhooks, and their clicks and audit log entries are anonymised. The user ID is refused from then on, so that clients
+// holding it get a new one. The returned job tracks the erasure.
+// Returns ErrNoUser if the context carries no user ID.
+func (s *erasureService) Erase(ctx context.Context) (*Job, error) {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return nil, ErrNoUser
+	}
+
+	if err := s.userStorage.RevokeUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	s.revocations.set(userID, true)
+
+	job := &userstorage.ErasureJob{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		Status:    userstorage.ErasureStatusPending,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.userStorage.SetErasureJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	output := jobFromStorage(job)
+	s.workerPool.AddTask(job)
+	return output, nil
+}
+
+// GetJob returns the erasure job with the given ID. The ID is the only credential,
+// as the user who started the job is revoked by then.
+// Returns ErrNotFound if there is no such job.
+func (s *erasureService) GetJob(ctx context.Context, id string) (*Job, error) {
+	job, err := s.userStorage.GetErasureJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, userstorage.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return jobFromStorage(job), nil
+}
+
+// IsRevoked reports whether the user ID was revoked by an erasure. The answers are
+// cached, so that authenticating requests does not query the storage every time.
+func (s *erasureService) IsRevoked(ctx context.Context, userID string) (bool, error) {
+	if revoked, ok := s.revocations.get(userID); ok {
+		return revoked, nil
+	}
+	revoked, err := s.userStorage.IsRevoked(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	s.revocations.set(userID, revoked)
+	return revoked, nil
+}
+
+// QueueDepth returns the number of erasure jobs waiting for a worker.
+func (s *erasureService) QueueDepth() int {
+	return len(s.input)
+}
+
+func (s *erasureService) eraseWorker(ctx context.Context) error {
+	for {
+		select {
+		case job, ok := <-s.input:
+			if !ok {
+				return nil
+			}
+			s.run(ctx, job)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// run erases the data of the job's user, attempting it up to maxAttempts times,
+// and records the outcome in the job.
+func (s *erasureService) run(ctx context.Context, job *userstorage.ErasureJob) {
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if err = s.erase(ctx, job.UserID); err == nil || ctx.Err() != nil {
+			break
+		}
+	}
+	if ctx.Err() != nil {
+		// The job stays pending, the server is shutting down.
+		return
+	}
+
+	finishedAt := time.Now().UTC()
+	job.FinishedAt = &finishedAt
+	job.Status = userstorage.ErasureStatusDone
+	if err != nil {
+		s.logger.Errorf("failed to erase user %s: %v", job.UserID, err)
+		job.Status = userstorage.ErasureStatusFailed
+		job.Error = err.Error()
+	}
+	if err := s.userStorage.SetErasureJob(ctx, job); err != nil {
+		s.logger.Errorf("failed to store erasure job %s: %v", job.ID, err)
+	}
+}
+
+// erase removes the data of the user. The links go last, as they are needed to
+// find the data that refers to them, so that an interrupted erasure can be redone.
+func (s *erasureService) erase(ctx context.Context, userID string) error {
+	shortURLs, err := s.shortURLs(context.WithValue(ctx, key, userID))
+	if err != nil {
+		return fmt.Errorf("list links: %w", err)
+	}
+
+	if err := s.ruleStorage.DeleteRules(ctx, shortURLs); err != nil {
+		return fmt.Errorf("delete rules: %w", err)
+	}
+	if err := s.variantStorage.DeleteServed(ctx, shortURLs); err != nil {
+		return fmt.Errorf("delete variant statistics: %w", err)
+	}
+	if err := s.clickStorage.AnonymizeClicks(ctx, shortURLs); err != nil {
+		return fmt.Errorf("anonymise clicks: %w", err)
+	}
+	if err := s.templateStorage.DeleteTemplates(ctx, userID); err != nil {
+		return fmt.Errorf("delete templates: %w", err)
+	}
+	if err := s.webhookStorage.DeleteWebhooks(ctx, userID); err != nil {
+		return fmt.Errorf("delete webhooks: %w", err)
+	}
+	if err := s.auditStorage.AnonymizeEntries(ctx, userID, auditstorage.PseudonymPrefix+uuid.NewString()); err != nil {
+		return fmt.Errorf("anonymise audit entries: %w", err)
+	}
+	if err := s.storage.EraseURLs(ctx, userID); err != nil {
+		return fmt.Errorf("delete links: %w", err)
+	}
+	if err := s.dumper.Remove(shortURLs); err != nil {
+		return fmt.Errorf("remove links from dump: %w", err)
+	}
+	return nil
+}
+
+// shortURLs returns the short URLs of all the links of the user from the context.
+func (s *erasureService) shortURLs(ctx context.Context) ([]string, error) {
+	records, err := s.storage.IterateURLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer records.Close()
+
+	var shortURLs []string
+	for records.Next() {
+		shortURLs = append(shortURLs, records.Record().ShortURL)
+	}
+	return shortURLs, records.Err()
+}
+
+func jobFromStorage(job *userstorage.ErasureJob) *Job {
+	return &Job{
+		ID:         job.ID,
+		Status:     job.Status,
+		Error:      job.Error,
+		CreatedAt:  job.CreatedAt,
+		FinishedAt: job.FinishedAt,
+	}
+}
@@ -0,0 +1,221 @@
+package erasure
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	userstorage "github.com/DanilNaum/SnipURL/internal/app/repository/user"
+	"github.com/stretchr/testify/require"
+)
+
+// sliceURLIterator walks a fixed list of URL records.
+type sliceURLIterator struct {
+	records []*urlstorage.URLRecord
+	pos     int
+}
+
+func (it *sliceURLIterator) Next() bool {
+	if it.pos >= len(it.records) {
+		return false
+	}
+	it.pos++
+	return true
+}
+
+func (it *sliceURLIterator) Record() *urlstorage.URLRecord {
+	return it.records[it.pos-1]
+}
+
+func (it *sliceURLIterator) Err() error {
+	return nil
+}
+
+func (it *sliceURLIterator) Close() {}
+
+func TestErasureService_Erase(t *testing.T) {
+	errStorage := errors.New("storage failure")
+
+	tests := []struct {
+		name               string
+		eraseURLsErr       error
+		wantStatus         string
+		wantError          string
+		eraseURLsCallCount int
+	}{
+		{
+			name:               "erased",
+			wantStatus:         StatusDone,
+			eraseURLsCallCount: 1,
+		},
+		{
+			name:               "failing storage is retried",
+			eraseURLsErr:       errStorage,
+			wantStatus:         StatusFailed,
+			wantError:          "delete links: storage failure",
+			eraseURLsCallCount: maxAttempts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finished := make(chan *userstorage.ErasureJob, 1)
+			mockUserStorage := &userStorageMock{
+				RevokeUserFunc: func(ctx context.Context, userID string) error {
+					require.Equal(t, "user", userID)
+					return nil
+				},
+				SetErasureJobFunc: func(ctx context.Context, job *userstorage.ErasureJob) error {
+					if job.Status != userstorage.ErasureStatusPending {
+						j := *job
+						finished <- &j
+					}
+					return nil
+				},
+			}
+			mockStorage := &urlStorageMock{
+				IterateURLsFunc: func(ctx context.Context) (urlstorage.URLIterator, error) {
+					require.Equal(t, "user", ctx.Value(key))
+					return &sliceURLIterator{records: []*urlstorage.URLRecord{{ShortURL: "a"}, {ShortURL: "b"}}}, nil
+				},
+				EraseURLsFunc: func(ctx context.Context, userID string) error {
+					return tt.eraseURLsErr
+				},
+			}
+			deleteByURL := func(ctx context.Context, shortURLs []string) error {
+				require.Equal(t, []string{"a", "b"}, shortURLs)
+				return nil
+			}
+			mockDumper := &dumperMock{
+				RemoveFunc: func(shortURLs []string) error {
+					require.Equal(t, []string{"a", "b"}, shortURLs)
+					return nil
+				},
+			}
+
+			mockWebhookStorage := &webhookStorageMock{
+				DeleteWebhooksFunc: func(ctx context.Context, userID string) error {
+					require.Equal(t, "user", userID)
+					return nil
+				},
+			}
+
+			mockAuditStorage := &auditStorageMock{
+				AnonymizeEntriesFunc: func(ctx context.Context, userID, pseudonym string) error {
+					require.Equal(t, "user", userID)
+					require.True(t, strings.HasPrefix(pseudonym, auditstorage.PseudonymPrefix))
+					return nil
+				},
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			s := NewErasureService(ctx,
+				mockStorage,
+				&templateStorageMock{DeleteTemplatesFunc: func(ctx context.Context, userID string) error { return nil }},
+				mockWebhookStorage,
+				&ruleStorageMock{DeleteRulesFunc: deleteByURL},
+				&variantStorageMock{DeleteServedFunc: deleteByURL},
+				&clickStorageMock{AnonymizeClicksFunc: deleteByURL},
+				mockAuditStorage,
+				mockUserStorage,
+				mockDumper,
+				&loggerMock{ErrorfFunc: func(s string, ifaceVals ...interface{}) {}},
+			)
+
+			job, err := s.Erase(context.WithValue(context.Background(), key, "user"))
+			require.NoError(t, err)
+			require.Equal(t, StatusPending, job.Status)
+			require.Equal(t, 1, len(mockUserStorage.RevokeUserCalls()))
+
+			stored := <-finished
+			require.Equal(t, job.ID, stored.ID)
+			require.Equal(t, tt.wantStatus, stored.Status)
+			require.Equal(t, tt.wantError, stored.Error)
+			require.NotNil(t, stored.FinishedAt)
+			require.Equal(t, tt.eraseURLsCallCount, len(mockStorage.EraseURLsCalls()))
+			require.Equal(t, tt.eraseURLsCallCount, len(mockWebhookStorage.DeleteWebhooksCalls()))
+			require.Equal(t, tt.eraseURLsCallCount, len(mockAuditStorage.AnonymizeEntriesCalls()))
+			if tt.eraseURLsErr == nil {
+				require.Equal(t, 1, len(mockDumper.RemoveCalls()))
+			}
+		})
+	}
+}
+
+func TestErasureService_EraseNoUser(t *testing.T) {
+	mockUserStorage := &userStorageMock{}
+
+	s := NewErasureService(context.Background(), nil, nil, nil, nil, nil, nil, nil, mockUserStorage, nil, nil)
+
+	_, err := s.Erase(context.Background())
+	require.ErrorIs(t, err, ErrNoUser)
+	require.Equal(t, 0, len(mockUserStorage.RevokeUserCalls()))
+}
+
+func TestErasureService_GetJob(t *testing.T) {
+	mockUserStorage := &userStorageMock{
+		GetErasureJobFunc: func(ctx context.Context, id string) (*userstorage.ErasureJob, error) {
+			return nil, userstorage.ErrNotFound
+		},
+	}
+
+	s := NewErasureService(context.Background(), nil, nil, nil, nil, nil, nil, nil, mockUserStorage, nil, nil)
+
+	_, err := s.GetJob(context.Background(), "job")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestErasureService_IsRevoked(t *testing.T) {
+	mockUserStorage := &userStorageMock{
+		IsRevokedFunc: func(ctx context.Context, userID string) (bool, error) {
+			return userID == "revoked", nil
+		},
+		RevokeUserFunc: func(ctx context.Context, userID string) error {
+			return nil
+		},
+		SetErasureJobFunc: func(ctx context.Context, job *userstorage.ErasureJob) error {
+			return nil
+		},
+	}
+	s := NewErasureService(context.Background(), nil, nil, nil, nil, nil, nil, nil, mockUserStorage, nil, nil)
+	s.workerPool = &workerPoolMock{}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.revocations.now = func() time.Time { return now }
+
+	for range 2 {
+		revoked, err := s.IsRevoked(context.Background(), "revoked")
+		require.NoError(t, err)
+		require.True(t, revoked)
+		revoked, err = s.IsRevoked(context.Background(), "user")
+		require.NoError(t, err)
+		require.False(t, revoked)
+	}
+	require.Len(t, mockUserStorage.IsRevokedCalls(), 2)
+
+	// The erasure revokes the user at once, without waiting for the cached answer to expire.
+	_, err := s.Erase(context.WithValue(context.Background(), key, "user"))
+	require.NoError(t, err)
+	revoked, err := s.IsRevoked(context.Background(), "user")
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.Len(t, mockUserStorage.IsRevokedCalls(), 2)
+
+	// A user ID found not revoked is checked again once its answer expires.
+	revoked, err = s.IsRevoked(context.Background(), "other")
+	require.NoError(t, err)
+	require.False(t, revoked)
+	now = now.Add(revocationTTL)
+	_, err = s.IsRevoked(context.Background(), "other")
+	require.NoError(t, err)
+	require.Len(t, mockUserStorage.IsRevokedCalls(), 4)
+	require.Len(t, s.revocations.allowed, 1)
+}
+
+type workerPoolMock struct{}
+
+func (workerPoolMock) AddTask(*userstorage.ErasureJob) {}
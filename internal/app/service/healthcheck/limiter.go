@@ -0,0 +1,59 @@
+package healthcheck
+
+import (
+	"context"
+	"sync"
+)
+
+// hostLimiter caps the number of concurrent checks of every host.
+// Hosts are forgotten once no check waits for or holds one of their slots.
+type hostLimiter struct {
+	mu    sync.Mutex
+	limit int
+	hosts map[string]*hostSlots
+}
+
+type hostSlots struct {
+	sem   chan struct{}
+	users int
+}
+
+func newHostLimiter(limit int) *hostLimiter {
+	return &hostLimiter{
+		limit: limit,
+		hosts: make(map[string]*hostSlots),
+	}
+}
+
+// acquire waits for a free slot of the host and returns the function releasing it.
+// Returns the context error if the context is done first.
+func (l *hostLimiter) acquire(ctx context.Context, host string) (func(), error) {
+	l.mu.Lock()
+	slots, ok := l.hosts[host]
+	if !ok {
+		slots = &hostSlots{sem: make(chan struct{}, l.limit)}
+		l.hosts[host] = slots
+	}
+	slots.users++
+	l.mu.Unlock()
+
+	select {
+	case slots.sem <- struct{}{}:
+		return func() {
+			<-slots.sem
+			l.leave(host, slots)
+		}, nil
+	case <-ctx.Done():
+		l.leave(host, slots)
+		return nil, ctx.Err()
+	}
+}
+
+func (l *hostLimiter) leave(host string, slots *hostSlots) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	slots.users--
+	if slots.users == 0 {
+		delete(l.hosts, host)
+	}
+}
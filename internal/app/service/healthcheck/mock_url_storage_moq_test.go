@@ -0,0 +1,145 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package healthcheck
+
+import (
+	"context"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"sync"
+	"time"
+)
+
+// Ensure, that urlStorageMock does implement urlStorage.
+// If this is not the case, regenerate this file with moq.
+var _ urlStorage = &urlStorageMock{}
+
+// urlStorageMock is a mock implementation of urlStorage.
+//
+//	func TestSomethingThatUsesurlStorage(t *testing.T) {
+//
+//		// make and configure a mocked urlStorage
+//		mockedurlStorage := &urlStorageMock{
+//			GetURLsToCheckFunc: func(ctx context.Context, checkedBefore time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+//				panic("mock out the GetURLsToCheck method")
+//			},
+//			SetHealthFunc: func(ctx context.Context, id string, health *urlstorage.Health) error {
+//				panic("mock out the SetHealth method")
+//			},
+//		}
+//
+//		// use mockedurlStorage in code that requires urlStorage
+//		// and then make assertions.
+//
+//	}
+type urlStorageMock struct {
+	// GetURLsToCheckFunc mocks the GetURLsToCheck method.
+	GetURLsToCheckFunc func(ctx context.Context, checkedBefore time.Time, limit int) ([]*urlstorage.URLRecord, error)
+
+	// SetHealthFunc mocks the SetHealth method.
+	SetHealthFunc func(ctx context.Context, id string, health *urlstorage.Health) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetURLsToCheck holds details about calls to the GetURLsToCheck method.
+		GetURLsToCheck []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CheckedBefore is the checkedBefore argument value.
+			CheckedBefore time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// SetHealth holds details about calls to the SetHealth method.
+		SetHealth []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Health is the health argument value.
+			Health *urlstorage.Health
+		}
+	}
+	lockGetURLsToCheck sync.RWMutex
+	lockSetHealth      sync.RWMutex
+}
+
+// GetURLsToCheck calls GetURLsToCheckFunc.
+func (mock *urlStorageMock) GetURLsToCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+	if mock.GetURLsToCheckFunc == nil {
+		panic("urlStorageMock.GetURLsToCheckFunc: method is nil but urlStorage.GetURLsToCheck was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		CheckedBefore time.Time
+		Limit         int
+	}{
+		Ctx:           ctx,
+		CheckedBefore: checkedBefore,
+		Limit:         limit,
+	}
+	mock.lockGetURLsToCheck.Lock()
+	mock.calls.GetURLsToCheck = append(mock.calls.GetURLsToCheck, callInfo)
+	mock.lockGetURLsToCheck.Unlock()
+	return mock.GetURLsToCheckFunc(ctx, checkedBefore, limit)
+}
+
+// GetURLsToCheckCalls gets all the calls that were made to GetURLsToCheck.
+// Check the length with:
+//
+//	len(mockedurlStorage.GetURLsToCheckCalls())
+func (mock *urlStorageMock) GetURLsToCheckCalls() []struct {
+	Ctx           context.Context
+	CheckedBefore time.Time
+	Limit         int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		CheckedBefore time.Time
+		Limit         int
+	}
+	mock.lockGetURLsToCheck.RLock()
+	calls = mock.calls.GetURLsToCheck
+	mock.lockGetURLsToCheck.RUnlock()
+	return calls
+}
+
+// SetHealth calls SetHealthFunc.
+func (mock *urlStorageMock) SetHealth(ctx context.Context, id string, health *urlstorage.Health) error {
+	if mock.SetHealthFunc == nil {
+		panic("urlStorageMock.SetHealthFunc: method is nil but urlStorage.SetHealth was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     string
+		Health *urlstorage.Health
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Health: health,
+	}
+	mock.lockSetHealth.Lock()
+	mock.calls.SetHealth = append(mock.calls.SetHealth, callInfo)
+	mock.lockSetHealth.Unlock()
+	return mock.SetHealthFunc(ctx, id, health)
+}
+
+// SetHealthCalls gets all the calls that were made to SetHealth.
+// Check the length with:
+//
+//	len(mockedurlStorage.SetHealthCalls())
+func (mock *urlStorageMock) SetHealthCalls() []struct {
+	Ctx    context.Context
+	ID     string
+	Health *urlstorage.Health
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     string
+		Health *urlstorage.Health
+	}
+	mock.lockSetHealth.RLock()
+	calls = mock.calls.SetHealth
+	mock.lockSetHealth.RUnlock()
+	return calls
+}
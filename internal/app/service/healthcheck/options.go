@@ -0,0 +1,63 @@
+package healthcheck
+
+import "time"
+
+// Option configures the health checker.
+type Option func(c *healthChecker)
+
+// WithWorkers sets the number of links checked at the same time.
+func WithWorkers(n int) Option {
+	return func(c *healthChecker) {
+		c.workerNum = n
+	}
+}
+
+// WithPerHostLimit sets the number of concurrent requests sent to one host.
+func WithPerHostLimit(n int) Option {
+	return func(c *healthChecker) {
+		c.perHostLimit = n
+	}
+}
+
+// WithInterval sets how often the storage is polled for links due for a check.
+func WithInterval(d time.Duration) Option {
+	return func(c *healthChecker) {
+		c.interval = d
+	}
+}
+
+// WithRecheckAfter sets how long the result of a check is kept before the link is checked again.
+func WithRecheckAfter(d time.Duration) Option {
+	return func(c *healthChecker) {
+		c.recheckAfter = d
+	}
+}
+
+// WithTimeout sets the time a target has to answer a check, redirects included.
+func WithTimeout(d time.Duration) Option {
+	return func(c *healthChecker) {
+		c.timeout = d
+	}
+}
+
+// WithBrokenAfter sets how long a target must keep failing before its link is flagged broken.
+func WithBrokenAfter(d time.Duration) Option {
+	return func(c *healthChecker) {
+		c.brokenAfter = d
+	}
+}
+
+// WithBatchSize sets the number of links due for a check read from the storage at a time.
+func WithBatchSize(n int) Option {
+	return func(c *healthChecker) {
+		c.batchSize = n
+	}
+}
+
+// WithPrivateTargets allows checks of targets on loopback, private and link-local addresses,
+// which are refused by default so that links cannot be used to probe the internal network.
+func WithPrivateTargets(allow bool) Option {
+	return func(c *healthChecker) {
+		c.allowPrivate = allow
+	}
+}
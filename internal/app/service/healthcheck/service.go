@@ -0,0 +1,294 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/DanilNaum/SnipURL/pkg/netguard"
+	"github.com/DanilNaum/SnipURL/pkg/workerpool"
+)
+
+// Defaults of the health checker, changed with the options.
+const (
+	defaultWorkerNum    = 8
+	defaultPerHostLimit = 2
+	defaultInterval     = time.Minute
+	defaultRecheckAfter = 6 * time.Hour
+	defaultTimeout      = 10 * time.Second
+	defaultBrokenAfter  = 24 * time.Hour
+	defaultBatchSize    = 100
+)
+
+const (
+	userAgent    = "SnipURL-HealthChecker/1.0"
+	maxRedirects = 5
+	// maxDrainSize is the part of a response body read before closing it, so that
+	// the connection can be reused without downloading large pages.
+	maxDrainSize = 4 << 10
+)
+
+//go:generate moq -out mock_url_storage_moq_test.go . urlStorage
+type urlStorage interface {
+	GetURLsToCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*urlstorage.URLRecord, error)
+	SetHealth(ctx context.Context, id string, health *urlstorage.Health) error
+}
+
+//go:generate moq -out mock_logger_moq_test.go . logger
+type logger interface {
+	Errorf(string, ...interface{})
+}
+
+type workerPool interface {
+	AddTask(task *urlstorage.URLRecord)
+}
+
+type healthChecker struct {
+	input      chan *urlstorage.URLRecord
+	storage    urlStorage
+	logger     logger
+	workerPool workerPool
+	client     *http.Client
+	hosts      *hostLimiter
+
+	mu       sync.Mutex
+	inFlight map[string]bool
+
+	workerNum    int
+	perHostLimit int
+	interval     time.Duration
+	recheckAfter time.Duration
+	timeout      time.Duration
+	brokenAfter  time.Duration
+	batchSize    int
+	allowPrivate bool
+}
+
+// NewHealthChecker creates and starts a checker of the targets of the stored links.
+// Every interval it reads the links that were never checked or were checked longer than
+// the recheck period ago and sends a HEAD request to their original URLs, falling back
+// to GET when HEAD is answered with an error status. The status code, latency and time
+// of every check are stored on the link. Links whose target keeps failing for the broken
+// period are flagged broken. The checks run in a worker pool that stops with the context.
+//
+// Parameters:
+//   - ctx: the context for managing the checker lifecycle
+//   - storage: Storage of the links and their health
+//   - logger: Logger for recording errors
+//   - opts: Optional settings of the checker
+//
+// Returns:
+//   - *healthChecker: a running health checker
+func NewHealthChecker(ctx context.Context, storage urlStorage, logger logger, opts ...Option) *healthChecker {
+	c := &healthChecker{
+		storage:      storage,
+		logger:       logger,
+		inFlight:     make(map[string]bool),
+		workerNum:    defaultWorkerNum,
+		perHostLimit: defaultPerHostLimit,
+		interval:     defaultInterval,
+		recheckAfter: defaultRecheckAfter,
+		timeout:      defaultTimeout,
+		brokenAfter:  defaultBrokenAfter,
+		batchSize:    defaultBatchSize,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.hosts = newHostLimiter(c.perHostLimit)
+	c.client = c.newClient()
+
+	// The pool outlives ctx until the scheduler returns, so that no task is added
+	// after the pool has closed its input.
+	poolCtx, stopPool := context.WithCancel(context.Background())
+	c.input = make(chan *urlstorage.URLRecord, c.workerNum)
+	c.workerPool = workerpool.NewWorkerPool(poolCtx, c.workerNum, c.input, c.checkWorker)
+	go c.schedule(ctx, stopPool)
+	return c
+}
+
+// QueueDepth returns the number of links waiting for a check.
+func (c *healthChecker) QueueDepth() int {
+	return len(c.input)
+}
+
+func (c *healthChecker) newClient() *http.Client {
+	dialer := &net.Dialer{Timeout: c.timeout}
+	if !c.allowPrivate {
+		dialer.Control = netguard.Control
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: c.timeout,
+			MaxIdleConnsPerHost: c.perHostLimit,
+			IdleConnTimeout:     c.interval + time.Minute,
+		},
+		Timeout: c.timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			return nil
+		},
+	}
+}
+
+func (c *healthChecker) schedule(ctx context.Context, stopPool context.CancelFunc) {
+	defer stopPool()
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.enqueueDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// enqueueDue adds the links due for a check to the pool, skipping those still being checked.
+func (c *healthChecker) enqueueDue(ctx context.Context) {
+	records, err := c.storage.GetURLsToCheck(ctx, time.Now().Add(-c.recheckAfter), c.batchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Errorf("failed to get links to check: %v", err)
+		}
+		return
+	}
+	for _, record := range records {
+		if ctx.Err() != nil {
+			return
+		}
+		if !c.begin(record.ShortURL) {
+			continue
+		}
+		c.workerPool.AddTask(record)
+	}
+}
+
+func (c *healthChecker) begin(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.inFlight[id] {
+		return false
+	}
+	c.inFlight[id] = true
+	return true
+}
+
+func (c *healthChecker) end(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.inFlight, id)
+}
+
+func (c *healthChecker) checkWorker(ctx context.Context) error {
+	for {
+		select {
+		case record, ok := <-c.input:
+			if !ok {
+				return nil
+			}
+			c.checkAndStore(ctx, record)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *healthChecker) checkAndStore(ctx context.Context, record *urlstorage.URLRecord) {
+	defer c.end(record.ShortURL)
+
+	health, err := c.check(ctx, record)
+	if err != nil {
+		// The check was interrupted and says nothing about the target.
+		return
+	}
+	err = c.storage.SetHealth(ctx, record.ShortURL, health)
+	if err != nil && !errors.Is(err, urlstorage.ErrNotFound) {
+		c.logger.Errorf("failed to store health of %s: %v", record.ShortURL, err)
+	}
+}
+
+// check requests the original URL of the link and returns its health.
+// The failing period carries over from the previous health of the record.
+// Returns an error only if the context is done before the check completes.
+func (c *healthChecker) check(ctx context.Context, record *urlstorage.URLRecord) (*urlstorage.Health, error) {
+	var status int
+	var latency time.Duration
+	target, err := url.Parse(record.OriginalURL)
+	if err == nil && target.Scheme != "http" && target.Scheme != "https" {
+		err = fmt.Errorf("unsupported scheme %q", target.Scheme)
+	}
+	if err == nil {
+		var release func()
+		release, err = c.hosts.acquire(ctx, strings.ToLower(target.Hostname()))
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		status, err = c.probe(ctx, http.MethodHead, target)
+		if err == nil && status >= http.StatusBadRequest {
+			status, err = c.probe(ctx, http.MethodGet, target)
+		}
+		latency = time.Since(start)
+		release()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+
+	health := &urlstorage.Health{
+		StatusCode: status,
+		Latency:    latency,
+		CheckedAt:  time.Now(),
+	}
+	if err != nil {
+		health.Error = err.Error()
+	}
+	if isFailure(status, err) {
+		failingSince := health.CheckedAt
+		if previous := record.Health; previous != nil && previous.FailingSince != nil {
+			failingSince = *previous.FailingSince
+		}
+		health.FailingSince = &failingSince
+		health.Broken = health.CheckedAt.Sub(failingSince) >= c.brokenAfter
+	}
+	return health, nil
+}
+
+// isFailure reports whether a check shows the target gone: no response, 404, 410 or a
+// server error. Other client errors, such as 401, 403 or 429, come from a target that
+// exists but refuses the checker.
+func isFailure(status int, err error) bool {
+	return err != nil ||
+		status == http.StatusNotFound ||
+		status == http.StatusGone ||
+		status >= http.StatusInternalServerError
+}
+
+func (c *healthChecker) probe(ctx context.Context, method string, target *url.URL) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainSize))
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,217 @@
+package healthcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/DanilNaum/SnipURL/pkg/netguard"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestChecker returns a checker that is not started, for calling check directly.
+func newTestChecker(opts ...Option) *healthChecker {
+	c := &healthChecker{
+		perHostLimit: defaultPerHostLimit,
+		interval:     defaultInterval,
+		timeout:      time.Second,
+		brokenAfter:  time.Hour,
+		allowPrivate: true,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.hosts = newHostLimiter(c.perHostLimit)
+	c.client = c.newClient()
+	return c
+}
+
+func TestHealthChecker_check(t *testing.T) {
+	slow := make(chan struct{})
+	defer close(slow)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/no-head", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/forbidden", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-slow:
+		case <-r.Context().Done():
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	longAgo := time.Now().Add(-2 * time.Hour)
+	recently := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name       string
+		url        string
+		previous   *urlstorage.Health
+		wantStatus int
+		wantError  bool
+		wantFailed bool
+		wantBroken bool
+	}{
+		{name: "ok", url: server.URL + "/ok", wantStatus: http.StatusOK},
+		{name: "get after refused head", url: server.URL + "/no-head", wantStatus: http.StatusOK},
+		{name: "redirect is followed", url: server.URL + "/moved", wantStatus: http.StatusOK},
+		{name: "forbidden target exists", url: server.URL + "/forbidden", wantStatus: http.StatusForbidden},
+		{name: "not found", url: server.URL + "/missing", wantStatus: http.StatusNotFound, wantFailed: true},
+		{
+			name:       "failing for a while",
+			url:        server.URL + "/error",
+			previous:   &urlstorage.Health{StatusCode: http.StatusBadGateway, FailingSince: &longAgo},
+			wantStatus: http.StatusBadGateway,
+			wantFailed: true,
+			wantBroken: true,
+		},
+		{
+			name:       "failing recently",
+			url:        server.URL + "/error",
+			previous:   &urlstorage.Health{StatusCode: http.StatusBadGateway, FailingSince: &recently},
+			wantStatus: http.StatusBadGateway,
+			wantFailed: true,
+		},
+		{
+			name:     "recovered",
+			url:      server.URL + "/ok",
+			previous: &urlstorage.Health{StatusCode: http.StatusBadGateway, FailingSince: &longAgo, Broken: true},
+		},
+		{name: "timeout", url: server.URL + "/slow", wantError: true, wantFailed: true},
+		{name: "unsupported scheme", url: "ftp://example.com/file", wantError: true, wantFailed: true},
+	}
+
+	c := newTestChecker(WithTimeout(200 * time.Millisecond))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, err := c.check(context.Background(), &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: tt.url, Health: tt.previous})
+			require.NoError(t, err)
+			if tt.wantStatus != 0 {
+				require.Equal(t, tt.wantStatus, health.StatusCode)
+			}
+			require.Equal(t, tt.wantError, health.Error != "", health.Error)
+			require.Equal(t, tt.wantFailed, health.FailingSince != nil)
+			require.Equal(t, tt.wantBroken, health.Broken)
+			require.WithinDuration(t, time.Now(), health.CheckedAt, time.Second)
+			if tt.previous != nil && tt.wantFailed {
+				require.Equal(t, *tt.previous.FailingSince, *health.FailingSince, "the failing period carries over")
+			}
+		})
+	}
+}
+
+func TestHealthChecker_checkRefusesPrivateTargets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	c := newTestChecker(WithPrivateTargets(false))
+	health, err := c.check(context.Background(), &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: server.URL})
+	require.NoError(t, err)
+	require.Contains(t, health.Error, netguard.ErrBlockedAddress.Error())
+	require.NotNil(t, health.FailingSince)
+}
+
+func TestHealthChecker_checkPerHostLimit(t *testing.T) {
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			old := peak.Load()
+			if n <= old || peak.CompareAndSwap(old, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	c := newTestChecker(WithPerHostLimit(2))
+	errs := make([]error, 8)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = c.check(context.Background(), &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: server.URL})
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.LessOrEqual(t, peak.Load(), int32(2))
+	require.Empty(t, c.hosts.hosts, "idle hosts are forgotten")
+}
+
+func TestNewHealthChecker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+		}
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	checked := make(map[string]*urlstorage.Health)
+	done := make(chan struct{})
+	storage := &urlStorageMock{
+		GetURLsToCheckFunc: func(ctx context.Context, checkedBefore time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			var due []*urlstorage.URLRecord
+			for _, id := range []string{"ok", "gone"} {
+				if _, ok := checked[id]; !ok {
+					due = append(due, &urlstorage.URLRecord{ShortURL: id, OriginalURL: server.URL + "/" + id})
+				}
+			}
+			return due, nil
+		},
+		SetHealthFunc: func(ctx context.Context, id string, health *urlstorage.Health) error {
+			mu.Lock()
+			defer mu.Unlock()
+			checked[id] = health
+			if len(checked) == 2 {
+				close(done)
+			}
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	NewHealthChecker(ctx, storage, &loggerMock{}, WithInterval(10*time.Millisecond), WithBatchSize(10), WithPrivateTargets(true))
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("links were not checked")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 10, storage.GetURLsToCheckCalls()[0].Limit)
+	require.Equal(t, http.StatusOK, checked["ok"].StatusCode)
+	require.Nil(t, checked["ok"].FailingSince)
+	require.Equal(t, http.StatusGone, checked["gone"].StatusCode)
+	require.NotNil(t, checked["gone"].FailingSince)
+}
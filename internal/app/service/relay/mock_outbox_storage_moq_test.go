@@ -0,0 +1,244 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package relay
+
+import (
+	"context"
+	outboxstorage "github.com/DanilNaum/SnipURL/internal/app/repository/outbox"
+	"sync"
+)
+
+// Ensure, that outboxStorageMock does implement outboxStorage.
+// If this is not the case, regenerate this file with moq.
+var _ outboxStorage = &outboxStorageMock{}
+
+// outboxStorageMock is a mock implementation of outboxStorage.
+//
+//	func TestSomethingThatUsesoutboxStorage(t *testing.T) {
+//
+//		// make and configure a mocked outboxStorage
+//		mockedoutboxStorage := &outboxStorageMock{
+//			DeleteEventsFunc: func(ctx context.Context, upTo int64) error {
+//				panic("mock out the DeleteEvents method")
+//			},
+//			GetCheckpointFunc: func(ctx context.Context, subscriber string) (int64, error) {
+//				panic("mock out the GetCheckpoint method")
+//			},
+//			GetEventsFunc: func(ctx context.Context, after int64, limit int) ([]*outboxstorage.Record, error) {
+//				panic("mock out the GetEvents method")
+//			},
+//			SetCheckpointFunc: func(ctx context.Context, subscriber string, seq int64) error {
+//				panic("mock out the SetCheckpoint method")
+//			},
+//		}
+//
+//		// use mockedoutboxStorage in code that requires outboxStorage
+//		// and then make assertions.
+//
+//	}
+type outboxStorageMock struct {
+	// DeleteEventsFunc mocks the DeleteEvents method.
+	DeleteEventsFunc func(ctx context.Context, upTo int64) error
+
+	// GetCheckpointFunc mocks the GetCheckpoint method.
+	GetCheckpointFunc func(ctx context.Context, subscriber string) (int64, error)
+
+	// GetEventsFunc mocks the GetEvents method.
+	GetEventsFunc func(ctx context.Context, after int64, limit int) ([]*outboxstorage.Record, error)
+
+	// SetCheckpointFunc mocks the SetCheckpoint method.
+	SetCheckpointFunc func(ctx context.Context, subscriber string, seq int64) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteEvents holds details about calls to the DeleteEvents method.
+		DeleteEvents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UpTo is the upTo argument value.
+			UpTo int64
+		}
+		// GetCheckpoint holds details about calls to the GetCheckpoint method.
+		GetCheckpoint []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Subscriber is the subscriber argument value.
+			Subscriber string
+		}
+		// GetEvents holds details about calls to the GetEvents method.
+		GetEvents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// After is the after argument value.
+			After int64
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// SetCheckpoint holds details about calls to the SetCheckpoint method.
+		SetCheckpoint []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Subscriber is the subscriber argument value.
+			Subscriber string
+			// Seq is the seq argument value.
+			Seq int64
+		}
+	}
+	lockDeleteEvents  sync.RWMutex
+	lockGetCheckpoint sync.RWMutex
+	lockGetEvents     sync.RWMutex
+	lockSetCheckpoint sync.RWMutex
+}
+
+// DeleteEvents calls DeleteEventsFunc.
+func (mock *outboxStorageMock) DeleteEvents(ctx context.Context, upTo int64) error {
+	if mock.DeleteEventsFunc == nil {
+		panic("outboxStorageMock.DeleteEventsFunc: method is nil but outboxStorage.DeleteEvents was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UpTo int64
+	}{
+		Ctx:  ctx,
+		UpTo: upTo,
+	}
+	mock.lockDeleteEvents.Lock()
+	mock.calls.DeleteEvents = append(mock.calls.DeleteEvents, callInfo)
+	mock.lockDeleteEvents.Unlock()
+	return mock.DeleteEventsFunc(ctx, upTo)
+}
+
+// DeleteEventsCalls gets all the calls that were made to DeleteEvents.
+// Check the length with:
+//
+//	len(mockedoutboxStorage.DeleteEventsCalls())
+func (mock *outboxStorageMock) DeleteEventsCalls() []struct {
+	Ctx  context.Context
+	UpTo int64
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UpTo int64
+	}
+	mock.lockDeleteEvents.RLock()
+	calls = mock.calls.DeleteEvents
+	mock.lockDeleteEvents.RUnlock()
+	return calls
+}
+
+// GetCheckpoint calls GetCheckpointFunc.
+func (mock *outboxStorageMock) GetCheckpoint(ctx context.Context, subscriber string) (int64, error) {
+	if mock.GetCheckpointFunc == nil {
+		panic("outboxStorageMock.GetCheckpointFunc: method is nil but outboxStorage.GetCheckpoint was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Subscriber string
+	}{
+		Ctx:        ctx,
+		Subscriber: subscriber,
+	}
+	mock.lockGetCheckpoint.Lock()
+	mock.calls.GetCheckpoint = append(mock.calls.GetCheckpoint, callInfo)
+	mock.lockGetCheckpoint.Unlock()
+	return mock.GetCheckpointFunc(ctx, subscriber)
+}
+
+// GetCheckpointCalls gets all the calls that were made to GetCheckpoint.
+// Check the length with:
+//
+//	len(mockedoutboxStorage.GetCheckpointCalls())
+func (mock *outboxStorageMock) GetCheckpointCalls() []struct {
+	Ctx        context.Context
+	Subscriber string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Subscriber string
+	}
+	mock.lockGetCheckpoint.RLock()
+	calls = mock.calls.GetCheckpoint
+	mock.lockGetCheckpoint.RUnlock()
+	return calls
+}
+
+// GetEvents calls GetEventsFunc.
+func (mock *outboxStorageMock) GetEvents(ctx context.Context, after int64, limit int) ([]*outboxstorage.Record, error) {
+	if mock.GetEventsFunc == nil {
+		panic("outboxStorageMock.GetEventsFunc: method is nil but outboxStorage.GetEvents was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		After int64
+		Limit int
+	}{
+		Ctx:   ctx,
+		After: after,
+		Limit: limit,
+	}
+	mock.lockGetEvents.Lock()
+	mock.calls.GetEvents = append(mock.calls.GetEvents, callInfo)
+	mock.lockGetEvents.Unlock()
+	return mock.GetEventsFunc(ctx, after, limit)
+}
+
+// GetEventsCalls gets all the calls that were made to GetEvents.
+// Check the length with:
+//
+//	len(mockedoutboxStorage.GetEventsCalls())
+func (mock *outboxStorageMock) GetEventsCalls() []struct {
+	Ctx   context.Context
+	After int64
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		After int64
+		Limit int
+	}
+	mock.lockGetEvents.RLock()
+	calls = mock.calls.GetEvents
+	mock.lockGetEvents.RUnlock()
+	return calls
+}
+
+// SetCheckpoint calls SetCheckpointFunc.
+func (mock *outboxStorageMock) SetCheckpoint(ctx context.Context, subscriber string, seq int64) error {
+	if mock.SetCheckpointFunc == nil {
+		panic("outboxStorageMock.SetCheckpointFunc: method is nil but outboxStorage.SetCheckpoint was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Subscriber string
+		Seq        int64
+	}{
+		Ctx:        ctx,
+		Subscriber: subscriber,
+		Seq:        seq,
+	}
+	mock.lockSetCheckpoint.Lock()
+	mock.calls.SetCheckpoint = append(mock.calls.SetCheckpoint, callInfo)
+	mock.lockSetCheckpoint.Unlock()
+	return mock.SetCheckpointFunc(ctx, subscriber, seq)
+}
+
+// SetCheckpointCalls gets all the calls that were made to SetCheckpoint.
+// Check the length with:
+//
+//	len(mockedoutboxStorage.SetCheckpointCalls())
+func (mock *outboxStorageMock) SetCheckpointCalls() []struct {
+	Ctx        context.Context
+	Subscriber string
+	Seq        int64
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Subscriber string
+		Seq        int64
+	}
+	mock.lockSetCheckpoint.RLock()
+	calls = mock.calls.SetCheckpoint
+	mock.lockSetCheckpoint.RUnlock()
+	return calls
+}
@@ -0,0 +1,82 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package relay
+
+import (
+	"context"
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	"sync"
+)
+
+// Ensure, that subscriberMock does implement subscriber.
+// If this is not the case, regenerate this file with moq.
+var _ subscriber = &subscriberMock{}
+
+// subscriberMock is a mock implementation of subscriber.
+//
+//	func TestSomethingThatUsessubscriber(t *testing.T) {
+//
+//		// make and configure a mocked subscriber
+//		mockedsubscriber := &subscriberMock{
+//			HandleFunc: func(ctx context.Context, event *events.Event) error {
+//				panic("mock out the Handle method")
+//			},
+//		}
+//
+//		// use mockedsubscriber in code that requires subscriber
+//		// and then make assertions.
+//
+//	}
+type subscriberMock struct {
+	// HandleFunc mocks the Handle method.
+	HandleFunc func(ctx context.Context, event *events.Event) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Handle holds details about calls to the Handle method.
+		Handle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event *events.Event
+		}
+	}
+	lockHandle sync.RWMutex
+}
+
+// Handle calls HandleFunc.
+func (mock *subscriberMock) Handle(ctx context.Context, event *events.Event) error {
+	if mock.HandleFunc == nil {
+		panic("subscriberMock.HandleFunc: method is nil but subscriber.Handle was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Event *events.Event
+	}{
+		Ctx:   ctx,
+		Event: event,
+	}
+	mock.lockHandle.Lock()
+	mock.calls.Handle = append(mock.calls.Handle, callInfo)
+	mock.lockHandle.Unlock()
+	return mock.HandleFunc(ctx, event)
+}
+
+// HandleCalls gets all the calls that were made to Handle.
+// Check the length with:
+//
+//	len(mockedsubscriber.HandleCalls())
+func (mock *subscriberMock) HandleCalls() []struct {
+	Ctx   context.Context
+	Event *events.Event
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Event *events.Event
+	}
+	mock.lockHandle.RLock()
+	calls = mock.calls.Handle
+	mock.lockHandle.RUnlock()
+	return calls
+}
@@ -0,0 +1,44 @@
+package relay
+
+import "time"
+
+// Option configures the relay.
+type Option func(r *relay)
+
+// WithSubscriber registers a subscriber under a name that identifies its checkpoint,
+// so the name must stay the same across restarts.
+func WithSubscriber(name string, subscriber subscriber) Option {
+	return func(r *relay) {
+		r.subscribers = append(r.subscribers, &registration{name: name, subscriber: subscriber})
+	}
+}
+
+// WithBatchSize sets the number of events read from the outbox at once.
+func WithBatchSize(n int) Option {
+	return func(r *relay) {
+		r.batchSize = n
+	}
+}
+
+// WithPollInterval sets how often the outbox is polled once all its events are relayed.
+func WithPollInterval(d time.Duration) Option {
+	return func(r *relay) {
+		r.pollInterval = d
+	}
+}
+
+// WithBackoff sets the wait before the first retry of a failed event and the limit
+// it doubles up to.
+func WithBackoff(initial, max time.Duration) Option {
+	return func(r *relay) {
+		r.initialBackoff = initial
+		r.maxBackoff = max
+	}
+}
+
+// WithPruneInterval sets how often the events handled by every subscriber are deleted.
+func WithPruneInterval(d time.Duration) Option {
+	return func(r *relay) {
+		r.pruneInterval = d
+	}
+}
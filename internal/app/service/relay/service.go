@@ -0,0 +1,241 @@
+package relay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	outboxstorage "github.com/DanilNaum/SnipURL/internal/app/repository/outbox"
+)
+
+// Defaults of the relay, changed with the options.
+const (
+	defaultBatchSize      = 100
+	defaultPollInterval   = time.Second
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = time.Minute
+	defaultPruneInterval  = 10 * time.Minute
+)
+
+//go:generate moq -out mock_outbox_storage_moq_test.go . outboxStorage
+type outboxStorage interface {
+	GetEvents(ctx context.Context, after int64, limit int) ([]*outboxstorage.Record, error)
+	GetCheckpoint(ctx context.Context, subscriber string) (int64, error)
+	SetCheckpoint(ctx context.Context, subscriber string, seq int64) error
+	DeleteEvents(ctx context.Context, upTo int64) error
+}
+
+//go:generate moq -out mock_subscriber_moq_test.go . subscriber
+type subscriber interface {
+	Handle(ctx context.Context, event *events.Event) error
+}
+
+//go:generate moq -out mock_logger_moq_test.go . logger
+type logger interface {
+	Errorf(string, ...interface{})
+}
+
+type registration struct {
+	name       string
+	subscriber subscriber
+}
+
+type relay struct {
+	storage     outboxStorage
+	logger      logger
+	subscribers []*registration
+
+	batchSize      int
+	pollInterval   time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	pruneInterval  time.Duration
+
+	// done is closed once every subscriber and the pruner have stopped.
+	done chan struct{}
+}
+
+// NewRelay creates and starts a relay of the outbox events to the registered subscribers.
+// Every subscriber receives the events in the order they were committed, one at a time,
+// from its own goroutine, so that a slow subscriber does not hold up the others. A failed
+// event is retried with exponential backoff until the subscriber handles it; no event is
+// skipped. The sequence number of the last handled event is stored as the subscriber's
+// checkpoint after every batch, and the relay resumes from it after a restart, so an
+// event may be handled again but never lost. The events handled by every subscriber are
+// deleted periodically; without subscribers they are kept. The relay stops with the context.
+//
+// Parameters:
+//   - ctx: the context for managing the relay lifecycle
+//   - storage: The outbox with the events and the checkpoints
+//   - logger: Logger for recording errors
+//   - opts: The subscribers and optional settings of the relay
+//
+// Returns:
+//   - *relay: a running relay
+func NewRelay(ctx context.Context, storage outboxStorage, logger logger, opts ...Option) *relay {
+	r := &relay{
+		storage:        storage,
+		logger:         logger,
+		batchSize:      defaultBatchSize,
+		pollInterval:   defaultPollInterval,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		pruneInterval:  defaultPruneInterval,
+		done:           make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	var wg sync.WaitGroup
+	for _, registration := range r.subscribers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.relay(ctx, registration)
+		}()
+	}
+	if len(r.subscribers) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.prune(ctx)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(r.done)
+	}()
+	return r
+}
+
+// Done returns a channel closed once the relay has stopped.
+func (r *relay) Done() <-chan struct{} {
+	return r.done
+}
+
+// relay hands the events following the checkpoint of the subscriber to it until ctx is done.
+func (r *relay) relay(ctx context.Context, registration *registration) {
+	var checkpoint int64
+	for {
+		var err error
+		if checkpoint, err = r.storage.GetCheckpoint(ctx, registration.name); err == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		r.logger.Errorf("failed to get checkpoint of %s: %v", registration.name, err)
+		if !sleep(ctx, r.pollInterval) {
+			return
+		}
+	}
+
+	for {
+		records, err := r.storage.GetEvents(ctx, checkpoint, r.batchSize)
+		if err != nil && ctx.Err() == nil {
+			r.logger.Errorf("failed to get outbox events for %s: %v", registration.name, err)
+		}
+
+		handled := checkpoint
+		for _, record := range records {
+			if !r.handle(ctx, registration, record) {
+				break
+			}
+			handled = record.Seq
+		}
+		if handled != checkpoint {
+			checkpoint = handled
+			// The context may be done already, the checkpoint is stored regardless.
+			if err := r.storage.SetCheckpoint(context.WithoutCancel(ctx), registration.name, checkpoint); err != nil {
+				r.logger.Errorf("failed to store checkpoint of %s: %v", registration.name, err)
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		if len(records) == r.batchSize {
+			continue
+		}
+		if !sleep(ctx, r.pollInterval) {
+			return
+		}
+	}
+}
+
+// handle hands the event to the subscriber until it is handled and reports whether it was;
+// it is not only if ctx is done first.
+func (r *relay) handle(ctx context.Context, registration *registration, record *outboxstorage.Record) bool {
+	backoff := r.initialBackoff
+	for {
+		e := *record.Event
+		err := registration.subscriber.Handle(ctx, &e)
+		if err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		r.logger.Errorf("%s failed to handle %s event %s, retrying in %s: %v", registration.name, record.Event.Type, record.Event.ID, backoff, err)
+		if !sleep(ctx, backoff) {
+			return false
+		}
+		backoff = min(backoff*2, r.maxBackoff)
+	}
+}
+
+// prune deletes the events handled by every subscriber each prune interval until ctx is done.
+func (r *relay) prune(ctx context.Context) {
+	ticker := time.NewTicker(r.pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		upTo, err := r.handledByAll(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				r.logger.Errorf("failed to get outbox checkpoints: %v", err)
+			}
+			continue
+		}
+		if upTo == 0 {
+			continue
+		}
+		if err := r.storage.DeleteEvents(ctx, upTo); err != nil && ctx.Err() == nil {
+			r.logger.Errorf("failed to delete outbox events: %v", err)
+		}
+	}
+}
+
+// handledByAll returns the smallest checkpoint of the subscribers.
+func (r *relay) handledByAll(ctx context.Context) (int64, error) {
+	var upTo int64
+	for i, registration := range r.subscribers {
+		checkpoint, err := r.storage.GetCheckpoint(ctx, registration.name)
+		if err != nil {
+			return 0, err
+		}
+		if i == 0 || checkpoint < upTo {
+			upTo = checkpoint
+		}
+	}
+	return upTo, nil
+}
+
+// sleep waits for d and reports whether it did; it does not if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,157 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	outboxstorage "github.com/DanilNaum/SnipURL/internal/app/repository/outbox"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStorage returns an outbox holding records numbered from 1 and the given checkpoints.
+func newTestStorage(records int, checkpoints map[string]int64) *outboxStorageMock {
+	var mu sync.Mutex
+	outbox := make([]*outboxstorage.Record, 0, records)
+	for i := 1; i <= records; i++ {
+		outbox = append(outbox, &outboxstorage.Record{
+			Seq:   int64(i),
+			Event: &events.Event{ID: string(rune('a' + i - 1)), Type: events.TypeLinkCreated},
+		})
+	}
+	return &outboxStorageMock{
+		GetEventsFunc: func(ctx context.Context, after int64, limit int) ([]*outboxstorage.Record, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			var res []*outboxstorage.Record
+			for _, record := range outbox {
+				if record.Seq > after && len(res) < limit {
+					res = append(res, record)
+				}
+			}
+			return res, nil
+		},
+		GetCheckpointFunc: func(ctx context.Context, subscriber string) (int64, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return checkpoints[subscriber], nil
+		},
+		SetCheckpointFunc: func(ctx context.Context, subscriber string, seq int64) error {
+			mu.Lock()
+			defer mu.Unlock()
+			checkpoints[subscriber] = seq
+			return nil
+		},
+		DeleteEventsFunc: func(ctx context.Context, upTo int64) error {
+			return nil
+		},
+	}
+}
+
+func TestRelay_relay(t *testing.T) {
+	tests := []struct {
+		name           string
+		checkpoint     int64
+		failures       int
+		wantEvents     []string
+		wantCheckpoint int64
+	}{
+		{name: "relayed in order", wantEvents: []string{"a", "b", "c"}, wantCheckpoint: 3},
+		{name: "resumed from checkpoint", checkpoint: 2, wantEvents: []string{"c"}, wantCheckpoint: 3},
+		{name: "retried without skipping", failures: 2, wantEvents: []string{"a", "a", "a", "b", "c"}, wantCheckpoint: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkpoints := map[string]int64{"test": tt.checkpoint}
+			storage := newTestStorage(3, checkpoints)
+
+			handled := make(chan string, 10)
+			failures := tt.failures
+			subscriber := &subscriberMock{
+				HandleFunc: func(ctx context.Context, event *events.Event) error {
+					handled <- event.ID
+					if failures > 0 {
+						failures--
+						return errors.New("unavailable")
+					}
+					return nil
+				},
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			r := NewRelay(ctx, storage, &loggerMock{ErrorfFunc: func(string, ...interface{}) {}},
+				WithSubscriber("test", subscriber),
+				WithBatchSize(2),
+				WithPollInterval(time.Hour),
+				WithBackoff(time.Millisecond, time.Millisecond),
+				WithPruneInterval(time.Hour),
+			)
+
+			got := make([]string, 0, len(tt.wantEvents))
+			for range tt.wantEvents {
+				select {
+				case id := <-handled:
+					got = append(got, id)
+				case <-time.After(time.Second):
+					require.FailNow(t, "events were not relayed", "got %v", got)
+				}
+			}
+			require.Eventually(t, func() bool {
+				checkpoint, _ := storage.GetCheckpoint(ctx, "test")
+				return checkpoint == tt.wantCheckpoint
+			}, time.Second, time.Millisecond)
+
+			cancel()
+			<-r.Done()
+			require.Equal(t, tt.wantEvents, got)
+			require.Empty(t, handled)
+		})
+	}
+}
+
+func TestRelay_prune(t *testing.T) {
+	tests := []struct {
+		name        string
+		subscribers []string
+		checkpoints map[string]int64
+		wantUpTo    int64
+	}{
+		{name: "up to the slowest subscriber", subscribers: []string{"fast", "slow"}, checkpoints: map[string]int64{"fast": 5, "slow": 3}, wantUpTo: 3},
+		{name: "nothing handled by a new subscriber", subscribers: []string{"old", "new"}, checkpoints: map[string]int64{"old": 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := newTestStorage(0, tt.checkpoints)
+			deleted := make(chan int64, 10)
+			storage.DeleteEventsFunc = func(ctx context.Context, upTo int64) error {
+				deleted <- upTo
+				return nil
+			}
+
+			opts := []Option{WithPollInterval(time.Hour), WithPruneInterval(time.Millisecond)}
+			for _, name := range tt.subscribers {
+				opts = append(opts, WithSubscriber(name, &subscriberMock{}))
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			r := NewRelay(ctx, storage, &loggerMock{ErrorfFunc: func(string, ...interface{}) {}}, opts...)
+
+			require.Eventually(t, func() bool {
+				return len(storage.GetCheckpointCalls()) > 2*len(tt.subscribers)
+			}, time.Second, time.Millisecond)
+			cancel()
+			<-r.Done()
+
+			if tt.wantUpTo == 0 {
+				require.Empty(t, deleted)
+				return
+			}
+			require.NotEmpty(t, deleted)
+			require.Equal(t, tt.wantUpTo, <-deleted)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package tagging
+
+import (
+	"context"
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	"sync"
+)
+
+// Ensure, that templateStorageMock does implement templateStorage.
+// If this is not the case, regenerate this file with moq.
+var _ templateStorage = &templateStorageMock{}
+
+// templateStorageMock is a mock implementation of templateStorage.
+//
+//	func TestSomethingThatUsestemplateStorage(t *testing.T) {
+//
+//		// make and configure a mocked templateStorage
+//		mockedtemplateStorage := &templateStorageMock{
+//			DeleteTemplateFunc: func(ctx context.Context, userID string, name string) error {
+//				panic("mock out the DeleteTemplate method")
+//			},
+//			GetTemplatesFunc: func(ctx context.Context, userID string) ([]*templatestorage.Template, error) {
+//				panic("mock out the GetTemplates method")
+//			},
+//			SetTemplateFunc: func(ctx context.Context, template *templatestorage.Template) error {
+//				panic("mock out the SetTemplate method")
+//			},
+//		}
+//
+//		// use mockedtemplateStorage in code that requires templateStorage
+//		// and then make assertions.
+//
+//	}
+type templateStorageMock struct {
+	// DeleteTemplateFunc mocks the DeleteTemplate method.
+	DeleteTemplateFunc func(ctx context.Context, userID string, name string) error
+
+	// GetTemplatesFunc mocks the GetTemplates method.
+	GetTemplatesFunc func(ctx context.Context, userID string) ([]*templatestorage.Template, error)
+
+	// SetTemplateFunc mocks the SetTemplate method.
+	SetTemplateFunc func(ctx context.Context, template *templatestorage.Template) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteTemplate holds details about calls to the DeleteTemplate method.
+		DeleteTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// Name is the name argument value.
+			Name string
+		}
+		// GetTemplates holds details about calls to the GetTemplates method.
+		GetTemplates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// SetTemplate holds details about calls to the SetTemplate method.
+		SetTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Template is the template argument value.
+			Template *templatestorage.Template
+		}
+	}
+	lockDeleteTemplate sync.RWMutex
+	lockGetTemplates   sync.RWMutex
+	lockSetTemplate    sync.RWMutex
+}
+
+// DeleteTemplate calls DeleteTemplateFunc.
+func (mock *templateStorageMock) DeleteTemplate(ctx context.Context, userID string, name string) error {
+	if mock.DeleteTemplateFunc == nil {
+		panic("templateStorageMock.DeleteTemplateFunc: method is nil but templateStorage.DeleteTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+		Name   string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		Name:   name,
+	}
+	mock.lockDeleteTemplate.Lock()
+	mock.calls.DeleteTemplate = append(mock.calls.DeleteTemplate, callInfo)
+	mock.lockDeleteTemplate.Unlock()
+	return mock.DeleteTemplateFunc(ctx, userID, name)
+}
+
+// DeleteTemplateCalls gets all the calls that were made to DeleteTemplate.
+// Check the length with:
+//
+//	len(mockedtemplateStorage.DeleteTemplateCalls())
+func (mock *templateStorageMock) DeleteTemplateCalls() []struct {
+	Ctx    context.Context
+	UserID string
+	Name   string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+		Name   string
+	}
+	mock.lockDeleteTemplate.RLock()
+	calls = mock.calls.DeleteTemplate
+	mock.lockDeleteTemplate.RUnlock()
+	return calls
+}
+
+// GetTemplates calls GetTemplatesFunc.
+func (mock *templateStorageMock) GetTemplates(ctx context.Context, userID string) ([]*templatestorage.Template, error) {
+	if mock.GetTemplatesFunc == nil {
+		panic("templateStorageMock.GetTemplatesFunc: method is nil but templateStorage.GetTemplates was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetTemplates.Lock()
+	mock.calls.GetTemplates = append(mock.calls.GetTemplates, callInfo)
+	mock.lockGetTemplates.Unlock()
+	return mock.GetTemplatesFunc(ctx, userID)
+}
+
+// GetTemplatesCalls gets all the calls that were made to GetTemplates.
+// Check the length with:
+//
+//	len(mockedtemplateStorage.GetTemplatesCalls())
+func (mock *templateStorageMock) GetTemplatesCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockGetTemplates.RLock()
+	calls = mock.calls.GetTemplates
+	mock.lockGetTemplates.RUnlock()
+	return calls
+}
+
+// SetTemplate calls SetTemplateFunc.
+func (mock *templateStorageMock) SetTemplate(ctx context.Context, template *templatestorage.Template) error {
+	if mock.SetTemplateFunc == nil {
+		panic("templateStorageMock.SetTemplateFunc: method is nil but templateStorage.SetTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Template *templatestorage.Template
+	}{
+		Ctx:      ctx,
+		Template: template,
+	}
+	mock.lockSetTemplate.Lock()
+	mock.calls.SetTemplate = append(mock.calls.SetTemplate, callInfo)
+	mock.lockSetTemplate.Unlock()
+	return mock.SetTemplateFunc(ctx, template)
+}
+
+// SetTemplateCalls gets all the calls that were made to SetTemplate.
+// Check the length with:
+//
+//	len(mockedtemplateStorage.SetTemplateCalls())
+func (mock *templateStorageMock) SetTemplateCalls() []struct {
+	Ctx      context.Context
+	Template *templatestorage.Template
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Template *templatestorage.Template
+	}
+	mock.lockSetTemplate.RLock()
+	calls = mock.calls.SetTemplate
+	mock.lockSetTemplate.RUnlock()
+	return calls
+}
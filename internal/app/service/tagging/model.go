@@ -0,0 +1,11 @@
+package tagging
+
+// Template is a named set of UTM parameters that can be attached to short URLs.
+type Template struct {
+	Name     string
+	Source   string
+	Medium   string
+	Campaign string
+	Term     string
+	Content  string
+}
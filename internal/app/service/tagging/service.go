@@ -0,0 +1,113 @@
+package tagging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/middlewares"
+)
+
+// Predefined errors returned by the tagging service.
+var (
+	// ErrInvalidTemplate indicates that the template has no name or no UTM parameters.
+	ErrInvalidTemplate = fmt.Errorf("invalid template")
+
+	// ErrNotFound indicates that the template does not exist for the user.
+	ErrNotFound = fmt.Errorf("not found")
+
+	// ErrNoUser indicates that the context carries no user ID.
+	ErrNoUser = fmt.Errorf("user not found in context")
+)
+
+//go:generate moq -out mock_template_storage_moq_test.go . templateStorage
+type templateStorage interface {
+	SetTemplate(ctx context.Context, template *templatestorage.Template) error
+	GetTemplates(ctx context.Context, userID string) ([]*templatestorage.Template, error)
+	DeleteTemplate(ctx context.Context, userID, name string) error
+}
+
+var key = middlewares.Key{Key: "userID"}
+
+type taggingService struct {
+	storage templateStorage
+}
+
+// NewTaggingService creates a new service for managing the user's tagging templates.
+func NewTaggingService(storage templateStorage) *taggingService {
+	return &taggingService{
+		storage: storage,
+	}
+}
+
+// SetTemplate creates a template for the user from the context, or replaces the
+// user's template with the same name. Links using the template pick up the change
+// on their next redirect.
+// Returns ErrInvalidTemplate if the name is empty or no UTM parameter is set.
+func (s *taggingService) SetTemplate(ctx context.Context, template *Template) error {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return ErrNoUser
+	}
+
+	if template.Name == "" {
+		return ErrInvalidTemplate
+	}
+	if template.Source == "" && template.Medium == "" && template.Campaign == "" &&
+		template.Term == "" && template.Content == "" {
+		return ErrInvalidTemplate
+	}
+
+	return s.storage.SetTemplate(ctx, &templatestorage.Template{
+		Name:     template.Name,
+		UserID:   userID,
+		Source:   template.Source,
+		Medium:   template.Medium,
+		Campaign: template.Campaign,
+		Term:     template.Term,
+		Content:  template.Content,
+	})
+}
+
+// GetTemplates returns all templates of the user from the context.
+func (s *taggingService) GetTemplates(ctx context.Context) ([]*Template, error) {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return nil, ErrNoUser
+	}
+
+	templates, err := s.storage.GetTemplates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]*Template, 0, len(templates))
+	for _, template := range templates {
+		output = append(output, &Template{
+			Name:     template.Name,
+			Source:   template.Source,
+			Medium:   template.Medium,
+			Campaign: template.Campaign,
+			Term:     template.Term,
+			Content:  template.Content,
+		})
+	}
+	return output, nil
+}
+
+// DeleteTemplate removes the user's template with the given name.
+// Links that still reference it redirect without UTM parameters.
+// Returns ErrNotFound if the user has no such template.
+func (s *taggingService) DeleteTemplate(ctx context.Context, name string) error {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return ErrNoUser
+	}
+
+	err := s.storage.DeleteTemplate(ctx, userID, name)
+	if errors.Is(err, templatestorage.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
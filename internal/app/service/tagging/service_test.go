@@ -0,0 +1,66 @@
+package tagging
+
+import (
+	"context"
+	"testing"
+
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaggingService_SetTemplate(t *testing.T) {
+	tests := []struct {
+		name                         string
+		template                     *Template
+		setTemplateFuncNumberOfCalls int
+		wantErr                      error
+	}{
+		{
+			name:                         "valid template",
+			template:                     &Template{Name: "spring", Source: "newsletter", Medium: "email"},
+			setTemplateFuncNumberOfCalls: 1,
+		},
+		{
+			name:     "empty name",
+			template: &Template{Source: "newsletter"},
+			wantErr:  ErrInvalidTemplate,
+		},
+		{
+			name:     "no parameters",
+			template: &Template{Name: "spring"},
+			wantErr:  ErrInvalidTemplate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage := &templateStorageMock{
+				SetTemplateFunc: func(ctx context.Context, template *templatestorage.Template) error {
+					require.Equal(t, "user", template.UserID)
+					return nil
+				},
+			}
+
+			s := NewTaggingService(mockStorage)
+
+			ctx := context.WithValue(context.Background(), key, "user")
+			err := s.SetTemplate(ctx, tt.template)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.setTemplateFuncNumberOfCalls, len(mockStorage.SetTemplateCalls()))
+		})
+	}
+}
+
+func TestTaggingService_DeleteTemplate(t *testing.T) {
+	mockStorage := &templateStorageMock{
+		DeleteTemplateFunc: func(ctx context.Context, userID, name string) error {
+			return templatestorage.ErrNotFound
+		},
+	}
+
+	s := NewTaggingService(mockStorage)
+
+	ctx := context.WithValue(context.Background(), key, "user")
+	err := s.DeleteTemplate(ctx, "spring")
+	require.ErrorIs(t, err, ErrNotFound)
+}
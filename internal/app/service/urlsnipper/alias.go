@@ -0,0 +1,26 @@
+package urlsnipper
+
+import "regexp"
+
+// aliasPattern limits custom short URL IDs to URL-safe characters.
+var aliasPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{3,64}$`)
+
+// reservedAliases are the first path segments of the service's own routes, which
+// a custom short URL ID would shadow.
+var reservedAliases = map[string]struct{}{
+	"api":     {},
+	"debug":   {},
+	"metrics": {},
+	"ping":    {},
+}
+
+// validateAlias checks a custom short URL ID requested instead of the generated one.
+func validateAlias(alias string) error {
+	if !aliasPattern.MatchString(alias) {
+		return ErrInvalidAlias
+	}
+	if _, ok := reservedAliases[alias]; ok {
+		return ErrInvalidAlias
+	}
+	return nil
+}
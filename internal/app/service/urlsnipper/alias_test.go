@@ -0,0 +1,117 @@
+package urlsnipper
+
+import (
+	"context"
+	"testing"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAlias(t *testing.T) {
+	tests := []struct {
+		name  string
+		alias string
+		want  error
+	}{
+		{name: "valid", alias: "spring-sale_2024"},
+		{name: "too_short", alias: "ab", want: ErrInvalidAlias},
+		{name: "too_long", alias: string(make([]byte, 65)), want: ErrInvalidAlias},
+		{name: "slash", alias: "a/b/c", want: ErrInvalidAlias},
+		{name: "reserved", alias: "api", want: ErrInvalidAlias},
+		{name: "reserved_metrics", alias: "metrics", want: ErrInvalidAlias},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAlias(tt.alias)
+			require.ErrorIs(t, err, tt.want)
+			if tt.want != nil {
+				require.ErrorIs(t, err, ErrInvalidOption)
+			}
+		})
+	}
+}
+
+func TestUrlSnipperService_SetURLsAlias(t *testing.T) {
+	mockStorage := &urlStorageMock{
+		SetURLsFunc: func(ctx context.Context, urls []*urlstorage.URLRecord) ([]*urlstorage.URLRecord, error) {
+			inserted := make([]*urlstorage.URLRecord, 0, len(urls))
+			for _, u := range urls {
+				if u.ShortURL != "taken" {
+					inserted = append(inserted, u)
+				}
+			}
+			return inserted, nil
+		},
+	}
+	s := &urlSnipperService{
+		storage: mockStorage,
+		hasher:  &hasherMock{HashFunc: func(s string) string { return "hash" }},
+		dumper:  &dumperMock{AddFunc: func(record *dump.URLRecord) error { return nil }},
+	}
+
+	output, err := s.SetURLs(context.Background(), []*SetURLsInput{
+		{CorrelationID: "1", OriginalURL: "https://example.com/a", Alias: "spring-sale"},
+		{CorrelationID: "2", OriginalURL: "https://example.com/b"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "spring-sale", output["1"].ShortURLID)
+	require.Equal(t, "hash", output["2"].ShortURLID)
+
+	output, err = s.SetURLs(context.Background(), []*SetURLsInput{
+		{CorrelationID: "1", OriginalURL: "https://example.com/a", Alias: "taken"},
+		{CorrelationID: "2", OriginalURL: "https://example.com/b"},
+	})
+	require.ErrorIs(t, err, ErrFailedToGenerateID)
+	require.Len(t, output, 1)
+	require.Equal(t, "hash", output["2"].ShortURLID)
+
+	_, err = s.SetURLs(context.Background(), []*SetURLsInput{
+		{CorrelationID: "1", OriginalURL: "https://example.com/a", Alias: "no/slashes"},
+	})
+	require.ErrorIs(t, err, ErrInvalidAlias)
+	require.Len(t, mockStorage.SetURLsCalls(), 2)
+}
+
+func TestUrlSnipperService_SetURLsExisting(t *testing.T) {
+	existing := map[string]string{
+		"hash-a": "https://example.com/a",
+		"hash-b": "https://example.com/other",
+		"taken":  "https://example.com/c",
+	}
+	mockStorage := &urlStorageMock{
+		SetURLsFunc: func(ctx context.Context, urls []*urlstorage.URLRecord) ([]*urlstorage.URLRecord, error) {
+			inserted := make([]*urlstorage.URLRecord, 0, len(urls))
+			for _, u := range urls {
+				if _, ok := existing[u.ShortURL]; !ok {
+					existing[u.ShortURL] = u.OriginalURL
+					inserted = append(inserted, u)
+				}
+			}
+			return inserted, nil
+		},
+		GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+			return &urlstorage.URLRecord{ShortURL: id, OriginalURL: existing[id]}, nil
+		},
+	}
+	s := &urlSnipperService{
+		storage: mockStorage,
+		hasher:  &hasherMock{HashFunc: func(url string) string { return "hash-" + url[len(url)-1:] }},
+		dumper:  &dumperMock{AddFunc: func(record *dump.URLRecord) error { return nil }},
+	}
+
+	output, err := s.SetURLs(context.Background(), []*SetURLsInput{
+		{CorrelationID: "duplicate", OriginalURL: "https://example.com/a"},
+		{CorrelationID: "collision", OriginalURL: "https://example.com/b"},
+		{CorrelationID: "alias", OriginalURL: "https://example.com/c", Alias: "taken"},
+		{CorrelationID: "new", OriginalURL: "https://example.com/d"},
+		{CorrelationID: "first", OriginalURL: "https://example.com/e", Alias: "twice"},
+		{CorrelationID: "second", OriginalURL: "https://example.com/f", Alias: "twice"},
+	})
+	require.ErrorIs(t, err, ErrFailedToGenerateID)
+	require.Len(t, output, 3)
+	require.Equal(t, "twice", output["first"].ShortURLID)
+	require.Equal(t, &SetURLsOutput{CorrelationID: "duplicate", ShortURLID: "hash-a", Existing: true}, output["duplicate"])
+	require.Equal(t, &SetURLsOutput{CorrelationID: "new", ShortURLID: "hash-d"}, output["new"])
+}
@@ -0,0 +1,87 @@
+package urlsnipper
+
+import (
+	"context"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	clickstorage "github.com/DanilNaum/SnipURL/internal/app/repository/click"
+	"github.com/DanilNaum/SnipURL/pkg/fanout"
+)
+
+//go:generate moq -out mock_click_hub_moq_test.go . clickHub
+type clickHub interface {
+	Subscribe(ctx context.Context, key string) fanout.Subscription[*ClickEvent]
+	Publish(key string, value *ClickEvent)
+}
+
+// RecordClick stores one redirect of the short URL for analytics and counts it
+// on the link for sorting the user's URLs.
+// Every counted click is published with the clicks of the link so far and streamed
+// to the owners watching the link.
+// Failures are logged and do not affect the redirect.
+func (s *urlSnipperService) RecordClick(ctx context.Context, id string, click *Click) {
+	now := time.Now()
+	err := s.clickStorage.AddClick(ctx, &clickstorage.Click{
+		ShortURL: id,
+		Time:     now,
+		Country:  click.Country,
+		Variant:  click.Variant,
+	})
+	if err != nil {
+		s.logger.ErrorfContext(ctx, "failed to record click of %s: %v", id, err)
+	}
+	clicks, err := s.storage.IncrementClicks(ctx, id)
+	if err != nil {
+		s.logger.ErrorfContext(ctx, "failed to count click of %s: %v", id, err)
+		return
+	}
+	event := events.New(events.TypeLinkClicked, id)
+	event.Clicks = clicks
+	s.publish(event)
+
+	if s.clickHub != nil {
+		s.clickHub.Publish(id, &ClickEvent{
+			ShortURL: id,
+			Country:  click.Country,
+			Variant:  click.Variant,
+			Clicks:   clicks,
+			Time:     now.UTC(),
+		})
+	}
+}
+
+// WatchClicks streams the clicks of a short URL owned by the user from the context
+// as they are recorded, until ctx is done or the service stops. Clicks recorded
+// while the receiver is behind are dropped rather than held back.
+//
+// Returns:
+//   - ClickStream: The clicks of the link from now on
+//   - error: ErrNotFound if the URL does not exist or belongs to another user, storage error, or nil on success
+func (s *urlSnipperService) WatchClicks(ctx context.Context, id string) (ClickStream, error) {
+	if _, err := s.getOwnedRecord(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.clickHub.Subscribe(ctx, id), nil
+}
+
+// GetClickStats returns the click analytics of a short URL owned by the user from the context.
+//
+// Returns:
+//   - *ClickStats: Total clicks and clicks per country
+//   - error: ErrNotFound if the URL does not exist or belongs to another user, storage error, or nil on success
+func (s *urlSnipperService) GetClickStats(ctx context.Context, id string) (*ClickStats, error) {
+	if _, err := s.getOwnedRecord(ctx, id); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.clickStorage.GetClickStats(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClickStats{
+		Total:     stats.Total,
+		ByCountry: stats.ByCountry,
+	}, nil
+}
@@ -0,0 +1,139 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	clickstorage "github.com/DanilNaum/SnipURL/internal/app/repository/click"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/DanilNaum/SnipURL/pkg/fanout"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUrlSnipperService_RecordClick(t *testing.T) {
+	mockClickStorage := &clickStorageMock{
+		AddClickFunc: func(ctx context.Context, click *clickstorage.Click) error {
+			return errors.New("storage error")
+		},
+	}
+	mockLogger := &loggerMock{
+		ErrorfContextFunc: func(ctx context.Context, s string, ifaceVals ...interface{}) {},
+	}
+
+	mockStorage := &urlStorageMock{
+		IncrementClicksFunc: func(ctx context.Context, id string) (int, error) {
+			return 7, nil
+		},
+	}
+	mockPublisher := &publisherMock{
+		PublishFunc: func(event *events.Event) {},
+	}
+	mockClickHub := &clickHubMock{
+		PublishFunc: func(key string, value *ClickEvent) {},
+	}
+
+	s := &urlSnipperService{
+		storage:      mockStorage,
+		clickStorage: mockClickStorage,
+		publisher:    mockPublisher,
+		clickHub:     mockClickHub,
+		logger:       mockLogger,
+	}
+
+	s.RecordClick(context.Background(), "abc123", &Click{Country: "DE", Variant: "a"})
+
+	require.Len(t, mockClickStorage.AddClickCalls(), 1)
+	got := mockClickStorage.AddClickCalls()[0].Click
+	require.Equal(t, "abc123", got.ShortURL)
+	require.Equal(t, "DE", got.Country)
+	require.Equal(t, "a", got.Variant)
+	require.False(t, got.Time.IsZero())
+	require.Len(t, mockLogger.ErrorfContextCalls(), 1)
+	require.Len(t, mockStorage.IncrementClicksCalls(), 1)
+	require.Len(t, mockPublisher.PublishCalls(), 1)
+	event := mockPublisher.PublishCalls()[0].Event
+	require.Equal(t, events.TypeLinkClicked, event.Type)
+	require.Equal(t, "abc123", event.ShortURL)
+	require.Equal(t, 7, event.Clicks)
+	require.Len(t, mockClickHub.PublishCalls(), 1)
+	require.Equal(t, "abc123", mockClickHub.PublishCalls()[0].Key)
+	streamed := mockClickHub.PublishCalls()[0].Value
+	require.Equal(t, "DE", streamed.Country)
+	require.Equal(t, "a", streamed.Variant)
+	require.Equal(t, 7, streamed.Clicks)
+	require.Equal(t, got.Time.UTC(), streamed.Time)
+}
+
+func TestUrlSnipperService_WatchClicks(t *testing.T) {
+	mockStorage := &urlStorageMock{
+		GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+			if id != "abc123" {
+				return nil, urlstorage.ErrNotFound
+			}
+			return &urlstorage.URLRecord{ShortURL: id, UserID: "user"}, nil
+		},
+		IncrementClicksFunc: func(ctx context.Context, id string) (int, error) {
+			return 1, nil
+		},
+	}
+	mockClickStorage := &clickStorageMock{
+		AddClickFunc: func(ctx context.Context, click *clickstorage.Click) error {
+			return nil
+		},
+	}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key, "user"))
+	defer cancel()
+	s := &urlSnipperService{
+		storage:      mockStorage,
+		clickStorage: mockClickStorage,
+		clickHub:     fanout.NewHub[string, *ClickEvent](context.Background(), 1),
+	}
+
+	_, err := s.WatchClicks(context.WithValue(context.Background(), key, "other"), "abc123")
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = s.WatchClicks(ctx, "missing")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	stream, err := s.WatchClicks(ctx, "abc123")
+	require.NoError(t, err)
+	s.RecordClick(context.Background(), "abc123", &Click{Country: "DE"})
+	s.RecordClick(context.Background(), "abc123", &Click{Country: "FR"})
+
+	click := <-stream.C()
+	require.Equal(t, "abc123", click.ShortURL)
+	require.Equal(t, "DE", click.Country)
+	require.Equal(t, uint64(1), stream.Dropped())
+
+	cancel()
+	_, ok := <-stream.C()
+	require.False(t, ok)
+}
+
+func TestUrlSnipperService_GetClickStats(t *testing.T) {
+	mockStorage := &urlStorageMock{
+		GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+			return &urlstorage.URLRecord{ShortURL: id, UserID: "user"}, nil
+		},
+	}
+	mockClickStorage := &clickStorageMock{
+		GetClickStatsFunc: func(ctx context.Context, shortURL string) (*clickstorage.ClickStats, error) {
+			return &clickstorage.ClickStats{Total: 3, ByCountry: map[string]int{"DE": 2, "": 1}}, nil
+		},
+	}
+
+	s := &urlSnipperService{
+		storage:      mockStorage,
+		clickStorage: mockClickStorage,
+	}
+
+	ctx := context.WithValue(context.Background(), key, "user")
+	got, err := s.GetClickStats(ctx, "abc123")
+	require.NoError(t, err)
+	require.Equal(t, &ClickStats{Total: 3, ByCountry: map[string]int{"DE": 2, "": 1}}, got)
+
+	ctx = context.WithValue(context.Background(), key, "other")
+	_, err = s.GetClickStats(ctx, "abc123")
+	require.ErrorIs(t, err, ErrNotFound)
+}
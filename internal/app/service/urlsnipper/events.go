@@ -0,0 +1,29 @@
+package urlsnipper
+
+import (
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+//go:generate moq -out mock_publisher_moq_test.go . publisher
+type publisher interface {
+	Publish(event *events.Event)
+}
+
+// publish hands the event to the publisher. Without a publisher events are not emitted.
+// Only click limit expiries and clicks are published here; the storage emits the other
+// events, including the ends of the active windows, to the outbox together with the changes.
+func (s *urlSnipperService) publish(event *events.Event) {
+	if s.publisher == nil {
+		return
+	}
+	s.publisher.Publish(event)
+}
+
+func (s *urlSnipperService) publishExpired(record *urlstorage.URLRecord, reason string) {
+	event := events.New(events.TypeLinkExpired, record.ShortURL)
+	event.UserID = record.UserID
+	event.OriginalURL = record.OriginalURL
+	event.Reason = reason
+	s.publish(event)
+}
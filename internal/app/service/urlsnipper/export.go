@@ -0,0 +1,54 @@
+package urlsnipper
+
+import (
+	"context"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// URLIterator walks the URLs returned by ExportURLs, reading them from storage as it
+// advances. Next advances to the next URL and reports whether there is one, Err reports
+// the error that stopped the iteration, and Close must be called once the iterator is
+// no longer needed.
+type URLIterator interface {
+	Next() bool
+	URL() *URL
+	Err() error
+	Close()
+}
+
+type urlIterator struct {
+	records urlstorage.URLIterator
+	url     *URL
+}
+
+// ExportURLs returns an iterator over all the URLs of the user from the context in
+// creation order, the deleted ones included, with their metadata and click totals.
+func (s *urlSnipperService) ExportURLs(ctx context.Context) (URLIterator, error) {
+	records, err := s.storage.IterateURLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &urlIterator{records: records}, nil
+}
+
+func (it *urlIterator) Next() bool {
+	if !it.records.Next() {
+		it.url = nil
+		return false
+	}
+	it.url = urlFromRecord(it.records.Record())
+	return true
+}
+
+func (it *urlIterator) URL() *URL {
+	return it.url
+}
+
+func (it *urlIterator) Err() error {
+	return it.records.Err()
+}
+
+func (it *urlIterator) Close() {
+	it.records.Close()
+}
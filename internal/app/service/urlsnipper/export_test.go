@@ -0,0 +1,63 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+)
+
+type sliceURLIterator struct {
+	records []*urlstorage.URLRecord
+	pos     int
+	err     error
+	closed  bool
+}
+
+func (it *sliceURLIterator) Next() bool {
+	if it.pos >= len(it.records) {
+		return false
+	}
+	it.pos++
+	return true
+}
+
+func (it *sliceURLIterator) Record() *urlstorage.URLRecord { return it.records[it.pos-1] }
+func (it *sliceURLIterator) Err() error                    { return it.err }
+func (it *sliceURLIterator) Close()                        { it.closed = true }
+
+func TestUrlSnipperService_ExportURLs(t *testing.T) {
+	records := &sliceURLIterator{
+		records: []*urlstorage.URLRecord{
+			{ShortURL: "a", OriginalURL: "https://example.com/a", Tags: []string{"launch"}, Clicks: 3},
+			{ShortURL: "b", OriginalURL: "https://example.com/b", Deleted: true},
+		},
+		err: errors.New("connection reset"),
+	}
+	s := &urlSnipperService{
+		storage: &urlStorageMock{
+			IterateURLsFunc: func(ctx context.Context) (urlstorage.URLIterator, error) {
+				return records, nil
+			},
+		},
+	}
+
+	it, err := s.ExportURLs(context.Background())
+	require.NoError(t, err)
+
+	var urls []*URL
+	for it.Next() {
+		urls = append(urls, it.URL())
+	}
+	require.Len(t, urls, 2)
+	require.Equal(t, []string{"launch"}, urls[0].Tags)
+	require.Equal(t, 3, urls[0].Clicks)
+	require.True(t, urls[1].Deleted)
+	require.Nil(t, it.URL())
+	require.EqualError(t, it.Err(), "connection reset")
+
+	it.Close()
+	require.True(t, records.closed)
+}
@@ -0,0 +1,185 @@
+package urlsnipper
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// Sort orders and directions accepted by ListURLs.
+const (
+	SortCreated = urlstorage.SortCreated
+	SortClicks  = urlstorage.SortClicks
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+	maxSearchLength = 200
+)
+
+// ListURLsInput requests a page of the user's URLs.
+// Sort defaults to SortCreated and Order to OrderDesc, so the newest links come first.
+// Limit defaults to 100 and may not exceed 1000. Cursor is the NextCursor of the
+// previous page, empty for the first page; it is only valid with the same Sort and Order.
+// Search matches a substring of the original URL or title, ignoring case.
+// Broken keeps only the links whose target the health checker reported broken.
+type ListURLsInput struct {
+	Tag    string
+	Search string
+	Broken bool
+	Sort   string
+	Order  string
+	Limit  int
+	Cursor string
+}
+
+// URLPage is a page of the user's URLs. NextCursor is empty on the last page.
+type URLPage struct {
+	URLs       []*URL
+	NextCursor string
+}
+
+// cursor is the position after the last link of a page. It is handed out
+// base64-encoded and is opaque to clients.
+type cursor struct {
+	Sort      string    `json:"s"`
+	Desc      bool      `json:"d,omitempty"`
+	CreatedAt time.Time `json:"t,omitempty"`
+	Clicks    int       `json:"c,omitempty"`
+	ShortURL  string    `json:"id"`
+}
+
+// ListURLs returns a page of the URLs of the user from the context, filtered by tag
+// and search string and ordered by creation time or clicks.
+//
+// Parameters:
+//   - ctx: The context containing the user ID
+//   - input: Filters, order and page of the list
+//
+// Returns:
+//   - *URLPage: The URLs of the page and the cursor of the next one
+//   - error: ErrInvalidListQuery if the order, limit or search are invalid, ErrInvalidCursor
+//     if the cursor is malformed, storage error, or nil on success
+func (s *urlSnipperService) ListURLs(ctx context.Context, input *ListURLsInput) (_ *URLPage, err error) {
+	ctx, span := startSpan(ctx, "ListURLs")
+	defer func() { endSpan(span, err) }()
+
+	filter, err := listFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	limit := filter.Limit
+	// One more link than requested tells whether there is a next page.
+	filter.Limit++
+
+	records, err := s.storage.GetURLs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &URLPage{URLs: make([]*URL, 0, min(len(records), limit))}
+	if len(records) > limit {
+		records = records[:limit]
+		page.NextCursor = encodeCursor(filter, records[len(records)-1])
+	}
+	for _, record := range records {
+		page.URLs = append(page.URLs, urlFromRecord(record))
+	}
+	return page, nil
+}
+
+func listFilter(input *ListURLsInput) (*urlstorage.URLFilter, error) {
+	filter := &urlstorage.URLFilter{
+		Tag:    normalizeTag(input.Tag),
+		Search: input.Search,
+		Broken: input.Broken,
+		Sort:   input.Sort,
+		Limit:  input.Limit,
+	}
+
+	switch filter.Sort {
+	case "":
+		filter.Sort = SortCreated
+	case SortCreated, SortClicks:
+	default:
+		return nil, fmt.Errorf("%w: unknown sort %q", ErrInvalidListQuery, input.Sort)
+	}
+
+	switch input.Order {
+	case "", OrderDesc:
+		filter.Desc = true
+	case OrderAsc:
+	default:
+		return nil, fmt.Errorf("%w: unknown order %q", ErrInvalidListQuery, input.Order)
+	}
+
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultPageSize
+	case filter.Limit < 0 || filter.Limit > maxPageSize:
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidListQuery, maxPageSize)
+	}
+
+	if err := validateSearch(filter.Search); err != nil {
+		return nil, err
+	}
+
+	if input.Cursor != "" {
+		after, err := decodeCursor(input.Cursor, filter)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = after
+	}
+	return filter, nil
+}
+
+func validateSearch(search string) error {
+	if utf8.RuneCountInString(search) > maxSearchLength {
+		return fmt.Errorf("%w: search is longer than %d characters", ErrInvalidListQuery, maxSearchLength)
+	}
+	return nil
+}
+
+func encodeCursor(filter *urlstorage.URLFilter, last *urlstorage.URLRecord) string {
+	c := cursor{
+		Sort:     filter.Sort,
+		Desc:     filter.Desc,
+		ShortURL: last.ShortURL,
+	}
+	if filter.Sort == SortClicks {
+		c.Clicks = last.Clicks
+	} else {
+		c.CreatedAt = last.CreatedAt
+	}
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(value string, filter *urlstorage.URLFilter) (*urlstorage.URLCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.Sort != filter.Sort || c.Desc != filter.Desc || c.ShortURL == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &urlstorage.URLCursor{
+		CreatedAt: c.CreatedAt,
+		Clicks:    c.Clicks,
+		ShortURL:  c.ShortURL,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package urlsnipper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUrlSnipperService_ListURLs(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockStorage := &urlStorageMock{
+		GetURLsFunc: func(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error) {
+			if filter.After != nil {
+				return []*urlstorage.URLRecord{{ShortURL: "c", CreatedAt: created}}, nil
+			}
+			return []*urlstorage.URLRecord{
+				{ShortURL: "a", CreatedAt: created.Add(2 * time.Hour)},
+				{ShortURL: "b", CreatedAt: created.Add(time.Hour), Health: &urlstorage.Health{StatusCode: 404, FailingSince: &created, Broken: true}},
+				{ShortURL: "c", CreatedAt: created},
+			}, nil
+		},
+	}
+	s := &urlSnipperService{storage: mockStorage}
+
+	page, err := s.ListURLs(context.Background(), &ListURLsInput{Limit: 2, Tag: "Launch", Search: "sale", Broken: true})
+	require.NoError(t, err)
+	require.Len(t, page.URLs, 2)
+	require.Equal(t, "b", page.URLs[1].ShortURL)
+	require.Equal(t, &Health{StatusCode: 404, FailingSince: &created, Broken: true}, page.URLs[1].Health)
+	require.NotEmpty(t, page.NextCursor)
+
+	filter := mockStorage.GetURLsCalls()[0].Filter
+	require.Equal(t, &urlstorage.URLFilter{Tag: "launch", Search: "sale", Broken: true, Sort: SortCreated, Desc: true, Limit: 3}, filter)
+
+	page, err = s.ListURLs(context.Background(), &ListURLsInput{Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.URLs, 1)
+	require.Empty(t, page.NextCursor)
+
+	after := mockStorage.GetURLsCalls()[1].Filter.After
+	require.Equal(t, "b", after.ShortURL)
+	require.True(t, created.Add(time.Hour).Equal(after.CreatedAt))
+}
+
+func TestUrlSnipperService_ListURLsInvalidQuery(t *testing.T) {
+	s := &urlSnipperService{
+		storage: &urlStorageMock{
+			GetURLsFunc: func(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error) {
+				return []*urlstorage.URLRecord{{ShortURL: "a"}, {ShortURL: "b"}}, nil
+			},
+		},
+	}
+	page, err := s.ListURLs(context.Background(), &ListURLsInput{Limit: 1})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		input   *ListURLsInput
+		wantErr error
+	}{
+		{name: "unknown sort", input: &ListURLsInput{Sort: "title"}, wantErr: ErrInvalidListQuery},
+		{name: "unknown order", input: &ListURLsInput{Order: "up"}, wantErr: ErrInvalidListQuery},
+		{name: "limit too large", input: &ListURLsInput{Limit: maxPageSize + 1}, wantErr: ErrInvalidListQuery},
+		{name: "negative limit", input: &ListURLsInput{Limit: -1}, wantErr: ErrInvalidListQuery},
+		{name: "malformed cursor", input: &ListURLsInput{Cursor: "not a cursor"}, wantErr: ErrInvalidCursor},
+		{name: "cursor of another sort", input: &ListURLsInput{Sort: SortClicks, Cursor: page.NextCursor}, wantErr: ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.ListURLs(context.Background(), tt.input)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
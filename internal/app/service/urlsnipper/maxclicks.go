@@ -0,0 +1,50 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ConsumeClick takes one redirect from a click-limited short URL. Call it right before
+// redirecting; the storage decrements the counter atomically, so concurrent redirects
+// never exceed the limit. Links without a limit are not touched. Taking the last
+// redirect publishes the expiry of the link.
+//
+// Returns:
+//   - error: ErrExhausted if no redirects are left, ErrDeleted if the URL was deleted,
+//     wrapped error with ErrFailedToGetURL for other errors, or nil on success
+func (s *urlSnipperService) ConsumeClick(ctx context.Context, id string) (err error) {
+	ctx, span := startSpan(ctx, "ConsumeClick", attribute.String("snipurl.short_url", id))
+	defer func() { endSpan(span, err) }()
+
+	clicksLeft, err := s.storage.ConsumeClick(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlstorage.ErrExhausted):
+			return ErrExhausted
+		case errors.Is(err, urlstorage.ErrDeleted):
+			return ErrDeleted
+		default:
+			return fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+		}
+	}
+
+	record, err := s.storage.GetURL(ctx, id)
+	if err != nil {
+		s.logger.ErrorfContext(ctx, "failed to dump click limit of %s: %v", id, err)
+		return nil
+	}
+	if record.MaxClicks > 0 {
+		record.ClicksLeft = clicksLeft
+		s.dump(record)
+		if clicksLeft == 0 {
+			s.publishExpired(record, events.ReasonClickLimit)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMaxClicks(t *testing.T) {
+	tests := []struct {
+		name           string
+		record         *urlstorage.URLRecord
+		maxClicks      int
+		wantMaxClicks  int
+		wantClicksLeft int
+	}{
+		{name: "new limit", record: &urlstorage.URLRecord{}, maxClicks: 3, wantMaxClicks: 3, wantClicksLeft: 3},
+		{name: "raise limit keeps used clicks", record: &urlstorage.URLRecord{MaxClicks: 3, ClicksLeft: 1}, maxClicks: 5, wantMaxClicks: 5, wantClicksLeft: 3},
+		{name: "lower limit below used clicks", record: &urlstorage.URLRecord{MaxClicks: 5, ClicksLeft: 1}, maxClicks: 2, wantMaxClicks: 2, wantClicksLeft: 0},
+		{name: "remove limit", record: &urlstorage.URLRecord{MaxClicks: 5, ClicksLeft: 1}, maxClicks: 0, wantMaxClicks: 0, wantClicksLeft: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			WithMaxClicks(tt.maxClicks)(tt.record)
+			require.Equal(t, tt.wantMaxClicks, tt.record.MaxClicks)
+			require.Equal(t, tt.wantClicksLeft, tt.record.ClicksLeft)
+		})
+	}
+
+	require.ErrorIs(t, validateRecord(&urlstorage.URLRecord{MaxClicks: -1}), ErrInvalidMaxClicks)
+}
+
+func TestUrlSnipperService_ConsumeClick(t *testing.T) {
+	tests := []struct {
+		name        string
+		consume     func(ctx context.Context, id string) (int, error)
+		wantErr     error
+		wantDumps   int
+		wantExpired bool
+	}{
+		{
+			name:      "click consumed",
+			consume:   func(ctx context.Context, id string) (int, error) { return 2, nil },
+			wantDumps: 1,
+		},
+		{
+			name:        "last click consumed",
+			consume:     func(ctx context.Context, id string) (int, error) { return 0, nil },
+			wantDumps:   1,
+			wantExpired: true,
+		},
+		{
+			name:    "click limit reached",
+			consume: func(ctx context.Context, id string) (int, error) { return 0, urlstorage.ErrExhausted },
+			wantErr: ErrExhausted,
+		},
+		{
+			name:    "deleted url",
+			consume: func(ctx context.Context, id string) (int, error) { return 0, urlstorage.ErrDeleted },
+			wantErr: ErrDeleted,
+		},
+		{
+			name:    "storage error",
+			consume: func(ctx context.Context, id string) (int, error) { return 0, errors.New("storage error") },
+			wantErr: ErrFailedToGetURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage := &urlStorageMock{
+				ConsumeClickFunc: tt.consume,
+				GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+					return &urlstorage.URLRecord{ShortURL: id, MaxClicks: 3, ClicksLeft: 1}, nil
+				},
+			}
+			var dumped []*dump.URLRecord
+			mockDumper := &dumperMock{
+				AddFunc: func(record *dump.URLRecord) error {
+					dumped = append(dumped, record)
+					return nil
+				},
+			}
+
+			var published []*events.Event
+			mockPublisher := &publisherMock{
+				PublishFunc: func(event *events.Event) {
+					published = append(published, event)
+				},
+			}
+
+			s := &urlSnipperService{
+				storage:   mockStorage,
+				dumper:    mockDumper,
+				publisher: mockPublisher,
+			}
+
+			err := s.ConsumeClick(context.Background(), "abc123")
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Len(t, dumped, tt.wantDumps)
+			if tt.wantDumps > 0 {
+				clicksLeft, _ := tt.consume(context.Background(), "abc123")
+				require.Equal(t, clicksLeft, dumped[0].ClicksLeft)
+			}
+			if tt.wantExpired {
+				require.Len(t, published, 1)
+				require.Equal(t, events.TypeLinkExpired, published[0].Type)
+				require.Equal(t, events.ReasonClickLimit, published[0].Reason)
+			} else {
+				require.Empty(t, published)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package urlsnipper
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+const (
+	maxTitleLength = 200
+	maxNotesLength = 2000
+	maxTags        = 20
+	maxTagLength   = 50
+)
+
+func validateMetadata(record *urlstorage.URLRecord) error {
+	if utf8.RuneCountInString(record.Title) > maxTitleLength ||
+		utf8.RuneCountInString(record.Notes) > maxNotesLength ||
+		len(record.Tags) > maxTags {
+		return ErrInvalidMetadata
+	}
+	for _, tag := range record.Tags {
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return ErrInvalidMetadata
+		}
+	}
+	return nil
+}
+
+// normalizeTags trims and lowercases tags and drops empty and duplicate ones,
+// keeping the original order.
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	output := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		output = append(output, tag)
+	}
+	if len(output) == 0 {
+		return nil
+	}
+	return output
+}
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
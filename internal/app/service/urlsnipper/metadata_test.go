@@ -0,0 +1,75 @@
+package urlsnipper
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{name: "normalized", tags: []string{" Launch ", "q3", "LAUNCH", ""}, want: []string{"launch", "q3"}},
+		{name: "only empty tags", tags: []string{" ", ""}},
+		{name: "no tags"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &urlstorage.URLRecord{Tags: []string{"old"}}
+			WithTags(tt.tags)(record)
+			require.Equal(t, tt.want, record.Tags)
+		})
+	}
+}
+
+func TestValidateMetadata(t *testing.T) {
+	tooManyTags := make([]string, 0, maxTags+1)
+	for i := 0; i <= maxTags; i++ {
+		tooManyTags = append(tooManyTags, strings.Repeat("t", i+1))
+	}
+
+	tests := []struct {
+		name    string
+		record  *urlstorage.URLRecord
+		wantErr error
+	}{
+		{name: "valid", record: &urlstorage.URLRecord{Title: "Spring sale", Notes: "Newsletter link", Tags: []string{"sale"}}},
+		{name: "long title", record: &urlstorage.URLRecord{Title: strings.Repeat("a", maxTitleLength+1)}, wantErr: ErrInvalidMetadata},
+		{name: "long notes", record: &urlstorage.URLRecord{Notes: strings.Repeat("a", maxNotesLength+1)}, wantErr: ErrInvalidMetadata},
+		{name: "long tag", record: &urlstorage.URLRecord{Tags: []string{strings.Repeat("a", maxTagLength+1)}}, wantErr: ErrInvalidMetadata},
+		{name: "too many tags", record: &urlstorage.URLRecord{Tags: tooManyTags}, wantErr: ErrInvalidMetadata},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, validateMetadata(tt.record), tt.wantErr)
+		})
+	}
+}
+
+func TestUrlSnipperService_GetURLsByTag(t *testing.T) {
+	mockStorage := &urlStorageMock{
+		GetURLsFunc: func(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error) {
+			return []*urlstorage.URLRecord{
+				{ShortURL: "abc123", OriginalURL: "https://example.com", Title: "Launch", Tags: []string{"launch"}},
+			}, nil
+		},
+	}
+	s := &urlSnipperService{storage: mockStorage}
+
+	urls, err := s.GetURLs(context.Background(), &URLFilter{Tag: " Launch "})
+	require.NoError(t, err)
+	require.Len(t, urls, 1)
+	require.Equal(t, "Launch", urls[0].Title)
+	require.Equal(t, []string{"launch"}, urls[0].Tags)
+
+	require.Len(t, mockStorage.GetURLsCalls(), 1)
+	require.Equal(t, "launch", mockStorage.GetURLsCalls()[0].Filter.Tag)
+}
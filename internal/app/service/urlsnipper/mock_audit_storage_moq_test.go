@@ -0,0 +1,82 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"context"
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	"sync"
+)
+
+// Ensure, that auditStorageMock does implement auditStorage.
+// If this is not the case, regenerate this file with moq.
+var _ auditStorage = &auditStorageMock{}
+
+// auditStorageMock is a mock implementation of auditStorage.
+//
+//	func TestSomethingThatUsesauditStorage(t *testing.T) {
+//
+//		// make and configure a mocked auditStorage
+//		mockedauditStorage := &auditStorageMock{
+//			GetEntriesFunc: func(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error) {
+//				panic("mock out the GetEntries method")
+//			},
+//		}
+//
+//		// use mockedauditStorage in code that requires auditStorage
+//		// and then make assertions.
+//
+//	}
+type auditStorageMock struct {
+	// GetEntriesFunc mocks the GetEntries method.
+	GetEntriesFunc func(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetEntries holds details about calls to the GetEntries method.
+		GetEntries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter *auditstorage.Filter
+		}
+	}
+	lockGetEntries sync.RWMutex
+}
+
+// GetEntries calls GetEntriesFunc.
+func (mock *auditStorageMock) GetEntries(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error) {
+	if mock.GetEntriesFunc == nil {
+		panic("auditStorageMock.GetEntriesFunc: method is nil but auditStorage.GetEntries was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter *auditstorage.Filter
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockGetEntries.Lock()
+	mock.calls.GetEntries = append(mock.calls.GetEntries, callInfo)
+	mock.lockGetEntries.Unlock()
+	return mock.GetEntriesFunc(ctx, filter)
+}
+
+// GetEntriesCalls gets all the calls that were made to GetEntries.
+// Check the length with:
+//
+//	len(mockedauditStorage.GetEntriesCalls())
+func (mock *auditStorageMock) GetEntriesCalls() []struct {
+	Ctx    context.Context
+	Filter *auditstorage.Filter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter *auditstorage.Filter
+	}
+	mock.lockGetEntries.RLock()
+	calls = mock.calls.GetEntries
+	mock.lockGetEntries.RUnlock()
+	return calls
+}
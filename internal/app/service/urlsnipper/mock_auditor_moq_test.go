@@ -0,0 +1,87 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that auditorMock does implement auditor.
+// If this is not the case, regenerate this file with moq.
+var _ auditor = &auditorMock{}
+
+// auditorMock is a mock implementation of auditor.
+//
+//	func TestSomethingThatUsesauditor(t *testing.T) {
+//
+//		// make and configure a mocked auditor
+//		mockedauditor := &auditorMock{
+//			RecordFunc: func(ctx context.Context, action string, shortURLs ...string)  {
+//				panic("mock out the Record method")
+//			},
+//		}
+//
+//		// use mockedauditor in code that requires auditor
+//		// and then make assertions.
+//
+//	}
+type auditorMock struct {
+	// RecordFunc mocks the Record method.
+	RecordFunc func(ctx context.Context, action string, shortURLs ...string)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Record holds details about calls to the Record method.
+		Record []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Action is the action argument value.
+			Action string
+			// ShortURLs is the shortURLs argument value.
+			ShortURLs []string
+		}
+	}
+	lockRecord sync.RWMutex
+}
+
+// Record calls RecordFunc.
+func (mock *auditorMock) Record(ctx context.Context, action string, shortURLs ...string) {
+	if mock.RecordFunc == nil {
+		panic("auditorMock.RecordFunc: method is nil but auditor.Record was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Action    string
+		ShortURLs []string
+	}{
+		Ctx:       ctx,
+		Action:    action,
+		ShortURLs: shortURLs,
+	}
+	mock.lockRecord.Lock()
+	mock.calls.Record = append(mock.calls.Record, callInfo)
+	mock.lockRecord.Unlock()
+	mock.RecordFunc(ctx, action, shortURLs...)
+}
+
+// RecordCalls gets all the calls that were made to Record.
+// Check the length with:
+//
+//	len(mockedauditor.RecordCalls())
+func (mock *auditorMock) RecordCalls() []struct {
+	Ctx       context.Context
+	Action    string
+	ShortURLs []string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Action    string
+		ShortURLs []string
+	}
+	mock.lockRecord.RLock()
+	calls = mock.calls.Record
+	mock.lockRecord.RUnlock()
+	return calls
+}
@@ -0,0 +1,132 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"context"
+	"github.com/DanilNaum/SnipURL/pkg/fanout"
+	"sync"
+)
+
+// Ensure, that clickHubMock does implement clickHub.
+// If this is not the case, regenerate this file with moq.
+var _ clickHub = &clickHubMock{}
+
+// clickHubMock is a mock implementation of clickHub.
+//
+//	func TestSomethingThatUsesclickHub(t *testing.T) {
+//
+//		// make and configure a mocked clickHub
+//		mockedclickHub := &clickHubMock{
+//			PublishFunc: func(key string, value *ClickEvent)  {
+//				panic("mock out the Publish method")
+//			},
+//			SubscribeFunc: func(ctx context.Context, key string) fanout.Subscription[*ClickEvent] {
+//				panic("mock out the Subscribe method")
+//			},
+//		}
+//
+//		// use mockedclickHub in code that requires clickHub
+//		// and then make assertions.
+//
+//	}
+type clickHubMock struct {
+	// PublishFunc mocks the Publish method.
+	PublishFunc func(key string, value *ClickEvent)
+
+	// SubscribeFunc mocks the Subscribe method.
+	SubscribeFunc func(ctx context.Context, key string) fanout.Subscription[*ClickEvent]
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Publish holds details about calls to the Publish method.
+		Publish []struct {
+			// Key is the key argument value.
+			Key string
+			// Value is the value argument value.
+			Value *ClickEvent
+		}
+		// Subscribe holds details about calls to the Subscribe method.
+		Subscribe []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+		}
+	}
+	lockPublish   sync.RWMutex
+	lockSubscribe sync.RWMutex
+}
+
+// Publish calls PublishFunc.
+func (mock *clickHubMock) Publish(key string, value *ClickEvent) {
+	if mock.PublishFunc == nil {
+		panic("clickHubMock.PublishFunc: method is nil but clickHub.Publish was just called")
+	}
+	callInfo := struct {
+		Key   string
+		Value *ClickEvent
+	}{
+		Key:   key,
+		Value: value,
+	}
+	mock.lockPublish.Lock()
+	mock.calls.Publish = append(mock.calls.Publish, callInfo)
+	mock.lockPublish.Unlock()
+	mock.PublishFunc(key, value)
+}
+
+// PublishCalls gets all the calls that were made to Publish.
+// Check the length with:
+//
+//	len(mockedclickHub.PublishCalls())
+func (mock *clickHubMock) PublishCalls() []struct {
+	Key   string
+	Value *ClickEvent
+} {
+	var calls []struct {
+		Key   string
+		Value *ClickEvent
+	}
+	mock.lockPublish.RLock()
+	calls = mock.calls.Publish
+	mock.lockPublish.RUnlock()
+	return calls
+}
+
+// Subscribe calls SubscribeFunc.
+func (mock *clickHubMock) Subscribe(ctx context.Context, key string) fanout.Subscription[*ClickEvent] {
+	if mock.SubscribeFunc == nil {
+		panic("clickHubMock.SubscribeFunc: method is nil but clickHub.Subscribe was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockSubscribe.Lock()
+	mock.calls.Subscribe = append(mock.calls.Subscribe, callInfo)
+	mock.lockSubscribe.Unlock()
+	return mock.SubscribeFunc(ctx, key)
+}
+
+// SubscribeCalls gets all the calls that were made to Subscribe.
+// Check the length with:
+//
+//	len(mockedclickHub.SubscribeCalls())
+func (mock *clickHubMock) SubscribeCalls() []struct {
+	Ctx context.Context
+	Key string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+	}
+	mock.lockSubscribe.RLock()
+	calls = mock.calls.Subscribe
+	mock.lockSubscribe.RUnlock()
+	return calls
+}
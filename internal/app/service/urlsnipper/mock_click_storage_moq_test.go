@@ -0,0 +1,132 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"context"
+	clickstorage "github.com/DanilNaum/SnipURL/internal/app/repository/click"
+	"sync"
+)
+
+// Ensure, that clickStorageMock does implement clickStorage.
+// If this is not the case, regenerate this file with moq.
+var _ clickStorage = &clickStorageMock{}
+
+// clickStorageMock is a mock implementation of clickStorage.
+//
+//	func TestSomethingThatUsesclickStorage(t *testing.T) {
+//
+//		// make and configure a mocked clickStorage
+//		mockedclickStorage := &clickStorageMock{
+//			AddClickFunc: func(ctx context.Context, click *clickstorage.Click) error {
+//				panic("mock out the AddClick method")
+//			},
+//			GetClickStatsFunc: func(ctx context.Context, shortURL string) (*clickstorage.ClickStats, error) {
+//				panic("mock out the GetClickStats method")
+//			},
+//		}
+//
+//		// use mockedclickStorage in code that requires clickStorage
+//		// and then make assertions.
+//
+//	}
+type clickStorageMock struct {
+	// AddClickFunc mocks the AddClick method.
+	AddClickFunc func(ctx context.Context, click *clickstorage.Click) error
+
+	// GetClickStatsFunc mocks the GetClickStats method.
+	GetClickStatsFunc func(ctx context.Context, shortURL string) (*clickstorage.ClickStats, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddClick holds details about calls to the AddClick method.
+		AddClick []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Click is the click argument value.
+			Click *clickstorage.Click
+		}
+		// GetClickStats holds details about calls to the GetClickStats method.
+		GetClickStats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ShortURL is the shortURL argument value.
+			ShortURL string
+		}
+	}
+	lockAddClick      sync.RWMutex
+	lockGetClickStats sync.RWMutex
+}
+
+// AddClick calls AddClickFunc.
+func (mock *clickStorageMock) AddClick(ctx context.Context, click *clickstorage.Click) error {
+	if mock.AddClickFunc == nil {
+		panic("clickStorageMock.AddClickFunc: method is nil but clickStorage.AddClick was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Click *clickstorage.Click
+	}{
+		Ctx:   ctx,
+		Click: click,
+	}
+	mock.lockAddClick.Lock()
+	mock.calls.AddClick = append(mock.calls.AddClick, callInfo)
+	mock.lockAddClick.Unlock()
+	return mock.AddClickFunc(ctx, click)
+}
+
+// AddClickCalls gets all the calls that were made to AddClick.
+// Check the length with:
+//
+//	len(mockedclickStorage.AddClickCalls())
+func (mock *clickStorageMock) AddClickCalls() []struct {
+	Ctx   context.Context
+	Click *clickstorage.Click
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Click *clickstorage.Click
+	}
+	mock.lockAddClick.RLock()
+	calls = mock.calls.AddClick
+	mock.lockAddClick.RUnlock()
+	return calls
+}
+
+// GetClickStats calls GetClickStatsFunc.
+func (mock *clickStorageMock) GetClickStats(ctx context.Context, shortURL string) (*clickstorage.ClickStats, error) {
+	if mock.GetClickStatsFunc == nil {
+		panic("clickStorageMock.GetClickStatsFunc: method is nil but clickStorage.GetClickStats was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ShortURL string
+	}{
+		Ctx:      ctx,
+		ShortURL: shortURL,
+	}
+	mock.lockGetClickStats.Lock()
+	mock.calls.GetClickStats = append(mock.calls.GetClickStats, callInfo)
+	mock.lockGetClickStats.Unlock()
+	return mock.GetClickStatsFunc(ctx, shortURL)
+}
+
+// GetClickStatsCalls gets all the calls that were made to GetClickStats.
+// Check the length with:
+//
+//	len(mockedclickStorage.GetClickStatsCalls())
+func (mock *clickStorageMock) GetClickStatsCalls() []struct {
+	Ctx      context.Context
+	ShortURL string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ShortURL string
+	}
+	mock.lockGetClickStats.RLock()
+	calls = mock.calls.GetClickStats
+	mock.lockGetClickStats.RUnlock()
+	return calls
+}
@@ -4,6 +4,7 @@
 package urlsnipper
 
 import (
+	"context"
 	"sync"
 )
 
@@ -17,7 +18,7 @@ var _ deleteService = &deleteServiceMock{}
 //
 //		// make and configure a mocked deleteService
 //		mockeddeleteService := &deleteServiceMock{
-//			DeleteFunc: func(userID string, input []string)  {
+//			DeleteFunc: func(ctx context.Context, userID string, input []string)  {
 //				panic("mock out the Delete method")
 //			},
 //		}
@@ -28,12 +29,14 @@ var _ deleteService = &deleteServiceMock{}
 //	}
 type deleteServiceMock struct {
 	// DeleteFunc mocks the Delete method.
-	DeleteFunc func(userID string, input []string)
+	DeleteFunc func(ctx context.Context, userID string, input []string)
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// Delete holds details about calls to the Delete method.
 		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// UserID is the userID argument value.
 			UserID string
 			// Input is the input argument value.
@@ -44,21 +47,23 @@ type deleteServiceMock struct {
 }
 
 // Delete calls DeleteFunc.
-func (mock *deleteServiceMock) Delete(userID string, input []string) {
+func (mock *deleteServiceMock) Delete(ctx context.Context, userID string, input []string) {
 	if mock.DeleteFunc == nil {
 		panic("deleteServiceMock.DeleteFunc: method is nil but deleteService.Delete was just called")
 	}
 	callInfo := struct {
+		Ctx    context.Context
 		UserID string
 		Input  []string
 	}{
+		Ctx:    ctx,
 		UserID: userID,
 		Input:  input,
 	}
 	mock.lockDelete.Lock()
 	mock.calls.Delete = append(mock.calls.Delete, callInfo)
 	mock.lockDelete.Unlock()
-	mock.DeleteFunc(userID, input)
+	mock.DeleteFunc(ctx, userID, input)
 }
 
 // DeleteCalls gets all the calls that were made to Delete.
@@ -66,10 +71,12 @@ func (mock *deleteServiceMock) Delete(userID string, input []string) {
 //
 //	len(mockeddeleteService.DeleteCalls())
 func (mock *deleteServiceMock) DeleteCalls() []struct {
+	Ctx    context.Context
 	UserID string
 	Input  []string
 } {
 	var calls []struct {
+		Ctx    context.Context
 		UserID string
 		Input  []string
 	}
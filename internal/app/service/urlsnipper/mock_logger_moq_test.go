@@ -4,6 +4,7 @@
 package urlsnipper
 
 import (
+	"context"
 	"sync"
 )
 
@@ -20,6 +21,9 @@ var _ logger = &loggerMock{}
 //			ErrorfFunc: func(s string, ifaceVals ...interface{})  {
 //				panic("mock out the Errorf method")
 //			},
+//			ErrorfContextFunc: func(contextMoqParam context.Context, s string, ifaceVals ...interface{})  {
+//				panic("mock out the ErrorfContext method")
+//			},
 //		}
 //
 //		// use mockedlogger in code that requires logger
@@ -30,6 +34,9 @@ type loggerMock struct {
 	// ErrorfFunc mocks the Errorf method.
 	ErrorfFunc func(s string, ifaceVals ...interface{})
 
+	// ErrorfContextFunc mocks the ErrorfContext method.
+	ErrorfContextFunc func(contextMoqParam context.Context, s string, ifaceVals ...interface{})
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Errorf holds details about calls to the Errorf method.
@@ -39,8 +46,18 @@ type loggerMock struct {
 			// IfaceVals is the ifaceVals argument value.
 			IfaceVals []interface{}
 		}
+		// ErrorfContext holds details about calls to the ErrorfContext method.
+		ErrorfContext []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// S is the s argument value.
+			S string
+			// IfaceVals is the ifaceVals argument value.
+			IfaceVals []interface{}
+		}
 	}
-	lockErrorf sync.RWMutex
+	lockErrorf        sync.RWMutex
+	lockErrorfContext sync.RWMutex
 }
 
 // Errorf calls ErrorfFunc.
@@ -78,3 +95,43 @@ func (mock *loggerMock) ErrorfCalls() []struct {
 	mock.lockErrorf.RUnlock()
 	return calls
 }
+
+// ErrorfContext calls ErrorfContextFunc.
+func (mock *loggerMock) ErrorfContext(contextMoqParam context.Context, s string, ifaceVals ...interface{}) {
+	if mock.ErrorfContextFunc == nil {
+		panic("loggerMock.ErrorfContextFunc: method is nil but logger.ErrorfContext was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		S               string
+		IfaceVals       []interface{}
+	}{
+		ContextMoqParam: contextMoqParam,
+		S:               s,
+		IfaceVals:       ifaceVals,
+	}
+	mock.lockErrorfContext.Lock()
+	mock.calls.ErrorfContext = append(mock.calls.ErrorfContext, callInfo)
+	mock.lockErrorfContext.Unlock()
+	mock.ErrorfContextFunc(contextMoqParam, s, ifaceVals...)
+}
+
+// ErrorfContextCalls gets all the calls that were made to ErrorfContext.
+// Check the length with:
+//
+//	len(mockedlogger.ErrorfContextCalls())
+func (mock *loggerMock) ErrorfContextCalls() []struct {
+	ContextMoqParam context.Context
+	S               string
+	IfaceVals       []interface{}
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		S               string
+		IfaceVals       []interface{}
+	}
+	mock.lockErrorfContext.RLock()
+	calls = mock.calls.ErrorfContext
+	mock.lockErrorfContext.RUnlock()
+	return calls
+}
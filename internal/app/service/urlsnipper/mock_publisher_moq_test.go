@@ -0,0 +1,75 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	"sync"
+)
+
+// Ensure, that publisherMock does implement publisher.
+// If this is not the case, regenerate this file with moq.
+var _ publisher = &publisherMock{}
+
+// publisherMock is a mock implementation of publisher.
+//
+//	func TestSomethingThatUsespublisher(t *testing.T) {
+//
+//		// make and configure a mocked publisher
+//		mockedpublisher := &publisherMock{
+//			PublishFunc: func(event *events.Event)  {
+//				panic("mock out the Publish method")
+//			},
+//		}
+//
+//		// use mockedpublisher in code that requires publisher
+//		// and then make assertions.
+//
+//	}
+type publisherMock struct {
+	// PublishFunc mocks the Publish method.
+	PublishFunc func(event *events.Event)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Publish holds details about calls to the Publish method.
+		Publish []struct {
+			// Event is the event argument value.
+			Event *events.Event
+		}
+	}
+	lockPublish sync.RWMutex
+}
+
+// Publish calls PublishFunc.
+func (mock *publisherMock) Publish(event *events.Event) {
+	if mock.PublishFunc == nil {
+		panic("publisherMock.PublishFunc: method is nil but publisher.Publish was just called")
+	}
+	callInfo := struct {
+		Event *events.Event
+	}{
+		Event: event,
+	}
+	mock.lockPublish.Lock()
+	mock.calls.Publish = append(mock.calls.Publish, callInfo)
+	mock.lockPublish.Unlock()
+	mock.PublishFunc(event)
+}
+
+// PublishCalls gets all the calls that were made to Publish.
+// Check the length with:
+//
+//	len(mockedpublisher.PublishCalls())
+func (mock *publisherMock) PublishCalls() []struct {
+	Event *events.Event
+} {
+	var calls []struct {
+		Event *events.Event
+	}
+	mock.lockPublish.RLock()
+	calls = mock.calls.Publish
+	mock.lockPublish.RUnlock()
+	return calls
+}
@@ -0,0 +1,74 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"sync"
+)
+
+// Ensure, that redirectMetricsMock does implement redirectMetrics.
+// If this is not the case, regenerate this file with moq.
+var _ redirectMetrics = &redirectMetricsMock{}
+
+// redirectMetricsMock is a mock implementation of redirectMetrics.
+//
+//	func TestSomethingThatUsesredirectMetrics(t *testing.T) {
+//
+//		// make and configure a mocked redirectMetrics
+//		mockedredirectMetrics := &redirectMetricsMock{
+//			ObserveRedirectFunc: func(result string)  {
+//				panic("mock out the ObserveRedirect method")
+//			},
+//		}
+//
+//		// use mockedredirectMetrics in code that requires redirectMetrics
+//		// and then make assertions.
+//
+//	}
+type redirectMetricsMock struct {
+	// ObserveRedirectFunc mocks the ObserveRedirect method.
+	ObserveRedirectFunc func(result string)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ObserveRedirect holds details about calls to the ObserveRedirect method.
+		ObserveRedirect []struct {
+			// Result is the result argument value.
+			Result string
+		}
+	}
+	lockObserveRedirect sync.RWMutex
+}
+
+// ObserveRedirect calls ObserveRedirectFunc.
+func (mock *redirectMetricsMock) ObserveRedirect(result string) {
+	if mock.ObserveRedirectFunc == nil {
+		panic("redirectMetricsMock.ObserveRedirectFunc: method is nil but redirectMetrics.ObserveRedirect was just called")
+	}
+	callInfo := struct {
+		Result string
+	}{
+		Result: result,
+	}
+	mock.lockObserveRedirect.Lock()
+	mock.calls.ObserveRedirect = append(mock.calls.ObserveRedirect, callInfo)
+	mock.lockObserveRedirect.Unlock()
+	mock.ObserveRedirectFunc(result)
+}
+
+// ObserveRedirectCalls gets all the calls that were made to ObserveRedirect.
+// Check the length with:
+//
+//	len(mockedredirectMetrics.ObserveRedirectCalls())
+func (mock *redirectMetricsMock) ObserveRedirectCalls() []struct {
+	Result string
+} {
+	var calls []struct {
+		Result string
+	}
+	mock.lockObserveRedirect.RLock()
+	calls = mock.calls.ObserveRedirect
+	mock.lockObserveRedirect.RUnlock()
+	return calls
+}
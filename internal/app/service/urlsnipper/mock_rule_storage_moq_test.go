@@ -0,0 +1,238 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"context"
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	"sync"
+)
+
+// Ensure, that ruleStorageMock does implement ruleStorage.
+// If this is not the case, regenerate this file with moq.
+var _ ruleStorage = &ruleStorageMock{}
+
+// ruleStorageMock is a mock implementation of ruleStorage.
+//
+//	func TestSomethingThatUsesruleStorage(t *testing.T) {
+//
+//		// make and configure a mocked ruleStorage
+//		mockedruleStorage := &ruleStorageMock{
+//			DeleteRuleFunc: func(ctx context.Context, shortURL string, id int) error {
+//				panic("mock out the DeleteRule method")
+//			},
+//			GetRulesFunc: func(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+//				panic("mock out the GetRules method")
+//			},
+//			SetRuleFunc: func(ctx context.Context, rule *rulestorage.Rule) (int, error) {
+//				panic("mock out the SetRule method")
+//			},
+//			UpdateRuleFunc: func(ctx context.Context, rule *rulestorage.Rule) error {
+//				panic("mock out the UpdateRule method")
+//			},
+//		}
+//
+//		// use mockedruleStorage in code that requires ruleStorage
+//		// and then make assertions.
+//
+//	}
+type ruleStorageMock struct {
+	// DeleteRuleFunc mocks the DeleteRule method.
+	DeleteRuleFunc func(ctx context.Context, shortURL string, id int) error
+
+	// GetRulesFunc mocks the GetRules method.
+	GetRulesFunc func(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error)
+
+	// SetRuleFunc mocks the SetRule method.
+	SetRuleFunc func(ctx context.Context, rule *rulestorage.Rule) (int, error)
+
+	// UpdateRuleFunc mocks the UpdateRule method.
+	UpdateRuleFunc func(ctx context.Context, rule *rulestorage.Rule) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteRule holds details about calls to the DeleteRule method.
+		DeleteRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ShortURL is the shortURL argument value.
+			ShortURL string
+			// ID is the id argument value.
+			ID int
+		}
+		// GetRules holds details about calls to the GetRules method.
+		GetRules []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ShortURL is the shortURL argument value.
+			ShortURL string
+		}
+		// SetRule holds details about calls to the SetRule method.
+		SetRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rule is the rule argument value.
+			Rule *rulestorage.Rule
+		}
+		// UpdateRule holds details about calls to the UpdateRule method.
+		UpdateRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rule is the rule argument value.
+			Rule *rulestorage.Rule
+		}
+	}
+	lockDeleteRule sync.RWMutex
+	lockGetRules   sync.RWMutex
+	lockSetRule    sync.RWMutex
+	lockUpdateRule sync.RWMutex
+}
+
+// DeleteRule calls DeleteRuleFunc.
+func (mock *ruleStorageMock) DeleteRule(ctx context.Context, shortURL string, id int) error {
+	if mock.DeleteRuleFunc == nil {
+		panic("ruleStorageMock.DeleteRuleFunc: method is nil but ruleStorage.DeleteRule was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ShortURL string
+		ID       int
+	}{
+		Ctx:      ctx,
+		ShortURL: shortURL,
+		ID:       id,
+	}
+	mock.lockDeleteRule.Lock()
+	mock.calls.DeleteRule = append(mock.calls.DeleteRule, callInfo)
+	mock.lockDeleteRule.Unlock()
+	return mock.DeleteRuleFunc(ctx, shortURL, id)
+}
+
+// DeleteRuleCalls gets all the calls that were made to DeleteRule.
+// Check the length with:
+//
+//	len(mockedruleStorage.DeleteRuleCalls())
+func (mock *ruleStorageMock) DeleteRuleCalls() []struct {
+	Ctx      context.Context
+	ShortURL string
+	ID       int
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ShortURL string
+		ID       int
+	}
+	mock.lockDeleteRule.RLock()
+	calls = mock.calls.DeleteRule
+	mock.lockDeleteRule.RUnlock()
+	return calls
+}
+
+// GetRules calls GetRulesFunc.
+func (mock *ruleStorageMock) GetRules(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+	if mock.GetRulesFunc == nil {
+		panic("ruleStorageMock.GetRulesFunc: method is nil but ruleStorage.GetRules was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ShortURL string
+	}{
+		Ctx:      ctx,
+		ShortURL: shortURL,
+	}
+	mock.lockGetRules.Lock()
+	mock.calls.GetRules = append(mock.calls.GetRules, callInfo)
+	mock.lockGetRules.Unlock()
+	return mock.GetRulesFunc(ctx, shortURL)
+}
+
+// GetRulesCalls gets all the calls that were made to GetRules.
+// Check the length with:
+//
+//	len(mockedruleStorage.GetRulesCalls())
+func (mock *ruleStorageMock) GetRulesCalls() []struct {
+	Ctx      context.Context
+	ShortURL string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ShortURL string
+	}
+	mock.lockGetRules.RLock()
+	calls = mock.calls.GetRules
+	mock.lockGetRules.RUnlock()
+	return calls
+}
+
+// SetRule calls SetRuleFunc.
+func (mock *ruleStorageMock) SetRule(ctx context.Context, rule *rulestorage.Rule) (int, error) {
+	if mock.SetRuleFunc == nil {
+		panic("ruleStorageMock.SetRuleFunc: method is nil but ruleStorage.SetRule was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Rule *rulestorage.Rule
+	}{
+		Ctx:  ctx,
+		Rule: rule,
+	}
+	mock.lockSetRule.Lock()
+	mock.calls.SetRule = append(mock.calls.SetRule, callInfo)
+	mock.lockSetRule.Unlock()
+	return mock.SetRuleFunc(ctx, rule)
+}
+
+// SetRuleCalls gets all the calls that were made to SetRule.
+// Check the length with:
+//
+//	len(mockedruleStorage.SetRuleCalls())
+func (mock *ruleStorageMock) SetRuleCalls() []struct {
+	Ctx  context.Context
+	Rule *rulestorage.Rule
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Rule *rulestorage.Rule
+	}
+	mock.lockSetRule.RLock()
+	calls = mock.calls.SetRule
+	mock.lockSetRule.RUnlock()
+	return calls
+}
+
+// UpdateRule calls UpdateRuleFunc.
+func (mock *ruleStorageMock) UpdateRule(ctx context.Context, rule *rulestorage.Rule) error {
+	if mock.UpdateRuleFunc == nil {
+		panic("ruleStorageMock.UpdateRuleFunc: method is nil but ruleStorage.UpdateRule was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Rule *rulestorage.Rule
+	}{
+		Ctx:  ctx,
+		Rule: rule,
+	}
+	mock.lockUpdateRule.Lock()
+	mock.calls.UpdateRule = append(mock.calls.UpdateRule, callInfo)
+	mock.lockUpdateRule.Unlock()
+	return mock.UpdateRuleFunc(ctx, rule)
+}
+
+// UpdateRuleCalls gets all the calls that were made to UpdateRule.
+// Check the length with:
+//
+//	len(mockedruleStorage.UpdateRuleCalls())
+func (mock *ruleStorageMock) UpdateRuleCalls() []struct {
+	Ctx  context.Context
+	Rule *rulestorage.Rule
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Rule *rulestorage.Rule
+	}
+	mock.lockUpdateRule.RLock()
+	calls = mock.calls.UpdateRule
+	mock.lockUpdateRule.RUnlock()
+	return calls
+}
@@ -0,0 +1,138 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"context"
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	"sync"
+)
+
+// Ensure, that templateStorageMock does implement templateStorage.
+// If this is not the case, regenerate this file with moq.
+var _ templateStorage = &templateStorageMock{}
+
+// templateStorageMock is a mock implementation of templateStorage.
+//
+//	func TestSomethingThatUsestemplateStorage(t *testing.T) {
+//
+//		// make and configure a mocked templateStorage
+//		mockedtemplateStorage := &templateStorageMock{
+//			GetTemplateFunc: func(ctx context.Context, userID string, name string) (*templatestorage.Template, error) {
+//				panic("mock out the GetTemplate method")
+//			},
+//			GetTemplatesFunc: func(ctx context.Context, userID string) ([]*templatestorage.Template, error) {
+//				panic("mock out the GetTemplates method")
+//			},
+//		}
+//
+//		// use mockedtemplateStorage in code that requires templateStorage
+//		// and then make assertions.
+//
+//	}
+type templateStorageMock struct {
+	// GetTemplateFunc mocks the GetTemplate method.
+	GetTemplateFunc func(ctx context.Context, userID string, name string) (*templatestorage.Template, error)
+
+	// GetTemplatesFunc mocks the GetTemplates method.
+	GetTemplatesFunc func(ctx context.Context, userID string) ([]*templatestorage.Template, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetTemplate holds details about calls to the GetTemplate method.
+		GetTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// Name is the name argument value.
+			Name string
+		}
+		// GetTemplates holds details about calls to the GetTemplates method.
+		GetTemplates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+	}
+	lockGetTemplate  sync.RWMutex
+	lockGetTemplates sync.RWMutex
+}
+
+// GetTemplate calls GetTemplateFunc.
+func (mock *templateStorageMock) GetTemplate(ctx context.Context, userID string, name string) (*templatestorage.Template, error) {
+	if mock.GetTemplateFunc == nil {
+		panic("templateStorageMock.GetTemplateFunc: method is nil but templateStorage.GetTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+		Name   string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		Name:   name,
+	}
+	mock.lockGetTemplate.Lock()
+	mock.calls.GetTemplate = append(mock.calls.GetTemplate, callInfo)
+	mock.lockGetTemplate.Unlock()
+	return mock.GetTemplateFunc(ctx, userID, name)
+}
+
+// GetTemplateCalls gets all the calls that were made to GetTemplate.
+// Check the length with:
+//
+//	len(mockedtemplateStorage.GetTemplateCalls())
+func (mock *templateStorageMock) GetTemplateCalls() []struct {
+	Ctx    context.Context
+	UserID string
+	Name   string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+		Name   string
+	}
+	mock.lockGetTemplate.RLock()
+	calls = mock.calls.GetTemplate
+	mock.lockGetTemplate.RUnlock()
+	return calls
+}
+
+// GetTemplates calls GetTemplatesFunc.
+func (mock *templateStorageMock) GetTemplates(ctx context.Context, userID string) ([]*templatestorage.Template, error) {
+	if mock.GetTemplatesFunc == nil {
+		panic("templateStorageMock.GetTemplatesFunc: method is nil but templateStorage.GetTemplates was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetTemplates.Lock()
+	mock.calls.GetTemplates = append(mock.calls.GetTemplates, callInfo)
+	mock.lockGetTemplates.Unlock()
+	return mock.GetTemplatesFunc(ctx, userID)
+}
+
+// GetTemplatesCalls gets all the calls that were made to GetTemplates.
+// Check the length with:
+//
+//	len(mockedtemplateStorage.GetTemplatesCalls())
+func (mock *templateStorageMock) GetTemplatesCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockGetTemplates.RLock()
+	calls = mock.calls.GetTemplates
+	mock.lockGetTemplates.RUnlock()
+	return calls
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
 	"sync"
+	"time"
 )
 
 // Ensure, that urlStorageMock does implement urlStorage.
@@ -19,18 +20,33 @@ var _ urlStorage = &urlStorageMock{}
 //
 //		// make and configure a mocked urlStorage
 //		mockedurlStorage := &urlStorageMock{
-//			GetURLFunc: func(ctx context.Context, id string) (string, error) {
+//			ConsumeClickFunc: func(ctx context.Context, id string) (int, error) {
+//				panic("mock out the ConsumeClick method")
+//			},
+//			EndWindowsFunc: func(ctx context.Context, now time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+//				panic("mock out the EndWindows method")
+//			},
+//			GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
 //				panic("mock out the GetURL method")
 //			},
-//			GetURLsFunc: func(ctx context.Context) ([]*urlstorage.URLRecord, error) {
+//			GetURLsFunc: func(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error) {
 //				panic("mock out the GetURLs method")
 //			},
-//			SetURLFunc: func(ctx context.Context, id string, url string) (int, error) {
+//			IncrementClicksFunc: func(ctx context.Context, id string) (int, error) {
+//				panic("mock out the IncrementClicks method")
+//			},
+//			IterateURLsFunc: func(ctx context.Context) (urlstorage.URLIterator, error) {
+//				panic("mock out the IterateURLs method")
+//			},
+//			SetURLFunc: func(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 //				panic("mock out the SetURL method")
 //			},
 //			SetURLsFunc: func(ctx context.Context, urls []*urlstorage.URLRecord) ([]*urlstorage.URLRecord, error) {
 //				panic("mock out the SetURLs method")
 //			},
+//			UpdateURLFunc: func(ctx context.Context, record *urlstorage.URLRecord) error {
+//				panic("mock out the UpdateURL method")
+//			},
 //		}
 //
 //		// use mockedurlStorage in code that requires urlStorage
@@ -38,20 +54,51 @@ var _ urlStorage = &urlStorageMock{}
 //
 //	}
 type urlStorageMock struct {
+	// ConsumeClickFunc mocks the ConsumeClick method.
+	ConsumeClickFunc func(ctx context.Context, id string) (int, error)
+
+	// EndWindowsFunc mocks the EndWindows method.
+	EndWindowsFunc func(ctx context.Context, now time.Time, limit int) ([]*urlstorage.URLRecord, error)
+
 	// GetURLFunc mocks the GetURL method.
-	GetURLFunc func(ctx context.Context, id string) (string, error)
+	GetURLFunc func(ctx context.Context, id string) (*urlstorage.URLRecord, error)
 
 	// GetURLsFunc mocks the GetURLs method.
-	GetURLsFunc func(ctx context.Context) ([]*urlstorage.URLRecord, error)
+	GetURLsFunc func(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error)
+
+	// IncrementClicksFunc mocks the IncrementClicks method.
+	IncrementClicksFunc func(ctx context.Context, id string) (int, error)
+
+	// IterateURLsFunc mocks the IterateURLs method.
+	IterateURLsFunc func(ctx context.Context) (urlstorage.URLIterator, error)
 
 	// SetURLFunc mocks the SetURL method.
-	SetURLFunc func(ctx context.Context, id string, url string) (int, error)
+	SetURLFunc func(ctx context.Context, record *urlstorage.URLRecord) (int, error)
 
 	// SetURLsFunc mocks the SetURLs method.
 	SetURLsFunc func(ctx context.Context, urls []*urlstorage.URLRecord) ([]*urlstorage.URLRecord, error)
 
+	// UpdateURLFunc mocks the UpdateURL method.
+	UpdateURLFunc func(ctx context.Context, record *urlstorage.URLRecord) error
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// ConsumeClick holds details about calls to the ConsumeClick method.
+		ConsumeClick []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// EndWindows holds details about calls to the EndWindows method.
+		EndWindows []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
 		// GetURL holds details about calls to the GetURL method.
 		GetURL []struct {
 			// Ctx is the ctx argument value.
@@ -63,15 +110,27 @@ type urlStorageMock struct {
 		GetURLs []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter *urlstorage.URLFilter
 		}
-		// SetURL holds details about calls to the SetURL method.
-		SetURL []struct {
+		// IncrementClicks holds details about calls to the IncrementClicks method.
+		IncrementClicks []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// ID is the id argument value.
 			ID string
-			// URL is the url argument value.
-			URL string
+		}
+		// IterateURLs holds details about calls to the IterateURLs method.
+		IterateURLs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// SetURL holds details about calls to the SetURL method.
+		SetURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Record is the record argument value.
+			Record *urlstorage.URLRecord
 		}
 		// SetURLs holds details about calls to the SetURLs method.
 		SetURLs []struct {
@@ -80,15 +139,103 @@ type urlStorageMock struct {
 			// Urls is the urls argument value.
 			Urls []*urlstorage.URLRecord
 		}
+		// UpdateURL holds details about calls to the UpdateURL method.
+		UpdateURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Record is the record argument value.
+			Record *urlstorage.URLRecord
+		}
+	}
+	lockConsumeClick    sync.RWMutex
+	lockEndWindows      sync.RWMutex
+	lockGetURL          sync.RWMutex
+	lockGetURLs         sync.RWMutex
+	lockIncrementClicks sync.RWMutex
+	lockIterateURLs     sync.RWMutex
+	lockSetURL          sync.RWMutex
+	lockSetURLs         sync.RWMutex
+	lockUpdateURL       sync.RWMutex
+}
+
+// ConsumeClick calls ConsumeClickFunc.
+func (mock *urlStorageMock) ConsumeClick(ctx context.Context, id string) (int, error) {
+	if mock.ConsumeClickFunc == nil {
+		panic("urlStorageMock.ConsumeClickFunc: method is nil but urlStorage.ConsumeClick was just called")
 	}
-	lockGetURL  sync.RWMutex
-	lockGetURLs sync.RWMutex
-	lockSetURL  sync.RWMutex
-	lockSetURLs sync.RWMutex
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockConsumeClick.Lock()
+	mock.calls.ConsumeClick = append(mock.calls.ConsumeClick, callInfo)
+	mock.lockConsumeClick.Unlock()
+	return mock.ConsumeClickFunc(ctx, id)
+}
+
+// ConsumeClickCalls gets all the calls that were made to ConsumeClick.
+// Check the length with:
+//
+//	len(mockedurlStorage.ConsumeClickCalls())
+func (mock *urlStorageMock) ConsumeClickCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockConsumeClick.RLock()
+	calls = mock.calls.ConsumeClick
+	mock.lockConsumeClick.RUnlock()
+	return calls
+}
+
+// EndWindows calls EndWindowsFunc.
+func (mock *urlStorageMock) EndWindows(ctx context.Context, now time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+	if mock.EndWindowsFunc == nil {
+		panic("urlStorageMock.EndWindowsFunc: method is nil but urlStorage.EndWindows was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int
+	}{
+		Ctx:   ctx,
+		Now:   now,
+		Limit: limit,
+	}
+	mock.lockEndWindows.Lock()
+	mock.calls.EndWindows = append(mock.calls.EndWindows, callInfo)
+	mock.lockEndWindows.Unlock()
+	return mock.EndWindowsFunc(ctx, now, limit)
+}
+
+// EndWindowsCalls gets all the calls that were made to EndWindows.
+// Check the length with:
+//
+//	len(mockedurlStorage.EndWindowsCalls())
+func (mock *urlStorageMock) EndWindowsCalls() []struct {
+	Ctx   context.Context
+	Now   time.Time
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int
+	}
+	mock.lockEndWindows.RLock()
+	calls = mock.calls.EndWindows
+	mock.lockEndWindows.RUnlock()
+	return calls
 }
 
 // GetURL calls GetURLFunc.
-func (mock *urlStorageMock) GetURL(ctx context.Context, id string) (string, error) {
+func (mock *urlStorageMock) GetURL(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
 	if mock.GetURLFunc == nil {
 		panic("urlStorageMock.GetURLFunc: method is nil but urlStorage.GetURL was just called")
 	}
@@ -124,19 +271,21 @@ func (mock *urlStorageMock) GetURLCalls() []struct {
 }
 
 // GetURLs calls GetURLsFunc.
-func (mock *urlStorageMock) GetURLs(ctx context.Context) ([]*urlstorage.URLRecord, error) {
+func (mock *urlStorageMock) GetURLs(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error) {
 	if mock.GetURLsFunc == nil {
 		panic("urlStorageMock.GetURLsFunc: method is nil but urlStorage.GetURLs was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
+		Ctx    context.Context
+		Filter *urlstorage.URLFilter
 	}{
-		Ctx: ctx,
+		Ctx:    ctx,
+		Filter: filter,
 	}
 	mock.lockGetURLs.Lock()
 	mock.calls.GetURLs = append(mock.calls.GetURLs, callInfo)
 	mock.lockGetURLs.Unlock()
-	return mock.GetURLsFunc(ctx)
+	return mock.GetURLsFunc(ctx, filter)
 }
 
 // GetURLsCalls gets all the calls that were made to GetURLs.
@@ -144,10 +293,12 @@ func (mock *urlStorageMock) GetURLs(ctx context.Context) ([]*urlstorage.URLRecor
 //
 //	len(mockedurlStorage.GetURLsCalls())
 func (mock *urlStorageMock) GetURLsCalls() []struct {
-	Ctx context.Context
+	Ctx    context.Context
+	Filter *urlstorage.URLFilter
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx    context.Context
+		Filter *urlstorage.URLFilter
 	}
 	mock.lockGetURLs.RLock()
 	calls = mock.calls.GetURLs
@@ -155,24 +306,90 @@ func (mock *urlStorageMock) GetURLsCalls() []struct {
 	return calls
 }
 
-// SetURL calls SetURLFunc.
-func (mock *urlStorageMock) SetURL(ctx context.Context, id string, url string) (int, error) {
-	if mock.SetURLFunc == nil {
-		panic("urlStorageMock.SetURLFunc: method is nil but urlStorage.SetURL was just called")
+// IncrementClicks calls IncrementClicksFunc.
+func (mock *urlStorageMock) IncrementClicks(ctx context.Context, id string) (int, error) {
+	if mock.IncrementClicksFunc == nil {
+		panic("urlStorageMock.IncrementClicksFunc: method is nil but urlStorage.IncrementClicks was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
 		ID  string
-		URL string
 	}{
 		Ctx: ctx,
 		ID:  id,
-		URL: url,
+	}
+	mock.lockIncrementClicks.Lock()
+	mock.calls.IncrementClicks = append(mock.calls.IncrementClicks, callInfo)
+	mock.lockIncrementClicks.Unlock()
+	return mock.IncrementClicksFunc(ctx, id)
+}
+
+// IncrementClicksCalls gets all the calls that were made to IncrementClicks.
+// Check the length with:
+//
+//	len(mockedurlStorage.IncrementClicksCalls())
+func (mock *urlStorageMock) IncrementClicksCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockIncrementClicks.RLock()
+	calls = mock.calls.IncrementClicks
+	mock.lockIncrementClicks.RUnlock()
+	return calls
+}
+
+// IterateURLs calls IterateURLsFunc.
+func (mock *urlStorageMock) IterateURLs(ctx context.Context) (urlstorage.URLIterator, error) {
+	if mock.IterateURLsFunc == nil {
+		panic("urlStorageMock.IterateURLsFunc: method is nil but urlStorage.IterateURLs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockIterateURLs.Lock()
+	mock.calls.IterateURLs = append(mock.calls.IterateURLs, callInfo)
+	mock.lockIterateURLs.Unlock()
+	return mock.IterateURLsFunc(ctx)
+}
+
+// IterateURLsCalls gets all the calls that were made to IterateURLs.
+// Check the length with:
+//
+//	len(mockedurlStorage.IterateURLsCalls())
+func (mock *urlStorageMock) IterateURLsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockIterateURLs.RLock()
+	calls = mock.calls.IterateURLs
+	mock.lockIterateURLs.RUnlock()
+	return calls
+}
+
+// SetURL calls SetURLFunc.
+func (mock *urlStorageMock) SetURL(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
+	if mock.SetURLFunc == nil {
+		panic("urlStorageMock.SetURLFunc: method is nil but urlStorage.SetURL was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Record *urlstorage.URLRecord
+	}{
+		Ctx:    ctx,
+		Record: record,
 	}
 	mock.lockSetURL.Lock()
 	mock.calls.SetURL = append(mock.calls.SetURL, callInfo)
 	mock.lockSetURL.Unlock()
-	return mock.SetURLFunc(ctx, id, url)
+	return mock.SetURLFunc(ctx, record)
 }
 
 // SetURLCalls gets all the calls that were made to SetURL.
@@ -180,14 +397,12 @@ func (mock *urlStorageMock) SetURL(ctx context.Context, id string, url string) (
 //
 //	len(mockedurlStorage.SetURLCalls())
 func (mock *urlStorageMock) SetURLCalls() []struct {
-	Ctx context.Context
-	ID  string
-	URL string
+	Ctx    context.Context
+	Record *urlstorage.URLRecord
 } {
 	var calls []struct {
-		Ctx context.Context
-		ID  string
-		URL string
+		Ctx    context.Context
+		Record *urlstorage.URLRecord
 	}
 	mock.lockSetURL.RLock()
 	calls = mock.calls.SetURL
@@ -230,3 +445,39 @@ func (mock *urlStorageMock) SetURLsCalls() []struct {
 	mock.lockSetURLs.RUnlock()
 	return calls
 }
+
+// UpdateURL calls UpdateURLFunc.
+func (mock *urlStorageMock) UpdateURL(ctx context.Context, record *urlstorage.URLRecord) error {
+	if mock.UpdateURLFunc == nil {
+		panic("urlStorageMock.UpdateURLFunc: method is nil but urlStorage.UpdateURL was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Record *urlstorage.URLRecord
+	}{
+		Ctx:    ctx,
+		Record: record,
+	}
+	mock.lockUpdateURL.Lock()
+	mock.calls.UpdateURL = append(mock.calls.UpdateURL, callInfo)
+	mock.lockUpdateURL.Unlock()
+	return mock.UpdateURLFunc(ctx, record)
+}
+
+// UpdateURLCalls gets all the calls that were made to UpdateURL.
+// Check the length with:
+//
+//	len(mockedurlStorage.UpdateURLCalls())
+func (mock *urlStorageMock) UpdateURLCalls() []struct {
+	Ctx    context.Context
+	Record *urlstorage.URLRecord
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Record *urlstorage.URLRecord
+	}
+	mock.lockUpdateURL.RLock()
+	calls = mock.calls.UpdateURL
+	mock.lockUpdateURL.RUnlock()
+	return calls
+}
@@ -0,0 +1,137 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that variantStorageMock does implement variantStorage.
+// If this is not the case, regenerate this file with moq.
+var _ variantStorage = &variantStorageMock{}
+
+// variantStorageMock is a mock implementation of variantStorage.
+//
+//	func TestSomethingThatUsesvariantStorage(t *testing.T) {
+//
+//		// make and configure a mocked variantStorage
+//		mockedvariantStorage := &variantStorageMock{
+//			GetServedFunc: func(ctx context.Context, shortURL string) (map[string]int, error) {
+//				panic("mock out the GetServed method")
+//			},
+//			IncrementServedFunc: func(ctx context.Context, shortURL string, variant string) error {
+//				panic("mock out the IncrementServed method")
+//			},
+//		}
+//
+//		// use mockedvariantStorage in code that requires variantStorage
+//		// and then make assertions.
+//
+//	}
+type variantStorageMock struct {
+	// GetServedFunc mocks the GetServed method.
+	GetServedFunc func(ctx context.Context, shortURL string) (map[string]int, error)
+
+	// IncrementServedFunc mocks the IncrementServed method.
+	IncrementServedFunc func(ctx context.Context, shortURL string, variant string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetServed holds details about calls to the GetServed method.
+		GetServed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ShortURL is the shortURL argument value.
+			ShortURL string
+		}
+		// IncrementServed holds details about calls to the IncrementServed method.
+		IncrementServed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ShortURL is the shortURL argument value.
+			ShortURL string
+			// Variant is the variant argument value.
+			Variant string
+		}
+	}
+	lockGetServed       sync.RWMutex
+	lockIncrementServed sync.RWMutex
+}
+
+// GetServed calls GetServedFunc.
+func (mock *variantStorageMock) GetServed(ctx context.Context, shortURL string) (map[string]int, error) {
+	if mock.GetServedFunc == nil {
+		panic("variantStorageMock.GetServedFunc: method is nil but variantStorage.GetServed was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ShortURL string
+	}{
+		Ctx:      ctx,
+		ShortURL: shortURL,
+	}
+	mock.lockGetServed.Lock()
+	mock.calls.GetServed = append(mock.calls.GetServed, callInfo)
+	mock.lockGetServed.Unlock()
+	return mock.GetServedFunc(ctx, shortURL)
+}
+
+// GetServedCalls gets all the calls that were made to GetServed.
+// Check the length with:
+//
+//	len(mockedvariantStorage.GetServedCalls())
+func (mock *variantStorageMock) GetServedCalls() []struct {
+	Ctx      context.Context
+	ShortURL string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ShortURL string
+	}
+	mock.lockGetServed.RLock()
+	calls = mock.calls.GetServed
+	mock.lockGetServed.RUnlock()
+	return calls
+}
+
+// IncrementServed calls IncrementServedFunc.
+func (mock *variantStorageMock) IncrementServed(ctx context.Context, shortURL string, variant string) error {
+	if mock.IncrementServedFunc == nil {
+		panic("variantStorageMock.IncrementServedFunc: method is nil but variantStorage.IncrementServed was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ShortURL string
+		Variant  string
+	}{
+		Ctx:      ctx,
+		ShortURL: shortURL,
+		Variant:  variant,
+	}
+	mock.lockIncrementServed.Lock()
+	mock.calls.IncrementServed = append(mock.calls.IncrementServed, callInfo)
+	mock.lockIncrementServed.Unlock()
+	return mock.IncrementServedFunc(ctx, shortURL, variant)
+}
+
+// IncrementServedCalls gets all the calls that were made to IncrementServed.
+// Check the length with:
+//
+//	len(mockedvariantStorage.IncrementServedCalls())
+func (mock *variantStorageMock) IncrementServedCalls() []struct {
+	Ctx      context.Context
+	ShortURL string
+	Variant  string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ShortURL string
+		Variant  string
+	}
+	mock.lockIncrementServed.RLock()
+	calls = mock.calls.IncrementServed
+	mock.lockIncrementServed.RUnlock()
+	return calls
+}
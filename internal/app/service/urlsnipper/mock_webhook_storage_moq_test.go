@@ -0,0 +1,82 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package urlsnipper
+
+import (
+	"context"
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	"sync"
+)
+
+// Ensure, that webhookStorageMock does implement webhookStorage.
+// If this is not the case, regenerate this file with moq.
+var _ webhookStorage = &webhookStorageMock{}
+
+// webhookStorageMock is a mock implementation of webhookStorage.
+//
+//	func TestSomethingThatUseswebhookStorage(t *testing.T) {
+//
+//		// make and configure a mocked webhookStorage
+//		mockedwebhookStorage := &webhookStorageMock{
+//			GetWebhooksFunc: func(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+//				panic("mock out the GetWebhooks method")
+//			},
+//		}
+//
+//		// use mockedwebhookStorage in code that requires webhookStorage
+//		// and then make assertions.
+//
+//	}
+type webhookStorageMock struct {
+	// GetWebhooksFunc mocks the GetWebhooks method.
+	GetWebhooksFunc func(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetWebhooks holds details about calls to the GetWebhooks method.
+		GetWebhooks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+	}
+	lockGetWebhooks sync.RWMutex
+}
+
+// GetWebhooks calls GetWebhooksFunc.
+func (mock *webhookStorageMock) GetWebhooks(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+	if mock.GetWebhooksFunc == nil {
+		panic("webhookStorageMock.GetWebhooksFunc: method is nil but webhookStorage.GetWebhooks was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetWebhooks.Lock()
+	mock.calls.GetWebhooks = append(mock.calls.GetWebhooks, callInfo)
+	mock.lockGetWebhooks.Unlock()
+	return mock.GetWebhooksFunc(ctx, userID)
+}
+
+// GetWebhooksCalls gets all the calls that were made to GetWebhooks.
+// Check the length with:
+//
+//	len(mockedwebhookStorage.GetWebhooksCalls())
+func (mock *webhookStorageMock) GetWebhooksCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockGetWebhooks.RLock()
+	calls = mock.calls.GetWebhooks
+	mock.lockGetWebhooks.RUnlock()
+	return calls
+}
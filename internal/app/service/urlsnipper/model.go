@@ -1,19 +1,277 @@
 package urlsnipper
 
+import (
+	"net/url"
+	"time"
+)
+
 // SetURLsInput represents the input parameters for setting URLs in the URL snipper service.
 type SetURLsInput struct {
 	CorrelationID string
 	OriginalURL   string
+	// Alias is a custom short URL ID used instead of the generated one.
+	Alias        string
+	RedirectType int
+	Passthrough  bool
+	QueryMode    string
+	Template     string
+	Variants     []*Variant
+	Password     string
+	MaxClicks    int
+	NotBefore    *time.Time
+	NotAfter     *time.Time
+	FallbackURL  string
+	Title        string
+	Notes        string
+	Tags         []string
 }
 
 // SetURLsOutput represents the output returned after setting a URL in the URL snipper service.
+// Existing reports that the original URL already had the generated ShortURLID,
+// so nothing was inserted for it.
 type SetURLsOutput struct {
 	CorrelationID string
 	ShortURLID    string
+	Existing      bool
 }
 
 // URL represents a mapping between a short URL and its original long URL.
+// RedirectType is zero when the link uses the service-wide default.
+// Passthrough and QueryMode control how ResolveTarget builds the redirect target.
+// Template is the name of the tagging template attached to the link, if any.
+// Variants are the weighted targets of an A/B split link; see SelectVariant.
+// Rules are only loaded by GetURL and ExportUserData.
 type URL struct {
-	ShortURL    string
-	OriginalURL string
+	ShortURL     string
+	OriginalURL  string
+	RedirectType int
+	Passthrough  bool
+	QueryMode    string
+	Template     string
+	Variants     []*Variant
+	Rules        []*Rule
+	// Protected reports whether the link requires a password before redirecting.
+	Protected bool
+	// unlockKey identifies the current password of a protected link, see CheckPassword.
+	unlockKey string
+	// MaxClicks limits the number of redirects, zero means unlimited.
+	// ClicksLeft is the number of redirects remaining under the limit.
+	MaxClicks  int
+	ClicksLeft int
+	// NotBefore and NotAfter bound the active window of the link, nil means unbounded.
+	// Outside the window GetURL resolves the link to FallbackURL, if it is set.
+	NotBefore   *time.Time
+	NotAfter    *time.Time
+	FallbackURL string
+	// Title, Notes and Tags help the owner to organize links and do not affect redirects.
+	Title string
+	Notes string
+	Tags  []string
+	// CreatedAt is the moment the link was created, Clicks is the number of redirects made.
+	CreatedAt time.Time
+	Clicks    int
+	// Deleted reports whether the owner deleted the link, only ExportURLs and ExportUserData return those.
+	Deleted bool
+	// Health is the result of the latest check of the original URL, nil if it was never checked.
+	Health *Health
+}
+
+// Health is the result of a background check of the original URL of a link.
+// StatusCode is zero and Error is set when no response was received.
+// FailingSince is the start of the current run of failed checks, nil if the latest check succeeded.
+// Broken reports whether the target has been failing long enough to be considered gone.
+type Health struct {
+	StatusCode   int
+	Latency      time.Duration
+	Error        string
+	CheckedAt    time.Time
+	FailingSince *time.Time
+	Broken       bool
+}
+
+// URLFilter narrows down the URLs returned by GetURLs. Empty fields do not filter.
+// Search matches a substring of the original URL or title, ignoring case.
+// Broken keeps only the links whose target the health checker reported broken.
+type URLFilter struct {
+	Tag    string
+	Search string
+	Broken bool
+}
+
+// Variant is a weighted target of an A/B split link.
+// The chance of a variant being served is its weight divided by the sum of all weights.
+type Variant struct {
+	Name   string
+	URL    string
+	Weight int
+}
+
+// VariantStats reports how many visits a variant of an A/B split link has served.
+type VariantStats struct {
+	Variant
+	Served int
+}
+
+// Rule routes matching requests of a short URL to TargetURL instead of the original URL.
+// Rules are evaluated by Position, then by creation; empty conditions match any request.
+// Platform is one of the Platform constants, Language is a language tag matched against
+// Accept-Language, Country is a comma-separated list of ISO 3166-1 alpha-2 codes (or "EU")
+// matched against the client's GeoIP location, and TimeFrom/TimeTo is an "HH:MM" UTC
+// window that may span midnight.
+type Rule struct {
+	ID        int
+	Position  int
+	Platform  string
+	Language  string
+	Country   string
+	TimeFrom  string
+	TimeTo    string
+	TargetURL string
+}
+
+// Click is a single redirect of a short URL recorded for analytics.
+// Country is empty when the client could not be located.
+type Click struct {
+	Country string
+	Variant string
+}
+
+// RedirectRequest is a visit of a short URL, see Redirect.
+// Path and Query are the path suffix after the short URL and the request query,
+// both applied to passthrough links. Variant is the A/B variant served to the client
+// on a previous visit, if any. Password unlocks a protected link unless UnlockKey
+// is the key CheckPassword returned for the current password of the link;
+// ClientKey identifies the client for limiting failed password attempts.
+type RedirectRequest struct {
+	ID        string
+	Path      string
+	Query     url.Values
+	Client    *Client
+	Variant   string
+	Password  string
+	UnlockKey string
+	ClientKey string
+}
+
+// Redirect is the result of a successful visit of a short URL.
+// RedirectType is zero when the link uses the default redirect type of the transport.
+// Variant is the name of the A/B variant served, empty when no variant was chosen.
+type Redirect struct {
+	Target       string
+	RedirectType int
+	Variant      string
+}
+
+// ClickEvent is a redirect of a short URL streamed to its owner as it is recorded.
+// Clicks is the number of redirects of the link so far.
+type ClickEvent struct {
+	ShortURL string
+	Country  string
+	Variant  string
+	Clicks   int
+	Time     time.Time
+}
+
+// ClickStream delivers the clicks of a watched short URL, see WatchClicks.
+// The channel is closed when the stream ends. Dropped is the number of clicks
+// left out so far because the receiver did not keep up with them.
+type ClickStream interface {
+	C() <-chan *ClickEvent
+	Dropped() uint64
+}
+
+// ClickStats summarises the redirects of a short URL.
+// Clicks from clients that could not be located are counted under an empty country.
+type ClickStats struct {
+	Total     int
+	ByCountry map[string]int
+}
+
+// Template is a tagging template of the user, as exported by ExportUserData.
+type Template struct {
+	Name     string
+	Source   string
+	Medium   string
+	Campaign string
+	Term     string
+	Content  string
+}
+
+// UserData is everything stored about a user. The links are read from storage as
+// Links advances, which must be closed once it is no longer needed.
+type UserData struct {
+	UserID       string
+	Templates    []*Template
+	Webhooks     []*Webhook
+	AuditEntries []*AuditEntry
+	Links        UserDataIterator
+}
+
+// Webhook is a webhook of the user, as exported by ExportUserData. Its secret is not exported.
+type Webhook struct {
+	ID             string
+	URL            string
+	Events         []string
+	ClickThreshold int
+	CreatedAt      time.Time
+}
+
+// AuditEntry is an action of the user on a link recorded in the audit log, as exported
+// by ExportUserData.
+type AuditEntry struct {
+	ID         int64
+	Action     string
+	ShortURL   string
+	IP         string
+	AuthMethod string
+	CreatedAt  time.Time
+}
+
+// UserDataLink is a link of the user with its rules and the statistics stored about it.
+type UserDataLink struct {
+	URL          *URL
+	VariantStats []*VariantStats
+	ClickStats   *ClickStats
+}
+
+// Link states reported by GetURLInfo.
+const (
+	// LinkStatusActive links redirect to their target.
+	LinkStatusActive = "active"
+	// LinkStatusScheduled links are not active yet and redirect to the fallback URL, if any.
+	LinkStatusScheduled = "scheduled"
+	// LinkStatusEnded links are no longer active and redirect to the fallback URL, if any.
+	LinkStatusEnded = "ended"
+	// LinkStatusExhausted links have used up their click limit and do not redirect.
+	LinkStatusExhausted = "exhausted"
+)
+
+// Safety verdicts of the targets of a link, from the least to the most severe.
+const (
+	SafetySafe      = "safe"
+	SafetyWarning   = "warning"
+	SafetyDangerous = "dangerous"
+)
+
+// URLInfo describes where a short URL leads, for visitors to check before following it.
+type URLInfo struct {
+	ShortURL string
+	// Target is where the link redirects now, with the owner's tagging template applied.
+	// It is empty for password-protected links and for inactive links without a fallback URL.
+	Target string
+	// MultipleTargets reports whether routing rules or A/B variants send some visitors elsewhere.
+	MultipleTargets bool
+	Protected       bool
+	// Status is one of the LinkStatus constants.
+	Status    string
+	CreatedAt time.Time
+	Clicks    int
+	Safety    *Safety
+}
+
+// Safety is the verdict of offline checks of every target a link may redirect to,
+// the most severe one winning. Reasons explain verdicts other than SafetySafe.
+type Safety struct {
+	Verdict string
+	Reasons []string
 }
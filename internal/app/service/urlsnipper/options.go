@@ -0,0 +1,164 @@
+package urlsnipper
+
+import (
+	"net/http"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Option sets an optional attribute of a short URL.
+// Options are accepted both on creation (SetURL) and on edit (UpdateURL).
+type Option func(record *urlstorage.URLRecord)
+
+// WithRedirectType sets the HTTP status code used to redirect to the original URL.
+// Zero resets the link to the service-wide default.
+func WithRedirectType(code int) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.RedirectType = code
+	}
+}
+
+// WithPassthrough enables or disables forwarding of the request path suffix and
+// query string to the original URL.
+func WithPassthrough(on bool) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.Passthrough = on
+	}
+}
+
+// WithQueryMode sets how the request query is merged into the original URL on passthrough.
+// See QueryModeAppend, QueryModeOverride and QueryModeDrop.
+func WithQueryMode(mode string) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.QueryMode = mode
+	}
+}
+
+// WithTemplate attaches the user's tagging template with the given name to the link.
+// An empty name detaches the template.
+func WithTemplate(name string) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.Template = name
+	}
+}
+
+// WithVariants replaces the A/B split variants of the link.
+// An empty list turns the link back into a regular one.
+func WithVariants(variants []*Variant) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.Variants = variantsToRecord(variants)
+	}
+}
+
+// WithPassword protects the link with a password, stored as a bcrypt hash.
+// An empty password removes the protection. A password that cannot be hashed
+// makes validation fail with ErrInvalidPassword.
+func WithPassword(password string) Option {
+	return func(record *urlstorage.URLRecord) {
+		if password == "" {
+			record.PasswordHash = ""
+			return
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			record.PasswordHash = invalidPasswordHash
+			return
+		}
+		record.PasswordHash = string(hash)
+	}
+}
+
+// WithMaxClicks limits the number of redirects the link allows. Zero removes the limit.
+// When the limit of an existing link changes, redirects already made still count.
+func WithMaxClicks(n int) Option {
+	return func(record *urlstorage.URLRecord) {
+		used := record.MaxClicks - record.ClicksLeft
+		record.MaxClicks = n
+		record.ClicksLeft = max(n-used, 0)
+	}
+}
+
+// WithNotBefore sets the moment the link starts redirecting to the original URL.
+// Nil removes the bound.
+func WithNotBefore(t *time.Time) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.NotBefore = t
+	}
+}
+
+// WithNotAfter sets the moment the link stops redirecting to the original URL.
+// Nil removes the bound.
+func WithNotAfter(t *time.Time) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.NotAfter = t
+	}
+}
+
+// WithFallbackURL sets the redirect target used outside the link's active window.
+// An empty URL makes the link unavailable outside the window.
+func WithFallbackURL(url string) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.FallbackURL = url
+	}
+}
+
+// WithTitle sets the human-readable title of the link.
+func WithTitle(title string) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.Title = title
+	}
+}
+
+// WithNotes sets free-form notes on the link.
+func WithNotes(notes string) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.Notes = notes
+	}
+}
+
+// WithTags replaces the tags of the link. Tags are trimmed and lowercased,
+// empty and duplicate tags are dropped. An empty list removes all tags.
+func WithTags(tags []string) Option {
+	return func(record *urlstorage.URLRecord) {
+		record.Tags = normalizeTags(tags)
+	}
+}
+
+// IsValidRedirectType reports whether code can be used as a redirect type.
+// Zero is accepted and means "use the service-wide default".
+func IsValidRedirectType(code int) bool {
+	switch code {
+	case 0,
+		http.StatusMovedPermanently,
+		http.StatusFound,
+		http.StatusTemporaryRedirect,
+		http.StatusPermanentRedirect:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateRecord(record *urlstorage.URLRecord) error {
+	if !IsValidRedirectType(record.RedirectType) {
+		return ErrInvalidRedirectType
+	}
+	if !isValidQueryMode(record.QueryMode) {
+		return ErrInvalidQueryMode
+	}
+	if record.PasswordHash == invalidPasswordHash {
+		return ErrInvalidPassword
+	}
+	if record.MaxClicks < 0 {
+		return ErrInvalidMaxClicks
+	}
+	if err := validateSchedule(record); err != nil {
+		return err
+	}
+	if err := validateMetadata(record); err != nil {
+		return err
+	}
+	return validateVariants(record.Variants)
+}
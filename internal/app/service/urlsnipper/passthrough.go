@@ -0,0 +1,87 @@
+package urlsnipper
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Query merge modes applied to passthrough links.
+const (
+	// QueryModeAppend keeps the original query and appends the request parameters to it.
+	// It is used when a link has no query mode set.
+	QueryModeAppend = "append"
+	// QueryModeOverride replaces original query parameters with request parameters of the same name.
+	QueryModeOverride = "override"
+	// QueryModeDrop ignores the request query and keeps the original one.
+	QueryModeDrop = "drop"
+)
+
+func isValidQueryMode(mode string) bool {
+	switch mode {
+	case "", QueryModeAppend, QueryModeOverride, QueryModeDrop:
+		return true
+	default:
+		return false
+	}
+}
+
+// ResolveTarget builds the redirect target for the link.
+// For passthrough links the path suffix is appended to the original URL path and the
+// request query is merged according to the link's query mode. Links without passthrough
+// redirect to the original URL as is and reject a non-empty path suffix with ErrPassthroughDisabled.
+// A path suffix with ".." segments, escaped or not, is rejected with ErrInvalidPassthroughPath,
+// so that the target stays under the base path of the link.
+func ResolveTarget(link *URL, path string, query url.Values) (string, error) {
+	if !link.Passthrough {
+		if path != "" {
+			return "", ErrPassthroughDisabled
+		}
+		return link.OriginalURL, nil
+	}
+
+	target, err := url.Parse(link.OriginalURL)
+	if err != nil {
+		return "", err
+	}
+
+	if path != "" {
+		if hasDotDotSegment(path) {
+			return "", ErrInvalidPassthroughPath
+		}
+		target = target.JoinPath(path)
+	}
+
+	if len(query) == 0 {
+		return target.String(), nil
+	}
+
+	switch link.QueryMode {
+	case QueryModeDrop:
+	case QueryModeOverride:
+		merged := target.Query()
+		for key, values := range query {
+			merged[key] = values
+		}
+		target.RawQuery = merged.Encode()
+	default:
+		merged := target.Query()
+		for key, values := range query {
+			merged[key] = append(merged[key], values...)
+		}
+		target.RawQuery = merged.Encode()
+	}
+
+	return target.String(), nil
+}
+
+func hasDotDotSegment(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segment = unescaped
+		}
+		if segment == ".." {
+			return true
+		}
+	}
+	return false
+}
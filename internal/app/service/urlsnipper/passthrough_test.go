@@ -0,0 +1,83 @@
+package urlsnipper
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		link    *URL
+		path    string
+		query   url.Values
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "no passthrough ignores query",
+			link:  &URL{OriginalURL: "https://example.com/a?b=1"},
+			query: url.Values{"x": {"1"}},
+			want:  "https://example.com/a?b=1",
+		},
+		{
+			name:    "no passthrough rejects path",
+			link:    &URL{OriginalURL: "https://example.com"},
+			path:    "docs/page",
+			wantErr: ErrPassthroughDisabled,
+		},
+		{
+			name: "path is appended",
+			link: &URL{OriginalURL: "https://example.com/base/", Passthrough: true},
+			path: "docs/page",
+			want: "https://example.com/base/docs/page",
+		},
+		{
+			name:    "dot-dot segment is rejected",
+			link:    &URL{OriginalURL: "https://example.com/base/", Passthrough: true},
+			path:    "../../admin",
+			wantErr: ErrInvalidPassthroughPath,
+		},
+		{
+			name:    "escaped dot-dot segment is rejected",
+			link:    &URL{OriginalURL: "https://example.com/base/", Passthrough: true},
+			path:    "docs/%2e%2E/../admin",
+			wantErr: ErrInvalidPassthroughPath,
+		},
+		{
+			name: "dots inside a segment are kept",
+			link: &URL{OriginalURL: "https://example.com/base/", Passthrough: true},
+			path: "docs/v1..v2",
+			want: "https://example.com/base/docs/v1..v2",
+		},
+		{
+			name:  "append mode keeps both values",
+			link:  &URL{OriginalURL: "https://example.com?x=0&y=2", Passthrough: true},
+			path:  "docs/page",
+			query: url.Values{"x": {"1"}},
+			want:  "https://example.com/docs/page?x=0&x=1&y=2",
+		},
+		{
+			name:  "override mode replaces values",
+			link:  &URL{OriginalURL: "https://example.com?x=0&y=2", Passthrough: true, QueryMode: QueryModeOverride},
+			query: url.Values{"x": {"1"}},
+			want:  "https://example.com?x=1&y=2",
+		},
+		{
+			name:  "drop mode keeps original query",
+			link:  &URL{OriginalURL: "https://example.com?x=0", Passthrough: true, QueryMode: QueryModeDrop},
+			query: url.Values{"x": {"1"}},
+			want:  "https://example.com?x=0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveTarget(tt.link, tt.path, tt.query)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package urlsnipper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// invalidPasswordHash marks a record whose password could not be hashed.
+	// It is never a valid bcrypt hash, so validation rejects it before it is stored.
+	invalidPasswordHash = "!"
+
+	// unlockKeySize is the number of bytes of the password hash digest kept in an unlock key.
+	unlockKeySize = 8
+
+	maxPasswordAttempts    = 5
+	passwordAttemptsWindow = 15 * time.Minute
+
+	// limiterSweepSize is the number of tracked clients above which expired
+	// entries are dropped on the next attempt.
+	limiterSweepSize = 10000
+)
+
+// CheckPassword verifies the password of a protected short URL.
+// Failed attempts are counted per link and client; after maxPasswordAttempts
+// failures within passwordAttemptsWindow further attempts are rejected until the window passes.
+// A guess is counted before it is checked, so concurrent guesses cannot exceed the limit.
+// Public links accept any password.
+//
+// The returned unlock key lets the client skip the password on later redirects, see
+// RedirectRequest. It is derived from the password hash, so setting a new password
+// on the link invalidates the keys handed out for the old one.
+//
+// Returns:
+//   - string: The unlock key of the link, empty for public links
+//   - error: ErrDeleted if the URL was deleted, ErrPasswordRequired if the password is empty,
+//     ErrWrongPassword if it does not match, ErrTooManyAttempts if the client is rate limited,
+//     ErrFailedToGetURL on storage error, or nil on success
+func (s *urlSnipperService) CheckPassword(ctx context.Context, id, password, client string) (_ string, err error) {
+	ctx, span := startSpan(ctx, "CheckPassword", attribute.String("snipurl.short_url", id))
+	defer func() { endSpan(span, err) }()
+
+	record, err := s.storage.GetURL(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlstorage.ErrDeleted):
+			return "", ErrDeleted
+		default:
+			return "", fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+		}
+	}
+	if record.PasswordHash == "" {
+		return "", nil
+	}
+
+	attemptKey := id + "|" + client
+	if !s.limiter.allow(attemptKey) {
+		return "", ErrTooManyAttempts
+	}
+	if password == "" {
+		s.limiter.release(attemptKey)
+		return "", ErrPasswordRequired
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(record.PasswordHash), []byte(password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return "", ErrWrongPassword
+		}
+		s.limiter.release(attemptKey)
+		return "", err
+	}
+
+	s.limiter.reset(attemptKey)
+	return unlockKey(record.PasswordHash), nil
+}
+
+// unlockKey derives the unlock key of a link from its password hash. The bcrypt hash
+// is salted, so the key changes whenever the password is set, even to the same value.
+func unlockKey(passwordHash string) string {
+	if passwordHash == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(passwordHash))
+	return hex.EncodeToString(sum[:unlockKeySize])
+}
+
+type attempts struct {
+	count int
+	since time.Time
+}
+
+// attemptLimiter counts attempts per key within a fixed window.
+// An attempt is reserved before the password is checked and kept if it fails,
+// so concurrent attempts of the same client cannot exceed the limit.
+type attemptLimiter struct {
+	mu       sync.Mutex
+	max      int
+	window   time.Duration
+	now      func() time.Time
+	attempts map[string]*attempts
+}
+
+func newAttemptLimiter(max int, window time.Duration) *attemptLimiter {
+	return &attemptLimiter{
+		max:      max,
+		window:   window,
+		now:      time.Now,
+		attempts: make(map[string]*attempts),
+	}
+}
+
+// allow reserves an attempt for the key and reports whether it is within the limit.
+// A reserved attempt counts as failed unless it is given back with release or reset.
+func (l *attemptLimiter) allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if len(l.attempts) >= limiterSweepSize {
+		for k, a := range l.attempts {
+			if now.Sub(a.since) >= l.window {
+				delete(l.attempts, k)
+			}
+		}
+	}
+
+	a, ok := l.attempts[key]
+	if !ok || now.Sub(a.since) >= l.window {
+		l.attempts[key] = &attempts{count: 1, since: now}
+		return true
+	}
+	if a.count >= l.max {
+		return false
+	}
+	a.count++
+	return true
+}
+
+// release gives back an attempt reserved by allow that was not a password guess.
+func (l *attemptLimiter) release(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	a, ok := l.attempts[key]
+	if !ok {
+		return
+	}
+	a.count--
+	if a.count <= 0 {
+		delete(l.attempts, key)
+	}
+}
+
+// reset forgets the attempts of the key after a correct password.
+func (l *attemptLimiter) reset(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.attempts, key)
+}
@@ -0,0 +1,167 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUrlSnipperService_CheckPassword(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	mockStorage := &urlStorageMock{
+		GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+			switch id {
+			case "public":
+				return &urlstorage.URLRecord{ShortURL: id}, nil
+			case "deleted":
+				return nil, urlstorage.ErrDeleted
+			default:
+				return &urlstorage.URLRecord{ShortURL: id, PasswordHash: string(hash)}, nil
+			}
+		},
+	}
+
+	tests := []struct {
+		name     string
+		id       string
+		password string
+		wantKey  string
+		wantErr  error
+	}{
+		{name: "public link", id: "public"},
+		{name: "correct password", id: "protected", password: "secret", wantKey: unlockKey(string(hash))},
+		{name: "wrong password", id: "protected", password: "guess", wantErr: ErrWrongPassword},
+		{name: "missing password", id: "protected", wantErr: ErrPasswordRequired},
+		{name: "deleted link", id: "deleted", password: "secret", wantErr: ErrDeleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &urlSnipperService{
+				storage: mockStorage,
+				limiter: newAttemptLimiter(maxPasswordAttempts, passwordAttemptsWindow),
+			}
+			key, err := s.CheckPassword(context.Background(), tt.id, tt.password, "203.0.113.7")
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.wantKey, key)
+		})
+	}
+}
+
+func TestUrlSnipperService_CheckPasswordRateLimit(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	mockStorage := &urlStorageMock{
+		GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+			return &urlstorage.URLRecord{ShortURL: id, PasswordHash: string(hash)}, nil
+		},
+	}
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newAttemptLimiter(maxPasswordAttempts, passwordAttemptsWindow)
+	limiter.now = func() time.Time { return now }
+
+	s := &urlSnipperService{
+		storage: mockStorage,
+		limiter: limiter,
+	}
+
+	ctx := context.Background()
+	for i := 0; i < maxPasswordAttempts; i++ {
+		_, err := s.CheckPassword(ctx, "abc123", "guess", "client")
+		require.ErrorIs(t, err, ErrWrongPassword)
+	}
+	_, err = s.CheckPassword(ctx, "abc123", "secret", "client")
+	require.ErrorIs(t, err, ErrTooManyAttempts)
+	_, err = s.CheckPassword(ctx, "abc123", "secret", "other")
+	require.NoError(t, err)
+
+	now = now.Add(passwordAttemptsWindow)
+	_, err = s.CheckPassword(ctx, "abc123", "secret", "client")
+	require.NoError(t, err)
+}
+
+func TestUrlSnipperService_CheckPasswordConcurrentAttempts(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	mockStorage := &urlStorageMock{
+		GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+			return &urlstorage.URLRecord{ShortURL: id, PasswordHash: string(hash)}, nil
+		},
+	}
+
+	s := &urlSnipperService{
+		storage: mockStorage,
+		limiter: newAttemptLimiter(maxPasswordAttempts, passwordAttemptsWindow),
+	}
+
+	const guesses = 50
+	var checked atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < guesses; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.CheckPassword(context.Background(), "abc123", "guess", "client")
+			if errors.Is(err, ErrWrongPassword) {
+				checked.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(maxPasswordAttempts), checked.Load())
+}
+
+func TestAttemptLimiter_Release(t *testing.T) {
+	limiter := newAttemptLimiter(2, passwordAttemptsWindow)
+
+	require.True(t, limiter.allow("client"))
+	require.True(t, limiter.allow("client"))
+	require.False(t, limiter.allow("client"))
+
+	limiter.release("client")
+	require.True(t, limiter.allow("client"))
+	require.False(t, limiter.allow("client"))
+
+	limiter.reset("client")
+	require.True(t, limiter.allow("client"))
+}
+
+func TestUnlockKey(t *testing.T) {
+	first, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+	second, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	require.Empty(t, unlockKey(""))
+	require.Len(t, unlockKey(string(first)), 2*unlockKeySize)
+	require.Equal(t, unlockKey(string(first)), unlockKey(string(first)))
+	require.NotEqual(t, unlockKey(string(first)), unlockKey(string(second)), "a new password must invalidate old unlock keys")
+}
+
+func TestWithPassword(t *testing.T) {
+	record := &urlstorage.URLRecord{}
+
+	WithPassword("secret")(record)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(record.PasswordHash), []byte("secret")))
+	require.NoError(t, validateRecord(record))
+
+	WithPassword("")(record)
+	require.Empty(t, record.PasswordHash)
+
+	WithPassword(strings.Repeat("x", 73))(record)
+	require.ErrorIs(t, validateRecord(record), ErrInvalidPassword)
+}
@@ -0,0 +1,172 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// Reasons of the safety verdicts.
+const (
+	safetyReasonUnparsable = "target is not a valid URL"
+	safetyReasonScheme     = "target does not use http or https"
+	safetyReasonUserinfo   = "target hides its host behind a user name"
+	safetyReasonPlainHTTP  = "target is not encrypted"
+	safetyReasonIPHost     = "target host is an IP address"
+	safetyReasonPunycode   = "target host contains international characters that may imitate another domain"
+	safetyReasonPort       = "target uses a non-standard port"
+)
+
+var safetySeverity = map[string]int{
+	SafetySafe:      0,
+	SafetyWarning:   1,
+	SafetyDangerous: 2,
+}
+
+// GetURLInfo describes a short URL without redirecting or counting a click.
+// The target of a password-protected link is not disclosed, but it is still
+// checked for safety, together with the targets of its rules and variants.
+//
+// Returns:
+//   - *URLInfo: The target, state, creation date, click count and safety of the link
+//   - error: ErrNotFound if the URL does not exist, ErrDeleted if it was deleted,
+//     ErrFailedToGetURL on storage errors, or nil on success
+func (s *urlSnipperService) GetURLInfo(ctx context.Context, id string) (*URLInfo, error) {
+	record, err := s.storage.GetURL(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlstorage.ErrNotFound):
+			return nil, ErrNotFound
+		case errors.Is(err, urlstorage.ErrDeleted):
+			return nil, ErrDeleted
+		default:
+			return nil, fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+		}
+	}
+
+	info := &URLInfo{
+		ShortURL:  record.ShortURL,
+		Protected: record.PasswordHash != "",
+		Status:    LinkStatusActive,
+		CreatedAt: record.CreatedAt,
+		Clicks:    record.Clicks,
+	}
+
+	var link *URL
+	switch err := checkSchedule(record, time.Now()); {
+	case errors.Is(err, ErrNotYetActive):
+		info.Status = LinkStatusScheduled
+	case errors.Is(err, ErrEnded):
+		info.Status = LinkStatusEnded
+	case record.MaxClicks > 0 && record.ClicksLeft <= 0:
+		info.Status = LinkStatusExhausted
+	}
+	if info.Status == LinkStatusScheduled || info.Status == LinkStatusEnded {
+		if record.FallbackURL != "" {
+			link = fallbackFromRecord(record)
+		}
+	} else {
+		link = urlFromRecord(record)
+		rules, err := s.ruleStorage.GetRules(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+		}
+		for _, rule := range rules {
+			link.Rules = append(link.Rules, ruleFromRecord(rule))
+		}
+	}
+
+	targets := make([]string, 0, 1)
+	if link != nil {
+		if err := s.applyTemplate(ctx, record, link); err != nil {
+			return nil, err
+		}
+		targets = append(targets, link.OriginalURL)
+		for _, rule := range link.Rules {
+			targets = append(targets, rule.TargetURL)
+		}
+		for _, variant := range link.Variants {
+			targets = append(targets, variant.URL)
+		}
+		info.MultipleTargets = len(targets) > 1
+		if !info.Protected {
+			info.Target = link.OriginalURL
+		}
+	}
+	info.Safety = assessSafety(targets)
+
+	return info, nil
+}
+
+// assessSafety checks the targets offline, without fetching them, for signs of
+// links that try to look like somewhere else or send data unencrypted.
+func assessSafety(targets []string) *Safety {
+	safety := &Safety{
+		Verdict: SafetySafe,
+		Reasons: []string{},
+	}
+	seen := make(map[string]bool)
+	flag := func(verdict, reason string) {
+		if safetySeverity[verdict] > safetySeverity[safety.Verdict] {
+			safety.Verdict = verdict
+		}
+		if !seen[reason] {
+			seen[reason] = true
+			safety.Reasons = append(safety.Reasons, reason)
+		}
+	}
+
+	for _, target := range targets {
+		u, err := url.Parse(target)
+		if err != nil {
+			flag(SafetyDangerous, safetyReasonUnparsable)
+			continue
+		}
+		switch strings.ToLower(u.Scheme) {
+		case "https":
+		case "http":
+			flag(SafetyWarning, safetyReasonPlainHTTP)
+		default:
+			flag(SafetyDangerous, safetyReasonScheme)
+			continue
+		}
+		if u.User != nil {
+			flag(SafetyDangerous, safetyReasonUserinfo)
+		}
+		host := u.Hostname()
+		if net.ParseIP(host) != nil {
+			flag(SafetyWarning, safetyReasonIPHost)
+		}
+		if isInternationalHost(host) {
+			flag(SafetyWarning, safetyReasonPunycode)
+		}
+		if port := u.Port(); port != "" && port != "80" && port != "443" {
+			flag(SafetyWarning, safetyReasonPort)
+		}
+	}
+
+	return safety
+}
+
+// isInternationalHost reports whether the host has non-ASCII characters,
+// either as is or encoded in punycode labels.
+func isInternationalHost(host string) bool {
+	for _, r := range host {
+		if r > unicode.MaxASCII {
+			return true
+		}
+	}
+	for _, label := range strings.Split(strings.ToLower(host), ".") {
+		if strings.HasPrefix(label, "xn--") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package urlsnipper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUrlSnipperService_GetURLInfo(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		record  *urlstorage.URLRecord
+		getErr  error
+		rules   []*rulestorage.Rule
+		want    *URLInfo
+		wantErr error
+	}{
+		{
+			name:   "active link",
+			record: &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "https://example.com", CreatedAt: created, Clicks: 7},
+			want: &URLInfo{
+				ShortURL: "abc123", Target: "https://example.com", Status: LinkStatusActive, CreatedAt: created, Clicks: 7,
+				Safety: &Safety{Verdict: SafetySafe, Reasons: []string{}},
+			},
+		},
+		{
+			name:   "protected link hides target",
+			record: &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "http://example.com", PasswordHash: "hash"},
+			want: &URLInfo{
+				ShortURL: "abc123", Protected: true, Status: LinkStatusActive,
+				Safety: &Safety{Verdict: SafetyWarning, Reasons: []string{safetyReasonPlainHTTP}},
+			},
+		},
+		{
+			name:   "rules are checked",
+			record: &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "https://example.com"},
+			rules:  []*rulestorage.Rule{{ID: 1, TargetURL: "https://paypal.com@evil.example"}},
+			want: &URLInfo{
+				ShortURL: "abc123", Target: "https://example.com", MultipleTargets: true, Status: LinkStatusActive,
+				Safety: &Safety{Verdict: SafetyDangerous, Reasons: []string{safetyReasonUserinfo}},
+			},
+		},
+		{
+			name:   "ended link with fallback",
+			record: &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "https://example.com", NotAfter: &past, FallbackURL: "https://example.com/over"},
+			want: &URLInfo{
+				ShortURL: "abc123", Target: "https://example.com/over", Status: LinkStatusEnded,
+				Safety: &Safety{Verdict: SafetySafe, Reasons: []string{}},
+			},
+		},
+		{
+			name:   "scheduled link without fallback",
+			record: &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "https://example.com", NotBefore: &future},
+			want: &URLInfo{
+				ShortURL: "abc123", Status: LinkStatusScheduled,
+				Safety: &Safety{Verdict: SafetySafe, Reasons: []string{}},
+			},
+		},
+		{
+			name:   "exhausted link",
+			record: &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "https://example.com", MaxClicks: 1, Clicks: 1},
+			want: &URLInfo{
+				ShortURL: "abc123", Target: "https://example.com", Status: LinkStatusExhausted, Clicks: 1,
+				Safety: &Safety{Verdict: SafetySafe, Reasons: []string{}},
+			},
+		},
+		{name: "missing link", getErr: urlstorage.ErrNotFound, wantErr: ErrNotFound},
+		{name: "deleted link", getErr: urlstorage.ErrDeleted, wantErr: ErrDeleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &urlSnipperService{
+				storage: &urlStorageMock{
+					GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+						return tt.record, tt.getErr
+					},
+				},
+				ruleStorage: &ruleStorageMock{
+					GetRulesFunc: func(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+						return tt.rules, nil
+					},
+				},
+			}
+
+			info, err := s.GetURLInfo(context.Background(), "abc123")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, info)
+		})
+	}
+}
+
+func TestAssessSafety(t *testing.T) {
+	tests := []struct {
+		name        string
+		targets     []string
+		wantVerdict string
+		wantReasons []string
+	}{
+		{name: "no targets", wantVerdict: SafetySafe, wantReasons: []string{}},
+		{name: "https", targets: []string{"https://example.com/path?q=1"}, wantVerdict: SafetySafe, wantReasons: []string{}},
+		{name: "javascript", targets: []string{"javascript:alert(1)"}, wantVerdict: SafetyDangerous, wantReasons: []string{safetyReasonScheme}},
+		{name: "ip host", targets: []string{"https://192.0.2.1/login"}, wantVerdict: SafetyWarning, wantReasons: []string{safetyReasonIPHost}},
+		{name: "punycode", targets: []string{"https://xn--pple-43d.com"}, wantVerdict: SafetyWarning, wantReasons: []string{safetyReasonPunycode}},
+		{name: "unicode host", targets: []string{"https://аpple.com"}, wantVerdict: SafetyWarning, wantReasons: []string{safetyReasonPunycode}},
+		{name: "port", targets: []string{"https://example.com:8443"}, wantVerdict: SafetyWarning, wantReasons: []string{safetyReasonPort}},
+		{
+			name:        "worst verdict wins and reasons are deduplicated",
+			targets:     []string{"http://example.com", "http://example.org", "ftp://example.com"},
+			wantVerdict: SafetyDangerous,
+			wantReasons: []string{safetyReasonPlainHTTP, safetyReasonScheme},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			safety := assessSafety(tt.targets)
+			require.Equal(t, tt.wantVerdict, safety.Verdict)
+			require.Equal(t, tt.wantReasons, safety.Reasons)
+		})
+	}
+}
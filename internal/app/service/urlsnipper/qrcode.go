@@ -0,0 +1,30 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// CheckURL reports whether a short URL exists and has not been deleted.
+// Unlike GetURL it ignores the schedule and the click limit, so QR codes stay
+// available for links that are not active yet or have used up their clicks.
+//
+// Returns:
+//   - error: ErrNotFound if the URL does not exist, ErrDeleted if it was deleted,
+//     ErrFailedToGetURL on storage errors, or nil if the URL exists
+func (s *urlSnipperService) CheckURL(ctx context.Context, id string) error {
+	_, err := s.storage.GetURL(ctx, id)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, urlstorage.ErrNotFound):
+		return ErrNotFound
+	case errors.Is(err, urlstorage.ErrDeleted):
+		return ErrDeleted
+	default:
+		return fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+	}
+}
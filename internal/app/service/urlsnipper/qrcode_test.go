@@ -0,0 +1,59 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUrlSnipperService_CheckURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		getURL  func(ctx context.Context, id string) (*urlstorage.URLRecord, error)
+		wantErr error
+	}{
+		{
+			name: "existing url",
+			getURL: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return &urlstorage.URLRecord{ShortURL: id, MaxClicks: 1, ClicksLeft: 0}, nil
+			},
+		},
+		{
+			name: "missing url",
+			getURL: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return nil, urlstorage.ErrNotFound
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    "deleted url",
+			getURL:  func(ctx context.Context, id string) (*urlstorage.URLRecord, error) { return nil, urlstorage.ErrDeleted },
+			wantErr: ErrDeleted,
+		},
+		{
+			name: "storage error",
+			getURL: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return nil, errors.New("storage error")
+			},
+			wantErr: ErrFailedToGetURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &urlSnipperService{
+				storage: &urlStorageMock{GetURLFunc: tt.getURL},
+			}
+
+			err := s.CheckURL(context.Background(), "abc123")
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
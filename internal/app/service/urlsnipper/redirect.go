@@ -0,0 +1,64 @@
+package urlsnipper
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Redirect resolves a visit of a short URL to its target. It is the redirect pipeline
+// shared by the transports: the link is looked up, the password of a protected link
+// is checked, routing rules are applied, an A/B variant is chosen if no rule matched,
+// the passthrough path and query are resolved, and one redirect of a click-limited
+// link is taken. Only then the served variant and the click are recorded, so rejected
+// visits never count in the statistics.
+//
+// Returns:
+//   - *Redirect: The target, redirect type and variant to serve
+//   - error: errors of GetURL, CheckPassword, ResolveTarget and ConsumeClick, or nil on success
+func (s *urlSnipperService) Redirect(ctx context.Context, req *RedirectRequest) (_ *Redirect, err error) {
+	ctx, span := startSpan(ctx, "Redirect", attribute.String("snipurl.short_url", req.ID))
+	defer func() { endSpan(span, err) }()
+
+	link, err := s.GetURL(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.Protected && req.UnlockKey != link.unlockKey {
+		_, err = s.CheckPassword(ctx, req.ID, req.Password, req.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	link, matched := ApplyRules(link, req.Client)
+
+	var variant string
+	if !matched && len(link.Variants) > 0 {
+		link, variant = SelectVariant(link, req.Variant)
+	}
+
+	target, err := ResolveTarget(link, req.Path, req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.MaxClicks > 0 {
+		err = s.ConsumeClick(ctx, req.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if variant != "" {
+		s.RecordVariant(ctx, req.ID, variant)
+	}
+	s.RecordClick(ctx, req.ID, &Click{Country: req.Client.Country, Variant: variant})
+
+	return &Redirect{
+		Target:       target,
+		RedirectType: link.RedirectType,
+		Variant:      variant,
+	}, nil
+}
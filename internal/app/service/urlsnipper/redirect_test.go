@@ -0,0 +1,157 @@
+package urlsnipper
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	clickstorage "github.com/DanilNaum/SnipURL/internal/app/repository/click"
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUrlSnipperService_Redirect(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	variants := []urlstorage.Variant{
+		{Name: "a", URL: "https://a.example.com", Weight: 1},
+		{Name: "b", URL: "https://b.example.com", Weight: 1},
+	}
+	records := map[string]*urlstorage.URLRecord{
+		"plain":     {ShortURL: "plain", OriginalURL: "https://example.com", RedirectType: 301},
+		"split":     {ShortURL: "split", OriginalURL: "https://example.com", Variants: variants},
+		"protected": {ShortURL: "protected", OriginalURL: "https://example.com", PasswordHash: string(hash)},
+		"forward":   {ShortURL: "forward", OriginalURL: "https://example.com/docs", Passthrough: true},
+		"limited":   {ShortURL: "limited", OriginalURL: "https://example.com", Variants: variants, MaxClicks: 1, ClicksLeft: 1},
+	}
+
+	tests := []struct {
+		name    string
+		req     *RedirectRequest
+		want    *Redirect
+		wantErr error
+	}{
+		{
+			name: "plain link",
+			req:  &RedirectRequest{ID: "plain"},
+			want: &Redirect{Target: "https://example.com", RedirectType: 301},
+		},
+		{
+			name: "sticky variant",
+			req:  &RedirectRequest{ID: "split", Variant: "b"},
+			want: &Redirect{Target: "https://b.example.com", Variant: "b"},
+		},
+		{
+			name:    "password required",
+			req:     &RedirectRequest{ID: "protected"},
+			wantErr: ErrPasswordRequired,
+		},
+		{
+			name:    "wrong password",
+			req:     &RedirectRequest{ID: "protected", Password: "guess"},
+			wantErr: ErrWrongPassword,
+		},
+		{
+			name: "correct password",
+			req:  &RedirectRequest{ID: "protected", Password: "secret"},
+			want: &Redirect{Target: "https://example.com"},
+		},
+		{
+			name: "unlocked link",
+			req:  &RedirectRequest{ID: "protected", UnlockKey: unlockKey(string(hash))},
+			want: &Redirect{Target: "https://example.com"},
+		},
+		{
+			name:    "unlock key of a previous password",
+			req:     &RedirectRequest{ID: "protected", UnlockKey: unlockKey("$2a$04$previous")},
+			wantErr: ErrPasswordRequired,
+		},
+		{
+			name: "passthrough",
+			req:  &RedirectRequest{ID: "forward", Path: "guide", Query: url.Values{"page": {"2"}}},
+			want: &Redirect{Target: "https://example.com/docs/guide?page=2"},
+		},
+		{
+			name:    "path without passthrough",
+			req:     &RedirectRequest{ID: "plain", Path: "guide"},
+			wantErr: ErrPassthroughDisabled,
+		},
+		{
+			name:    "click limit reached",
+			req:     &RedirectRequest{ID: "limited", Variant: "a"},
+			wantErr: ErrExhausted,
+		},
+		{
+			name:    "deleted link",
+			req:     &RedirectRequest{ID: "deleted"},
+			wantErr: ErrDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage := &urlStorageMock{
+				GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+					record, ok := records[id]
+					if !ok {
+						return nil, urlstorage.ErrDeleted
+					}
+					return record, nil
+				},
+				ConsumeClickFunc: func(ctx context.Context, id string) (int, error) {
+					return 0, urlstorage.ErrExhausted
+				},
+				IncrementClicksFunc: func(ctx context.Context, id string) (int, error) {
+					return 1, nil
+				},
+			}
+			mockRuleStorage := &ruleStorageMock{
+				GetRulesFunc: func(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+					return nil, nil
+				},
+			}
+			mockVariantStorage := &variantStorageMock{
+				IncrementServedFunc: func(ctx context.Context, shortURL, variant string) error {
+					return nil
+				},
+			}
+			mockClickStorage := &clickStorageMock{
+				AddClickFunc: func(ctx context.Context, click *clickstorage.Click) error {
+					return nil
+				},
+			}
+
+			s := &urlSnipperService{
+				storage:        mockStorage,
+				ruleStorage:    mockRuleStorage,
+				variantStorage: mockVariantStorage,
+				clickStorage:   mockClickStorage,
+				limiter:        newAttemptLimiter(maxPasswordAttempts, passwordAttemptsWindow),
+			}
+
+			tt.req.Client = NewClient("", "", time.Now())
+			tt.req.ClientKey = "203.0.113.7"
+			got, err := s.Redirect(context.Background(), tt.req)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+
+			if tt.wantErr != nil {
+				require.Empty(t, mockVariantStorage.IncrementServedCalls())
+				require.Empty(t, mockClickStorage.AddClickCalls())
+				return
+			}
+			if tt.want.Variant != "" {
+				require.Len(t, mockVariantStorage.IncrementServedCalls(), 1)
+				require.Equal(t, tt.want.Variant, mockVariantStorage.IncrementServedCalls()[0].Variant)
+			} else {
+				require.Empty(t, mockVariantStorage.IncrementServedCalls())
+			}
+			require.Len(t, mockClickStorage.AddClickCalls(), 1)
+			require.Equal(t, tt.want.Variant, mockClickStorage.AddClickCalls()[0].Click.Variant)
+		})
+	}
+}
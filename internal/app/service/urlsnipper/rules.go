@@ -0,0 +1,126 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+)
+
+// AddRule appends a routing rule to a short URL owned by the user from the context.
+//
+// Returns:
+//   - *Rule: The stored rule with its assigned ID
+//   - error: ErrNotFound if the URL does not exist or belongs to another user,
+//     ErrInvalidRule if a condition or the target is malformed, storage error, or nil on success
+func (s *urlSnipperService) AddRule(ctx context.Context, id string, rule *Rule) (*Rule, error) {
+	if _, err := s.getOwnedRecord(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	record := ruleToRecord(id, rule)
+	ruleID, err := s.ruleStorage.SetRule(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	record.ID = ruleID
+
+	return ruleFromRecord(record), nil
+}
+
+// GetRules returns the routing rules of a short URL owned by the user from the context
+// in evaluation order.
+//
+// Returns:
+//   - []*Rule: The URL's rules
+//   - error: ErrNotFound if the URL does not exist or belongs to another user, storage error, or nil on success
+func (s *urlSnipperService) GetRules(ctx context.Context, id string) ([]*Rule, error) {
+	if _, err := s.getOwnedRecord(ctx, id); err != nil {
+		return nil, err
+	}
+
+	records, err := s.ruleStorage.GetRules(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*Rule, 0, len(records))
+	for _, record := range records {
+		rules = append(rules, ruleFromRecord(record))
+	}
+	return rules, nil
+}
+
+// UpdateRule replaces the conditions, position and target of one of the short URL's rules.
+//
+// Returns:
+//   - *Rule: The rule after the update
+//   - error: ErrNotFound if the URL does not exist or belongs to another user,
+//     ErrRuleNotFound if the URL has no such rule, ErrInvalidRule if the rule is malformed,
+//     storage error, or nil on success
+func (s *urlSnipperService) UpdateRule(ctx context.Context, id string, rule *Rule) (*Rule, error) {
+	if _, err := s.getOwnedRecord(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	record := ruleToRecord(id, rule)
+	err := s.ruleStorage.UpdateRule(ctx, record)
+	if err != nil {
+		if errors.Is(err, rulestorage.ErrNotFound) {
+			return nil, ErrRuleNotFound
+		}
+		return nil, err
+	}
+
+	return ruleFromRecord(record), nil
+}
+
+// DeleteRule removes one of the short URL's rules.
+//
+// Returns:
+//   - error: ErrNotFound if the URL does not exist or belongs to another user,
+//     ErrRuleNotFound if the URL has no such rule, storage error, or nil on success
+func (s *urlSnipperService) DeleteRule(ctx context.Context, id string, ruleID int) error {
+	if _, err := s.getOwnedRecord(ctx, id); err != nil {
+		return err
+	}
+
+	err := s.ruleStorage.DeleteRule(ctx, id, ruleID)
+	if errors.Is(err, rulestorage.ErrNotFound) {
+		return ErrRuleNotFound
+	}
+	return err
+}
+
+func ruleToRecord(shortURL string, rule *Rule) *rulestorage.Rule {
+	return &rulestorage.Rule{
+		ID:        rule.ID,
+		ShortURL:  shortURL,
+		Position:  rule.Position,
+		Platform:  rule.Platform,
+		Language:  rule.Language,
+		Country:   rule.Country,
+		TimeFrom:  rule.TimeFrom,
+		TimeTo:    rule.TimeTo,
+		TargetURL: rule.TargetURL,
+	}
+}
+
+func ruleFromRecord(record *rulestorage.Rule) *Rule {
+	return &Rule{
+		ID:        record.ID,
+		Position:  record.Position,
+		Platform:  record.Platform,
+		Language:  record.Language,
+		Country:   record.Country,
+		TimeFrom:  record.TimeFrom,
+		TimeTo:    record.TimeTo,
+		TargetURL: record.TargetURL,
+	}
+}
@@ -0,0 +1,49 @@
+package urlsnipper
+
+import (
+	"net/url"
+	"time"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// checkSchedule reports whether the link is inside its active window at now.
+// The window includes NotBefore and excludes NotAfter.
+func checkSchedule(record *urlstorage.URLRecord, now time.Time) error {
+	if record.NotBefore != nil && now.Before(*record.NotBefore) {
+		return ErrNotYetActive
+	}
+	if record.NotAfter != nil && !now.Before(*record.NotAfter) {
+		return ErrEnded
+	}
+	return nil
+}
+
+func validateSchedule(record *urlstorage.URLRecord) error {
+	if record.NotBefore != nil && record.NotAfter != nil && !record.NotAfter.After(*record.NotBefore) {
+		return ErrInvalidSchedule
+	}
+	if record.FallbackURL == "" {
+		return nil
+	}
+	target, err := url.Parse(record.FallbackURL)
+	if err != nil || target.Scheme == "" || target.Host == "" {
+		return ErrInvalidSchedule
+	}
+	return nil
+}
+
+// fallbackFromRecord returns the link as it resolves outside its active window:
+// a plain redirect to the fallback URL with the link's redirect type.
+func fallbackFromRecord(record *urlstorage.URLRecord) *URL {
+	return &URL{
+		ShortURL:     record.ShortURL,
+		OriginalURL:  record.FallbackURL,
+		RedirectType: record.RedirectType,
+		Template:     record.Template,
+		Rules:        []*Rule{},
+		NotBefore:    record.NotBefore,
+		NotAfter:     record.NotAfter,
+		FallbackURL:  record.FallbackURL,
+	}
+}
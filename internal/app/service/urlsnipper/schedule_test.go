@@ -0,0 +1,137 @@
+package urlsnipper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSchedule(t *testing.T) {
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 8, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		record  *urlstorage.URLRecord
+		now     time.Time
+		wantErr error
+	}{
+		{name: "no window", record: &urlstorage.URLRecord{}, now: start},
+		{name: "before start", record: &urlstorage.URLRecord{NotBefore: &start}, now: start.Add(-time.Second), wantErr: ErrNotYetActive},
+		{name: "at start", record: &urlstorage.URLRecord{NotBefore: &start, NotAfter: &end}, now: start},
+		{name: "inside window", record: &urlstorage.URLRecord{NotBefore: &start, NotAfter: &end}, now: start.Add(time.Hour)},
+		{name: "at end", record: &urlstorage.URLRecord{NotBefore: &start, NotAfter: &end}, now: end, wantErr: ErrEnded},
+		{name: "after end without start", record: &urlstorage.URLRecord{NotAfter: &end}, now: end.Add(time.Hour), wantErr: ErrEnded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, checkSchedule(tt.record, tt.now), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		record  *urlstorage.URLRecord
+		wantErr error
+	}{
+		{name: "valid window", record: &urlstorage.URLRecord{NotBefore: &start, NotAfter: &end, FallbackURL: "https://example.com/soon"}},
+		{name: "end before start", record: &urlstorage.URLRecord{NotBefore: &end, NotAfter: &start}, wantErr: ErrInvalidSchedule},
+		{name: "empty window", record: &urlstorage.URLRecord{NotBefore: &start, NotAfter: &start}, wantErr: ErrInvalidSchedule},
+		{name: "relative fallback", record: &urlstorage.URLRecord{FallbackURL: "/soon"}, wantErr: ErrInvalidSchedule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSchedule(tt.record)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, ErrInvalidOption)
+			}
+		})
+	}
+}
+
+func TestUrlSnipperService_GetURLSchedule(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		record  *urlstorage.URLRecord
+		want    *URL
+		wantErr error
+	}{
+		{
+			name:    "not yet active",
+			record:  &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "https://example.com", NotBefore: &future},
+			wantErr: ErrNotYetActive,
+		},
+		{
+			name:    "ended",
+			record:  &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "https://example.com", NotAfter: &past},
+			wantErr: ErrEnded,
+		},
+		{
+			name: "fallback before start",
+			record: &urlstorage.URLRecord{
+				ShortURL:     "abc123",
+				OriginalURL:  "https://example.com",
+				RedirectType: 301,
+				Passthrough:  true,
+				PasswordHash: "hash",
+				NotBefore:    &future,
+				FallbackURL:  "https://example.com/soon",
+			},
+			want: &URL{
+				ShortURL:     "abc123",
+				OriginalURL:  "https://example.com/soon",
+				RedirectType: 301,
+				Rules:        []*Rule{},
+				NotBefore:    &future,
+				FallbackURL:  "https://example.com/soon",
+			},
+		},
+		{
+			name:   "inside window",
+			record: &urlstorage.URLRecord{ShortURL: "abc123", OriginalURL: "https://example.com", NotBefore: &past, NotAfter: &future},
+			want: &URL{
+				ShortURL:    "abc123",
+				OriginalURL: "https://example.com",
+				Rules:       []*Rule{},
+				NotBefore:   &past,
+				NotAfter:    &future,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &urlSnipperService{
+				storage: &urlStorageMock{
+					GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+						return tt.record, nil
+					},
+				},
+				ruleStorage: &ruleStorageMock{
+					GetRulesFunc: func(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+						return nil, nil
+					},
+				},
+			}
+
+			got, err := s.GetURL(context.Background(), "abc123")
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
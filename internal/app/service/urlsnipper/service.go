@@ -4,10 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/DanilNaum/SnipURL/internal/app/metrics"
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	clickstorage "github.com/DanilNaum/SnipURL/internal/app/repository/click"
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
 	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
 	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/middlewares"
 	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Predefined error variables for URL-related operations, providing specific error conditions
@@ -24,6 +32,89 @@ var (
 
 	// ErrDeleted indicates that the requested URL has been deleted.
 	ErrDeleted = fmt.Errorf("deleted")
+
+	// ErrNotFound indicates that the requested URL does not exist or is not owned by the user.
+	ErrNotFound = fmt.Errorf("not found")
+
+	// ErrInvalidOption indicates that a link attribute has an unsupported value.
+	// More specific errors below wrap it.
+	ErrInvalidOption = fmt.Errorf("invalid option")
+
+	// ErrInvalidRedirectType indicates that the requested redirect status code is not supported.
+	ErrInvalidRedirectType = fmt.Errorf("%w: redirect type", ErrInvalidOption)
+
+	// ErrInvalidQueryMode indicates that the requested query merge mode is not supported.
+	ErrInvalidQueryMode = fmt.Errorf("%w: query mode", ErrInvalidOption)
+
+	// ErrTemplateNotFound indicates that the link references a tagging template the user does not have.
+	ErrTemplateNotFound = fmt.Errorf("%w: template not found", ErrInvalidOption)
+
+	// ErrInvalidVariants indicates that an A/B split variant has no name, a duplicate name,
+	// a non-positive weight or a URL that is not absolute.
+	ErrInvalidVariants = fmt.Errorf("%w: variants", ErrInvalidOption)
+
+	// ErrInvalidPassword indicates that a link password cannot be hashed, e.g. because it is
+	// longer than 72 bytes.
+	ErrInvalidPassword = fmt.Errorf("%w: password", ErrInvalidOption)
+
+	// ErrInvalidMaxClicks indicates that the click limit of a link is negative.
+	ErrInvalidMaxClicks = fmt.Errorf("%w: max clicks", ErrInvalidOption)
+
+	// ErrInvalidSchedule indicates that the active window of a link ends before it starts
+	// or that its fallback URL is not absolute.
+	ErrInvalidSchedule = fmt.Errorf("%w: schedule", ErrInvalidOption)
+
+	// ErrInvalidMetadata indicates that the title, notes or tags of a link are too long,
+	// or that there are too many tags.
+	ErrInvalidMetadata = fmt.Errorf("%w: metadata", ErrInvalidOption)
+
+	// ErrInvalidAlias indicates that a custom short URL ID has characters other than
+	// letters, digits, '-' and '_', is shorter than 3 or longer than 64 characters,
+	// or is reserved by the service's routes.
+	ErrInvalidAlias = fmt.Errorf("%w: alias", ErrInvalidOption)
+
+	// ErrInvalidListQuery indicates that a page of the user's URLs was requested with an
+	// unknown sort order, a limit out of range or a search string that is too long.
+	ErrInvalidListQuery = fmt.Errorf("invalid list query")
+
+	// ErrInvalidCursor indicates that the page cursor is malformed or was issued for
+	// another sort order.
+	ErrInvalidCursor = fmt.Errorf("%w: cursor", ErrInvalidListQuery)
+
+	// ErrNotYetActive indicates that the active window of the link has not started yet
+	// and the link has no fallback URL.
+	ErrNotYetActive = fmt.Errorf("not yet active")
+
+	// ErrEnded indicates that the active window of the link is over and the link has
+	// no fallback URL.
+	ErrEnded = fmt.Errorf("ended")
+
+	// ErrExhausted indicates that a click-limited link has no redirects left.
+	ErrExhausted = fmt.Errorf("click limit reached")
+
+	// ErrPasswordRequired indicates that the link is protected and no password was given.
+	ErrPasswordRequired = fmt.Errorf("password required")
+
+	// ErrWrongPassword indicates that the given password does not match the link's password.
+	ErrWrongPassword = fmt.Errorf("wrong password")
+
+	// ErrTooManyAttempts indicates that the client made too many failed password attempts
+	// for the link and has to wait before trying again.
+	ErrTooManyAttempts = fmt.Errorf("too many attempts")
+
+	// ErrInvalidRule indicates that a routing rule has an unknown platform, a malformed
+	// country list or time window, or a target that is not an absolute URL.
+	ErrInvalidRule = fmt.Errorf("invalid rule")
+
+	// ErrRuleNotFound indicates that the short URL has no routing rule with the given ID.
+	ErrRuleNotFound = fmt.Errorf("rule not found")
+
+	// ErrPassthroughDisabled indicates that a path suffix was requested for a link without passthrough.
+	ErrPassthroughDisabled = fmt.Errorf("passthrough disabled")
+
+	// ErrInvalidPassthroughPath indicates a path suffix with ".." segments, which could
+	// lead the redirect out of the base path of the link.
+	ErrInvalidPassthroughPath = fmt.Errorf("invalid passthrough path")
 )
 
 const (
@@ -33,10 +124,51 @@ const (
 
 //go:generate moq -out mock_url_storage_moq_test.go . urlStorage
 type urlStorage interface {
-	SetURL(ctx context.Context, id, url string) (length int, err error)
-	GetURL(ctx context.Context, id string) (string, error)
+	SetURL(ctx context.Context, record *urlstorage.URLRecord) (length int, err error)
+	GetURL(ctx context.Context, id string) (*urlstorage.URLRecord, error)
 	SetURLs(ctx context.Context, urls []*urlstorage.URLRecord) (insertedURLs []*urlstorage.URLRecord, err error)
-	GetURLs(ctx context.Context) ([]*urlstorage.URLRecord, error)
+	UpdateURL(ctx context.Context, record *urlstorage.URLRecord) error
+	GetURLs(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error)
+	IterateURLs(ctx context.Context) (urlstorage.URLIterator, error)
+	ConsumeClick(ctx context.Context, id string) (clicksLeft int, err error)
+	IncrementClicks(ctx context.Context, id string) (clicks int, err error)
+	EndWindows(ctx context.Context, now time.Time, limit int) ([]*urlstorage.URLRecord, error)
+}
+
+//go:generate moq -out mock_template_storage_moq_test.go . templateStorage
+type templateStorage interface {
+	GetTemplate(ctx context.Context, userID, name string) (*templatestorage.Template, error)
+	GetTemplates(ctx context.Context, userID string) ([]*templatestorage.Template, error)
+}
+
+//go:generate moq -out mock_rule_storage_moq_test.go . ruleStorage
+type ruleStorage interface {
+	SetRule(ctx context.Context, rule *rulestorage.Rule) (id int, err error)
+	GetRules(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error)
+	UpdateRule(ctx context.Context, rule *rulestorage.Rule) error
+	DeleteRule(ctx context.Context, shortURL string, id int) error
+}
+
+//go:generate moq -out mock_variant_storage_moq_test.go . variantStorage
+type variantStorage interface {
+	IncrementServed(ctx context.Context, shortURL, variant string) error
+	GetServed(ctx context.Context, shortURL string) (map[string]int, error)
+}
+
+//go:generate moq -out mock_click_storage_moq_test.go . clickStorage
+type clickStorage interface {
+	AddClick(ctx context.Context, click *clickstorage.Click) error
+	GetClickStats(ctx context.Context, shortURL string) (*clickstorage.ClickStats, error)
+}
+
+//go:generate moq -out mock_webhook_storage_moq_test.go . webhookStorage
+type webhookStorage interface {
+	GetWebhooks(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error)
+}
+
+//go:generate moq -out mock_audit_storage_moq_test.go . auditStorage
+type auditStorage interface {
+	GetEntries(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error)
 }
 
 //go:generate moq -out mock_hasher_moq_test.go . hasher
@@ -53,19 +185,41 @@ type dumper interface {
 //go:generate moq -out mock_logger_moq_test.go . logger
 type logger interface {
 	Errorf(string, ...interface{})
+	ErrorfContext(context.Context, string, ...interface{})
+}
+
+//go:generate moq -out mock_redirect_metrics_moq_test.go . redirectMetrics
+type redirectMetrics interface {
+	ObserveRedirect(result string)
 }
 
 //go:generate moq -out mock_delete_service_moq_test.go . deleteService
 type deleteService interface {
-	Delete(userID string, input []string)
+	Delete(ctx context.Context, userID string, input []string)
+}
+
+//go:generate moq -out mock_auditor_moq_test.go . auditor
+type auditor interface {
+	Record(ctx context.Context, action string, shortURLs ...string)
 }
 
 type urlSnipperService struct {
-	storage       urlStorage
-	hasher        hasher
-	dumper        dumper
-	logger        logger
-	deleteService deleteService
+	storage         urlStorage
+	templateStorage templateStorage
+	ruleStorage     ruleStorage
+	variantStorage  variantStorage
+	clickStorage    clickStorage
+	webhookStorage  webhookStorage
+	auditStorage    auditStorage
+	hasher          hasher
+	dumper          dumper
+	logger          logger
+	deleteService   deleteService
+	publisher       publisher
+	clickHub        clickHub
+	metrics         redirectMetrics
+	auditor         auditor
+	limiter         *attemptLimiter
 }
 
 // NewURLSnipperService creates and returns a new instance of urlSnipperService with the provided dependencies.
@@ -73,54 +227,89 @@ type urlSnipperService struct {
 //
 // Parameters:
 //   - storage: Implementation of URL storage interface
+//   - templateStorage: Storage of the users' tagging templates
+//   - ruleStorage: Storage of the links' routing rules
+//   - variantStorage: Storage of the A/B split served counters
+//   - clickStorage: Storage of the click analytics
+//   - webhookStorage: Storage of the users' webhooks, exported with their data
+//   - auditStorage: Audit log storage, whose entries of a user are exported with their data
 //   - hasher: Hash generator for creating short URL IDs
 //   - dumper: URL record dumper
 //   - deleteService: Service for handling URL deletions
+//   - publisher: Receiver of the click limit expiries and the clicks of the links, nil to emit
+//     none; creations, updates, deletions and window ends are emitted by the storage to the outbox
+//   - clickHub: Fan-out of the recorded clicks to the owners watching them, nil to stream none
+//   - metrics: Counter of the resolved links by result, nil to count none
+//   - auditor: Audit log of the creations and updates of the links, nil to record none;
+//     deletions are recorded by the delete service once done
 //   - logger: Logger for recording errors
 //
 // Returns:
 //   - *urlSnipperService: Configured URL snipper service instance
-func NewURLSnipperService(storage urlStorage, hasher hasher, dumper dumper, deleteService deleteService, logger logger) *urlSnipperService {
+func NewURLSnipperService(storage urlStorage, templateStorage templateStorage, ruleStorage ruleStorage, variantStorage variantStorage, clickStorage clickStorage, webhookStorage webhookStorage, auditStorage auditStorage, hasher hasher, dumper dumper, deleteService deleteService, publisher publisher, clickHub clickHub, metrics redirectMetrics, auditor auditor, logger logger) *urlSnipperService {
 	return &urlSnipperService{
-		storage:       storage,
-		hasher:        hasher,
-		dumper:        dumper,
-		deleteService: deleteService,
-		logger:        logger,
+		storage:         storage,
+		templateStorage: templateStorage,
+		ruleStorage:     ruleStorage,
+		variantStorage:  variantStorage,
+		clickStorage:    clickStorage,
+		webhookStorage:  webhookStorage,
+		auditStorage:    auditStorage,
+		hasher:          hasher,
+		dumper:          dumper,
+		deleteService:   deleteService,
+		publisher:       publisher,
+		clickHub:        clickHub,
+		metrics:         metrics,
+		auditor:         auditor,
+		logger:          logger,
+		limiter:         newAttemptLimiter(maxPasswordAttempts, passwordAttemptsWindow),
 	}
 }
 
 // SetURL creates a short URL from the given original URL. It attempts to generate a unique short URL ID
 // by hashing the URL. If a collision occurs (ErrConflict), it returns the conflicting ID. If generation fails after
-// _maxAttempts, it returns ErrFailedToGenerateID. On success, it stores the URL mapping and dumps the record.
+// _maxAttempts, it returns ErrFailedToGenerateID. On success, it stores the URL mapping and dumps the record;
+// the storage emits the creation of the link to the outbox.
 //
 // Parameters:
 //   - ctx: The context for the operation
 //   - url: The original URL to be shortened
+//   - opts: Optional link attributes such as the redirect type
 //
 // Returns:
 //   - string: The generated short URL ID on success, or empty string on failure
-//   - error: ErrConflict if ID exists, ErrFailedToGenerateID if generation fails, or nil on success
-func (s *urlSnipperService) SetURL(ctx context.Context, url string) (string, error) {
+//   - error: ErrConflict if ID exists, ErrFailedToGenerateID if generation fails,
+//     ErrInvalidOption if an option is invalid or the template does not exist, or nil on success
+func (s *urlSnipperService) SetURL(ctx context.Context, url string, opts ...Option) (_ string, err error) {
+	ctx, span := startSpan(ctx, "SetURL")
+	defer func() { endSpan(span, err) }()
+
+	record := &urlstorage.URLRecord{OriginalURL: url}
+	for _, opt := range opts {
+		opt(record)
+	}
+	if err := validateRecord(record); err != nil {
+		return "", err
+	}
+	userID, _ := ctx.Value(key).(string)
+	if err := s.checkTemplate(ctx, userID, record.Template); err != nil {
+		return "", err
+	}
+	record.UserID = userID
 
 	urlCopy := url
 	for i := 0; i < _maxAttempts; i++ {
 		id := s.hasher.Hash(urlCopy)
-		length, err := s.storage.SetURL(ctx, id, url)
+		record.ShortURL = id
+		length, err := s.storage.SetURL(ctx, record)
 		if errors.Is(err, urlstorage.ErrConflict) {
 			return id, ErrConflict
 		}
 		if err == nil {
-			rec := &dump.URLRecord{
-				UUID:        length,
-				ShortURL:    id,
-				OriginalURL: url,
-			}
-
-			err = s.dumper.Add(rec)
-			if err != nil {
-				s.logger.Errorf("failed to dump record: %v", err)
-			}
+			record.ID = length
+			s.dump(record)
+			s.audit(ctx, auditstorage.ActionCreate, id)
 
 			return id, nil
 		}
@@ -131,59 +320,234 @@ func (s *urlSnipperService) SetURL(ctx context.Context, url string) (string, err
 	return "", ErrFailedToGenerateID
 }
 
-// GetURL retrieves the original URL associated with the given short URL ID.
-// If the URL has been deleted, it returns ErrDeleted. For any other errors,
-// it wraps them with ErrFailedToGetURL.
+// GetURL retrieves the short URL with the given ID together with its routing rules.
+// If the link uses a tagging template, the template's UTM parameters are already
+// applied to the returned OriginalURL, variant URLs and rule targets. Use ApplyRules
+// and SelectVariant to pick the target for a particular client.
+// Outside its active window the link resolves to its fallback URL without rules,
+// variants, passthrough or password protection; without a fallback GetURL returns
+// ErrNotYetActive or ErrEnded. The first request after the end of the window publishes
+// the expiry of the link.
+// If the URL has been deleted, it returns ErrDeleted; if its click limit is used up,
+// ErrExhausted. For any other errors, it wraps them with ErrFailedToGetURL.
+// Every resolution is counted as a hit, a miss or a gone link, failures aside.
 //
 // Parameters:
 //   - ctx: The context for the operation
 //   - id: The short URL ID to look up
 //
 // Returns:
-//   - string: The original URL if found, or empty string on failure
-//   - error: ErrDeleted if URL was deleted, wrapped error with ErrFailedToGetURL for other errors, or nil on success
-func (s *urlSnipperService) GetURL(ctx context.Context, id string) (string, error) {
-	url, err := s.storage.GetURL(ctx, id)
+//   - *URL: The short URL with its original URL and redirect settings, or nil on failure
+//   - error: ErrDeleted if URL was deleted, ErrNotYetActive or ErrEnded outside the active
+//     window, ErrExhausted if no redirects are left, wrapped error with ErrFailedToGetURL for other errors, or nil on success
+func (s *urlSnipperService) GetURL(ctx context.Context, id string) (_ *URL, err error) {
+	ctx, span := startSpan(ctx, "GetURL", attribute.String("snipurl.short_url", id))
+	defer func() { endSpan(span, err) }()
+
+	link, err := s.getURL(ctx, id)
+	s.observeRedirect(err)
+	return link, err
+}
+
+func (s *urlSnipperService) getURL(ctx context.Context, id string) (*URL, error) {
+	record, err := s.storage.GetURL(ctx, id)
 	if err != nil {
 		switch {
 		case errors.Is(err, urlstorage.ErrDeleted):
-			return "", ErrDeleted
+			return nil, ErrDeleted
 		default:
-			return "", fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+		}
+	}
+	if err := checkSchedule(record, time.Now()); err != nil {
+		if record.FallbackURL == "" {
+			return nil, err
+		}
+		link := fallbackFromRecord(record)
+		if err := s.applyTemplate(ctx, record, link); err != nil {
+			return nil, err
+		}
+		return link, nil
+	}
+	if record.MaxClicks > 0 && record.ClicksLeft <= 0 {
+		return nil, ErrExhausted
+	}
+
+	link := urlFromRecord(record)
+
+	rules, err := s.ruleStorage.GetRules(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+	}
+	link.Rules = make([]*Rule, 0, len(rules))
+	for _, rule := range rules {
+		link.Rules = append(link.Rules, ruleFromRecord(rule))
+	}
+
+	err = s.applyTemplate(ctx, record, link)
+	if err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// observeRedirect counts the result of GetURL. Unknown and not yet active links are misses,
+// deleted, exhausted and ended ones are gone; failures are not counted.
+func (s *urlSnipperService) observeRedirect(err error) {
+	if s.metrics == nil {
+		return
+	}
+	switch {
+	case err == nil:
+		s.metrics.ObserveRedirect(metrics.RedirectHit)
+	case errors.Is(err, urlstorage.ErrNotFound), errors.Is(err, ErrNotYetActive):
+		s.metrics.ObserveRedirect(metrics.RedirectMiss)
+	case errors.Is(err, ErrDeleted), errors.Is(err, ErrExhausted), errors.Is(err, ErrEnded):
+		s.metrics.ObserveRedirect(metrics.RedirectGone)
+	}
+}
+
+// UpdateURL applies the given options to a short URL owned by the user from the context.
+// The updated record is stored and dumped.
+//
+// Parameters:
+//   - ctx: The context containing the user ID
+//   - id: The short URL ID to edit
+//   - opts: Link attributes to change
+//
+// Returns:
+//   - *URL: The short URL after the update
+//   - error: ErrNotFound if the URL does not exist or belongs to another user,
+//     ErrInvalidOption if an option is invalid or the template does not exist, storage error, or nil on success
+func (s *urlSnipperService) UpdateURL(ctx context.Context, id string, opts ...Option) (_ *URL, err error) {
+	ctx, span := startSpan(ctx, "UpdateURL", attribute.String("snipurl.short_url", id))
+	defer func() { endSpan(span, err) }()
+
+	record, err := s.getOwnedRecord(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, opt := range opts {
+		opt(record)
+	}
+	if err := validateRecord(record); err != nil {
+		return nil, err
+	}
+	if err := s.checkTemplate(ctx, record.UserID, record.Template); err != nil {
+		return nil, err
+	}
+
+	err = s.storage.UpdateURL(ctx, record)
+	if err != nil {
+		if errors.Is(err, urlstorage.ErrNotFound) {
+			return nil, ErrNotFound
 		}
+		return nil, err
 	}
+	s.dump(record)
+	s.audit(ctx, auditstorage.ActionUpdate, record.ShortURL)
 
-	return url, nil
+	return urlFromRecord(record), nil
+}
+
+// getOwnedRecord returns the record of the short URL if it exists and belongs to the
+// user from the context, and ErrNotFound otherwise.
+func (s *urlSnipperService) getOwnedRecord(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	record, err := s.storage.GetURL(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlstorage.ErrNotFound), errors.Is(err, urlstorage.ErrDeleted):
+			return nil, ErrNotFound
+		default:
+			return nil, fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+		}
+	}
+	if record.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return record, nil
 }
 
 // SetURLs creates multiple short URLs from the given array of original URLs in batch.
-// It generates unique short URL IDs by hashing each original URL, stores the URL mappings,
-// and dumps the records. If any operation fails, it returns an error.
+// It generates unique short URL IDs by hashing each original URL, unless the item asks for
+// an alias, stores the URL mappings and dumps the records. An original URL that already
+// has its generated short URL, or an alias that is already taken, is not inserted, which
+// fails the batch with ErrFailedToGenerateID. If any operation fails, it returns an error.
 //
 // Parameters:
 //   - ctx: The context for the operation
 //   - urls: Array of SetURLsInput containing original URLs and correlation IDs
 //
 // Returns:
-//   - map[string]*SetURLsOutput: Map of correlation IDs to their corresponding short URL outputs
-//   - error: ErrFailedToGenerateID if not all URLs were inserted, storage error, or nil on success
-func (s *urlSnipperService) SetURLs(ctx context.Context, urls []*SetURLsInput) (map[string]*SetURLsOutput, error) {
+//   - map[string]*SetURLsOutput: Map of correlation IDs to their corresponding short URL outputs,
+//     only of the inserted and Existing URLs when some were not inserted
+//   - error: ErrFailedToGenerateID if not all URLs were inserted, ErrInvalidOption
+//     if an item has an unsupported attribute or unknown template, storage error, or nil on success
+func (s *urlSnipperService) SetURLs(ctx context.Context, urls []*SetURLsInput) (_ map[string]*SetURLsOutput, err error) {
+	ctx, span := startSpan(ctx, "SetURLs", attribute.Int("snipurl.batch_size", len(urls)))
+	defer func() { endSpan(span, err) }()
+
 	output := make(map[string]*SetURLsOutput, len(urls))
+	requested := make(map[string]*SetURLsInput, len(urls))
 
 	toInsert := make([]*urlstorage.URLRecord, 0, len(urls))
+	userID, _ := ctx.Value(key).(string)
+	checkedTemplates := make(map[string]struct{})
 
 	for _, url := range urls {
 		id := s.hasher.Hash(url.OriginalURL)
+		if url.Alias != "" {
+			if err := validateAlias(url.Alias); err != nil {
+				return nil, err
+			}
+			id = url.Alias
+		}
+
+		record := &urlstorage.URLRecord{
+			ShortURL:     id,
+			OriginalURL:  url.OriginalURL,
+			UserID:       userID,
+			Alias:        url.Alias != "",
+			RedirectType: url.RedirectType,
+			Passthrough:  url.Passthrough,
+			QueryMode:    url.QueryMode,
+			Template:     url.Template,
+			Variants:     variantsToRecord(url.Variants),
+			NotBefore:    url.NotBefore,
+			NotAfter:     url.NotAfter,
+			FallbackURL:  url.FallbackURL,
+			Title:        url.Title,
+			Notes:        url.Notes,
+			Tags:         normalizeTags(url.Tags),
+		}
+		if url.Password != "" {
+			WithPassword(url.Password)(record)
+		}
+		WithMaxClicks(url.MaxClicks)(record)
+		if err := validateRecord(record); err != nil {
+			return nil, err
+		}
+		if _, ok := checkedTemplates[record.Template]; !ok {
+			if err := s.checkTemplate(ctx, userID, record.Template); err != nil {
+				return nil, err
+			}
+			checkedTemplates[record.Template] = struct{}{}
+		}
 
-		toInsert = append(toInsert, &urlstorage.URLRecord{
-			ShortURL:    id,
-			OriginalURL: url.OriginalURL,
-		})
+		toInsert = append(toInsert, record)
 
 		output[url.CorrelationID] = &SetURLsOutput{
 			CorrelationID: url.CorrelationID,
 			ShortURLID:    id,
 		}
+		requested[url.CorrelationID] = url
 
 	}
 
@@ -191,53 +555,170 @@ func (s *urlSnipperService) SetURLs(ctx context.Context, urls []*SetURLsInput) (
 	if err != nil {
 		return nil, err
 	}
+	created := make([]string, 0, len(inserted))
 	for _, record := range inserted {
-		rec := &dump.URLRecord{
-			UUID:        record.ID,
-			ShortURL:    record.ShortURL,
-			OriginalURL: record.OriginalURL,
-		}
-		err := s.dumper.Add(rec)
-		if err != nil {
-			s.logger.Errorf("failed to dump record: %v", err)
-		}
+		s.dump(record)
+		created = append(created, record.ShortURL)
 	}
+	s.audit(ctx, auditstorage.ActionCreate, created...)
 
 	if len(inserted) != len(toInsert) {
-		return nil, ErrFailedToGenerateID
+		// Several items may ask for the same alias, only the one that was inserted owns it.
+		stored := make(map[string]string, len(inserted))
+		for _, record := range inserted {
+			stored[record.ShortURL] = record.OriginalURL
+		}
+		for correlationID, out := range output {
+			if originalURL, ok := stored[out.ShortURLID]; ok && originalURL == requested[correlationID].OriginalURL {
+				continue
+			}
+			if url := requested[correlationID]; url.Alias == "" && s.hasOriginalURL(ctx, out.ShortURLID, url.OriginalURL) {
+				out.Existing = true
+				continue
+			}
+			delete(output, correlationID)
+		}
+		return output, ErrFailedToGenerateID
 	}
 
 	return output, nil
 }
 
-// GetURLs retrieves all URLs stored in the system.
+// hasOriginalURL reports whether the short URL id is an active link to originalURL,
+// i.e. a batch item was not inserted because its original URL was already shortened.
+func (s *urlSnipperService) hasOriginalURL(ctx context.Context, id, originalURL string) bool {
+	record, err := s.storage.GetURL(ctx, id)
+	return err == nil && record.OriginalURL == originalURL
+}
+
+// GetURLs retrieves all the URLs of the user from the context that match the filter,
+// oldest first. Unlike ListURLs it does not page the result.
 // It returns a slice of URL objects containing both short and original URLs.
 // If there's an error retrieving URLs from storage, it returns the error.
 //
 // Parameters:
 //   - ctx: The context for the operation
+//   - filter: Narrows down the returned URLs; tags are matched case-insensitively
 //
 // Returns:
 //   - []*URL: Slice of URL objects containing short and original URLs
-//   - error: Storage error or nil on success
-func (s *urlSnipperService) GetURLs(ctx context.Context) ([]*URL, error) {
-	urls, err := s.storage.GetURLs(ctx)
+//   - error: ErrInvalidListQuery if the search is too long, storage error, or nil on success
+func (s *urlSnipperService) GetURLs(ctx context.Context, filter *URLFilter) (_ []*URL, err error) {
+	ctx, span := startSpan(ctx, "GetURLs")
+	defer func() { endSpan(span, err) }()
+
+	if err := validateSearch(filter.Search); err != nil {
+		return nil, err
+	}
+	urls, err := s.storage.GetURLs(ctx, &urlstorage.URLFilter{
+		Tag:    normalizeTag(filter.Tag),
+		Search: filter.Search,
+		Broken: filter.Broken,
+		Sort:   urlstorage.SortCreated,
+	})
 	if err != nil {
 		return nil, err
 	}
 	output := make([]*URL, 0, len(urls))
 	for _, url := range urls {
-		output = append(output, &URL{
-			ShortURL:    url.ShortURL,
-			OriginalURL: url.OriginalURL,
-		})
+		output = append(output, urlFromRecord(url))
 	}
 	return output, nil
 
 }
 
+func (s *urlSnipperService) dump(record *urlstorage.URLRecord) {
+	rec := &dump.URLRecord{
+		UUID:         record.ID,
+		ShortURL:     record.ShortURL,
+		OriginalURL:  record.OriginalURL,
+		UserID:       record.UserID,
+		RedirectType: record.RedirectType,
+		Passthrough:  record.Passthrough,
+		QueryMode:    record.QueryMode,
+		Template:     record.Template,
+		Variants:     variantsToDump(record.Variants),
+		PasswordHash: record.PasswordHash,
+		MaxClicks:    record.MaxClicks,
+		ClicksLeft:   record.ClicksLeft,
+		NotBefore:    record.NotBefore,
+		NotAfter:     record.NotAfter,
+		EndEmitted:   record.EndEmitted,
+		FallbackURL:  record.FallbackURL,
+		Title:        record.Title,
+		Notes:        record.Notes,
+		Tags:         record.Tags,
+		CreatedAt:    record.CreatedAt,
+	}
+
+	err := s.dumper.Add(rec)
+	if err != nil {
+		s.logger.Errorf("failed to dump record: %v", err)
+	}
+}
+
+func urlFromRecord(record *urlstorage.URLRecord) *URL {
+	return &URL{
+		ShortURL:     record.ShortURL,
+		OriginalURL:  record.OriginalURL,
+		RedirectType: record.RedirectType,
+		Passthrough:  record.Passthrough,
+		QueryMode:    record.QueryMode,
+		Template:     record.Template,
+		Variants:     variantsFromRecord(record.Variants),
+		Protected:    record.PasswordHash != "",
+		unlockKey:    unlockKey(record.PasswordHash),
+		MaxClicks:    record.MaxClicks,
+		ClicksLeft:   record.ClicksLeft,
+		NotBefore:    record.NotBefore,
+		NotAfter:     record.NotAfter,
+		FallbackURL:  record.FallbackURL,
+		Title:        record.Title,
+		Notes:        record.Notes,
+		Tags:         append([]string(nil), record.Tags...),
+		CreatedAt:    record.CreatedAt,
+		Clicks:       record.Clicks,
+		Deleted:      record.Deleted,
+		Health:       healthFromRecord(record.Health),
+	}
+}
+
+func healthFromRecord(health *urlstorage.Health) *Health {
+	if health == nil {
+		return nil
+	}
+	return &Health{
+		StatusCode:   health.StatusCode,
+		Latency:      health.Latency,
+		Error:        health.Error,
+		CheckedAt:    health.CheckedAt,
+		FailingSince: health.FailingSince,
+		Broken:       health.Broken,
+	}
+}
+
+func variantsToDump(variants []urlstorage.Variant) []dump.URLVariant {
+	if len(variants) == 0 {
+		return nil
+	}
+	output := make([]dump.URLVariant, 0, len(variants))
+	for _, variant := range variants {
+		output = append(output, dump.URLVariant{Name: variant.Name, URL: variant.URL, Weight: variant.Weight})
+	}
+	return output
+}
+
 var key = middlewares.Key{Key: "userID"}
 
+// audit records the action of the user from the context on the links, if the service
+// has an audit log.
+func (s *urlSnipperService) audit(ctx context.Context, action string, shortURLs ...string) {
+	if s.auditor == nil {
+		return
+	}
+	s.auditor.Record(ctx, action, shortURLs...)
+}
+
 // DeleteURLs asynchronously deletes multiple URLs in batches.
 // It extracts the user ID from the context and processes URL deletions in batches of size defined by batchSize.
 // If the user ID cannot be extracted from the context, the operation is aborted.
@@ -257,7 +738,7 @@ func (s *urlSnipperService) DeleteURLs(ctx context.Context, ids []string) {
 			if end > len(ids) {
 				end = len(ids)
 			}
-			s.deleteService.Delete(userID, ids[i:end])
+			s.deleteService.Delete(ctx, userID, ids[i:end])
 		}
 	}()
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
 	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
 	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
 )
@@ -20,11 +21,11 @@ func BenchmarkSetURL(b *testing.B) {
 		},
 	}
 	storage := &urlStorageMock{
-		SetURLFunc: func(ctx context.Context, id string, url string) (int, error) {
+		SetURLFunc: func(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 			return 1, nil
 		},
 	}
-	service := NewURLSnipperService(storage, hasher, dumper, nil, nil)
+	service := NewURLSnipperService(storage, nil, nil, nil, nil, nil, nil, hasher, dumper, nil, nil, nil, nil, nil, nil)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -39,11 +40,16 @@ func BenchmarkGetURL(b *testing.B) {
 		},
 	}
 	storage := &urlStorageMock{
-		GetURLFunc: func(ctx context.Context, id string) (string, error) {
-			return "http://example.com", nil
+		GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+			return &urlstorage.URLRecord{ShortURL: id, OriginalURL: "http://example.com"}, nil
 		},
 	}
-	service := NewURLSnipperService(storage, hasher, nil, nil, nil)
+	rules := &ruleStorageMock{
+		GetRulesFunc: func(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+			return nil, nil
+		},
+	}
+	service := NewURLSnipperService(storage, nil, rules, nil, nil, nil, nil, hasher, nil, nil, nil, nil, nil, nil, nil)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -67,7 +73,7 @@ func BenchmarkSetURLs(b *testing.B) {
 			return urls, nil
 		},
 	}
-	service := NewURLSnipperService(storage, hasher, dumper, nil, nil)
+	service := NewURLSnipperService(storage, nil, nil, nil, nil, nil, nil, hasher, dumper, nil, nil, nil, nil, nil, nil)
 
 	urls := []*SetURLsInput{
 		{CorrelationID: "1", OriginalURL: "http://example.com"},
@@ -81,27 +87,27 @@ func BenchmarkSetURLs(b *testing.B) {
 
 func BenchmarkGetURLs(b *testing.B) {
 	storage := &urlStorageMock{
-		GetURLsFunc: func(ctx context.Context) ([]*urlstorage.URLRecord, error) {
+		GetURLsFunc: func(ctx context.Context, filter *urlstorage.URLFilter) ([]*urlstorage.URLRecord, error) {
 			return []*urlstorage.URLRecord{
 				{ShortURL: "mockedHash", OriginalURL: "http://example.com"},
 			}, nil
 		},
 	}
-	service := NewURLSnipperService(storage, nil, nil, nil, nil)
+	service := NewURLSnipperService(storage, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, _ = service.GetURLs(context.Background())
+		_, _ = service.GetURLs(context.Background(), &URLFilter{})
 	}
 }
 
 func BenchmarkDeleteURLs(b *testing.B) {
 	deleteService := &deleteServiceMock{
-		DeleteFunc: func(userID string, ids []string) {
+		DeleteFunc: func(ctx context.Context, userID string, ids []string) {
 			// Mock implementation does nothing
 		},
 	}
-	service := NewURLSnipperService(nil, nil, nil, deleteService, nil)
+	service := NewURLSnipperService(nil, nil, nil, nil, nil, nil, nil, nil, nil, deleteService, nil, nil, nil, nil, nil)
 
 	ids := []string{"id1", "id2", "id3"}
 
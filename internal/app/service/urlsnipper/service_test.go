@@ -5,6 +5,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/DanilNaum/SnipURL/internal/app/metrics"
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
 	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +19,7 @@ func TestUrlSnipperService_SetURL(t *testing.T) {
 		url                 string
 		hashResults         []string
 		hashFuncGenerator   func() func(s string) string
-		setURLFuncGenerator func() func(ctx context.Context, id string, url string) (int, error)
+		setURLFuncGenerator func() func(ctx context.Context, record *urlstorage.URLRecord) (int, error)
 		setURLResults       []error
 
 		want    string
@@ -38,9 +42,9 @@ func TestUrlSnipperService_SetURL(t *testing.T) {
 					}
 				}
 			},
-			setURLFuncGenerator: func() func(ctx context.Context, id string, url string) (int, error) {
+			setURLFuncGenerator: func() func(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 				i := 0
-				return func(ctx context.Context, id string, url string) (int, error) {
+				return func(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 					switch i {
 					case 0:
 						i++
@@ -74,9 +78,9 @@ func TestUrlSnipperService_SetURL(t *testing.T) {
 					}
 				}
 			},
-			setURLFuncGenerator: func() func(ctx context.Context, id string, url string) (int, error) {
+			setURLFuncGenerator: func() func(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 				i := 0
-				return func(ctx context.Context, id string, url string) (int, error) {
+				return func(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 					switch i {
 					case 0:
 						i++
@@ -108,9 +112,9 @@ func TestUrlSnipperService_SetURL(t *testing.T) {
 					}
 				}
 			},
-			setURLFuncGenerator: func() func(ctx context.Context, id string, url string) (int, error) {
+			setURLFuncGenerator: func() func(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 				i := 0
-				return func(ctx context.Context, id string, url string) (int, error) {
+				return func(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
 					if i < _maxAttempts {
 						i++
 						return -1, errors.New("collision")
@@ -141,15 +145,24 @@ func TestUrlSnipperService_SetURL(t *testing.T) {
 				},
 			}
 
+			mockAuditor := &auditorMock{
+				RecordFunc: func(ctx context.Context, action string, shortURLs ...string) {
+					require.Equal(t, auditstorage.ActionCreate, action)
+					require.Equal(t, []string{tt.want}, shortURLs)
+				},
+			}
+
 			s := &urlSnipperService{
 				hasher:  mockHasher,
 				storage: mockStorage,
 				dumper:  mockDumper,
+				auditor: mockAuditor,
 			}
 
 			got, err := s.SetURL(context.Background(), tt.url)
 			require.ErrorIs(t, err, tt.wantErr)
 			require.Equal(t, tt.want, got)
+			require.Equal(t, len(mockDumper.AddCalls()), len(mockAuditor.RecordCalls()))
 
 		})
 	}
@@ -158,31 +171,63 @@ func TestUrlSnipperService_GetURL(t *testing.T) {
 	tests := []struct {
 		name                    string
 		id                      string
-		getURLFunc              func(ctx context.Context, id string) (string, error)
+		getURLFunc              func(ctx context.Context, id string) (*urlstorage.URLRecord, error)
 		getURLFuncNumberOfCalls int
-		want                    string
+		want                    *URL
 		wantErr                 error
+		wantRedirect            string
 	}{
 		{
 			name: "successful url retrieval",
 			id:   "abc123",
-			getURLFunc: func(ctx context.Context, id string) (string, error) {
-				return "http://example.com", nil
+			getURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return &urlstorage.URLRecord{ShortURL: id, OriginalURL: "http://example.com", RedirectType: 301}, nil
 
 			},
 			getURLFuncNumberOfCalls: 1,
-			want:                    "http://example.com",
+			want:                    &URL{ShortURL: "abc123", OriginalURL: "http://example.com", RedirectType: 301, Rules: []*Rule{}},
+			wantRedirect:            metrics.RedirectHit,
 		},
 		{
 			name: "storage error",
 			id:   "def456",
-			getURLFunc: func(ctx context.Context, id string) (string, error) {
-				return "", errors.New("storage error")
+			getURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return nil, errors.New("storage error")
 
 			},
 			getURLFuncNumberOfCalls: 1,
 			wantErr:                 ErrFailedToGetURL,
 		},
+		{
+			name: "click limit reached",
+			id:   "ghi789",
+			getURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return &urlstorage.URLRecord{ShortURL: id, OriginalURL: "http://example.com", MaxClicks: 1}, nil
+			},
+			getURLFuncNumberOfCalls: 1,
+			wantErr:                 ErrExhausted,
+			wantRedirect:            metrics.RedirectGone,
+		},
+		{
+			name: "not found",
+			id:   "missing",
+			getURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return nil, urlstorage.ErrNotFound
+			},
+			getURLFuncNumberOfCalls: 1,
+			wantErr:                 ErrFailedToGetURL,
+			wantRedirect:            metrics.RedirectMiss,
+		},
+		{
+			name: "deleted",
+			id:   "deleted",
+			getURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return nil, urlstorage.ErrDeleted
+			},
+			getURLFuncNumberOfCalls: 1,
+			wantErr:                 ErrDeleted,
+			wantRedirect:            metrics.RedirectGone,
+		},
 	}
 
 	for _, tt := range tests {
@@ -191,14 +236,120 @@ func TestUrlSnipperService_GetURL(t *testing.T) {
 				GetURLFunc: tt.getURLFunc,
 			}
 
+			mockRuleStorage := &ruleStorageMock{
+				GetRulesFunc: func(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+					return nil, nil
+				},
+			}
+
+			mockMetrics := &redirectMetricsMock{
+				ObserveRedirectFunc: func(result string) {},
+			}
+
 			s := &urlSnipperService{
-				storage: mockStorage,
+				storage:     mockStorage,
+				ruleStorage: mockRuleStorage,
+				metrics:     mockMetrics,
 			}
 
 			got, err := s.GetURL(context.Background(), tt.id)
 			require.ErrorIs(t, err, tt.wantErr)
 			require.Equal(t, tt.want, got)
 			require.Equal(t, tt.getURLFuncNumberOfCalls, len(mockStorage.GetURLCalls()))
+			if tt.wantRedirect == "" {
+				require.Empty(t, mockMetrics.ObserveRedirectCalls())
+				return
+			}
+			require.Len(t, mockMetrics.ObserveRedirectCalls(), 1)
+			require.Equal(t, tt.wantRedirect, mockMetrics.ObserveRedirectCalls()[0].Result)
+		})
+	}
+}
+
+func TestUrlSnipperService_UpdateURL(t *testing.T) {
+	tests := []struct {
+		name                       string
+		userID                     string
+		opts                       []Option
+		getURLFunc                 func(ctx context.Context, id string) (*urlstorage.URLRecord, error)
+		updateURLFuncNumberOfCalls int
+		want                       *URL
+		wantErr                    error
+	}{
+		{
+			name:   "successful update",
+			userID: "user",
+			opts:   []Option{WithRedirectType(308)},
+			getURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return &urlstorage.URLRecord{ShortURL: id, OriginalURL: "http://example.com", UserID: "user"}, nil
+			},
+			updateURLFuncNumberOfCalls: 1,
+			want:                       &URL{ShortURL: "abc123", OriginalURL: "http://example.com", RedirectType: 308},
+		},
+		{
+			name:   "other user's url",
+			userID: "user",
+			opts:   []Option{WithRedirectType(308)},
+			getURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return &urlstorage.URLRecord{ShortURL: id, OriginalURL: "http://example.com", UserID: "other"}, nil
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:   "deleted url",
+			userID: "user",
+			opts:   []Option{WithRedirectType(308)},
+			getURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return nil, urlstorage.ErrDeleted
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:   "invalid redirect type",
+			userID: "user",
+			opts:   []Option{WithRedirectType(200)},
+			getURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return &urlstorage.URLRecord{ShortURL: id, OriginalURL: "http://example.com", UserID: "user"}, nil
+			},
+			wantErr: ErrInvalidRedirectType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage := &urlStorageMock{
+				GetURLFunc: tt.getURLFunc,
+				UpdateURLFunc: func(ctx context.Context, record *urlstorage.URLRecord) error {
+					return nil
+				},
+			}
+
+			mockDumper := &dumperMock{
+				AddFunc: func(record *dump.URLRecord) error {
+					return nil
+				},
+			}
+
+			mockAuditor := &auditorMock{
+				RecordFunc: func(ctx context.Context, action string, shortURLs ...string) {
+					require.Equal(t, auditstorage.ActionUpdate, action)
+					require.Equal(t, []string{"abc123"}, shortURLs)
+				},
+			}
+
+			s := &urlSnipperService{
+				storage: mockStorage,
+				dumper:  mockDumper,
+				auditor: mockAuditor,
+			}
+
+			ctx := context.WithValue(context.Background(), key, tt.userID)
+			got, err := s.UpdateURL(ctx, "abc123", tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.updateURLFuncNumberOfCalls, len(mockStorage.UpdateURLCalls()))
+			require.Equal(t, tt.updateURLFuncNumberOfCalls, len(mockDumper.AddCalls()))
+			require.Equal(t, tt.updateURLFuncNumberOfCalls, len(mockAuditor.RecordCalls()))
 		})
 	}
 }
@@ -0,0 +1,84 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// checkTemplate verifies that the user owns a template with the given name.
+// An empty name means the link has no template and is always accepted.
+func (s *urlSnipperService) checkTemplate(ctx context.Context, userID, name string) error {
+	if name == "" {
+		return nil
+	}
+	_, err := s.templateStorage.GetTemplate(ctx, userID, name)
+	if errors.Is(err, templatestorage.ErrNotFound) {
+		return ErrTemplateNotFound
+	}
+	return err
+}
+
+// applyTemplate adds the UTM parameters of the link owner's template to the original URL
+// and to every variant URL and rule target of the link.
+// Links whose template was deleted redirect to their targets unchanged.
+func (s *urlSnipperService) applyTemplate(ctx context.Context, record *urlstorage.URLRecord, link *URL) error {
+	if record.Template == "" {
+		return nil
+	}
+
+	template, err := s.templateStorage.GetTemplate(ctx, record.UserID, record.Template)
+	if err != nil {
+		if errors.Is(err, templatestorage.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("%w: %w", ErrFailedToGetURL, err)
+	}
+
+	link.OriginalURL, err = tagURL(link.OriginalURL, template)
+	if err != nil {
+		return err
+	}
+	for _, variant := range link.Variants {
+		variant.URL, err = tagURL(variant.URL, template)
+		if err != nil {
+			return err
+		}
+	}
+	for _, rule := range link.Rules {
+		rule.TargetURL, err = tagURL(rule.TargetURL, template)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tagURL sets the non-empty UTM parameters of the template on rawURL,
+// replacing parameters with the same name that are already present.
+func tagURL(rawURL string, template *templatestorage.Template) (string, error) {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := target.Query()
+	for key, value := range map[string]string{
+		"utm_source":   template.Source,
+		"utm_medium":   template.Medium,
+		"utm_campaign": template.Campaign,
+		"utm_term":     template.Term,
+		"utm_content":  template.Content,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	target.RawQuery = query.Encode()
+
+	return target.String(), nil
+}
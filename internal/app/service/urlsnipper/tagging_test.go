@@ -0,0 +1,120 @@
+package urlsnipper
+
+import (
+	"context"
+	"testing"
+
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		template *templatestorage.Template
+		want     string
+	}{
+		{
+			name:     "adds parameters",
+			url:      "https://example.com/page",
+			template: &templatestorage.Template{Source: "newsletter", Medium: "email", Campaign: "spring"},
+			want:     "https://example.com/page?utm_campaign=spring&utm_medium=email&utm_source=newsletter",
+		},
+		{
+			name:     "overrides existing parameters and keeps others",
+			url:      "https://example.com/page?utm_source=old&ref=1",
+			template: &templatestorage.Template{Source: "newsletter"},
+			want:     "https://example.com/page?ref=1&utm_source=newsletter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tagURL(tt.url, tt.template)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUrlSnipperService_GetURL_Template(t *testing.T) {
+	tests := []struct {
+		name       string
+		template   *templatestorage.Template
+		want       string
+		wantTarget string
+	}{
+		{
+			name:       "template applied",
+			template:   &templatestorage.Template{Name: "spring", UserID: "user", Source: "newsletter"},
+			want:       "http://example.com?utm_source=newsletter",
+			wantTarget: "https://apps.apple.com/app?utm_source=newsletter",
+		},
+		{
+			name:       "template deleted",
+			want:       "http://example.com",
+			wantTarget: "https://apps.apple.com/app",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage := &urlStorageMock{
+				GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+					return &urlstorage.URLRecord{ShortURL: id, OriginalURL: "http://example.com", UserID: "user", Template: "spring"}, nil
+				},
+			}
+			mockTemplateStorage := &templateStorageMock{
+				GetTemplateFunc: func(ctx context.Context, userID, name string) (*templatestorage.Template, error) {
+					require.Equal(t, "user", userID)
+					require.Equal(t, "spring", name)
+					if tt.template == nil {
+						return nil, templatestorage.ErrNotFound
+					}
+					return tt.template, nil
+				},
+			}
+
+			mockRuleStorage := &ruleStorageMock{
+				GetRulesFunc: func(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+					return []*rulestorage.Rule{{ID: 1, ShortURL: shortURL, TargetURL: "https://apps.apple.com/app"}}, nil
+				},
+			}
+
+			s := &urlSnipperService{
+				storage:         mockStorage,
+				templateStorage: mockTemplateStorage,
+				ruleStorage:     mockRuleStorage,
+			}
+
+			got, err := s.GetURL(context.Background(), "abc123")
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.OriginalURL)
+			require.Equal(t, tt.wantTarget, got.Rules[0].TargetURL)
+			require.Equal(t, "spring", got.Template)
+		})
+	}
+}
+
+func TestUrlSnipperService_SetURL_UnknownTemplate(t *testing.T) {
+	mockStorage := &urlStorageMock{}
+	mockTemplateStorage := &templateStorageMock{
+		GetTemplateFunc: func(ctx context.Context, userID, name string) (*templatestorage.Template, error) {
+			return nil, templatestorage.ErrNotFound
+		},
+	}
+
+	s := &urlSnipperService{
+		storage:         mockStorage,
+		templateStorage: mockTemplateStorage,
+	}
+
+	ctx := context.WithValue(context.Background(), key, "user")
+	_, err := s.SetURL(ctx, "http://example.com", WithTemplate("missing"))
+	require.ErrorIs(t, err, ErrTemplateNotFound)
+	require.ErrorIs(t, err, ErrInvalidOption)
+	require.Empty(t, mockStorage.SetURLCalls())
+}
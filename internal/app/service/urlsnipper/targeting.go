@@ -0,0 +1,229 @@
+package urlsnipper
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Platforms recognised in the platform condition of a routing rule.
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+	PlatformWindows = "windows"
+	PlatformMacOS   = "macos"
+	PlatformLinux   = "linux"
+)
+
+const ruleTimeLayout = "15:04"
+
+// CountryEU matches any client located in a European Union member state
+// in the country condition of a routing rule.
+const CountryEU = "EU"
+
+// Client describes the request attributes that routing rules are evaluated against.
+// Country is the upper-case ISO code of the client's location and stays empty
+// when GeoIP lookups are disabled or the address is unknown.
+type Client struct {
+	Platform  string
+	Languages []string
+	Country   string
+	InEU      bool
+	Time      time.Time
+}
+
+// NewClient builds a Client from the User-Agent and Accept-Language headers of a request.
+// Languages are lower-cased and ordered by their quality value; the wildcard and
+// languages with q=0 are skipped.
+func NewClient(userAgent, acceptLanguage string, now time.Time) *Client {
+	return &Client{
+		Platform:  detectPlatform(userAgent),
+		Languages: parseAcceptLanguage(acceptLanguage),
+		Time:      now,
+	}
+}
+
+// ApplyRules returns the link with OriginalURL replaced by the target of the first
+// rule matching the client and reports whether a rule matched.
+// If no rule matches, the link is returned unchanged.
+func ApplyRules(link *URL, client *Client) (*URL, bool) {
+	for _, rule := range link.Rules {
+		if rule.matches(client) {
+			routed := *link
+			routed.OriginalURL = rule.TargetURL
+			return &routed, true
+		}
+	}
+	return link, false
+}
+
+func (r *Rule) matches(client *Client) bool {
+	if r.Platform != "" && r.Platform != client.Platform {
+		return false
+	}
+	if r.Language != "" && !matchesLanguage(r.Language, client.Languages) {
+		return false
+	}
+	if r.Country != "" && !matchesCountry(r.Country, client) {
+		return false
+	}
+	if r.TimeFrom != "" && !inTimeWindow(r.TimeFrom, r.TimeTo, client.Time) {
+		return false
+	}
+	return true
+}
+
+// matchesLanguage reports whether any accepted language equals want or is a
+// subtag of it, so "en" matches "en-us".
+func matchesLanguage(want string, languages []string) bool {
+	want = strings.ToLower(want)
+	for _, language := range languages {
+		if language == want || strings.HasPrefix(language, want+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesCountry reports whether the client is located in one of the
+// comma-separated countries; CountryEU matches every EU member state.
+func matchesCountry(want string, client *Client) bool {
+	if client.Country == "" {
+		return false
+	}
+	for _, country := range strings.Split(want, ",") {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		if country == client.Country || (country == CountryEU && client.InEU) {
+			return true
+		}
+	}
+	return false
+}
+
+func inTimeWindow(from, to string, now time.Time) bool {
+	start, err := time.Parse(ruleTimeLayout, from)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(ruleTimeLayout, to)
+	if err != nil {
+		return false
+	}
+
+	now = now.UTC()
+	minute := now.Hour()*60 + now.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+
+	if startMinute <= endMinute {
+		return minute >= startMinute && minute < endMinute
+	}
+	return minute >= startMinute || minute < endMinute
+}
+
+func detectPlatform(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	switch {
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"), strings.Contains(ua, "ipod"):
+		return PlatformIOS
+	case strings.Contains(ua, "android"):
+		return PlatformAndroid
+	case strings.Contains(ua, "windows"):
+		return PlatformWindows
+	case strings.Contains(ua, "macintosh"), strings.Contains(ua, "mac os x"):
+		return PlatformMacOS
+	case strings.Contains(ua, "linux"):
+		return PlatformLinux
+	default:
+		return ""
+	}
+}
+
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		language string
+		quality  float64
+	}
+
+	parts := strings.Split(header, ",")
+	languages := make([]weighted, 0, len(parts))
+	for _, part := range parts {
+		language, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		language = strings.ToLower(strings.TrimSpace(language))
+		if language == "" || language == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		languages = append(languages, weighted{language: language, quality: quality})
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+
+	output := make([]string, 0, len(languages))
+	for _, l := range languages {
+		output = append(output, l.language)
+	}
+	return output
+}
+
+func validateRule(rule *Rule) error {
+	switch rule.Platform {
+	case "", PlatformIOS, PlatformAndroid, PlatformWindows, PlatformMacOS, PlatformLinux:
+	default:
+		return ErrInvalidRule
+	}
+
+	if rule.Country != "" {
+		for _, country := range strings.Split(rule.Country, ",") {
+			if !isCountryCode(strings.TrimSpace(country)) {
+				return ErrInvalidRule
+			}
+		}
+	}
+
+	if (rule.TimeFrom == "") != (rule.TimeTo == "") {
+		return ErrInvalidRule
+	}
+	if rule.TimeFrom != "" {
+		if _, err := time.Parse(ruleTimeLayout, rule.TimeFrom); err != nil {
+			return ErrInvalidRule
+		}
+		if _, err := time.Parse(ruleTimeLayout, rule.TimeTo); err != nil {
+			return ErrInvalidRule
+		}
+	}
+
+	target, err := url.Parse(rule.TargetURL)
+	if err != nil || target.Scheme == "" || target.Host == "" {
+		return ErrInvalidRule
+	}
+	return nil
+}
+
+func isCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, c := range code {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
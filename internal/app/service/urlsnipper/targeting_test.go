@@ -0,0 +1,154 @@
+package urlsnipper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		userAgent      string
+		acceptLanguage string
+		want           *Client
+	}{
+		{
+			name:           "iphone",
+			userAgent:      "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15",
+			acceptLanguage: "ru-RU,ru;q=0.9,en;q=0.8",
+			want:           &Client{Platform: PlatformIOS, Languages: []string{"ru-ru", "ru", "en"}, Time: now},
+		},
+		{
+			name:           "android ordered by quality",
+			userAgent:      "Mozilla/5.0 (Linux; Android 14; Pixel 8)",
+			acceptLanguage: "de;q=0.5, en-US, *;q=0.1, fr;q=0",
+			want:           &Client{Platform: PlatformAndroid, Languages: []string{"en-us", "de"}, Time: now},
+		},
+		{
+			name:      "unknown platform",
+			userAgent: "curl/8.0",
+			want:      &Client{Platform: "", Languages: []string{}, Time: now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, NewClient(tt.userAgent, tt.acceptLanguage, now))
+		})
+	}
+}
+
+func TestApplyRules(t *testing.T) {
+	link := &URL{
+		ShortURL:    "abc123",
+		OriginalURL: "https://example.com",
+		Rules: []*Rule{
+			{ID: 1, Platform: PlatformIOS, TargetURL: "https://apps.apple.com/app"},
+			{ID: 2, Platform: PlatformAndroid, TargetURL: "https://play.google.com/app"},
+			{ID: 3, Language: "de", TargetURL: "https://example.de"},
+			{ID: 4, TimeFrom: "22:00", TimeTo: "06:00", TargetURL: "https://example.com/night"},
+			{ID: 5, Country: "us,CA", TargetURL: "https://example.com/na"},
+			{ID: 6, Country: CountryEU, TargetURL: "https://example.eu"},
+		},
+	}
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	midnight := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		client *Client
+		want   string
+	}{
+		{
+			name:   "ios",
+			client: &Client{Platform: PlatformIOS, Languages: []string{"de"}, Time: noon},
+			want:   "https://apps.apple.com/app",
+		},
+		{
+			name:   "android",
+			client: &Client{Platform: PlatformAndroid, Time: noon},
+			want:   "https://play.google.com/app",
+		},
+		{
+			name:   "language subtag",
+			client: &Client{Platform: PlatformWindows, Languages: []string{"de-at"}, Time: noon},
+			want:   "https://example.de",
+		},
+		{
+			name:   "time window over midnight",
+			client: &Client{Platform: PlatformLinux, Time: midnight},
+			want:   "https://example.com/night",
+		},
+		{
+			name:   "country list",
+			client: &Client{Platform: PlatformLinux, Country: "CA", Time: noon},
+			want:   "https://example.com/na",
+		},
+		{
+			name:   "eu group",
+			client: &Client{Platform: PlatformLinux, Country: "FR", InEU: true, Time: noon},
+			want:   "https://example.eu",
+		},
+		{
+			name:   "fallback",
+			client: &Client{Platform: PlatformLinux, Languages: []string{"en"}, Country: "GB", Time: noon},
+			want:   "https://example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, matched := ApplyRules(link, tt.client)
+			require.Equal(t, tt.want, got.OriginalURL)
+			require.Equal(t, tt.want != link.OriginalURL, matched)
+			require.Equal(t, "https://example.com", link.OriginalURL)
+		})
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    *Rule
+		wantErr error
+	}{
+		{
+			name: "valid",
+			rule: &Rule{Platform: PlatformIOS, Country: "US, EU", TimeFrom: "09:00", TimeTo: "18:00", TargetURL: "https://example.com"},
+		},
+		{
+			name:    "unknown platform",
+			rule:    &Rule{Platform: "symbian", TargetURL: "https://example.com"},
+			wantErr: ErrInvalidRule,
+		},
+		{
+			name:    "malformed country",
+			rule:    &Rule{Country: "US,USA", TargetURL: "https://example.com"},
+			wantErr: ErrInvalidRule,
+		},
+		{
+			name:    "half-open time window",
+			rule:    &Rule{TimeFrom: "09:00", TargetURL: "https://example.com"},
+			wantErr: ErrInvalidRule,
+		},
+		{
+			name:    "malformed time",
+			rule:    &Rule{TimeFrom: "9am", TimeTo: "18:00", TargetURL: "https://example.com"},
+			wantErr: ErrInvalidRule,
+		},
+		{
+			name:    "relative target",
+			rule:    &Rule{TargetURL: "/path"},
+			wantErr: ErrInvalidRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, validateRule(tt.rule), tt.wantErr)
+		})
+	}
+}
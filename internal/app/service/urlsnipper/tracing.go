@@ -0,0 +1,26 @@
+package urlsnipper
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+
+// startSpan starts the span of a service method, a child of the request span in ctx.
+func startSpan(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, "urlsnipper."+method, trace.WithAttributes(attrs...))
+}
+
+// endSpan ends the span of a service method, recording the error it returned.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,160 @@
+package urlsnipper
+
+import (
+	"context"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// UserDataIterator walks the links of a UserData, reading each with its rules and
+// statistics as it advances. Next advances to the next link and reports whether there
+// is one, Err reports the error that stopped the iteration, and Close must be called
+// once the iterator is no longer needed.
+type UserDataIterator interface {
+	Next() bool
+	Link() *UserDataLink
+	Err() error
+	Close()
+}
+
+type userDataIterator struct {
+	ctx     context.Context
+	service *urlSnipperService
+	records urlstorage.URLIterator
+	link    *UserDataLink
+	err     error
+}
+
+// ExportUserData returns everything stored about the user from the context: their
+// tagging templates, webhooks, audit log entries and all their links, the deleted ones
+// included, with the links' rules, variant served counters and click statistics.
+// Returns ErrNotFound if the context carries no user ID.
+func (s *urlSnipperService) ExportUserData(ctx context.Context) (_ *UserData, err error) {
+	ctx, span := startSpan(ctx, "ExportUserData")
+	defer func() { endSpan(span, err) }()
+
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	templates, err := s.templateStorage.GetTemplates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	data := &UserData{
+		UserID:    userID,
+		Templates: make([]*Template, 0, len(templates)),
+	}
+	for _, template := range templates {
+		data.Templates = append(data.Templates, &Template{
+			Name:     template.Name,
+			Source:   template.Source,
+			Medium:   template.Medium,
+			Campaign: template.Campaign,
+			Term:     template.Term,
+			Content:  template.Content,
+		})
+	}
+
+	webhooks, err := s.webhookStorage.GetWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	data.Webhooks = make([]*Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		data.Webhooks = append(data.Webhooks, &Webhook{
+			ID:             webhook.ID,
+			URL:            webhook.URL,
+			Events:         webhook.Events,
+			ClickThreshold: webhook.ClickThreshold,
+			CreatedAt:      webhook.CreatedAt,
+		})
+	}
+
+	entries, err := s.auditStorage.GetEntries(ctx, &auditstorage.Filter{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	data.AuditEntries = make([]*AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		data.AuditEntries = append(data.AuditEntries, &AuditEntry{
+			ID:         entry.ID,
+			Action:     entry.Action,
+			ShortURL:   entry.ShortURL,
+			IP:         entry.IP,
+			AuthMethod: entry.AuthMethod,
+			CreatedAt:  entry.CreatedAt,
+		})
+	}
+
+	records, err := s.storage.IterateURLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.Links = &userDataIterator{ctx: ctx, service: s, records: records}
+	return data, nil
+}
+
+func (it *userDataIterator) Next() bool {
+	it.link = nil
+	if it.err != nil || !it.records.Next() {
+		return false
+	}
+	link, err := it.service.userDataLink(it.ctx, it.records.Record())
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.link = link
+	return true
+}
+
+func (it *userDataIterator) Link() *UserDataLink {
+	return it.link
+}
+
+func (it *userDataIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.records.Err()
+}
+
+func (it *userDataIterator) Close() {
+	it.records.Close()
+}
+
+// userDataLink loads the rules and statistics of the link.
+func (s *urlSnipperService) userDataLink(ctx context.Context, record *urlstorage.URLRecord) (*UserDataLink, error) {
+	link := &UserDataLink{URL: urlFromRecord(record)}
+
+	rules, err := s.ruleStorage.GetRules(ctx, record.ShortURL)
+	if err != nil {
+		return nil, err
+	}
+	link.URL.Rules = make([]*Rule, 0, len(rules))
+	for _, rule := range rules {
+		link.URL.Rules = append(link.URL.Rules, ruleFromRecord(rule))
+	}
+
+	served, err := s.variantStorage.GetServed(ctx, record.ShortURL)
+	if err != nil {
+		return nil, err
+	}
+	link.VariantStats = make([]*VariantStats, 0, len(record.Variants))
+	for _, variant := range record.Variants {
+		link.VariantStats = append(link.VariantStats, &VariantStats{
+			Variant: Variant{Name: variant.Name, URL: variant.URL, Weight: variant.Weight},
+			Served:  served[variant.Name],
+		})
+	}
+
+	clicks, err := s.clickStorage.GetClickStats(ctx, record.ShortURL)
+	if err != nil {
+		return nil, err
+	}
+	link.ClickStats = &ClickStats{Total: clicks.Total, ByCountry: clicks.ByCountry}
+	return link, nil
+}
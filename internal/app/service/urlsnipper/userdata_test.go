@@ -0,0 +1,126 @@
+package urlsnipper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
+	clickstorage "github.com/DanilNaum/SnipURL/internal/app/repository/click"
+	rulestorage "github.com/DanilNaum/SnipURL/internal/app/repository/rule"
+	templatestorage "github.com/DanilNaum/SnipURL/internal/app/repository/template"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUrlSnipperService_ExportUserData(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := &sliceURLIterator{
+		records: []*urlstorage.URLRecord{
+			{
+				ShortURL:    "a",
+				OriginalURL: "https://example.com/a",
+				Variants:    []urlstorage.Variant{{Name: "x", URL: "https://example.com/x", Weight: 1}},
+			},
+			{ShortURL: "b", OriginalURL: "https://example.com/b", Deleted: true},
+		},
+	}
+	s := &urlSnipperService{
+		storage: &urlStorageMock{
+			IterateURLsFunc: func(ctx context.Context) (urlstorage.URLIterator, error) {
+				return records, nil
+			},
+		},
+		templateStorage: &templateStorageMock{
+			GetTemplatesFunc: func(ctx context.Context, userID string) ([]*templatestorage.Template, error) {
+				require.Equal(t, "user", userID)
+				return []*templatestorage.Template{{Name: "spring", UserID: userID, Source: "newsletter"}}, nil
+			},
+		},
+		ruleStorage: &ruleStorageMock{
+			GetRulesFunc: func(ctx context.Context, shortURL string) ([]*rulestorage.Rule, error) {
+				if shortURL != "a" {
+					return nil, nil
+				}
+				return []*rulestorage.Rule{{ID: 1, ShortURL: "a", TargetURL: "https://example.com/ios"}}, nil
+			},
+		},
+		variantStorage: &variantStorageMock{
+			GetServedFunc: func(ctx context.Context, shortURL string) (map[string]int, error) {
+				return map[string]int{"x": 4}, nil
+			},
+		},
+		clickStorage: &clickStorageMock{
+			GetClickStatsFunc: func(ctx context.Context, shortURL string) (*clickstorage.ClickStats, error) {
+				return &clickstorage.ClickStats{Total: 2, ByCountry: map[string]int{"DE": 2}}, nil
+			},
+		},
+		webhookStorage: &webhookStorageMock{
+			GetWebhooksFunc: func(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+				require.Equal(t, "user", userID)
+				return []*webhookstorage.Webhook{{ID: "hook", UserID: userID, URL: "https://example.com/hook", Secret: "secret", Events: []string{"link.created"}, CreatedAt: createdAt}}, nil
+			},
+		},
+		auditStorage: &auditStorageMock{
+			GetEntriesFunc: func(ctx context.Context, filter *auditstorage.Filter) ([]*auditstorage.Entry, error) {
+				require.Equal(t, &auditstorage.Filter{UserID: "user"}, filter)
+				return []*auditstorage.Entry{{ID: 7, UserID: "user", Action: auditstorage.ActionCreate, ShortURL: "a", IP: "192.0.2.1", AuthMethod: auditstorage.AuthCookie, CreatedAt: createdAt}}, nil
+			},
+		},
+	}
+
+	_, err := s.ExportUserData(context.Background())
+	require.ErrorIs(t, err, ErrNotFound)
+
+	data, err := s.ExportUserData(context.WithValue(context.Background(), key, "user"))
+	require.NoError(t, err)
+	require.Equal(t, "user", data.UserID)
+	require.Equal(t, []*Template{{Name: "spring", Source: "newsletter"}}, data.Templates)
+	require.Equal(t, []*Webhook{{ID: "hook", URL: "https://example.com/hook", Events: []string{"link.created"}, CreatedAt: createdAt}}, data.Webhooks)
+	require.Equal(t, []*AuditEntry{{ID: 7, Action: auditstorage.ActionCreate, ShortURL: "a", IP: "192.0.2.1", AuthMethod: auditstorage.AuthCookie, CreatedAt: createdAt}}, data.AuditEntries)
+
+	var links []*UserDataLink
+	for data.Links.Next() {
+		links = append(links, data.Links.Link())
+	}
+	require.NoError(t, data.Links.Err())
+	data.Links.Close()
+	require.True(t, records.closed)
+
+	require.Len(t, links, 2)
+	require.Equal(t, "https://example.com/ios", links[0].URL.Rules[0].TargetURL)
+	require.Equal(t, 4, links[0].VariantStats[0].Served)
+	require.Equal(t, 2, links[0].ClickStats.Total)
+	require.True(t, links[1].URL.Deleted)
+	require.Empty(t, links[1].VariantStats)
+}
+
+func TestUrlSnipperService_DumpUserID(t *testing.T) {
+	dumper := &dumperMock{AddFunc: func(record *dump.URLRecord) error { return nil }}
+	s := &urlSnipperService{
+		storage: &urlStorageMock{
+			SetURLFunc: func(ctx context.Context, record *urlstorage.URLRecord) (int, error) {
+				return 1, nil
+			},
+			SetURLsFunc: func(ctx context.Context, urls []*urlstorage.URLRecord) ([]*urlstorage.URLRecord, error) {
+				return urls, nil
+			},
+		},
+		hasher: &hasherMock{HashFunc: func(s string) string { return "hash" }},
+		dumper: dumper,
+	}
+	ctx := context.WithValue(context.Background(), key, "user")
+
+	_, err := s.SetURL(ctx, "https://example.com/a")
+	require.NoError(t, err)
+	_, err = s.SetURLs(ctx, []*SetURLsInput{{CorrelationID: "1", OriginalURL: "https://example.com/b", Alias: "spring-sale"}})
+	require.NoError(t, err)
+
+	calls := dumper.AddCalls()
+	require.Len(t, calls, 2)
+	for _, call := range calls {
+		require.Equal(t, "user", call.Record.UserID)
+	}
+}
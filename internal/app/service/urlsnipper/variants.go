@@ -0,0 +1,122 @@
+package urlsnipper
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/url"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+)
+
+// SelectVariant picks the A/B split variant to serve and returns the link with
+// OriginalURL replaced by the variant's URL together with the variant name.
+// A sticky name from a previous visit is kept while the link still has that variant;
+// otherwise the variant is drawn at random in proportion to the weights.
+// Links without variants are returned unchanged with an empty name.
+func SelectVariant(link *URL, sticky string) (*URL, string) {
+	if len(link.Variants) == 0 {
+		return link, ""
+	}
+
+	variant := pickVariant(link.Variants, sticky, rand.IntN)
+
+	routed := *link
+	routed.OriginalURL = variant.URL
+	return &routed, variant.Name
+}
+
+func pickVariant(variants []*Variant, sticky string, intn func(n int) int) *Variant {
+	total := 0
+	for _, variant := range variants {
+		if variant.Name == sticky {
+			return variant
+		}
+		total += variant.Weight
+	}
+
+	n := intn(total)
+	for _, variant := range variants {
+		if n < variant.Weight {
+			return variant
+		}
+		n -= variant.Weight
+	}
+	return variants[len(variants)-1]
+}
+
+// RecordVariant counts one visit served by the named variant of the short URL.
+// Failures are logged and do not affect the redirect.
+func (s *urlSnipperService) RecordVariant(ctx context.Context, id, variant string) {
+	err := s.variantStorage.IncrementServed(ctx, id, variant)
+	if err != nil {
+		s.logger.ErrorfContext(ctx, "failed to record variant %s of %s: %v", variant, id, err)
+	}
+}
+
+// GetVariantStats reports how many times each variant of a short URL owned by the
+// user from the context was served. Variants are returned in the link's order.
+//
+// Returns:
+//   - []*VariantStats: Per-variant counters, empty for links without variants
+//   - error: ErrNotFound if the URL does not exist or belongs to another user, storage error, or nil on success
+func (s *urlSnipperService) GetVariantStats(ctx context.Context, id string) ([]*VariantStats, error) {
+	record, err := s.getOwnedRecord(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	served, err := s.variantStorage.GetServed(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*VariantStats, 0, len(record.Variants))
+	for _, variant := range record.Variants {
+		stats = append(stats, &VariantStats{
+			Variant: Variant{Name: variant.Name, URL: variant.URL, Weight: variant.Weight},
+			Served:  served[variant.Name],
+		})
+	}
+	return stats, nil
+}
+
+func validateVariants(variants []urlstorage.Variant) error {
+	names := make(map[string]struct{}, len(variants))
+	for _, variant := range variants {
+		if variant.Name == "" || variant.Weight <= 0 {
+			return ErrInvalidVariants
+		}
+		if _, ok := names[variant.Name]; ok {
+			return ErrInvalidVariants
+		}
+		names[variant.Name] = struct{}{}
+
+		target, err := url.Parse(variant.URL)
+		if err != nil || target.Scheme == "" || target.Host == "" {
+			return ErrInvalidVariants
+		}
+	}
+	return nil
+}
+
+func variantsToRecord(variants []*Variant) []urlstorage.Variant {
+	if len(variants) == 0 {
+		return nil
+	}
+	output := make([]urlstorage.Variant, 0, len(variants))
+	for _, variant := range variants {
+		output = append(output, urlstorage.Variant{Name: variant.Name, URL: variant.URL, Weight: variant.Weight})
+	}
+	return output
+}
+
+func variantsFromRecord(variants []urlstorage.Variant) []*Variant {
+	if len(variants) == 0 {
+		return nil
+	}
+	output := make([]*Variant, 0, len(variants))
+	for _, variant := range variants {
+		output = append(output, &Variant{Name: variant.Name, URL: variant.URL, Weight: variant.Weight})
+	}
+	return output
+}
@@ -0,0 +1,126 @@
+package urlsnipper
+
+import (
+	"context"
+	"testing"
+
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickVariant(t *testing.T) {
+	variants := []*Variant{
+		{Name: "a", URL: "https://a.example.com", Weight: 1},
+		{Name: "b", URL: "https://b.example.com", Weight: 3},
+	}
+
+	tests := []struct {
+		name   string
+		sticky string
+		draw   int
+		want   string
+	}{
+		{name: "first bucket", draw: 0, want: "a"},
+		{name: "second bucket start", draw: 1, want: "b"},
+		{name: "second bucket end", draw: 3, want: "b"},
+		{name: "sticky variant kept", sticky: "a", draw: 3, want: "a"},
+		{name: "unknown sticky variant redrawn", sticky: "c", draw: 2, want: "b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pickVariant(variants, tt.sticky, func(n int) int {
+				require.Equal(t, 4, n)
+				return tt.draw
+			})
+			require.Equal(t, tt.want, got.Name)
+		})
+	}
+}
+
+func TestSelectVariant(t *testing.T) {
+	link := &URL{ShortURL: "abc123", OriginalURL: "https://example.com"}
+	got, variant := SelectVariant(link, "")
+	require.Same(t, link, got)
+	require.Empty(t, variant)
+
+	link.Variants = []*Variant{{Name: "a", URL: "https://a.example.com", Weight: 1}}
+	got, variant = SelectVariant(link, "")
+	require.Equal(t, "https://a.example.com", got.OriginalURL)
+	require.Equal(t, "a", variant)
+	require.Equal(t, "https://example.com", link.OriginalURL)
+}
+
+func TestValidateVariants(t *testing.T) {
+	tests := []struct {
+		name     string
+		variants []urlstorage.Variant
+		wantErr  error
+	}{
+		{
+			name: "valid",
+			variants: []urlstorage.Variant{
+				{Name: "a", URL: "https://a.example.com", Weight: 1},
+				{Name: "b", URL: "https://b.example.com", Weight: 2},
+			},
+		},
+		{
+			name: "duplicate name",
+			variants: []urlstorage.Variant{
+				{Name: "a", URL: "https://a.example.com", Weight: 1},
+				{Name: "a", URL: "https://b.example.com", Weight: 2},
+			},
+			wantErr: ErrInvalidVariants,
+		},
+		{
+			name:     "zero weight",
+			variants: []urlstorage.Variant{{Name: "a", URL: "https://a.example.com"}},
+			wantErr:  ErrInvalidVariants,
+		},
+		{
+			name:     "relative url",
+			variants: []urlstorage.Variant{{Name: "a", URL: "/landing", Weight: 1}},
+			wantErr:  ErrInvalidVariants,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVariants(tt.variants)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUrlSnipperService_GetVariantStats(t *testing.T) {
+	mockStorage := &urlStorageMock{
+		GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+			return &urlstorage.URLRecord{ShortURL: id, UserID: "user", Variants: []urlstorage.Variant{
+				{Name: "a", URL: "https://a.example.com", Weight: 1},
+				{Name: "b", URL: "https://b.example.com", Weight: 1},
+			}}, nil
+		},
+	}
+	mockVariantStorage := &variantStorageMock{
+		GetServedFunc: func(ctx context.Context, shortURL string) (map[string]int, error) {
+			return map[string]int{"a": 5}, nil
+		},
+	}
+
+	s := &urlSnipperService{
+		storage:        mockStorage,
+		variantStorage: mockVariantStorage,
+	}
+
+	ctx := context.WithValue(context.Background(), key, "user")
+	got, err := s.GetVariantStats(ctx, "abc123")
+	require.NoError(t, err)
+	require.Equal(t, []*VariantStats{
+		{Variant: Variant{Name: "a", URL: "https://a.example.com", Weight: 1}, Served: 5},
+		{Variant: Variant{Name: "b", URL: "https://b.example.com", Weight: 1}, Served: 0},
+	}, got)
+
+	ctx = context.WithValue(context.Background(), key, "other")
+	_, err = s.GetVariantStats(ctx, "abc123")
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,50 @@
+package urlsnipper
+
+import (
+	"context"
+	"time"
+)
+
+// windowEndBatchSize is the number of links whose window end is emitted at once.
+const windowEndBatchSize = 100
+
+// RunWindowEnds emits the expiries of the links whose active window is over, every
+// interval until the context is done. The storage marks the links and writes their
+// expiries to the outbox together, so that the end of every window is emitted once,
+// whether the link is requested after it or not, and across restarts. The marked
+// links are dumped.
+//
+// Parameters:
+//   - ctx: The context stopping the scheduler
+//   - interval: The time between two runs
+func (s *urlSnipperService) RunWindowEnds(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.endWindows(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// endWindows emits the ends of all the windows that are over, a batch at a time.
+func (s *urlSnipperService) endWindows(ctx context.Context) {
+	for ctx.Err() == nil {
+		records, err := s.storage.EndWindows(ctx, time.Now(), windowEndBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Errorf("failed to emit the ends of link windows: %v", err)
+			}
+			return
+		}
+		for _, record := range records {
+			s.dump(record)
+		}
+		if len(records) < windowEndBatchSize {
+			return
+		}
+	}
+}
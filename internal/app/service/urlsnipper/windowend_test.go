@@ -0,0 +1,100 @@
+package urlsnipper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	dump "github.com/DanilNaum/SnipURL/pkg/utils/dumper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUrlSnipperService_endWindows(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	batch := func(n int) []*urlstorage.URLRecord {
+		records := make([]*urlstorage.URLRecord, 0, n)
+		for i := range n {
+			records = append(records, &urlstorage.URLRecord{ShortURL: fmt.Sprint(i), NotAfter: &past, EndEmitted: &past})
+		}
+		return records
+	}
+
+	tests := []struct {
+		name      string
+		batches   [][]*urlstorage.URLRecord
+		err       error
+		wantCalls int
+		wantDumps int
+		wantLogs  int
+	}{
+		{
+			name:      "nothing ended",
+			batches:   [][]*urlstorage.URLRecord{nil},
+			wantCalls: 1,
+		},
+		{
+			name:      "full batches are followed by the next one",
+			batches:   [][]*urlstorage.URLRecord{batch(windowEndBatchSize), batch(windowEndBatchSize), batch(1)},
+			wantCalls: 3,
+			wantDumps: 2*windowEndBatchSize + 1,
+		},
+		{
+			name:      "storage error",
+			err:       errors.New("connection reset"),
+			wantCalls: 1,
+			wantLogs:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage := &urlStorageMock{
+				EndWindowsFunc: func(ctx context.Context, now time.Time, limit int) ([]*urlstorage.URLRecord, error) {
+					require.Equal(t, windowEndBatchSize, limit)
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					records := tt.batches[0]
+					tt.batches = tt.batches[1:]
+					return records, nil
+				},
+			}
+			mockDumper := &dumperMock{
+				AddFunc: func(record *dump.URLRecord) error {
+					require.Equal(t, &past, record.EndEmitted)
+					return nil
+				},
+			}
+			mockLogger := &loggerMock{ErrorfFunc: func(s string, ifaceVals ...interface{}) {}}
+			s := &urlSnipperService{storage: mockStorage, dumper: mockDumper, logger: mockLogger}
+
+			s.endWindows(context.Background())
+
+			require.Len(t, mockStorage.EndWindowsCalls(), tt.wantCalls)
+			require.Len(t, mockDumper.AddCalls(), tt.wantDumps)
+			require.Len(t, mockLogger.ErrorfCalls(), tt.wantLogs)
+		})
+	}
+}
+
+func TestUrlSnipperService_GetURLDoesNotPublishEnd(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	mockPublisher := &publisherMock{
+		PublishFunc: func(event *events.Event) {},
+	}
+	s := &urlSnipperService{
+		storage: &urlStorageMock{
+			GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+				return &urlstorage.URLRecord{ShortURL: id, UserID: "user", OriginalURL: "https://example.com", NotAfter: &past}, nil
+			},
+		},
+		publisher: mockPublisher,
+	}
+
+	_, err := s.GetURL(context.Background(), "abc123")
+	require.ErrorIs(t, err, ErrEnded)
+	require.Empty(t, mockPublisher.PublishCalls(), "the end is emitted by RunWindowEnds")
+}
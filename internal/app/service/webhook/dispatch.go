@@ -0,0 +1,273 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	"github.com/DanilNaum/SnipURL/pkg/netguard"
+	"github.com/google/uuid"
+)
+
+// Headers of a delivery. The signature is the hex-encoded HMAC-SHA256 of the timestamp,
+// a dot and the body, keyed with the webhook secret and prefixed with "sha256=".
+const (
+	headerEvent     = "X-SnipURL-Event"
+	headerEventID   = "X-SnipURL-Event-ID"
+	headerTimestamp = "X-SnipURL-Timestamp"
+	headerSignature = "X-SnipURL-Signature"
+	userAgent       = "SnipURL-Webhook/1.0"
+	// maxDrainSize is the part of a response body read before closing it.
+	maxDrainSize = 4 << 10
+)
+
+// Publish queues the event for delivery to the webhooks subscribed to it. It never blocks:
+// when the queue is full, or the service is stopped, the event is dropped and logged.
+func (s *webhookService) Publish(event *events.Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.stopped {
+		return
+	}
+	select {
+	case s.input <- event:
+	default:
+		s.logger.Errorf("webhook queue is full, dropped %s event of %s", event.Type, event.ShortURL)
+	}
+}
+
+// Handle queues an event relayed from the outbox for delivery. Unlike Publish it waits
+// for room in the queue, so that the relay moves on only once the event is taken over.
+// Returns ErrStopped once the service is stopped, or the error of the context.
+func (s *webhookService) Handle(ctx context.Context, event *events.Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.stopped {
+		return ErrStopped
+	}
+	select {
+	case s.input <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *webhookService) deliverWorker(ctx context.Context) error {
+	for {
+		select {
+		case event, ok := <-s.input:
+			if !ok {
+				return nil
+			}
+			s.dispatch(ctx, event)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// dispatch delivers the event to every webhook subscribed to it. The event is copied,
+// as finding the subscribers may fill in its owner.
+func (s *webhookService) dispatch(ctx context.Context, published *events.Event) {
+	e := *published
+	event := &e
+	eventType, webhooks, err := s.subscribers(ctx, event)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Errorf("failed to find webhooks for %s event of %s: %v", event.Type, event.ShortURL, err)
+		}
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	body, err := s.payload(eventType, event)
+	if err != nil {
+		s.logger.Errorf("failed to build payload of %s event of %s: %v", event.Type, event.ShortURL, err)
+		return
+	}
+	for _, webhook := range webhooks {
+		s.deliver(ctx, webhook, eventType, event.ID, body)
+	}
+}
+
+// subscribers returns the webhook event type of the domain event and the webhooks
+// subscribed to it. A click is delivered only to the webhooks of the link's owner
+// whose threshold it reaches.
+func (s *webhookService) subscribers(ctx context.Context, event *events.Event) (string, []*webhookstorage.Webhook, error) {
+	eventType := event.Type
+	var webhooks []*webhookstorage.Webhook
+	var err error
+	if event.Type == events.TypeLinkClicked {
+		eventType = EventClicksThreshold
+		webhooks, err = s.storage.GetWebhooksByClickThreshold(ctx, event.Clicks)
+		if err != nil || len(webhooks) == 0 {
+			return eventType, nil, err
+		}
+		if err = s.resolveOwner(ctx, event); err != nil {
+			return eventType, nil, err
+		}
+	} else {
+		if event.UserID == "" {
+			return eventType, nil, nil
+		}
+		webhooks, err = s.storage.GetWebhooks(ctx, event.UserID)
+		if err != nil {
+			return eventType, nil, err
+		}
+	}
+
+	subscribed := webhooks[:0]
+	for _, webhook := range webhooks {
+		if webhook.UserID == event.UserID && slices.Contains(webhook.Events, eventType) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+	return eventType, subscribed, nil
+}
+
+// resolveOwner fills in the owner and original URL of an event emitted without them.
+func (s *webhookService) resolveOwner(ctx context.Context, event *events.Event) error {
+	if event.UserID != "" {
+		return nil
+	}
+	record, err := s.urlStorage.GetURL(ctx, event.ShortURL)
+	if err != nil {
+		if errors.Is(err, urlstorage.ErrNotFound) || errors.Is(err, urlstorage.ErrDeleted) {
+			return nil
+		}
+		return err
+	}
+	event.UserID = record.UserID
+	event.OriginalURL = record.OriginalURL
+	return nil
+}
+
+func (s *webhookService) payload(eventType string, event *events.Event) ([]byte, error) {
+	shortURL, err := url.JoinPath(s.baseURL, event.ShortURL)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&payload{
+		ID:         event.ID,
+		Type:       eventType,
+		OccurredAt: event.OccurredAt,
+		Data: payloadData{
+			ShortURL:    shortURL,
+			OriginalURL: event.OriginalURL,
+			Clicks:      event.Clicks,
+			Reason:      event.Reason,
+		},
+	})
+}
+
+// deliver posts the payload to the webhook until it is accepted, the webhook refuses it
+// or the attempts run out, waiting twice as long before every retry. Every attempt is logged.
+func (s *webhookService) deliver(ctx context.Context, webhook *webhookstorage.Webhook, eventType, eventID string, body []byte) {
+	backoff := s.initialBackoff
+	for attempt := 1; attempt <= s.maxAttempts; attempt++ {
+		delivery, err := s.attempt(ctx, webhook, eventType, eventID, body)
+		if ctx.Err() != nil {
+			// The server is shutting down, the attempt says nothing about the webhook.
+			return
+		}
+		delivery.Attempt = attempt
+		if err := s.storage.AddDelivery(ctx, delivery); err != nil && !errors.Is(err, webhookstorage.ErrNotFound) {
+			s.logger.Errorf("failed to log delivery to webhook %s: %v", webhook.ID, err)
+		}
+		if delivery.Success || !retryable(delivery, err) || attempt == s.maxAttempts {
+			return
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, s.maxBackoff)
+	}
+}
+
+// attempt posts the payload to the webhook once. The returned error is the one of the
+// request, if it failed before a response was received.
+func (s *webhookService) attempt(ctx context.Context, webhook *webhookstorage.Webhook, eventType, eventID string, body []byte) (*webhookstorage.Delivery, error) {
+	delivery := &webhookstorage.Delivery{
+		ID:        uuid.NewString(),
+		WebhookID: webhook.ID,
+		EventID:   eventID,
+		Event:     eventType,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery, err
+	}
+	timestamp := strconv.FormatInt(delivery.CreatedAt.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(headerEvent, eventType)
+	req.Header.Set(headerEventID, eventID)
+	req.Header.Set(headerTimestamp, timestamp)
+	req.Header.Set(headerSignature, Sign(webhook.Secret, timestamp, body))
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	delivery.Duration = time.Since(start)
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainSize))
+
+	delivery.StatusCode = resp.StatusCode
+	delivery.Success = resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices
+	if !delivery.Success {
+		delivery.Error = fmt.Sprintf("unexpected status %d", resp.StatusCode)
+	}
+	return delivery, nil
+}
+
+// retryable reports whether a failed delivery may succeed later: the webhook did not
+// answer, answered with a server error, or asked to slow down. A webhook resolving to
+// a private address is never called.
+func retryable(delivery *webhookstorage.Delivery, err error) bool {
+	if errors.Is(err, netguard.ErrBlockedAddress) {
+		return false
+	}
+	return delivery.StatusCode == 0 ||
+		delivery.StatusCode == http.StatusRequestTimeout ||
+		delivery.StatusCode == http.StatusTooManyRequests ||
+		delivery.StatusCode >= http.StatusInternalServerError
+}
+
+// Sign returns the signature of a delivery as sent in the X-SnipURL-Signature header.
+// Receivers recompute it from the X-SnipURL-Timestamp header and the raw body, compare
+// it in constant time and reject stale timestamps to prevent replays.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,221 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	"github.com/DanilNaum/SnipURL/pkg/netguard"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestService returns a service that is not started, for calling dispatch directly.
+func newTestService(storage webhookStorage, urlStorage urlStorage) *webhookService {
+	s := &webhookService{
+		storage:        storage,
+		urlStorage:     urlStorage,
+		baseURL:        "http://localhost:8080",
+		logger:         &loggerMock{ErrorfFunc: func(string, ...interface{}) {}},
+		maxAttempts:    3,
+		initialBackoff: time.Millisecond,
+		maxBackoff:     time.Millisecond,
+		timeout:        time.Second,
+		allowPrivate:   true,
+	}
+	s.client = s.newClient()
+	return s
+}
+
+func TestWebhookService_dispatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		wantAttempts int
+		wantSuccess  bool
+	}{
+		{name: "delivered", statuses: []int{http.StatusNoContent}, wantAttempts: 1, wantSuccess: true},
+		{name: "retried after server error", statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK}, wantAttempts: 3, wantSuccess: true},
+		{name: "attempts run out", statuses: []int{http.StatusInternalServerError}, wantAttempts: 3},
+		{name: "refused without retry", statuses: []int{http.StatusGone}, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			var mu sync.Mutex
+			var body []byte
+			var header http.Header
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(requests.Add(1))
+				mu.Lock()
+				body, _ = io.ReadAll(r.Body)
+				header = r.Header.Clone()
+				mu.Unlock()
+				w.WriteHeader(tt.statuses[min(n, len(tt.statuses))-1])
+			}))
+			defer server.Close()
+
+			storage := &webhookStorageMock{
+				GetWebhooksFunc: func(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+					return []*webhookstorage.Webhook{
+						{ID: "hook", UserID: userID, URL: server.URL, Secret: "secret", Events: []string{EventLinkCreated}},
+						{ID: "other", UserID: userID, URL: server.URL, Secret: "secret", Events: []string{EventLinkDeleted}},
+					}, nil
+				},
+				AddDeliveryFunc: func(ctx context.Context, delivery *webhookstorage.Delivery) error {
+					return nil
+				},
+			}
+			s := newTestService(storage, nil)
+
+			event := events.New(events.TypeLinkCreated, "abc123")
+			event.UserID = "user"
+			event.OriginalURL = "https://example.com"
+			s.dispatch(context.Background(), event)
+
+			require.Equal(t, tt.wantAttempts, int(requests.Load()))
+			deliveries := storage.AddDeliveryCalls()
+			require.Len(t, deliveries, tt.wantAttempts)
+			last := deliveries[len(deliveries)-1].Delivery
+			require.Equal(t, "hook", last.WebhookID)
+			require.Equal(t, event.ID, last.EventID)
+			require.Equal(t, tt.wantAttempts, last.Attempt)
+			require.Equal(t, tt.wantSuccess, last.Success)
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, EventLinkCreated, header.Get(headerEvent))
+			require.Equal(t, event.ID, header.Get(headerEventID))
+			require.Equal(t, Sign("secret", header.Get(headerTimestamp), body), header.Get(headerSignature))
+			var got payload
+			require.NoError(t, json.Unmarshal(body, &got))
+			require.Equal(t, "http://localhost:8080/abc123", got.Data.ShortURL)
+			require.Equal(t, "https://example.com", got.Data.OriginalURL)
+		})
+	}
+}
+
+func TestWebhookService_dispatchClicksThreshold(t *testing.T) {
+	var mu sync.Mutex
+	var delivered []payload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p payload
+		json.NewDecoder(r.Body).Decode(&p)
+		mu.Lock()
+		delivered = append(delivered, p)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	storage := &webhookStorageMock{
+		GetWebhooksByClickThresholdFunc: func(ctx context.Context, clicks int) ([]*webhookstorage.Webhook, error) {
+			if clicks != 100 {
+				return nil, nil
+			}
+			return []*webhookstorage.Webhook{
+				{ID: "owner", UserID: "owner", URL: server.URL, Events: []string{EventClicksThreshold}, ClickThreshold: 100},
+				{ID: "stranger", UserID: "stranger", URL: server.URL, Events: []string{EventClicksThreshold}, ClickThreshold: 100},
+			}, nil
+		},
+		AddDeliveryFunc: func(ctx context.Context, delivery *webhookstorage.Delivery) error {
+			return nil
+		},
+	}
+	urlStorage := &urlStorageMock{
+		GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+			return &urlstorage.URLRecord{ShortURL: id, UserID: "owner", OriginalURL: "https://example.com"}, nil
+		},
+	}
+	s := newTestService(storage, urlStorage)
+
+	for _, clicks := range []int{99, 100, 101} {
+		event := events.New(events.TypeLinkClicked, "abc123")
+		event.Clicks = clicks
+		s.dispatch(context.Background(), event)
+	}
+
+	require.Len(t, urlStorage.GetURLCalls(), 1, "the owner is looked up only when a threshold is reached")
+	require.Len(t, storage.AddDeliveryCalls(), 1)
+	require.Equal(t, "owner", storage.AddDeliveryCalls()[0].Delivery.WebhookID)
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, delivered, 1)
+	require.Equal(t, EventClicksThreshold, delivered[0].Type)
+	require.Equal(t, 100, delivered[0].Data.Clicks)
+}
+
+func TestWebhookService_Publish(t *testing.T) {
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get(headerEvent)
+	}))
+	defer server.Close()
+
+	storage := &webhookStorageMock{
+		GetWebhooksFunc: func(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+			return []*webhookstorage.Webhook{{ID: "hook", UserID: userID, URL: server.URL, Events: []string{EventLinkDeleted}}}, nil
+		},
+		AddDeliveryFunc: func(ctx context.Context, delivery *webhookstorage.Delivery) error {
+			return nil
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := NewWebhookService(ctx, storage, nil, "http://localhost:8080", &loggerMock{}, WithPrivateTargets(true))
+
+	event := events.New(events.TypeLinkDeleted, "abc123")
+	event.UserID = "user"
+	s.Publish(event)
+
+	select {
+	case got := <-received:
+		require.Equal(t, EventLinkDeleted, got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not delivered")
+	}
+
+	cancel()
+	require.Eventually(t, func() bool {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return s.stopped
+	}, time.Second, 10*time.Millisecond)
+	// Publishing after the stop neither blocks nor panics.
+	s.Publish(event)
+}
+
+func TestWebhookService_dispatchBlockedAddress(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	storage := &webhookStorageMock{
+		GetWebhooksFunc: func(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+			return []*webhookstorage.Webhook{{ID: "hook", UserID: userID, URL: server.URL, Events: []string{EventLinkCreated}}}, nil
+		},
+		AddDeliveryFunc: func(ctx context.Context, delivery *webhookstorage.Delivery) error {
+			return nil
+		},
+	}
+	s := newTestService(storage, nil)
+	s.allowPrivate = false
+	s.client = s.newClient()
+
+	event := events.New(events.TypeLinkCreated, "abc123")
+	event.UserID = "user"
+	s.dispatch(context.Background(), event)
+
+	require.Zero(t, requests.Load())
+	require.Len(t, storage.AddDeliveryCalls(), 1, "a blocked address is not retried")
+	require.Contains(t, storage.AddDeliveryCalls()[0].Delivery.Error, netguard.ErrBlockedAddress.Error())
+}
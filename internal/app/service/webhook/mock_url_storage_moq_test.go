@@ -0,0 +1,82 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package webhook
+
+import (
+	"context"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	"sync"
+)
+
+// Ensure, that urlStorageMock does implement urlStorage.
+// If this is not the case, regenerate this file with moq.
+var _ urlStorage = &urlStorageMock{}
+
+// urlStorageMock is a mock implementation of urlStorage.
+//
+//	func TestSomethingThatUsesurlStorage(t *testing.T) {
+//
+//		// make and configure a mocked urlStorage
+//		mockedurlStorage := &urlStorageMock{
+//			GetURLFunc: func(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+//				panic("mock out the GetURL method")
+//			},
+//		}
+//
+//		// use mockedurlStorage in code that requires urlStorage
+//		// and then make assertions.
+//
+//	}
+type urlStorageMock struct {
+	// GetURLFunc mocks the GetURL method.
+	GetURLFunc func(ctx context.Context, id string) (*urlstorage.URLRecord, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetURL holds details about calls to the GetURL method.
+		GetURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+	}
+	lockGetURL sync.RWMutex
+}
+
+// GetURL calls GetURLFunc.
+func (mock *urlStorageMock) GetURL(ctx context.Context, id string) (*urlstorage.URLRecord, error) {
+	if mock.GetURLFunc == nil {
+		panic("urlStorageMock.GetURLFunc: method is nil but urlStorage.GetURL was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetURL.Lock()
+	mock.calls.GetURL = append(mock.calls.GetURL, callInfo)
+	mock.lockGetURL.Unlock()
+	return mock.GetURLFunc(ctx, id)
+}
+
+// GetURLCalls gets all the calls that were made to GetURL.
+// Check the length with:
+//
+//	len(mockedurlStorage.GetURLCalls())
+func (mock *urlStorageMock) GetURLCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetURL.RLock()
+	calls = mock.calls.GetURL
+	mock.lockGetURL.RUnlock()
+	return calls
+}
@@ -0,0 +1,400 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package webhook
+
+import (
+	"context"
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	"sync"
+)
+
+// Ensure, that webhookStorageMock does implement webhookStorage.
+// If this is not the case, regenerate this file with moq.
+var _ webhookStorage = &webhookStorageMock{}
+
+// webhookStorageMock is a mock implementation of webhookStorage.
+//
+//	func TestSomethingThatUseswebhookStorage(t *testing.T) {
+//
+//		// make and configure a mocked webhookStorage
+//		mockedwebhookStorage := &webhookStorageMock{
+//			AddDeliveryFunc: func(ctx context.Context, delivery *webhookstorage.Delivery) error {
+//				panic("mock out the AddDelivery method")
+//			},
+//			DeleteWebhookFunc: func(ctx context.Context, userID string, id string) error {
+//				panic("mock out the DeleteWebhook method")
+//			},
+//			GetDeliveriesFunc: func(ctx context.Context, webhookID string, limit int) ([]*webhookstorage.Delivery, error) {
+//				panic("mock out the GetDeliveries method")
+//			},
+//			GetWebhookFunc: func(ctx context.Context, userID string, id string) (*webhookstorage.Webhook, error) {
+//				panic("mock out the GetWebhook method")
+//			},
+//			GetWebhooksFunc: func(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+//				panic("mock out the GetWebhooks method")
+//			},
+//			GetWebhooksByClickThresholdFunc: func(ctx context.Context, clicks int) ([]*webhookstorage.Webhook, error) {
+//				panic("mock out the GetWebhooksByClickThreshold method")
+//			},
+//			SetWebhookFunc: func(ctx context.Context, webhook *webhookstorage.Webhook) error {
+//				panic("mock out the SetWebhook method")
+//			},
+//		}
+//
+//		// use mockedwebhookStorage in code that requires webhookStorage
+//		// and then make assertions.
+//
+//	}
+type webhookStorageMock struct {
+	// AddDeliveryFunc mocks the AddDelivery method.
+	AddDeliveryFunc func(ctx context.Context, delivery *webhookstorage.Delivery) error
+
+	// DeleteWebhookFunc mocks the DeleteWebhook method.
+	DeleteWebhookFunc func(ctx context.Context, userID string, id string) error
+
+	// GetDeliveriesFunc mocks the GetDeliveries method.
+	GetDeliveriesFunc func(ctx context.Context, webhookID string, limit int) ([]*webhookstorage.Delivery, error)
+
+	// GetWebhookFunc mocks the GetWebhook method.
+	GetWebhookFunc func(ctx context.Context, userID string, id string) (*webhookstorage.Webhook, error)
+
+	// GetWebhooksFunc mocks the GetWebhooks method.
+	GetWebhooksFunc func(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error)
+
+	// GetWebhooksByClickThresholdFunc mocks the GetWebhooksByClickThreshold method.
+	GetWebhooksByClickThresholdFunc func(ctx context.Context, clicks int) ([]*webhookstorage.Webhook, error)
+
+	// SetWebhookFunc mocks the SetWebhook method.
+	SetWebhookFunc func(ctx context.Context, webhook *webhookstorage.Webhook) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddDelivery holds details about calls to the AddDelivery method.
+		AddDelivery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Delivery is the delivery argument value.
+			Delivery *webhookstorage.Delivery
+		}
+		// DeleteWebhook holds details about calls to the DeleteWebhook method.
+		DeleteWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetDeliveries holds details about calls to the GetDeliveries method.
+		GetDeliveries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebhookID is the webhookID argument value.
+			WebhookID string
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// GetWebhook holds details about calls to the GetWebhook method.
+		GetWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetWebhooks holds details about calls to the GetWebhooks method.
+		GetWebhooks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// GetWebhooksByClickThreshold holds details about calls to the GetWebhooksByClickThreshold method.
+		GetWebhooksByClickThreshold []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Clicks is the clicks argument value.
+			Clicks int
+		}
+		// SetWebhook holds details about calls to the SetWebhook method.
+		SetWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Webhook is the webhook argument value.
+			Webhook *webhookstorage.Webhook
+		}
+	}
+	lockAddDelivery                 sync.RWMutex
+	lockDeleteWebhook               sync.RWMutex
+	lockGetDeliveries               sync.RWMutex
+	lockGetWebhook                  sync.RWMutex
+	lockGetWebhooks                 sync.RWMutex
+	lockGetWebhooksByClickThreshold sync.RWMutex
+	lockSetWebhook                  sync.RWMutex
+}
+
+// AddDelivery calls AddDeliveryFunc.
+func (mock *webhookStorageMock) AddDelivery(ctx context.Context, delivery *webhookstorage.Delivery) error {
+	if mock.AddDeliveryFunc == nil {
+		panic("webhookStorageMock.AddDeliveryFunc: method is nil but webhookStorage.AddDelivery was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Delivery *webhookstorage.Delivery
+	}{
+		Ctx:      ctx,
+		Delivery: delivery,
+	}
+	mock.lockAddDelivery.Lock()
+	mock.calls.AddDelivery = append(mock.calls.AddDelivery, callInfo)
+	mock.lockAddDelivery.Unlock()
+	return mock.AddDeliveryFunc(ctx, delivery)
+}
+
+// AddDeliveryCalls gets all the calls that were made to AddDelivery.
+// Check the length with:
+//
+//	len(mockedwebhookStorage.AddDeliveryCalls())
+func (mock *webhookStorageMock) AddDeliveryCalls() []struct {
+	Ctx      context.Context
+	Delivery *webhookstorage.Delivery
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Delivery *webhookstorage.Delivery
+	}
+	mock.lockAddDelivery.RLock()
+	calls = mock.calls.AddDelivery
+	mock.lockAddDelivery.RUnlock()
+	return calls
+}
+
+// DeleteWebhook calls DeleteWebhookFunc.
+func (mock *webhookStorageMock) DeleteWebhook(ctx context.Context, userID string, id string) error {
+	if mock.DeleteWebhookFunc == nil {
+		panic("webhookStorageMock.DeleteWebhookFunc: method is nil but webhookStorage.DeleteWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+		ID     string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		ID:     id,
+	}
+	mock.lockDeleteWebhook.Lock()
+	mock.calls.DeleteWebhook = append(mock.calls.DeleteWebhook, callInfo)
+	mock.lockDeleteWebhook.Unlock()
+	return mock.DeleteWebhookFunc(ctx, userID, id)
+}
+
+// DeleteWebhookCalls gets all the calls that were made to DeleteWebhook.
+// Check the length with:
+//
+//	len(mockedwebhookStorage.DeleteWebhookCalls())
+func (mock *webhookStorageMock) DeleteWebhookCalls() []struct {
+	Ctx    context.Context
+	UserID string
+	ID     string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+		ID     string
+	}
+	mock.lockDeleteWebhook.RLock()
+	calls = mock.calls.DeleteWebhook
+	mock.lockDeleteWebhook.RUnlock()
+	return calls
+}
+
+// GetDeliveries calls GetDeliveriesFunc.
+func (mock *webhookStorageMock) GetDeliveries(ctx context.Context, webhookID string, limit int) ([]*webhookstorage.Delivery, error) {
+	if mock.GetDeliveriesFunc == nil {
+		panic("webhookStorageMock.GetDeliveriesFunc: method is nil but webhookStorage.GetDeliveries was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		WebhookID string
+		Limit     int
+	}{
+		Ctx:       ctx,
+		WebhookID: webhookID,
+		Limit:     limit,
+	}
+	mock.lockGetDeliveries.Lock()
+	mock.calls.GetDeliveries = append(mock.calls.GetDeliveries, callInfo)
+	mock.lockGetDeliveries.Unlock()
+	return mock.GetDeliveriesFunc(ctx, webhookID, limit)
+}
+
+// GetDeliveriesCalls gets all the calls that were made to GetDeliveries.
+// Check the length with:
+//
+//	len(mockedwebhookStorage.GetDeliveriesCalls())
+func (mock *webhookStorageMock) GetDeliveriesCalls() []struct {
+	Ctx       context.Context
+	WebhookID string
+	Limit     int
+} {
+	var calls []struct {
+		Ctx       context.Context
+		WebhookID string
+		Limit     int
+	}
+	mock.lockGetDeliveries.RLock()
+	calls = mock.calls.GetDeliveries
+	mock.lockGetDeliveries.RUnlock()
+	return calls
+}
+
+// GetWebhook calls GetWebhookFunc.
+func (mock *webhookStorageMock) GetWebhook(ctx context.Context, userID string, id string) (*webhookstorage.Webhook, error) {
+	if mock.GetWebhookFunc == nil {
+		panic("webhookStorageMock.GetWebhookFunc: method is nil but webhookStorage.GetWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+		ID     string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		ID:     id,
+	}
+	mock.lockGetWebhook.Lock()
+	mock.calls.GetWebhook = append(mock.calls.GetWebhook, callInfo)
+	mock.lockGetWebhook.Unlock()
+	return mock.GetWebhookFunc(ctx, userID, id)
+}
+
+// GetWebhookCalls gets all the calls that were made to GetWebhook.
+// Check the length with:
+//
+//	len(mockedwebhookStorage.GetWebhookCalls())
+func (mock *webhookStorageMock) GetWebhookCalls() []struct {
+	Ctx    context.Context
+	UserID string
+	ID     string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+		ID     string
+	}
+	mock.lockGetWebhook.RLock()
+	calls = mock.calls.GetWebhook
+	mock.lockGetWebhook.RUnlock()
+	return calls
+}
+
+// GetWebhooks calls GetWebhooksFunc.
+func (mock *webhookStorageMock) GetWebhooks(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+	if mock.GetWebhooksFunc == nil {
+		panic("webhookStorageMock.GetWebhooksFunc: method is nil but webhookStorage.GetWebhooks was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID string
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetWebhooks.Lock()
+	mock.calls.GetWebhooks = append(mock.calls.GetWebhooks, callInfo)
+	mock.lockGetWebhooks.Unlock()
+	return mock.GetWebhooksFunc(ctx, userID)
+}
+
+// GetWebhooksCalls gets all the calls that were made to GetWebhooks.
+// Check the length with:
+//
+//	len(mockedwebhookStorage.GetWebhooksCalls())
+func (mock *webhookStorageMock) GetWebhooksCalls() []struct {
+	Ctx    context.Context
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID string
+	}
+	mock.lockGetWebhooks.RLock()
+	calls = mock.calls.GetWebhooks
+	mock.lockGetWebhooks.RUnlock()
+	return calls
+}
+
+// GetWebhooksByClickThreshold calls GetWebhooksByClickThresholdFunc.
+func (mock *webhookStorageMock) GetWebhooksByClickThreshold(ctx context.Context, clicks int) ([]*webhookstorage.Webhook, error) {
+	if mock.GetWebhooksByClickThresholdFunc == nil {
+		panic("webhookStorageMock.GetWebhooksByClickThresholdFunc: method is nil but webhookStorage.GetWebhooksByClickThreshold was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Clicks int
+	}{
+		Ctx:    ctx,
+		Clicks: clicks,
+	}
+	mock.lockGetWebhooksByClickThreshold.Lock()
+	mock.calls.GetWebhooksByClickThreshold = append(mock.calls.GetWebhooksByClickThreshold, callInfo)
+	mock.lockGetWebhooksByClickThreshold.Unlock()
+	return mock.GetWebhooksByClickThresholdFunc(ctx, clicks)
+}
+
+// GetWebhooksByClickThresholdCalls gets all the calls that were made to GetWebhooksByClickThreshold.
+// Check the length with:
+//
+//	len(mockedwebhookStorage.GetWebhooksByClickThresholdCalls())
+func (mock *webhookStorageMock) GetWebhooksByClickThresholdCalls() []struct {
+	Ctx    context.Context
+	Clicks int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Clicks int
+	}
+	mock.lockGetWebhooksByClickThreshold.RLock()
+	calls = mock.calls.GetWebhooksByClickThreshold
+	mock.lockGetWebhooksByClickThreshold.RUnlock()
+	return calls
+}
+
+// SetWebhook calls SetWebhookFunc.
+func (mock *webhookStorageMock) SetWebhook(ctx context.Context, webhook *webhookstorage.Webhook) error {
+	if mock.SetWebhookFunc == nil {
+		panic("webhookStorageMock.SetWebhookFunc: method is nil but webhookStorage.SetWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Webhook *webhookstorage.Webhook
+	}{
+		Ctx:     ctx,
+		Webhook: webhook,
+	}
+	mock.lockSetWebhook.Lock()
+	mock.calls.SetWebhook = append(mock.calls.SetWebhook, callInfo)
+	mock.lockSetWebhook.Unlock()
+	return mock.SetWebhookFunc(ctx, webhook)
+}
+
+// SetWebhookCalls gets all the calls that were made to SetWebhook.
+// Check the length with:
+//
+//	len(mockedwebhookStorage.SetWebhookCalls())
+func (mock *webhookStorageMock) SetWebhookCalls() []struct {
+	Ctx     context.Context
+	Webhook *webhookstorage.Webhook
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Webhook *webhookstorage.Webhook
+	}
+	mock.lockSetWebhook.RLock()
+	calls = mock.calls.SetWebhook
+	mock.lockSetWebhook.RUnlock()
+	return calls
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+)
+
+// Event types a webhook can subscribe to.
+const (
+	EventLinkCreated = events.TypeLinkCreated
+	EventLinkDeleted = events.TypeLinkDeleted
+	EventLinkExpired = events.TypeLinkExpired
+	// EventClicksThreshold is delivered once a link of the user reaches the click
+	// threshold of the webhook.
+	EventClicksThreshold = "link.clicks_threshold"
+)
+
+// Webhook is an endpoint of the user notified about the events of the user's links.
+// ClickThreshold is required with EventClicksThreshold and not allowed without it.
+// Secret signs the payloads; it is generated on registration and returned only then.
+type Webhook struct {
+	ID             string
+	URL            string
+	Events         []string
+	ClickThreshold int
+	Secret         string
+	CreatedAt      time.Time
+}
+
+// Delivery is one attempt to deliver an event to a webhook. Attempts of the same event
+// share the EventID. StatusCode is zero and Error is set when no response was received.
+type Delivery struct {
+	ID         string
+	EventID    string
+	Event      string
+	Attempt    int
+	StatusCode int
+	Error      string
+	Success    bool
+	Duration   time.Duration
+	CreatedAt  time.Time
+}
+
+// payload is the JSON body of a delivery.
+type payload struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       payloadData `json:"data"`
+}
+
+type payloadData struct {
+	ShortURL    string `json:"short_url"`
+	OriginalURL string `json:"original_url,omitempty"`
+	Clicks      int    `json:"clicks,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+}
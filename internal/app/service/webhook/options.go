@@ -0,0 +1,50 @@
+package webhook
+
+import "time"
+
+// Option configures the webhook service.
+type Option func(s *webhookService)
+
+// WithWorkers sets the number of events delivered at the same time.
+func WithWorkers(n int) Option {
+	return func(s *webhookService) {
+		s.workerNum = n
+	}
+}
+
+// WithQueueSize sets the number of events waiting for delivery, beyond which new events are dropped.
+func WithQueueSize(n int) Option {
+	return func(s *webhookService) {
+		s.queueSize = n
+	}
+}
+
+// WithMaxAttempts sets the number of attempts to deliver an event to a webhook.
+func WithMaxAttempts(n int) Option {
+	return func(s *webhookService) {
+		s.maxAttempts = n
+	}
+}
+
+// WithBackoff sets the wait before the first retry, which doubles with every retry up to max.
+func WithBackoff(initial, max time.Duration) Option {
+	return func(s *webhookService) {
+		s.initialBackoff = initial
+		s.maxBackoff = max
+	}
+}
+
+// WithTimeout sets the time limit of one delivery attempt.
+func WithTimeout(d time.Duration) Option {
+	return func(s *webhookService) {
+		s.timeout = d
+	}
+}
+
+// WithPrivateTargets allows webhooks on loopback, private and link-local addresses,
+// which are refused by default so that webhooks cannot be used to reach the internal network.
+func WithPrivateTargets(allow bool) Option {
+	return func(s *webhookService) {
+		s.allowPrivate = allow
+	}
+}
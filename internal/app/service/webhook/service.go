@@ -0,0 +1,349 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/events"
+	urlstorage "github.com/DanilNaum/SnipURL/internal/app/repository/url"
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/middlewares"
+	"github.com/DanilNaum/SnipURL/pkg/netguard"
+	"github.com/DanilNaum/SnipURL/pkg/workerpool"
+	"github.com/google/uuid"
+)
+
+// Predefined errors returned by the webhook service.
+var (
+	// ErrInvalidWebhook indicates that the webhook URL is not an absolute http(s) URL, that it
+	// subscribes to no or unknown events, or that its click threshold does not match its events.
+	ErrInvalidWebhook = fmt.Errorf("invalid webhook")
+
+	// ErrTooManyWebhooks indicates that the user already has the maximum number of webhooks.
+	ErrTooManyWebhooks = fmt.Errorf("too many webhooks")
+
+	// ErrNotFound indicates that the user has no webhook with the given ID.
+	ErrNotFound = fmt.Errorf("not found")
+
+	// ErrNoUser indicates that the context carries no user ID.
+	ErrNoUser = fmt.Errorf("user not found in context")
+
+	// ErrStopped indicates that the service stopped with its context and takes no more events.
+	ErrStopped = fmt.Errorf("webhook service stopped")
+)
+
+// Defaults of the webhook service, changed with the options.
+const (
+	defaultWorkerNum      = 8
+	defaultQueueSize      = 1024
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = time.Minute
+	defaultTimeout        = 10 * time.Second
+)
+
+const (
+	maxWebhooksPerUser = 10
+	// deliveriesLimit is the number of the latest deliveries returned by GetDeliveries.
+	deliveriesLimit = 100
+	secretSize      = 32
+)
+
+//go:generate moq -out mock_webhook_storage_moq_test.go . webhookStorage
+type webhookStorage interface {
+	SetWebhook(ctx context.Context, webhook *webhookstorage.Webhook) error
+	GetWebhooks(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error)
+	GetWebhook(ctx context.Context, userID, id string) (*webhookstorage.Webhook, error)
+	GetWebhooksByClickThreshold(ctx context.Context, clicks int) ([]*webhookstorage.Webhook, error)
+	DeleteWebhook(ctx context.Context, userID, id string) error
+	AddDelivery(ctx context.Context, delivery *webhookstorage.Delivery) error
+	GetDeliveries(ctx context.Context, webhookID string, limit int) ([]*webhookstorage.Delivery, error)
+}
+
+//go:generate moq -out mock_url_storage_moq_test.go . urlStorage
+type urlStorage interface {
+	GetURL(ctx context.Context, id string) (*urlstorage.URLRecord, error)
+}
+
+//go:generate moq -out mock_logger_moq_test.go . logger
+type logger interface {
+	Errorf(string, ...interface{})
+}
+
+type workerPool interface {
+	AddTask(task *events.Event)
+}
+
+var key = middlewares.Key{Key: "userID"}
+
+type webhookService struct {
+	input      chan *events.Event
+	storage    webhookStorage
+	urlStorage urlStorage
+	baseURL    string
+	logger     logger
+	workerPool workerPool
+	client     *http.Client
+
+	// mu guards stopped, so that no event is added after the pool has closed its input.
+	mu      sync.RWMutex
+	stopped bool
+
+	workerNum      int
+	queueSize      int
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	timeout        time.Duration
+	allowPrivate   bool
+}
+
+// NewWebhookService creates a service managing the users' webhooks and delivering the
+// domain events of their links to them. Every event is posted as a JSON payload signed
+// with the webhook secret; failed deliveries are retried with exponential backoff, and
+// every attempt is logged. The deliveries run in a worker pool that stops with the context.
+//
+// Parameters:
+//   - ctx: the context for managing the worker pool lifecycle
+//   - storage: Storage of the webhooks and their deliveries
+//   - urlStorage: Storage of the links, to find the owners of clicked links
+//   - baseURL: Base of the short URLs in the payloads
+//   - logger: Logger for recording errors
+//   - opts: Optional settings of the service
+//
+// Returns:
+//   - *webhookService: a webhook service ready to register webhooks and publish events
+func NewWebhookService(ctx context.Context, storage webhookStorage, urlStorage urlStorage, baseURL string, logger logger, opts ...Option) *webhookService {
+	s := &webhookService{
+		storage:        storage,
+		urlStorage:     urlStorage,
+		baseURL:        baseURL,
+		logger:         logger,
+		workerNum:      defaultWorkerNum,
+		queueSize:      defaultQueueSize,
+		maxAttempts:    defaultMaxAttempts,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		timeout:        defaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.client = s.newClient()
+
+	// The pool outlives ctx until Publish stops accepting events.
+	poolCtx, stopPool := context.WithCancel(context.Background())
+	s.input = make(chan *events.Event, s.queueSize)
+	s.workerPool = workerpool.NewWorkerPool(poolCtx, s.workerNum, s.input, s.deliverWorker)
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		s.stopped = true
+		s.mu.Unlock()
+		stopPool()
+	}()
+	return s
+}
+
+// QueueDepth returns the number of events waiting to be dispatched to the webhooks.
+func (s *webhookService) QueueDepth() int {
+	return len(s.input)
+}
+
+func (s *webhookService) newClient() *http.Client {
+	dialer := &net.Dialer{Timeout: s.timeout}
+	if !s.allowPrivate {
+		dialer.Control = netguard.Control
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: s.timeout,
+		},
+		Timeout: s.timeout,
+		// A redirect is answered as is and counts as a failed delivery.
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Register adds a webhook for the user from the context and generates its secret.
+//
+// Returns:
+//   - *Webhook: The registered webhook with its ID and secret
+//   - error: ErrInvalidWebhook if the URL, events or click threshold are invalid,
+//     ErrTooManyWebhooks if the user has 10 webhooks already, ErrNoUser, storage error, or nil on success
+func (s *webhookService) Register(ctx context.Context, webhook *Webhook) (*Webhook, error) {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return nil, ErrNoUser
+	}
+	eventTypes, err := validateWebhook(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.storage.GetWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxWebhooksPerUser {
+		return nil, ErrTooManyWebhooks
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+	record := &webhookstorage.Webhook{
+		ID:             uuid.NewString(),
+		UserID:         userID,
+		URL:            webhook.URL,
+		Secret:         secret,
+		Events:         eventTypes,
+		ClickThreshold: webhook.ClickThreshold,
+		CreatedAt:      time.Now().UTC(),
+	}
+	if err := s.storage.SetWebhook(ctx, record); err != nil {
+		return nil, err
+	}
+
+	output := webhookFromStorage(record)
+	output.Secret = record.Secret
+	return output, nil
+}
+
+// GetWebhooks returns the webhooks of the user from the context, without their secrets.
+func (s *webhookService) GetWebhooks(ctx context.Context) ([]*Webhook, error) {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return nil, ErrNoUser
+	}
+
+	webhooks, err := s.storage.GetWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]*Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		output = append(output, webhookFromStorage(webhook))
+	}
+	return output, nil
+}
+
+// DeleteWebhook removes the user's webhook together with its delivery log.
+// Returns ErrNotFound if the user has no such webhook.
+func (s *webhookService) DeleteWebhook(ctx context.Context, id string) error {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return ErrNoUser
+	}
+
+	err := s.storage.DeleteWebhook(ctx, userID, id)
+	if errors.Is(err, webhookstorage.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// GetDeliveries returns the latest 100 delivery attempts of the user's webhook, newest first.
+// Returns ErrNotFound if the user has no such webhook.
+func (s *webhookService) GetDeliveries(ctx context.Context, id string) ([]*Delivery, error) {
+	userID, ok := ctx.Value(key).(string)
+	if !ok {
+		return nil, ErrNoUser
+	}
+
+	if _, err := s.storage.GetWebhook(ctx, userID, id); err != nil {
+		if errors.Is(err, webhookstorage.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	deliveries, err := s.storage.GetDeliveries(ctx, id, deliveriesLimit)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]*Delivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		output = append(output, deliveryFromStorage(delivery))
+	}
+	return output, nil
+}
+
+// validateWebhook checks the webhook and returns its event types without duplicates.
+func validateWebhook(webhook *Webhook) ([]string, error) {
+	target, err := url.Parse(webhook.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http(s) URL", ErrInvalidWebhook)
+	}
+	if len(webhook.Events) == 0 {
+		return nil, fmt.Errorf("%w: no events", ErrInvalidWebhook)
+	}
+
+	eventTypes := make([]string, 0, len(webhook.Events))
+	for _, eventType := range webhook.Events {
+		switch eventType {
+		case EventLinkCreated, EventLinkDeleted, EventLinkExpired, EventClicksThreshold:
+		default:
+			return nil, fmt.Errorf("%w: unknown event %q", ErrInvalidWebhook, eventType)
+		}
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	subscribed := slices.Contains(eventTypes, EventClicksThreshold)
+	switch {
+	case webhook.ClickThreshold < 0:
+		return nil, fmt.Errorf("%w: negative click threshold", ErrInvalidWebhook)
+	case subscribed && webhook.ClickThreshold == 0:
+		return nil, fmt.Errorf("%w: %s requires a click threshold", ErrInvalidWebhook, EventClicksThreshold)
+	case !subscribed && webhook.ClickThreshold > 0:
+		return nil, fmt.Errorf("%w: click threshold requires %s", ErrInvalidWebhook, EventClicksThreshold)
+	}
+	return eventTypes, nil
+}
+
+func newSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func webhookFromStorage(webhook *webhookstorage.Webhook) *Webhook {
+	return &Webhook{
+		ID:             webhook.ID,
+		URL:            webhook.URL,
+		Events:         append([]string(nil), webhook.Events...),
+		ClickThreshold: webhook.ClickThreshold,
+		CreatedAt:      webhook.CreatedAt,
+	}
+}
+
+func deliveryFromStorage(delivery *webhookstorage.Delivery) *Delivery {
+	return &Delivery{
+		ID:         delivery.ID,
+		EventID:    delivery.EventID,
+		Event:      delivery.Event,
+		Attempt:    delivery.Attempt,
+		StatusCode: delivery.StatusCode,
+		Error:      delivery.Error,
+		Success:    delivery.Success,
+		Duration:   delivery.Duration,
+		CreatedAt:  delivery.CreatedAt,
+	}
+}
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	webhookstorage "github.com/DanilNaum/SnipURL/internal/app/repository/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWebhook(t *testing.T) {
+	tests := []struct {
+		name       string
+		webhook    *Webhook
+		wantEvents []string
+		wantErr    bool
+	}{
+		{
+			name:       "valid",
+			webhook:    &Webhook{URL: "https://crm.example.com/hook", Events: []string{EventLinkCreated, EventLinkDeleted, EventLinkCreated}},
+			wantEvents: []string{EventLinkCreated, EventLinkDeleted},
+		},
+		{
+			name:       "clicks threshold",
+			webhook:    &Webhook{URL: "http://crm.example.com/hook", Events: []string{EventClicksThreshold}, ClickThreshold: 100},
+			wantEvents: []string{EventClicksThreshold},
+		},
+		{name: "relative url", webhook: &Webhook{URL: "/hook", Events: []string{EventLinkCreated}}, wantErr: true},
+		{name: "unsupported scheme", webhook: &Webhook{URL: "ftp://crm.example.com", Events: []string{EventLinkCreated}}, wantErr: true},
+		{name: "no events", webhook: &Webhook{URL: "https://crm.example.com/hook"}, wantErr: true},
+		{name: "unknown event", webhook: &Webhook{URL: "https://crm.example.com/hook", Events: []string{"link.clicked"}}, wantErr: true},
+		{name: "threshold missing", webhook: &Webhook{URL: "https://crm.example.com/hook", Events: []string{EventClicksThreshold}}, wantErr: true},
+		{
+			name:    "threshold without event",
+			webhook: &Webhook{URL: "https://crm.example.com/hook", Events: []string{EventLinkCreated}, ClickThreshold: 10},
+			wantErr: true,
+		},
+		{
+			name:    "negative threshold",
+			webhook: &Webhook{URL: "https://crm.example.com/hook", Events: []string{EventClicksThreshold}, ClickThreshold: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateWebhook(tt.webhook)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidWebhook)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEvents, got)
+		})
+	}
+}
+
+func TestWebhookService_Register(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing int
+		webhook  *Webhook
+		wantErr  error
+	}{
+		{name: "registered", webhook: &Webhook{URL: "https://crm.example.com/hook", Events: []string{EventLinkCreated}}},
+		{name: "invalid", webhook: &Webhook{URL: "https://crm.example.com/hook"}, wantErr: ErrInvalidWebhook},
+		{
+			name:     "too many",
+			existing: maxWebhooksPerUser,
+			webhook:  &Webhook{URL: "https://crm.example.com/hook", Events: []string{EventLinkCreated}},
+			wantErr:  ErrTooManyWebhooks,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &webhookStorageMock{
+				GetWebhooksFunc: func(ctx context.Context, userID string) ([]*webhookstorage.Webhook, error) {
+					return make([]*webhookstorage.Webhook, tt.existing), nil
+				},
+				SetWebhookFunc: func(ctx context.Context, webhook *webhookstorage.Webhook) error {
+					return nil
+				},
+			}
+			s := &webhookService{storage: storage}
+
+			ctx := context.WithValue(context.Background(), key, "user")
+			got, err := s.Register(ctx, tt.webhook)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.Empty(t, storage.SetWebhookCalls())
+				return
+			}
+
+			require.Len(t, storage.SetWebhookCalls(), 1)
+			stored := storage.SetWebhookCalls()[0].Webhook
+			require.Equal(t, "user", stored.UserID)
+			require.Len(t, stored.Secret, 2*secretSize)
+			require.Equal(t, stored.ID, got.ID)
+			require.Equal(t, stored.Secret, got.Secret)
+		})
+	}
+}
+
+func TestWebhookService_GetDeliveries(t *testing.T) {
+	storage := &webhookStorageMock{
+		GetWebhookFunc: func(ctx context.Context, userID, id string) (*webhookstorage.Webhook, error) {
+			if id != "mine" {
+				return nil, webhookstorage.ErrNotFound
+			}
+			return &webhookstorage.Webhook{ID: id, UserID: userID}, nil
+		},
+		GetDeliveriesFunc: func(ctx context.Context, webhookID string, limit int) ([]*webhookstorage.Delivery, error) {
+			return []*webhookstorage.Delivery{{ID: "d1", WebhookID: webhookID, Attempt: 2, StatusCode: 200, Success: true}}, nil
+		},
+	}
+	s := &webhookService{storage: storage}
+	ctx := context.WithValue(context.Background(), key, "user")
+
+	deliveries, err := s.GetDeliveries(ctx, "mine")
+	require.NoError(t, err)
+	require.Equal(t, []*Delivery{{ID: "d1", Attempt: 2, StatusCode: 200, Success: true}}, deliveries)
+	require.Equal(t, deliveriesLimit, storage.GetDeliveriesCalls()[0].Limit)
+
+	_, err = s.GetDeliveries(ctx, "other")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = s.GetDeliveries(context.Background(), "mine")
+	require.ErrorIs(t, err, ErrNoUser)
+}
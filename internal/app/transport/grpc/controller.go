@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/DanilNaum/SnipURL/internal/app/transport/grpc/interceptors"
 	"github.com/DanilNaum/SnipURL/pkg/protobuf"
@@ -16,9 +17,22 @@ type cookieManager interface {
 	SetToMetadata(userID string) metadata.MD
 }
 
+// userEraser удаляет пользователей и сообщает интерцептору аутентификации, отозван ли ID
+type userEraser interface {
+	erasureService
+	IsRevoked(ctx context.Context, userID string) (bool, error)
+}
+
+// requestMetrics учитывает число и длительность вызовов по методам
+type requestMetrics interface {
+	ObserveGRPCRequest(method, code string, duration time.Duration)
+}
+
 type logger interface {
 	Infof(format string, v ...any)
 	Errorf(format string, v ...any)
+	InfofContext(ctx context.Context, format string, v ...any)
+	ErrorfContext(ctx context.Context, format string, v ...any)
 }
 
 // Controller представляет gRPC контроллер
@@ -31,15 +45,26 @@ type Controller struct {
 // NewController создает новый gRPC контроллер с настроенными интерцепторами
 func NewController(
 	service service,
+	taggingService taggingService,
+	webhookService webhookService,
+	erasureService userEraser,
 	internalService internalService,
+	auditService auditService,
 	psqlStoragePinger psqlStoragePinger,
 	conf config,
 	cookieManager cookieManager,
 	logger logger,
 	trustedSubnetCIDR string,
+	locator locator,
+	proxyChecker proxyChecker,
+	qrRenderer qrRenderer,
+	metrics requestMetrics,
 ) (*Controller, error) {
-	authInterceptor := interceptors.NewAuthInterceptor(cookieManager, logger)
+	authInterceptor := interceptors.NewAuthInterceptor(cookieManager, erasureService, logger)
 	loggingInterceptor := interceptors.NewLoggingInterceptor(logger)
+	metricsInterceptor := interceptors.NewMetricsInterceptor(metrics)
+	tracingInterceptor := interceptors.NewTracingInterceptor()
+	requestIDInterceptor := interceptors.NewRequestIDInterceptor()
 
 	trustedSubnetInterceptor, err := interceptors.NewTrustedSubnetInterceptor(trustedSubnetCIDR, logger)
 	if err != nil {
@@ -47,24 +72,57 @@ func NewController(
 	}
 
 	protectedAuthMethods := map[string]bool{
-		"/snipurl.SnipURLService/GetUserURLs":    true,
-		"/snipurl.SnipURLService/DeleteUserURLs": true,
+		"/snipurl.SnipURLService/GetUserURLs":           true,
+		"/snipurl.SnipURLService/ListUserURLs":          true,
+		"/snipurl.SnipURLService/StreamUserURLs":        true,
+		"/snipurl.SnipURLService/ExportUserURLs":        true,
+		"/snipurl.SnipURLService/GetUserData":           true,
+		"/snipurl.SnipURLService/EraseUser":             true,
+		"/snipurl.SnipURLService/GetUserAuditLog":       true,
+		"/snipurl.SnipURLService/DeleteUserURLs":        true,
+		"/snipurl.SnipURLService/UpdateURL":             true,
+		"/snipurl.SnipURLService/SetTemplate":           true,
+		"/snipurl.SnipURLService/ListTemplates":         true,
+		"/snipurl.SnipURLService/DeleteTemplate":        true,
+		"/snipurl.SnipURLService/RegisterWebhook":       true,
+		"/snipurl.SnipURLService/ListWebhooks":          true,
+		"/snipurl.SnipURLService/DeleteWebhook":         true,
+		"/snipurl.SnipURLService/ListWebhookDeliveries": true,
+		"/snipurl.SnipURLService/AddRule":               true,
+		"/snipurl.SnipURLService/ListRules":             true,
+		"/snipurl.SnipURLService/UpdateRule":            true,
+		"/snipurl.SnipURLService/DeleteRule":            true,
+		"/snipurl.SnipURLService/GetVariantStats":       true,
+		"/snipurl.SnipURLService/GetClickStats":         true,
+		"/snipurl.SnipURLService/WatchClicks":           true,
 	}
 
 	protectedSubnetMethods := map[string]bool{
-		"/snipurl.SnipURLService/GetStats": true,
+		"/snipurl.SnipURLService/GetStats":    true,
+		"/snipurl.SnipURLService/GetAuditLog": true,
 	}
 
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			requestIDInterceptor.UnaryServerInterceptor(),
+			metricsInterceptor.UnaryServerInterceptor(),
+			tracingInterceptor.UnaryServerInterceptor(),
 			loggingInterceptor.UnaryServerInterceptor(),
 			authInterceptor.UnaryServerInterceptor(),
 			interceptors.RequireAuthInterceptor(protectedAuthMethods, logger),
 			trustedSubnetInterceptor.UnaryServerInterceptor(protectedSubnetMethods),
 		),
+		grpc.ChainStreamInterceptor(
+			requestIDInterceptor.StreamServerInterceptor(),
+			metricsInterceptor.StreamServerInterceptor(),
+			tracingInterceptor.StreamServerInterceptor(),
+			loggingInterceptor.StreamServerInterceptor(),
+			authInterceptor.StreamServerInterceptor(),
+			interceptors.RequireAuthStreamInterceptor(protectedAuthMethods, logger),
+		),
 	)
 
-	snipURLServer, err := NewServer(service, internalService, psqlStoragePinger, conf)
+	snipURLServer, err := NewServer(service, taggingService, webhookService, erasureService, internalService, auditService, psqlStoragePinger, conf, locator, proxyChecker, qrRenderer)
 	if err != nil {
 		return nil, err
 	}
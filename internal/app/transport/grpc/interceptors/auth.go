@@ -2,12 +2,15 @@ package interceptors
 
 import (
 	"context"
+	"net"
 
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
 	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/middlewares"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -16,26 +19,38 @@ type cookieManager interface {
 	SetToMetadata(userID string) metadata.MD
 }
 
+// revocations сообщает, отозван ли ID пользователя при удалении его данных
+type revocations interface {
+	IsRevoked(ctx context.Context, userID string) (bool, error)
+}
+
 type logger interface {
-	Infof(format string, v ...any)
-	Errorf(format string, v ...any)
+	InfofContext(ctx context.Context, format string, v ...any)
+	ErrorfContext(ctx context.Context, format string, v ...any)
 }
 
 // AuthInterceptor представляет интерцептор для аутентификации
 type AuthInterceptor struct {
 	cookieManager cookieManager
+	revocations   revocations
 	logger        logger
 }
 
-// NewAuthInterceptor создает новый интерцептор аутентификации
-func NewAuthInterceptor(cookieManager cookieManager, logger logger) *AuthInterceptor {
+// NewAuthInterceptor создает новый интерцептор аутентификации.
+// Вместо отозванного ID пользователя выдается новый
+func NewAuthInterceptor(cookieManager cookieManager, revocations revocations, logger logger) *AuthInterceptor {
 	return &AuthInterceptor{
 		cookieManager: cookieManager,
+		revocations:   revocations,
 		logger:        logger,
 	}
 }
 
-var key = middlewares.Key{Key: "userID"}
+var (
+	key           = middlewares.Key{Key: "userID"}
+	ipKey         = middlewares.Key{Key: "clientIP"}
+	authMethodKey = middlewares.Key{Key: "authMethod"}
+)
 
 // UnaryServerInterceptor возвращает унарный серверный интерцептор для аутентификации
 func (a *AuthInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
@@ -47,19 +62,16 @@ func (a *AuthInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	) (interface{}, error) {
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
-			a.logger.Errorf("Failed to get metadata from context for method %s", info.FullMethod)
+			a.logger.ErrorfContext(ctx, "Failed to get metadata from context for method %s", info.FullMethod)
 			return nil, status.Errorf(codes.Unauthenticated, "metadata not found")
 		}
 
-		userID, err := a.cookieManager.GetFromMetadata(md)
+		userID, authMethod, err := a.userID(ctx, md, info.FullMethod)
 		if err != nil {
-			a.logger.Infof("No valid user ID found in metadata for method %s, creating new user", info.FullMethod)
-			// Если пользователь не найден, создаем нового
-			// В реальном приложении здесь может быть логика создания нового пользователя
-			userID = uuid.NewString() // Заглушка для нового пользователя
+			return nil, err
 		}
 
-		newCtx := context.WithValue(ctx, key, userID)
+		newCtx := authContext(ctx, userID, authMethod)
 
 		resp, err := handler(newCtx, req)
 
@@ -72,6 +84,82 @@ func (a *AuthInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamServerInterceptor возвращает потоковый серверный интерцептор для аутентификации.
+// Cookie отправляется в заголовках до первого сообщения потока.
+func (a *AuthInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		md, ok := metadata.FromIncomingContext(stream.Context())
+		if !ok {
+			a.logger.ErrorfContext(stream.Context(), "Failed to get metadata from context for method %s", info.FullMethod)
+			return status.Errorf(codes.Unauthenticated, "metadata not found")
+		}
+
+		userID, authMethod, err := a.userID(stream.Context(), md, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.SetHeader(a.cookieManager.SetToMetadata(userID)); err != nil {
+			return err
+		}
+
+		return handler(srv, &contextStream{
+			ServerStream: stream,
+			ctx:          authContext(stream.Context(), userID, authMethod),
+		})
+	}
+}
+
+// userID возвращает ID пользователя из метаданных или новый ID, если его нет или он отозван,
+// вместе со способом аутентификации пользователя
+func (a *AuthInterceptor) userID(ctx context.Context, md metadata.MD, method string) (string, string, error) {
+	userID, err := a.cookieManager.GetFromMetadata(md)
+	if err != nil {
+		a.logger.InfofContext(ctx, "No valid user ID found in metadata for method %s, creating new user", method)
+		// Если пользователь не найден, создаем нового
+		return uuid.NewString(), auditstorage.AuthIssued, nil
+	}
+
+	revoked, err := a.revocations.IsRevoked(ctx, userID)
+	if err != nil {
+		a.logger.ErrorfContext(ctx, "Failed to check revocation of user ID for method %s: %v", method, err)
+		return "", "", status.Errorf(codes.Internal, "internal server error")
+	}
+	if revoked {
+		a.logger.InfofContext(ctx, "Revoked user ID found in metadata for method %s, creating new user", method)
+		return uuid.NewString(), auditstorage.AuthIssued, nil
+	}
+	return userID, auditstorage.AuthMetadata, nil
+}
+
+// authContext добавляет в контекст ID пользователя, способ его аутентификации и IP клиента
+// для журнала аудита действий пользователя
+func authContext(ctx context.Context, userID, authMethod string) context.Context {
+	ctx = context.WithValue(ctx, key, userID)
+	ctx = context.WithValue(ctx, authMethodKey, authMethod)
+	if p, ok := peer.FromContext(ctx); ok {
+		if tcpAddr, ok := p.Addr.(*net.TCPAddr); ok {
+			ctx = context.WithValue(ctx, ipKey, tcpAddr.IP.String())
+		}
+	}
+	return ctx
+}
+
+// contextStream подменяет контекст потока, чтобы обработчик видел userID
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
 // RequireAuthInterceptor создает интерцептор, который требует аутентификации для определенных методов
 func RequireAuthInterceptor(protectedMethods map[string]bool, logger logger) grpc.UnaryServerInterceptor {
 	return func(
@@ -83,7 +171,7 @@ func RequireAuthInterceptor(protectedMethods map[string]bool, logger logger) grp
 		if protectedMethods[info.FullMethod] {
 			userID := ctx.Value(key)
 			if userID == nil || userID == "" {
-				logger.Errorf("Authentication required for method %s but no user ID found", info.FullMethod)
+				logger.ErrorfContext(ctx, "Authentication required for method %s but no user ID found", info.FullMethod)
 				return nil, status.Errorf(codes.Unauthenticated, "authentication required")
 			}
 		}
@@ -91,3 +179,23 @@ func RequireAuthInterceptor(protectedMethods map[string]bool, logger logger) grp
 		return handler(ctx, req)
 	}
 }
+
+// RequireAuthStreamInterceptor создает потоковый интерцептор, который требует аутентификации для определенных методов
+func RequireAuthStreamInterceptor(protectedMethods map[string]bool, logger logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if protectedMethods[info.FullMethod] {
+			userID := stream.Context().Value(key)
+			if userID == nil || userID == "" {
+				logger.ErrorfContext(stream.Context(), "Authentication required for method %s but no user ID found", info.FullMethod)
+				return status.Errorf(codes.Unauthenticated, "authentication required")
+			}
+		}
+
+		return handler(srv, stream)
+	}
+}
@@ -31,7 +31,7 @@ func (l *LoggingInterceptor) UnaryServerInterceptor() grpc.UnaryServerIntercepto
 	) (interface{}, error) {
 		start := time.Now()
 
-		l.logger.Infof("gRPC request started: method=%s", info.FullMethod)
+		l.logger.InfofContext(ctx, "gRPC request started: method=%s", info.FullMethod)
 
 		resp, err := handler(ctx, req)
 
@@ -47,13 +47,42 @@ func (l *LoggingInterceptor) UnaryServerInterceptor() grpc.UnaryServerIntercepto
 		}
 
 		if err != nil {
-			l.logger.Errorf("gRPC request completed: method=%s, code=%s, duration=%v, error=%v",
+			l.logger.ErrorfContext(ctx, "gRPC request completed: method=%s, code=%s, duration=%v, error=%v",
 				info.FullMethod, code.String(), duration, err)
 		} else {
-			l.logger.Infof("gRPC request completed: method=%s, code=%s, duration=%v",
+			l.logger.InfofContext(ctx, "gRPC request completed: method=%s, code=%s, duration=%v",
 				info.FullMethod, code.String(), duration)
 		}
 
 		return resp, err
 	}
 }
+
+// StreamServerInterceptor возвращает потоковый серверный интерцептор для логирования
+func (l *LoggingInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		l.logger.InfofContext(stream.Context(), "gRPC stream started: method=%s", info.FullMethod)
+
+		err := handler(srv, stream)
+
+		duration := time.Since(start)
+
+		code := status.Code(err)
+		if err != nil {
+			l.logger.ErrorfContext(stream.Context(), "gRPC stream completed: method=%s, code=%s, duration=%v, error=%v",
+				info.FullMethod, code.String(), duration, err)
+		} else {
+			l.logger.InfofContext(stream.Context(), "gRPC stream completed: method=%s, code=%s, duration=%v",
+				info.FullMethod, code.String(), duration)
+		}
+
+		return err
+	}
+}
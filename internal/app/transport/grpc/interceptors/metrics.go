@@ -0,0 +1,62 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// requestMetrics учитывает число и длительность вызовов по методам
+type requestMetrics interface {
+	ObserveGRPCRequest(method, code string, duration time.Duration)
+}
+
+// MetricsInterceptor представляет интерцептор для сбора метрик вызовов
+type MetricsInterceptor struct {
+	metrics requestMetrics
+}
+
+// NewMetricsInterceptor создает новый интерцептор метрик
+func NewMetricsInterceptor(metrics requestMetrics) *MetricsInterceptor {
+	return &MetricsInterceptor{
+		metrics: metrics,
+	}
+}
+
+// UnaryServerInterceptor возвращает унарный серверный интерцептор, учитывающий
+// вызов с его кодом статуса и длительностью
+func (m *MetricsInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		m.metrics.ObserveGRPCRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor возвращает потоковый серверный интерцептор, учитывающий
+// поток с его кодом статуса и длительностью до завершения
+func (m *MetricsInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		err := handler(srv, stream)
+
+		m.metrics.ObserveGRPCRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
@@ -0,0 +1,71 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/DanilNaum/SnipURL/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDKey - ключ метаданных с ID запроса
+var requestIDKey = strings.ToLower(logging.RequestIDHeader)
+
+// RequestIDInterceptor представляет интерцептор, помещающий в контекст ID запроса
+// из метаданных клиента или новый ID и возвращающий его в заголовках ответа
+type RequestIDInterceptor struct{}
+
+// NewRequestIDInterceptor создает новый интерцептор ID запроса
+func NewRequestIDInterceptor() *RequestIDInterceptor {
+	return &RequestIDInterceptor{}
+}
+
+// UnaryServerInterceptor возвращает унарный серверный интерцептор ID запроса
+func (r *RequestIDInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		id := r.requestID(ctx)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id)); err != nil {
+			return nil, err
+		}
+
+		return handler(logging.ContextWithRequestID(ctx, id), req)
+	}
+}
+
+// StreamServerInterceptor возвращает потоковый серверный интерцептор ID запроса.
+// ID отправляется в заголовках до первого сообщения потока.
+func (r *RequestIDInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		id := r.requestID(stream.Context())
+		if err := stream.SetHeader(metadata.Pairs(requestIDKey, id)); err != nil {
+			return err
+		}
+
+		return handler(srv, &contextStream{
+			ServerStream: stream,
+			ctx:          logging.ContextWithRequestID(stream.Context(), id),
+		})
+	}
+}
+
+// requestID возвращает ID запроса из входящих метаданных, если он допустим, или новый ID
+func (r *RequestIDInterceptor) requestID(ctx context.Context) string {
+	var sent string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDKey); len(values) > 0 {
+			sent = values[0]
+		}
+	}
+	return logging.RequestID(sent)
+}
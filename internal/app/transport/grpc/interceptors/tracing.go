@@ -0,0 +1,120 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/DanilNaum/SnipURL/pkg/logging"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const tracerName = "github.com/DanilNaum/SnipURL/internal/app/transport/grpc"
+
+// TracingInterceptor представляет интерцептор, открывающий серверный спан на каждый вызов
+// и продолжающий трассировку вызывающей стороны по контексту W3C из метаданных
+type TracingInterceptor struct{}
+
+// NewTracingInterceptor создает новый интерцептор трассировки
+func NewTracingInterceptor() *TracingInterceptor {
+	return &TracingInterceptor{}
+}
+
+// UnaryServerInterceptor возвращает унарный серверный интерцептор, оборачивающий вызов в спан
+func (t *TracingInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, span := t.start(ctx, info.FullMethod)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+
+		t.finish(span, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor возвращает потоковый серверный интерцептор, оборачивающий поток
+// в спан до его завершения
+func (t *TracingInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, span := t.start(stream.Context(), info.FullMethod)
+		defer span.End()
+
+		err := handler(srv, &contextStream{
+			ServerStream: stream,
+			ctx:          ctx,
+		})
+
+		t.finish(span, err)
+		return err
+	}
+}
+
+// start извлекает контекст трассировки из входящих метаданных и открывает спан метода
+func (t *TracingInterceptor) start(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	ctx, span := otel.Tracer(tracerName).Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.RPCSystemGRPC,
+			semconv.RPCService(service),
+			semconv.RPCMethod(method),
+		),
+	)
+	if id, ok := logging.RequestIDFromContext(ctx); ok {
+		span.SetAttributes(attribute.String("snipurl.request_id", id))
+	}
+	return ctx, span
+}
+
+// finish записывает в спан код статуса вызова
+func (t *TracingInterceptor) finish(span trace.Span, err error) {
+	code := status.Code(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(code)))
+	if code != codes.OK {
+		span.SetStatus(otelcodes.Error, status.Convert(err).Message())
+	}
+}
+
+// metadataCarrier позволяет пропагатору читать контекст трассировки из метаданных gRPC
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -54,17 +54,17 @@ func (t *TrustedSubnetInterceptor) UnaryServerInterceptor(protectedMethods map[s
 
 		clientIP, err := t.getClientIP(ctx)
 		if err != nil {
-			t.logger.Errorf("Failed to get client IP for method %s: %v", info.FullMethod, err)
+			t.logger.ErrorfContext(ctx, "Failed to get client IP for method %s: %v", info.FullMethod, err)
 			return nil, status.Errorf(codes.PermissionDenied, "access denied")
 		}
 
 		if !t.trustedSubnet.Contains(clientIP) {
-			t.logger.Errorf("Access denied for IP %s to method %s: not in trusted subnet %s",
+			t.logger.ErrorfContext(ctx, "Access denied for IP %s to method %s: not in trusted subnet %s",
 				clientIP.String(), info.FullMethod, t.trustedSubnet.String())
 			return nil, status.Errorf(codes.PermissionDenied, "access denied")
 		}
 
-		t.logger.Infof("Access granted for IP %s to method %s", clientIP.String(), info.FullMethod)
+		t.logger.InfofContext(ctx, "Access granted for IP %s to method %s", clientIP.String(), info.FullMethod)
 		return handler(ctx, req)
 	}
 }
@@ -1,13 +1,218 @@
 package grpc
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/DanilNaum/SnipURL/internal/app/service/audit"
+	"github.com/DanilNaum/SnipURL/internal/app/service/erasure"
 	"github.com/DanilNaum/SnipURL/internal/app/service/private"
-	// "github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/internal/app/service/tagging"
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/internal/app/service/webhook"
 	"github.com/DanilNaum/SnipURL/pkg/protobuf"
+	"github.com/DanilNaum/SnipURL/pkg/qrcode"
 )
 
+// Request Mappers
+
+func shortURLRequestToServiceOptions(req *protobuf.ShortURLRequest) ([]urlsnipper.Option, error) {
+	notBefore, err := parseTimestamp(req.NotBefore)
+	if err != nil {
+		return nil, err
+	}
+	notAfter, err := parseTimestamp(req.NotAfter)
+	if err != nil {
+		return nil, err
+	}
+	return []urlsnipper.Option{
+		urlsnipper.WithRedirectType(int(req.RedirectType)),
+		urlsnipper.WithPassthrough(req.Passthrough),
+		urlsnipper.WithQueryMode(req.QueryMode),
+		urlsnipper.WithTemplate(req.Template),
+		urlsnipper.WithVariants(variantsToServiceModel(req.Variants)),
+		urlsnipper.WithPassword(req.Password),
+		urlsnipper.WithMaxClicks(int(req.MaxClicks)),
+		urlsnipper.WithNotBefore(notBefore),
+		urlsnipper.WithNotAfter(notAfter),
+		urlsnipper.WithFallbackURL(req.FallbackUrl),
+		urlsnipper.WithTitle(req.Title),
+		urlsnipper.WithNotes(req.Notes),
+		urlsnipper.WithTags(req.Tags),
+	}, nil
+}
+
+func jsonShortURLRequestToServiceOptions(req *protobuf.JsonShortURLRequest) ([]urlsnipper.Option, error) {
+	notBefore, err := parseTimestamp(req.NotBefore)
+	if err != nil {
+		return nil, err
+	}
+	notAfter, err := parseTimestamp(req.NotAfter)
+	if err != nil {
+		return nil, err
+	}
+	return []urlsnipper.Option{
+		urlsnipper.WithRedirectType(int(req.RedirectType)),
+		urlsnipper.WithPassthrough(req.Passthrough),
+		urlsnipper.WithQueryMode(req.QueryMode),
+		urlsnipper.WithTemplate(req.Template),
+		urlsnipper.WithVariants(variantsToServiceModel(req.Variants)),
+		urlsnipper.WithPassword(req.Password),
+		urlsnipper.WithMaxClicks(int(req.MaxClicks)),
+		urlsnipper.WithNotBefore(notBefore),
+		urlsnipper.WithNotAfter(notAfter),
+		urlsnipper.WithFallbackURL(req.FallbackUrl),
+		urlsnipper.WithTitle(req.Title),
+		urlsnipper.WithNotes(req.Notes),
+		urlsnipper.WithTags(req.Tags),
+	}, nil
+}
+
+func updateURLRequestToServiceOptions(req *protobuf.UpdateURLRequest) ([]urlsnipper.Option, error) {
+	opts := make([]urlsnipper.Option, 0, 13)
+	if req.RedirectType != nil {
+		opts = append(opts, urlsnipper.WithRedirectType(int(*req.RedirectType)))
+	}
+	if req.Passthrough != nil {
+		opts = append(opts, urlsnipper.WithPassthrough(*req.Passthrough))
+	}
+	if req.QueryMode != nil {
+		opts = append(opts, urlsnipper.WithQueryMode(*req.QueryMode))
+	}
+	if req.Template != nil {
+		opts = append(opts, urlsnipper.WithTemplate(*req.Template))
+	}
+	if req.Variants != nil {
+		opts = append(opts, urlsnipper.WithVariants(variantsToServiceModel(req.Variants.Items)))
+	}
+	if req.Password != nil {
+		opts = append(opts, urlsnipper.WithPassword(*req.Password))
+	}
+	if req.MaxClicks != nil {
+		opts = append(opts, urlsnipper.WithMaxClicks(int(*req.MaxClicks)))
+	}
+	if req.NotBefore != nil {
+		t, err := parseTimestamp(*req.NotBefore)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, urlsnipper.WithNotBefore(t))
+	}
+	if req.NotAfter != nil {
+		t, err := parseTimestamp(*req.NotAfter)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, urlsnipper.WithNotAfter(t))
+	}
+	if req.FallbackUrl != nil {
+		opts = append(opts, urlsnipper.WithFallbackURL(*req.FallbackUrl))
+	}
+	if req.Title != nil {
+		opts = append(opts, urlsnipper.WithTitle(*req.Title))
+	}
+	if req.Notes != nil {
+		opts = append(opts, urlsnipper.WithNotes(*req.Notes))
+	}
+	if req.Tags != nil {
+		opts = append(opts, urlsnipper.WithTags(req.Tags.Items))
+	}
+	return opts, nil
+}
+
+// parseTimestamp parses an RFC 3339 timestamp of the active window. An empty string yields nil.
+// A malformed timestamp is reported as ErrInvalidSchedule.
+func parseTimestamp(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", urlsnipper.ErrInvalidSchedule, err)
+	}
+	return &t, nil
+}
+
+// formatCreatedAt formats the creation time of a link, links restored from dumps
+// that predate it have none.
+func formatCreatedAt(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// formatTimestamp formats a bound of the active window, nil yields an empty string.
+func formatTimestamp(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func variantsToServiceModel(items []*protobuf.Variant) []*urlsnipper.Variant {
+	variants := make([]*urlsnipper.Variant, 0, len(items))
+	for _, item := range items {
+		variants = append(variants, &urlsnipper.Variant{
+			Name:   item.Name,
+			URL:    item.Url,
+			Weight: int(item.Weight),
+		})
+	}
+	return variants
+}
+
+func ruleItemToServiceModel(item *protobuf.RuleItem) *urlsnipper.Rule {
+	if item == nil {
+		return &urlsnipper.Rule{}
+	}
+	return &urlsnipper.Rule{
+		ID:        int(item.Id),
+		Position:  int(item.Position),
+		Platform:  item.Platform,
+		Language:  item.Language,
+		Country:   item.Country,
+		TimeFrom:  item.TimeFrom,
+		TimeTo:    item.TimeTo,
+		TargetURL: item.TargetUrl,
+	}
+}
+
+func qrCodeRequestToOptions(req *protobuf.QRCodeRequest) qrcode.Options {
+	opts := qrcode.Options{
+		Format: req.Format,
+		Size:   int(req.Size),
+		Level:  strings.ToUpper(req.Level),
+	}
+	if req.Margin != nil {
+		margin := int(*req.Margin)
+		opts.Margin = &margin
+	}
+	return opts
+}
+
+func templateItemToServiceModel(req *protobuf.TemplateItem) *tagging.Template {
+	return &tagging.Template{
+		Name:     req.Name,
+		Source:   req.UtmSource,
+		Medium:   req.UtmMedium,
+		Campaign: req.UtmCampaign,
+		Term:     req.UtmTerm,
+		Content:  req.UtmContent,
+	}
+}
+
+func webhookItemToServiceModel(req *protobuf.WebhookItem) *webhook.Webhook {
+	return &webhook.Webhook{
+		URL:            req.Url,
+		Events:         req.Events,
+		ClickThreshold: int(req.ClickThreshold),
+	}
+}
+
 // ShortURL Response Mappers
 
 func shortURLSuccessResponse(shortURL string, statusCode int32, message string) *protobuf.ShortURLResponse {
@@ -53,9 +258,13 @@ func shortURLConstructErrorResponse() *protobuf.ShortURLResponse {
 	return shortURLErrorResponse(http.StatusInternalServerError, "Failed to construct URL")
 }
 
+func shortURLInvalidOptionResponse(err error) *protobuf.ShortURLResponse {
+	return shortURLErrorResponse(http.StatusBadRequest, err.Error())
+}
+
 // OriginalURL Response Mappers
 
-func originalURLSuccessResponse(originalURL string) *protobuf.OriginalURLResponse {
+func originalURLSuccessResponse(originalURL string, redirectType int32, variant string) *protobuf.OriginalURLResponse {
 	return &protobuf.OriginalURLResponse{
 		Response: &protobuf.OriginalURLResponse_Success{
 			Success: &protobuf.SuccessOriginalURL{
@@ -63,7 +272,9 @@ func originalURLSuccessResponse(originalURL string) *protobuf.OriginalURLRespons
 					Code:    http.StatusOK,
 					Message: "URL found",
 				},
-				OriginalUrl: originalURL,
+				OriginalUrl:  originalURL,
+				RedirectType: redirectType,
+				Variant:      variant,
 			},
 		},
 	}
@@ -86,10 +297,97 @@ func originalURLDeletedResponse() *protobuf.OriginalURLResponse {
 	return originalURLErrorResponse(http.StatusGone, "URL has been deleted")
 }
 
+func originalURLNotYetActiveResponse() *protobuf.OriginalURLResponse {
+	return originalURLErrorResponse(http.StatusNotFound, "URL is not active yet")
+}
+
+func originalURLEndedResponse() *protobuf.OriginalURLResponse {
+	return originalURLErrorResponse(http.StatusGone, "URL is no longer active")
+}
+
+func originalURLExhaustedResponse() *protobuf.OriginalURLResponse {
+	return originalURLErrorResponse(http.StatusGone, "Click limit reached")
+}
+
+func originalURLNotFoundResponse() *protobuf.OriginalURLResponse {
+	return originalURLErrorResponse(http.StatusNotFound, "URL not found")
+}
+
+func originalURLBadRequestResponse() *protobuf.OriginalURLResponse {
+	return originalURLErrorResponse(http.StatusBadRequest, "Invalid query")
+}
+
+func originalURLUnknownClientResponse() *protobuf.OriginalURLResponse {
+	return originalURLErrorResponse(http.StatusBadRequest, "Client address is unknown")
+}
+
+func originalURLPasswordRequiredResponse() *protobuf.OriginalURLResponse {
+	return originalURLErrorResponse(http.StatusUnauthorized, "Password required")
+}
+
+func originalURLWrongPasswordResponse() *protobuf.OriginalURLResponse {
+	return originalURLErrorResponse(http.StatusForbidden, "Wrong password")
+}
+
+func originalURLTooManyAttemptsResponse() *protobuf.OriginalURLResponse {
+	return originalURLErrorResponse(http.StatusTooManyRequests, "Too many password attempts")
+}
+
 func originalURLInternalErrorResponse() *protobuf.OriginalURLResponse {
 	return originalURLErrorResponse(http.StatusInternalServerError, "Internal server error")
 }
 
+// URLInfo Response Mappers
+
+func urlInfoSuccessResponse(shortURL string, info *urlsnipper.URLInfo) *protobuf.URLInfoResponse {
+	return &protobuf.URLInfoResponse{
+		Response: &protobuf.URLInfoResponse_Success{
+			Success: &protobuf.SuccessURLInfo{
+				Status: &protobuf.Status{
+					Code:    http.StatusOK,
+					Message: "URL info retrieved successfully",
+				},
+				ShortUrl:        shortURL,
+				Target:          info.Target,
+				MultipleTargets: info.MultipleTargets,
+				Protected:       info.Protected,
+				LinkStatus:      info.Status,
+				CreatedAt:       formatCreatedAt(info.CreatedAt),
+				Clicks:          int32(info.Clicks),
+				Safety: &protobuf.Safety{
+					Verdict: info.Safety.Verdict,
+					Reasons: info.Safety.Reasons,
+				},
+			},
+		},
+	}
+}
+
+func urlInfoErrorResponse(statusCode int32, message string) *protobuf.URLInfoResponse {
+	return &protobuf.URLInfoResponse{
+		Response: &protobuf.URLInfoResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func urlInfoNotFoundResponse() *protobuf.URLInfoResponse {
+	return urlInfoErrorResponse(http.StatusNotFound, "URL not found")
+}
+
+func urlInfoDeletedResponse() *protobuf.URLInfoResponse {
+	return urlInfoErrorResponse(http.StatusGone, "URL has been deleted")
+}
+
+func urlInfoInternalErrorResponse() *protobuf.URLInfoResponse {
+	return urlInfoErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
 // JsonShortURL Response Mappers
 
 func jsonShortURLSuccessResponse(shortURL string, statusCode int32, message string) *protobuf.JsonShortURLResponse {
@@ -135,6 +433,39 @@ func jsonShortURLConstructErrorResponse() *protobuf.JsonShortURLResponse {
 	return jsonShortURLErrorResponse(http.StatusInternalServerError, "Failed to construct URL")
 }
 
+func jsonShortURLInvalidOptionResponse(err error) *protobuf.JsonShortURLResponse {
+	return jsonShortURLErrorResponse(http.StatusBadRequest, err.Error())
+}
+
+// batchURLItemToServiceModel converts a batch item into the service input.
+func batchURLItemToServiceModel(item *protobuf.BatchURLItem) (*urlsnipper.SetURLsInput, error) {
+	notBefore, err := parseTimestamp(item.NotBefore)
+	if err != nil {
+		return nil, err
+	}
+	notAfter, err := parseTimestamp(item.NotAfter)
+	if err != nil {
+		return nil, err
+	}
+	return &urlsnipper.SetURLsInput{
+		CorrelationID: item.CorrelationId,
+		OriginalURL:   item.OriginalUrl,
+		RedirectType:  int(item.RedirectType),
+		Passthrough:   item.Passthrough,
+		QueryMode:     item.QueryMode,
+		Template:      item.Template,
+		Variants:      variantsToServiceModel(item.Variants),
+		Password:      item.Password,
+		MaxClicks:     int(item.MaxClicks),
+		NotBefore:     notBefore,
+		NotAfter:      notAfter,
+		FallbackURL:   item.FallbackUrl,
+		Title:         item.Title,
+		Notes:         item.Notes,
+		Tags:          item.Tags,
+	}, nil
+}
+
 // BatchCreate Response Mappers
 
 func batchCreateSuccessResponse(items []*protobuf.BatchCreateResponseItem) *protobuf.BatchCreateResponse {
@@ -172,10 +503,53 @@ func batchCreateConstructErrorResponse() *protobuf.BatchCreateResponse {
 	return batchCreateErrorResponse(http.StatusInternalServerError, "Failed to construct URL")
 }
 
-func batchCreateResponseItem(correlationID, shortURL string) *protobuf.BatchCreateResponseItem {
+func batchCreateInvalidOptionResponse(err error) *protobuf.BatchCreateResponse {
+	return batchCreateErrorResponse(http.StatusBadRequest, err.Error())
+}
+
+// StreamCreateShortURLs Response Mappers
+
+func streamCreateSuccessResponse(items []*protobuf.BatchCreateResponseItem) *protobuf.StreamCreateShortURLsResponse {
+	return &protobuf.StreamCreateShortURLsResponse{
+		Response: &protobuf.StreamCreateShortURLsResponse_Success{
+			Success: &protobuf.SuccessBatchCreate{
+				Status: &protobuf.Status{
+					Code:    http.StatusCreated,
+					Message: "URLs created successfully",
+				},
+				Items: items,
+			},
+		},
+	}
+}
+
+func streamCreateRejectedResponse(err error, chunk []*protobuf.BatchURLItem) *protobuf.StreamCreateShortURLsResponse {
+	correlationIDs := make([]string, 0, len(chunk))
+	for _, item := range chunk {
+		correlationIDs = append(correlationIDs, item.CorrelationId)
+	}
+	return streamCreateErrorResponse(http.StatusBadRequest, err.Error(), correlationIDs)
+}
+
+func streamCreateErrorResponse(statusCode int32, message string, correlationIDs []string) *protobuf.StreamCreateShortURLsResponse {
+	return &protobuf.StreamCreateShortURLsResponse{
+		Response: &protobuf.StreamCreateShortURLsResponse_Error{
+			Error: &protobuf.StreamCreateError{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+				CorrelationIds: correlationIDs,
+			},
+		},
+	}
+}
+
+func batchCreateResponseItem(correlationID, shortURL string, existing bool) *protobuf.BatchCreateResponseItem {
 	return &protobuf.BatchCreateResponseItem{
 		CorrelationId: correlationID,
 		ShortUrl:      shortURL,
+		Existing:      existing,
 	}
 }
 
@@ -224,24 +598,930 @@ func userURLsConstructErrorResponse() *protobuf.UserURLsResponse {
 	return userURLsErrorResponse(http.StatusInternalServerError, "Failed to construct URL")
 }
 
-func userURLItem(shortURL, originalURL string) *protobuf.UserURLItem {
+// ListUserURLs Response Mappers
+
+func listUserURLsSuccessResponse(items []*protobuf.UserURLItem, nextCursor string, statusCode int32, message string) *protobuf.ListUserURLsResponse {
+	return &protobuf.ListUserURLsResponse{
+		Response: &protobuf.ListUserURLsResponse_Success{
+			Success: &protobuf.SuccessListUserURLs{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+				Items:      items,
+				NextCursor: nextCursor,
+			},
+		},
+	}
+}
+
+func listUserURLsErrorResponse(statusCode int32, message string) *protobuf.ListUserURLsResponse {
+	return &protobuf.ListUserURLsResponse{
+		Response: &protobuf.ListUserURLsResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func listUserURLsFoundResponse(items []*protobuf.UserURLItem, nextCursor string) *protobuf.ListUserURLsResponse {
+	return listUserURLsSuccessResponse(items, nextCursor, http.StatusOK, "URLs retrieved successfully")
+}
+
+func listUserURLsNoContentResponse() *protobuf.ListUserURLsResponse {
+	return listUserURLsSuccessResponse([]*protobuf.UserURLItem{}, "", http.StatusNoContent, "No URLs found")
+}
+
+func listUserURLsBadRequestResponse(err error) *protobuf.ListUserURLsResponse {
+	return listUserURLsErrorResponse(http.StatusBadRequest, err.Error())
+}
+
+func listUserURLsInternalErrorResponse() *protobuf.ListUserURLsResponse {
+	return listUserURLsErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+func listUserURLsConstructErrorResponse() *protobuf.ListUserURLsResponse {
+	return listUserURLsErrorResponse(http.StatusInternalServerError, "Failed to construct URL")
+}
+
+func userURLItem(shortURL string, u *urlsnipper.URL) *protobuf.UserURLItem {
 	return &protobuf.UserURLItem{
-		ShortUrl:    shortURL,
-		OriginalUrl: originalURL,
+		ShortUrl:     shortURL,
+		OriginalUrl:  u.OriginalURL,
+		RedirectType: int32(u.RedirectType),
+		Passthrough:  u.Passthrough,
+		QueryMode:    u.QueryMode,
+		Template:     u.Template,
+		Variants:     variantItems(u.Variants),
+		Protected:    u.Protected,
+		MaxClicks:    int32(u.MaxClicks),
+		ClicksLeft:   int32(u.ClicksLeft),
+		NotBefore:    formatTimestamp(u.NotBefore),
+		NotAfter:     formatTimestamp(u.NotAfter),
+		FallbackUrl:  u.FallbackURL,
+		Title:        u.Title,
+		Notes:        u.Notes,
+		Tags:         u.Tags,
+		CreatedAt:    formatCreatedAt(u.CreatedAt),
+		Clicks:       int32(u.Clicks),
+		Deleted:      u.Deleted,
+		Health:       linkHealthItem(u.Health),
 	}
 }
 
-// Delete Response Mappers
+func linkHealthItem(h *urlsnipper.Health) *protobuf.LinkHealth {
+	if h == nil {
+		return nil
+	}
+	return &protobuf.LinkHealth{
+		StatusCode:   int32(h.StatusCode),
+		LatencyMs:    h.Latency.Milliseconds(),
+		Error:        h.Error,
+		CheckedAt:    formatTimestamp(&h.CheckedAt),
+		FailingSince: formatTimestamp(h.FailingSince),
+		Broken:       h.Broken,
+	}
+}
 
-func deleteAcceptedResponse() *protobuf.DeleteResponse {
+func variantItem(v *urlsnipper.Variant) *protobuf.Variant {
+	return &protobuf.Variant{
+		Name:   v.Name,
+		Url:    v.URL,
+		Weight: int32(v.Weight),
+	}
+}
+
+func variantItems(variants []*urlsnipper.Variant) []*protobuf.Variant {
+	items := make([]*protobuf.Variant, 0, len(variants))
+	for _, v := range variants {
+		items = append(items, variantItem(v))
+	}
+	return items
+}
+
+// UpdateURL Response Mappers
+
+func updateURLSuccessResponse(item *protobuf.UserURLItem) *protobuf.UpdateURLResponse {
+	return &protobuf.UpdateURLResponse{
+		Response: &protobuf.UpdateURLResponse_Success{
+			Success: &protobuf.SuccessUpdateURL{
+				Status: &protobuf.Status{
+					Code:    http.StatusOK,
+					Message: "URL updated successfully",
+				},
+				Item: item,
+			},
+		},
+	}
+}
+
+func updateURLErrorResponse(statusCode int32, message string) *protobuf.UpdateURLResponse {
+	return &protobuf.UpdateURLResponse{
+		Response: &protobuf.UpdateURLResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func updateURLInvalidOptionResponse(err error) *protobuf.UpdateURLResponse {
+	return updateURLErrorResponse(http.StatusBadRequest, err.Error())
+}
+
+func updateURLNotFoundResponse() *protobuf.UpdateURLResponse {
+	return updateURLErrorResponse(http.StatusNotFound, "URL not found")
+}
+
+func updateURLInternalErrorResponse() *protobuf.UpdateURLResponse {
+	return updateURLErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+func updateURLConstructErrorResponse() *protobuf.UpdateURLResponse {
+	return updateURLErrorResponse(http.StatusInternalServerError, "Failed to construct URL")
+}
+
+// Template Response Mappers
+
+func templateItem(template *tagging.Template) *protobuf.TemplateItem {
+	return &protobuf.TemplateItem{
+		Name:        template.Name,
+		UtmSource:   template.Source,
+		UtmMedium:   template.Medium,
+		UtmCampaign: template.Campaign,
+		UtmTerm:     template.Term,
+		UtmContent:  template.Content,
+	}
+}
+
+func setTemplateErrorResponse(statusCode int32, message string) *protobuf.SetTemplateResponse {
+	return &protobuf.SetTemplateResponse{
+		Response: &protobuf.SetTemplateResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func setTemplateSuccessResponse(item *protobuf.TemplateItem) *protobuf.SetTemplateResponse {
+	return &protobuf.SetTemplateResponse{
+		Response: &protobuf.SetTemplateResponse_Success{
+			Success: &protobuf.SuccessSetTemplate{
+				Status: &protobuf.Status{
+					Code:    http.StatusCreated,
+					Message: "Template saved successfully",
+				},
+				Item: item,
+			},
+		},
+	}
+}
+
+func setTemplateInvalidResponse() *protobuf.SetTemplateResponse {
+	return setTemplateErrorResponse(http.StatusBadRequest, "Template needs a name and at least one UTM parameter")
+}
+
+func setTemplateInternalErrorResponse() *protobuf.SetTemplateResponse {
+	return setTemplateErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+func listTemplatesSuccessResponse(items []*protobuf.TemplateItem, statusCode int32, message string) *protobuf.ListTemplatesResponse {
+	return &protobuf.ListTemplatesResponse{
+		Response: &protobuf.ListTemplatesResponse_Success{
+			Success: &protobuf.SuccessListTemplates{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+				Items: items,
+			},
+		},
+	}
+}
+
+func listTemplatesErrorResponse(statusCode int32, message string) *protobuf.ListTemplatesResponse {
+	return &protobuf.ListTemplatesResponse{
+		Response: &protobuf.ListTemplatesResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func listTemplatesFoundResponse(items []*protobuf.TemplateItem) *protobuf.ListTemplatesResponse {
+	return listTemplatesSuccessResponse(items, http.StatusOK, "Templates retrieved successfully")
+}
+
+func listTemplatesNoContentResponse() *protobuf.ListTemplatesResponse {
+	return listTemplatesSuccessResponse([]*protobuf.TemplateItem{}, http.StatusNoContent, "No templates found")
+}
+
+func listTemplatesInternalErrorResponse() *protobuf.ListTemplatesResponse {
+	return listTemplatesErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+func deleteTemplateSuccessResponse() *protobuf.DeleteResponse {
 	return &protobuf.DeleteResponse{
 		Status: &protobuf.Status{
-			Code:    http.StatusAccepted,
-			Message: "URLs deletion accepted",
+			Code:    http.StatusNoContent,
+			Message: "Template deleted",
 		},
 	}
 }
 
+func deleteTemplateNotFoundResponse() *protobuf.DeleteResponse {
+	return &protobuf.DeleteResponse{
+		Status: &protobuf.Status{
+			Code:    http.StatusNotFound,
+			Message: "Template not found",
+		},
+	}
+}
+
+func deleteTemplateInternalErrorResponse() *protobuf.DeleteResponse {
+	return &protobuf.DeleteResponse{
+		Status: &protobuf.Status{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		},
+	}
+}
+
+// Webhook Response Mappers
+
+func webhookItem(webhook *webhook.Webhook) *protobuf.WebhookItem {
+	return &protobuf.WebhookItem{
+		Id:             webhook.ID,
+		Url:            webhook.URL,
+		Events:         webhook.Events,
+		ClickThreshold: int32(webhook.ClickThreshold),
+		Secret:         webhook.Secret,
+		CreatedAt:      formatCreatedAt(webhook.CreatedAt),
+	}
+}
+
+func webhookDeliveryItem(delivery *webhook.Delivery) *protobuf.WebhookDelivery {
+	return &protobuf.WebhookDelivery{
+		Id:         delivery.ID,
+		EventId:    delivery.EventID,
+		Event:      delivery.Event,
+		Attempt:    int32(delivery.Attempt),
+		StatusCode: int32(delivery.StatusCode),
+		Error:      delivery.Error,
+		Success:    delivery.Success,
+		DurationMs: delivery.Duration.Milliseconds(),
+		CreatedAt:  formatCreatedAt(delivery.CreatedAt),
+	}
+}
+
+func webhookErrorResponse(statusCode int32, message string) *protobuf.WebhookResponse {
+	return &protobuf.WebhookResponse{
+		Response: &protobuf.WebhookResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func webhookSuccessResponse(item *protobuf.WebhookItem) *protobuf.WebhookResponse {
+	return &protobuf.WebhookResponse{
+		Response: &protobuf.WebhookResponse_Success{
+			Success: &protobuf.SuccessWebhook{
+				Status: &protobuf.Status{
+					Code:    http.StatusCreated,
+					Message: "Webhook registered successfully",
+				},
+				Item: item,
+			},
+		},
+	}
+}
+
+func webhookInvalidResponse(message string) *protobuf.WebhookResponse {
+	return webhookErrorResponse(http.StatusBadRequest, message)
+}
+
+func webhookTooManyResponse() *protobuf.WebhookResponse {
+	return webhookErrorResponse(http.StatusConflict, "Too many webhooks")
+}
+
+func webhookInternalErrorResponse() *protobuf.WebhookResponse {
+	return webhookErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+func listWebhooksSuccessResponse(items []*protobuf.WebhookItem, statusCode int32, message string) *protobuf.ListWebhooksResponse {
+	return &protobuf.ListWebhooksResponse{
+		Response: &protobuf.ListWebhooksResponse_Success{
+			Success: &protobuf.SuccessListWebhooks{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+				Items: items,
+			},
+		},
+	}
+}
+
+func listWebhooksErrorResponse(statusCode int32, message string) *protobuf.ListWebhooksResponse {
+	return &protobuf.ListWebhooksResponse{
+		Response: &protobuf.ListWebhooksResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func listWebhooksFoundResponse(items []*protobuf.WebhookItem) *protobuf.ListWebhooksResponse {
+	return listWebhooksSuccessResponse(items, http.StatusOK, "Webhooks retrieved successfully")
+}
+
+func listWebhooksNoContentResponse() *protobuf.ListWebhooksResponse {
+	return listWebhooksSuccessResponse([]*protobuf.WebhookItem{}, http.StatusNoContent, "No webhooks found")
+}
+
+func listWebhooksInternalErrorResponse() *protobuf.ListWebhooksResponse {
+	return listWebhooksErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+func deleteWebhookSuccessResponse() *protobuf.DeleteResponse {
+	return &protobuf.DeleteResponse{
+		Status: &protobuf.Status{
+			Code:    http.StatusNoContent,
+			Message: "Webhook deleted",
+		},
+	}
+}
+
+func deleteWebhookNotFoundResponse() *protobuf.DeleteResponse {
+	return &protobuf.DeleteResponse{
+		Status: &protobuf.Status{
+			Code:    http.StatusNotFound,
+			Message: "Webhook not found",
+		},
+	}
+}
+
+func deleteWebhookInternalErrorResponse() *protobuf.DeleteResponse {
+	return &protobuf.DeleteResponse{
+		Status: &protobuf.Status{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		},
+	}
+}
+
+func listWebhookDeliveriesSuccessResponse(items []*protobuf.WebhookDelivery, statusCode int32, message string) *protobuf.ListWebhookDeliveriesResponse {
+	return &protobuf.ListWebhookDeliveriesResponse{
+		Response: &protobuf.ListWebhookDeliveriesResponse_Success{
+			Success: &protobuf.SuccessListWebhookDeliveries{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+				Items: items,
+			},
+		},
+	}
+}
+
+func listWebhookDeliveriesErrorResponse(statusCode int32, message string) *protobuf.ListWebhookDeliveriesResponse {
+	return &protobuf.ListWebhookDeliveriesResponse{
+		Response: &protobuf.ListWebhookDeliveriesResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func listWebhookDeliveriesFoundResponse(items []*protobuf.WebhookDelivery) *protobuf.ListWebhookDeliveriesResponse {
+	return listWebhookDeliveriesSuccessResponse(items, http.StatusOK, "Deliveries retrieved successfully")
+}
+
+func listWebhookDeliveriesNoContentResponse() *protobuf.ListWebhookDeliveriesResponse {
+	return listWebhookDeliveriesSuccessResponse([]*protobuf.WebhookDelivery{}, http.StatusNoContent, "No deliveries found")
+}
+
+func listWebhookDeliveriesNotFoundResponse() *protobuf.ListWebhookDeliveriesResponse {
+	return listWebhookDeliveriesErrorResponse(http.StatusNotFound, "Webhook not found")
+}
+
+func listWebhookDeliveriesInternalErrorResponse() *protobuf.ListWebhookDeliveriesResponse {
+	return listWebhookDeliveriesErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+// Rule Response Mappers
+
+func ruleItem(rule *urlsnipper.Rule) *protobuf.RuleItem {
+	return &protobuf.RuleItem{
+		Id:        int32(rule.ID),
+		Position:  int32(rule.Position),
+		Platform:  rule.Platform,
+		Language:  rule.Language,
+		Country:   rule.Country,
+		TimeFrom:  rule.TimeFrom,
+		TimeTo:    rule.TimeTo,
+		TargetUrl: rule.TargetURL,
+	}
+}
+
+func ruleSuccessResponse(item *protobuf.RuleItem, statusCode int32, message string) *protobuf.RuleResponse {
+	return &protobuf.RuleResponse{
+		Response: &protobuf.RuleResponse_Success{
+			Success: &protobuf.SuccessRule{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+				Rule: item,
+			},
+		},
+	}
+}
+
+func ruleErrorResponse(statusCode int32, message string) *protobuf.RuleResponse {
+	return &protobuf.RuleResponse{
+		Response: &protobuf.RuleResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func ruleErrorResponseFromServiceError(err error) *protobuf.RuleResponse {
+	switch {
+	case errors.Is(err, urlsnipper.ErrInvalidRule):
+		return ruleErrorResponse(http.StatusBadRequest, err.Error())
+	case errors.Is(err, urlsnipper.ErrNotFound):
+		return ruleErrorResponse(http.StatusNotFound, "URL not found")
+	case errors.Is(err, urlsnipper.ErrRuleNotFound):
+		return ruleErrorResponse(http.StatusNotFound, "Rule not found")
+	default:
+		return ruleErrorResponse(http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func listRulesSuccessResponse(items []*protobuf.RuleItem) *protobuf.ListRulesResponse {
+	return &protobuf.ListRulesResponse{
+		Response: &protobuf.ListRulesResponse_Success{
+			Success: &protobuf.SuccessListRules{
+				Status: &protobuf.Status{
+					Code:    http.StatusOK,
+					Message: "Rules retrieved successfully",
+				},
+				Items: items,
+			},
+		},
+	}
+}
+
+func listRulesErrorResponse(statusCode int32, message string) *protobuf.ListRulesResponse {
+	return &protobuf.ListRulesResponse{
+		Response: &protobuf.ListRulesResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func listRulesNotFoundResponse() *protobuf.ListRulesResponse {
+	return listRulesErrorResponse(http.StatusNotFound, "URL not found")
+}
+
+func listRulesInternalErrorResponse() *protobuf.ListRulesResponse {
+	return listRulesErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+func deleteRuleResponse(statusCode int32, message string) *protobuf.DeleteResponse {
+	return &protobuf.DeleteResponse{
+		Status: &protobuf.Status{
+			Code:    statusCode,
+			Message: message,
+		},
+	}
+}
+
+func deleteRuleSuccessResponse() *protobuf.DeleteResponse {
+	return deleteRuleResponse(http.StatusNoContent, "Rule deleted")
+}
+
+func deleteRuleNotFoundResponse() *protobuf.DeleteResponse {
+	return deleteRuleResponse(http.StatusNotFound, "Rule not found")
+}
+
+func deleteRuleInternalErrorResponse() *protobuf.DeleteResponse {
+	return deleteRuleResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+// VariantStats Response Mappers
+
+func variantStatsItem(stat *urlsnipper.VariantStats) *protobuf.VariantStatsItem {
+	return &protobuf.VariantStatsItem{
+		Variant: variantItem(&stat.Variant),
+		Served:  int64(stat.Served),
+	}
+}
+
+func variantStatsSuccessResponse(items []*protobuf.VariantStatsItem) *protobuf.VariantStatsResponse {
+	return &protobuf.VariantStatsResponse{
+		Response: &protobuf.VariantStatsResponse_Success{
+			Success: &protobuf.SuccessVariantStats{
+				Status: &protobuf.Status{
+					Code:    http.StatusOK,
+					Message: "Variant stats retrieved successfully",
+				},
+				Items: items,
+			},
+		},
+	}
+}
+
+func variantStatsErrorResponse(statusCode int32, message string) *protobuf.VariantStatsResponse {
+	return &protobuf.VariantStatsResponse{
+		Response: &protobuf.VariantStatsResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func variantStatsNotFoundResponse() *protobuf.VariantStatsResponse {
+	return variantStatsErrorResponse(http.StatusNotFound, "URL not found")
+}
+
+func variantStatsInternalErrorResponse() *protobuf.VariantStatsResponse {
+	return variantStatsErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+// Click Stats Response Mappers
+
+func clickStatsSuccessResponse(stats *urlsnipper.ClickStats) *protobuf.ClickStatsResponse {
+	byCountry := make(map[string]int64, len(stats.ByCountry))
+	for country, clicks := range stats.ByCountry {
+		byCountry[country] = int64(clicks)
+	}
+	return &protobuf.ClickStatsResponse{
+		Response: &protobuf.ClickStatsResponse_Success{
+			Success: &protobuf.SuccessClickStats{
+				Status: &protobuf.Status{
+					Code:    http.StatusOK,
+					Message: "Click stats retrieved successfully",
+				},
+				Total:     int64(stats.Total),
+				ByCountry: byCountry,
+			},
+		},
+	}
+}
+
+func clickStatsErrorResponse(statusCode int32, message string) *protobuf.ClickStatsResponse {
+	return &protobuf.ClickStatsResponse{
+		Response: &protobuf.ClickStatsResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func clickStatsNotFoundResponse() *protobuf.ClickStatsResponse {
+	return clickStatsErrorResponse(http.StatusNotFound, "URL not found")
+}
+
+func clickStatsInternalErrorResponse() *protobuf.ClickStatsResponse {
+	return clickStatsErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+func clickEvent(shortURL string, click *urlsnipper.ClickEvent, dropped uint64) *protobuf.ClickEvent {
+	return &protobuf.ClickEvent{
+		ShortUrl: shortURL,
+		Clicks:   int64(click.Clicks),
+		Country:  click.Country,
+		Variant:  click.Variant,
+		Time:     click.Time.Format(time.RFC3339),
+		Dropped:  dropped,
+	}
+}
+
+// QRCode Response Mappers
+
+func qrCodeSuccessResponse(img *qrcode.Image) *protobuf.QRCodeResponse {
+	return &protobuf.QRCodeResponse{
+		Response: &protobuf.QRCodeResponse_Success{
+			Success: &protobuf.SuccessQRCode{
+				Status: &protobuf.Status{
+					Code:    http.StatusOK,
+					Message: "QR code rendered successfully",
+				},
+				Data:        img.Data,
+				ContentType: img.ContentType,
+				Etag:        img.ETag,
+			},
+		},
+	}
+}
+
+func qrCodeNotModifiedResponse(img *qrcode.Image) *protobuf.QRCodeResponse {
+	return &protobuf.QRCodeResponse{
+		Response: &protobuf.QRCodeResponse_Success{
+			Success: &protobuf.SuccessQRCode{
+				Status: &protobuf.Status{
+					Code:    http.StatusNotModified,
+					Message: "QR code not modified",
+				},
+				ContentType: img.ContentType,
+				Etag:        img.ETag,
+			},
+		},
+	}
+}
+
+func qrCodeErrorResponse(statusCode int32, message string) *protobuf.QRCodeResponse {
+	return &protobuf.QRCodeResponse{
+		Response: &protobuf.QRCodeResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func qrCodeInvalidOptionResponse(err error) *protobuf.QRCodeResponse {
+	return qrCodeErrorResponse(http.StatusBadRequest, err.Error())
+}
+
+func qrCodeNotFoundResponse() *protobuf.QRCodeResponse {
+	return qrCodeErrorResponse(http.StatusNotFound, "URL not found")
+}
+
+func qrCodeDeletedResponse() *protobuf.QRCodeResponse {
+	return qrCodeErrorResponse(http.StatusGone, "URL has been deleted")
+}
+
+func qrCodeInternalErrorResponse() *protobuf.QRCodeResponse {
+	return qrCodeErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+// Delete Response Mappers
+
+func deleteAcceptedResponse() *protobuf.DeleteResponse {
+	return &protobuf.DeleteResponse{
+		Status: &protobuf.Status{
+			Code:    http.StatusAccepted,
+			Message: "URLs deletion accepted",
+		},
+	}
+}
+
+// UserData Response Mappers
+
+func userDataTemplateItems(templates []*urlsnipper.Template) []*protobuf.TemplateItem {
+	items := make([]*protobuf.TemplateItem, 0, len(templates))
+	for _, template := range templates {
+		items = append(items, &protobuf.TemplateItem{
+			Name:        template.Name,
+			UtmSource:   template.Source,
+			UtmMedium:   template.Medium,
+			UtmCampaign: template.Campaign,
+			UtmTerm:     template.Term,
+			UtmContent:  template.Content,
+		})
+	}
+	return items
+}
+
+func userDataWebhookItems(webhooks []*urlsnipper.Webhook) []*protobuf.WebhookItem {
+	items := make([]*protobuf.WebhookItem, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		items = append(items, &protobuf.WebhookItem{
+			Id:             webhook.ID,
+			Url:            webhook.URL,
+			Events:         webhook.Events,
+			ClickThreshold: int32(webhook.ClickThreshold),
+			CreatedAt:      formatCreatedAt(webhook.CreatedAt),
+		})
+	}
+	return items
+}
+
+func userDataAuditEntries(userID string, entries []*urlsnipper.AuditEntry) []*protobuf.AuditEntry {
+	items := make([]*protobuf.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, &protobuf.AuditEntry{
+			Id:         entry.ID,
+			UserId:     userID,
+			Action:     entry.Action,
+			ShortUrl:   entry.ShortURL,
+			Ip:         entry.IP,
+			AuthMethod: entry.AuthMethod,
+			CreatedAt:  formatCreatedAt(entry.CreatedAt),
+		})
+	}
+	return items
+}
+
+func userDataLink(shortURL string, link *urlsnipper.UserDataLink) *protobuf.UserDataLink {
+	rules := make([]*protobuf.RuleItem, 0, len(link.URL.Rules))
+	for _, rule := range link.URL.Rules {
+		rules = append(rules, ruleItem(rule))
+	}
+	variantStats := make([]*protobuf.VariantStatsItem, 0, len(link.VariantStats))
+	for _, stat := range link.VariantStats {
+		variantStats = append(variantStats, variantStatsItem(stat))
+	}
+	byCountry := make(map[string]int64, len(link.ClickStats.ByCountry))
+	for country, clicks := range link.ClickStats.ByCountry {
+		byCountry[country] = int64(clicks)
+	}
+	return &protobuf.UserDataLink{
+		Url:             userURLItem(shortURL, link.URL),
+		Rules:           rules,
+		VariantStats:    variantStats,
+		ClicksTotal:     int64(link.ClickStats.Total),
+		ClicksByCountry: byCountry,
+	}
+}
+
+// ErasureJob Response Mappers
+
+func erasureJobSuccessResponse(job *erasure.Job, statusCode int32, message string) *protobuf.ErasureJobResponse {
+	return &protobuf.ErasureJobResponse{
+		Response: &protobuf.ErasureJobResponse_Success{
+			Success: &protobuf.SuccessErasureJob{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+				Job: &protobuf.ErasureJob{
+					Id:         job.ID,
+					Status:     job.Status,
+					Error:      job.Error,
+					CreatedAt:  formatCreatedAt(job.CreatedAt),
+					FinishedAt: formatTimestamp(job.FinishedAt),
+				},
+			},
+		},
+	}
+}
+
+func erasureJobErrorResponse(statusCode int32, message string) *protobuf.ErasureJobResponse {
+	return &protobuf.ErasureJobResponse{
+		Response: &protobuf.ErasureJobResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func erasureJobAcceptedResponse(job *erasure.Job) *protobuf.ErasureJobResponse {
+	return erasureJobSuccessResponse(job, http.StatusAccepted, "User erasure accepted")
+}
+
+func erasureJobFoundResponse(job *erasure.Job) *protobuf.ErasureJobResponse {
+	return erasureJobSuccessResponse(job, http.StatusOK, "Erasure job retrieved successfully")
+}
+
+func erasureJobNotFoundResponse() *protobuf.ErasureJobResponse {
+	return erasureJobErrorResponse(http.StatusNotFound, "Erasure job not found")
+}
+
+func erasureJobInternalErrorResponse() *protobuf.ErasureJobResponse {
+	return erasureJobErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
+// AuditLog Mappers
+
+func auditLogRequestToFilter(req *protobuf.AuditLogRequest) (*audit.Filter, error) {
+	filter := &audit.Filter{
+		UserID:   req.UserId,
+		ShortURL: req.ShortUrl,
+		Action:   req.Action,
+		Limit:    int(req.Limit),
+		Before:   req.Cursor,
+	}
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from: %w", err)
+		}
+		filter.From = from
+	}
+	if req.To != "" {
+		to, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to: %w", err)
+		}
+		filter.To = to
+	}
+	return filter, nil
+}
+
+func auditLogSuccessResponse(page *audit.Page, statusCode int32, message string) *protobuf.AuditLogResponse {
+	entries := make([]*protobuf.AuditEntry, 0, len(page.Entries))
+	for _, entry := range page.Entries {
+		entries = append(entries, &protobuf.AuditEntry{
+			Id:         entry.ID,
+			UserId:     entry.UserID,
+			Action:     entry.Action,
+			ShortUrl:   entry.ShortURL,
+			Ip:         entry.IP,
+			AuthMethod: entry.AuthMethod,
+			CreatedAt:  formatCreatedAt(entry.CreatedAt),
+		})
+	}
+
+	return &protobuf.AuditLogResponse{
+		Response: &protobuf.AuditLogResponse_Success{
+			Success: &protobuf.SuccessAuditLog{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+				Entries:    entries,
+				NextCursor: page.Next,
+			},
+		},
+	}
+}
+
+func auditLogErrorResponse(statusCode int32, message string) *protobuf.AuditLogResponse {
+	return &protobuf.AuditLogResponse{
+		Response: &protobuf.AuditLogResponse_Error{
+			Error: &protobuf.Error{
+				Status: &protobuf.Status{
+					Code:    statusCode,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func auditLogFoundResponse(page *audit.Page) *protobuf.AuditLogResponse {
+	return auditLogSuccessResponse(page, http.StatusOK, "Audit log retrieved successfully")
+}
+
+func auditLogNoContentResponse() *protobuf.AuditLogResponse {
+	return auditLogSuccessResponse(&audit.Page{}, http.StatusNoContent, "No audit entries found")
+}
+
+func auditLogBadRequestResponse(err error) *protobuf.AuditLogResponse {
+	return auditLogErrorResponse(http.StatusBadRequest, err.Error())
+}
+
+func auditLogInternalErrorResponse() *protobuf.AuditLogResponse {
+	return auditLogErrorResponse(http.StatusInternalServerError, "Internal server error")
+}
+
 // Ping Response Mappers
 
 func pingResponse(statusCode int32, message string) *protobuf.PingResponse {
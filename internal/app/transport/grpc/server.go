@@ -3,31 +3,90 @@ package grpc
 import (
 	"context"
 	"errors"
+	"net"
+	"net/http"
 	"net/url"
+	"time"
 
+	"github.com/DanilNaum/SnipURL/internal/app/service/audit"
+	"github.com/DanilNaum/SnipURL/internal/app/service/erasure"
 	"github.com/DanilNaum/SnipURL/internal/app/service/private"
+	"github.com/DanilNaum/SnipURL/internal/app/service/tagging"
 	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/internal/app/service/webhook"
+	"github.com/DanilNaum/SnipURL/pkg/geoip"
 	"github.com/DanilNaum/SnipURL/pkg/protobuf"
+	"github.com/DanilNaum/SnipURL/pkg/qrcode"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type config interface {
 	GetPrefix() (string, error)
 	GetBaseURL() string
+	GetRedirectType() int
 }
 
 type service interface {
-	SetURL(ctx context.Context, url string) (string, error)
-	GetURL(ctx context.Context, id string) (string, error)
+	SetURL(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error)
+	Redirect(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error)
+	CheckURL(ctx context.Context, id string) error
+	GetURLInfo(ctx context.Context, id string) (*urlsnipper.URLInfo, error)
 	SetURLs(ctx context.Context, urls []*urlsnipper.SetURLsInput) (map[string]*urlsnipper.SetURLsOutput, error)
-	GetURLs(ctx context.Context) ([]*urlsnipper.URL, error)
+	UpdateURL(ctx context.Context, id string, opts ...urlsnipper.Option) (*urlsnipper.URL, error)
+	GetURLs(ctx context.Context, filter *urlsnipper.URLFilter) ([]*urlsnipper.URL, error)
+	ListURLs(ctx context.Context, input *urlsnipper.ListURLsInput) (*urlsnipper.URLPage, error)
+	ExportURLs(ctx context.Context) (urlsnipper.URLIterator, error)
+	ExportUserData(ctx context.Context) (*urlsnipper.UserData, error)
 	DeleteURLs(ctx context.Context, ids []string)
+	AddRule(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error)
+	GetRules(ctx context.Context, id string) ([]*urlsnipper.Rule, error)
+	UpdateRule(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error)
+	DeleteRule(ctx context.Context, id string, ruleID int) error
+	GetVariantStats(ctx context.Context, id string) ([]*urlsnipper.VariantStats, error)
+	GetClickStats(ctx context.Context, id string) (*urlsnipper.ClickStats, error)
+	WatchClicks(ctx context.Context, id string) (urlsnipper.ClickStream, error)
+}
+
+type locator interface {
+	Lookup(ip net.IP) (*geoip.Location, error)
+}
+
+type proxyChecker interface {
+	IsTrusted(ip net.IP) bool
+}
+
+type qrRenderer interface {
+	Render(content string, opts qrcode.Options) (*qrcode.Image, error)
+}
+
+type taggingService interface {
+	SetTemplate(ctx context.Context, template *tagging.Template) error
+	GetTemplates(ctx context.Context) ([]*tagging.Template, error)
+	DeleteTemplate(ctx context.Context, name string) error
+}
+
+type webhookService interface {
+	Register(ctx context.Context, webhook *webhook.Webhook) (*webhook.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]*webhook.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	GetDeliveries(ctx context.Context, id string) ([]*webhook.Delivery, error)
+}
+
+type erasureService interface {
+	Erase(ctx context.Context) (*erasure.Job, error)
+	GetJob(ctx context.Context, id string) (*erasure.Job, error)
 }
 
 type internalService interface {
 	GetState(ctx context.Context) (*private.State, error)
 }
 
+type auditService interface {
+	GetEntries(ctx context.Context, filter *audit.Filter) (*audit.Page, error)
+	GetUserEntries(ctx context.Context, filter *audit.Filter) (*audit.Page, error)
+}
+
 type psqlStoragePinger interface {
 	Ping(context.Context) error
 }
@@ -36,29 +95,60 @@ type psqlStoragePinger interface {
 type Server struct {
 	protobuf.UnimplementedSnipURLServiceServer
 	service           service
+	taggingService    taggingService
+	webhookService    webhookService
+	erasureService    erasureService
 	internalService   internalService
+	auditService      auditService
 	psqlStoragePinger psqlStoragePinger
+	locator           locator
+	proxyChecker      proxyChecker
+	qrRenderer        qrRenderer
 	baseURL           string
+	redirectType      int
 }
 
-// NewServer создает новый экземпляр gRPC сервера
+// NewServer создает новый экземпляр gRPC сервера.
+// locator определяет страну клиента по IP, proxyChecker решает,
+// можно ли доверять client_ip из запроса, qrRenderer рисует QR-коды ссылок,
+// auditService отдает журнал аудита действий пользователей.
 func NewServer(
 	service service,
+	taggingService taggingService,
+	webhookService webhookService,
+	erasureService erasureService,
 	internalService internalService,
+	auditService auditService,
 	psqlStoragePinger psqlStoragePinger,
 	conf config,
+	locator locator,
+	proxyChecker proxyChecker,
+	qrRenderer qrRenderer,
 ) (*Server, error) {
 	return &Server{
 		service:           service,
+		taggingService:    taggingService,
+		webhookService:    webhookService,
+		erasureService:    erasureService,
 		internalService:   internalService,
+		auditService:      auditService,
 		psqlStoragePinger: psqlStoragePinger,
+		locator:           locator,
+		proxyChecker:      proxyChecker,
+		qrRenderer:        qrRenderer,
 		baseURL:           conf.GetBaseURL(),
+		redirectType:      conf.GetRedirectType(),
 	}, nil
 }
 
 // CreateShortURL создает короткую ссылку из переданного URL
 func (s *Server) CreateShortURL(ctx context.Context, req *protobuf.ShortURLRequest) (*protobuf.ShortURLResponse, error) {
-	id, err := s.service.SetURL(ctx, req.Url)
+	opts, err := shortURLRequestToServiceOptions(req)
+	if err != nil {
+		return shortURLInvalidOptionResponse(err), nil
+	}
+
+	id, err := s.service.SetURL(ctx, req.Url, opts...)
 	if err != nil {
 		if errors.Is(err, urlsnipper.ErrConflict) {
 			fullShortURL, urlErr := url.JoinPath(s.baseURL, id)
@@ -68,6 +158,9 @@ func (s *Server) CreateShortURL(ctx context.Context, req *protobuf.ShortURLReque
 			return shortURLConflictResponse(fullShortURL), nil
 
 		}
+		if errors.Is(err, urlsnipper.ErrInvalidOption) {
+			return shortURLInvalidOptionResponse(err), nil
+		}
 		return shortURLInternalErrorResponse(), nil
 	}
 
@@ -79,22 +172,113 @@ func (s *Server) CreateShortURL(ctx context.Context, req *protobuf.ShortURLReque
 	return shortURLCreatedResponse(fullShortURL), nil
 }
 
-// GetOriginalURL получает оригинальный URL по короткому ID
+// GetOriginalURL получает оригинальный URL по короткому ID.
+// Правила маршрутизации ссылки проверяются по user_agent и accept_language из запроса
+// и по стране клиента. Страна определяется по client_ip, если запрос пришел от
+// доверенного прокси, иначе по адресу соединения.
+// Если правило не подошло, для A/B-ссылок выбирается вариант: ранее выбранный
+// variant сохраняется, иначе он выбирается по весам и возвращается в ответе.
+// Для ссылок с passthrough путь и строка запроса из запроса переносятся в целевой URL,
+// путь с сегментами ".." отклоняется.
+// Для ссылок, защищенных паролем, нужен верный password; неудачные попытки
+// ограничиваются по IP клиента. Если IP клиента неизвестен, запрос с password
+// отклоняется с кодом 400.
+// Вне окна активности ссылка ведет на резервный URL, если он задан, иначе
+// до начала окна возвращается 404, а после окончания — 410.
+// Для ссылок с лимитом переходов каждый редирект уменьшает счетчик; после
+// исчерпания лимита возвращается 410.
+// Каждый успешный переход учитывается в статистике кликов.
+// Сам переход выполняет сервис (см. urlsnipper.Redirect), общий для REST и gRPC.
 func (s *Server) GetOriginalURL(ctx context.Context, req *protobuf.ShortURLID) (*protobuf.OriginalURLResponse, error) {
-	originalURL, err := s.service.GetURL(ctx, req.Id)
+	query, err := url.ParseQuery(req.Query)
+	if err != nil {
+		return originalURLBadRequestResponse(), nil
+	}
+
+	// Попытки ввода пароля ограничиваются по IP клиента, поэтому без адреса
+	// клиента пароль не проверяется
+	ip := s.clientIP(ctx, req)
+	if ip == nil && req.Password != "" {
+		return originalURLUnknownClientResponse(), nil
+	}
+	var clientKey string
+	if ip != nil {
+		clientKey = ip.String()
+	}
+
+	redirect, err := s.service.Redirect(ctx, &urlsnipper.RedirectRequest{
+		ID:        req.Id,
+		Path:      req.Path,
+		Query:     query,
+		Client:    s.newClient(ctx, req),
+		Variant:   req.Variant,
+		Password:  req.Password,
+		ClientKey: clientKey,
+	})
 	if err != nil {
-		if errors.Is(err, urlsnipper.ErrDeleted) {
+		switch {
+		case errors.Is(err, urlsnipper.ErrDeleted):
 			return originalURLDeletedResponse(), nil
+		case errors.Is(err, urlsnipper.ErrExhausted):
+			return originalURLExhaustedResponse(), nil
+		case errors.Is(err, urlsnipper.ErrNotYetActive):
+			return originalURLNotYetActiveResponse(), nil
+		case errors.Is(err, urlsnipper.ErrEnded):
+			return originalURLEndedResponse(), nil
+		case errors.Is(err, urlsnipper.ErrPasswordRequired):
+			return originalURLPasswordRequiredResponse(), nil
+		case errors.Is(err, urlsnipper.ErrWrongPassword):
+			return originalURLWrongPasswordResponse(), nil
+		case errors.Is(err, urlsnipper.ErrTooManyAttempts):
+			return originalURLTooManyAttemptsResponse(), nil
+		case errors.Is(err, urlsnipper.ErrPassthroughDisabled):
+			return originalURLNotFoundResponse(), nil
+		case errors.Is(err, urlsnipper.ErrInvalidPassthroughPath):
+			return originalURLBadRequestResponse(), nil
+		default:
+			return originalURLInternalErrorResponse(), nil
 		}
-		return originalURLInternalErrorResponse(), nil
 	}
 
-	return originalURLSuccessResponse(originalURL), nil
+	redirectType := redirect.RedirectType
+	if redirectType == 0 {
+		redirectType = s.redirectType
+	}
+
+	return originalURLSuccessResponse(redirect.Target, int32(redirectType), redirect.Variant), nil
+}
+
+// GetURLInfo описывает ссылку без перехода по ней: переход не учитывается в статистике,
+// а цель ссылки, защищенной паролем, не раскрывается
+func (s *Server) GetURLInfo(ctx context.Context, req *protobuf.URLInfoRequest) (*protobuf.URLInfoResponse, error) {
+	info, err := s.service.GetURLInfo(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlsnipper.ErrNotFound):
+			return urlInfoNotFoundResponse(), nil
+		case errors.Is(err, urlsnipper.ErrDeleted):
+			return urlInfoDeletedResponse(), nil
+		default:
+			return urlInfoInternalErrorResponse(), nil
+		}
+	}
+
+	shortURL, err := url.JoinPath(s.baseURL, info.ShortURL)
+	if err != nil {
+		return urlInfoInternalErrorResponse(), nil
+	}
+
+	return urlInfoSuccessResponse(shortURL, info), nil
 }
 
 // CreateShortURLJson создает короткую ссылку из JSON запроса
 func (s *Server) CreateShortURLJson(ctx context.Context, req *protobuf.JsonShortURLRequest) (*protobuf.JsonShortURLResponse, error) {
-	id, err := s.service.SetURL(ctx, req.Url)
+	opts, err := jsonShortURLRequestToServiceOptions(req)
+	if err != nil {
+		return jsonShortURLInvalidOptionResponse(err), nil
+	}
+
+	id, err := s.service.SetURL(ctx, req.Url, opts...)
 	if err != nil {
 		if errors.Is(err, urlsnipper.ErrConflict) {
 			fullShortURL, urlErr := url.JoinPath(s.baseURL, id)
@@ -103,6 +287,9 @@ func (s *Server) CreateShortURLJson(ctx context.Context, req *protobuf.JsonShort
 			}
 			return jsonShortURLConflictResponse(fullShortURL), nil
 		}
+		if errors.Is(err, urlsnipper.ErrInvalidOption) {
+			return jsonShortURLInvalidOptionResponse(err), nil
+		}
 		return jsonShortURLInternalErrorResponse(), nil
 	}
 
@@ -118,14 +305,18 @@ func (s *Server) CreateShortURLJson(ctx context.Context, req *protobuf.JsonShort
 func (s *Server) BatchCreateShortURLs(ctx context.Context, req *protobuf.BatchCreateRequest) (*protobuf.BatchCreateResponse, error) {
 	urls := make([]*urlsnipper.SetURLsInput, 0, len(req.Items))
 	for _, item := range req.Items {
-		urls = append(urls, &urlsnipper.SetURLsInput{
-			CorrelationID: item.CorrelationId,
-			OriginalURL:   item.OriginalUrl,
-		})
+		input, err := batchURLItemToServiceModel(item)
+		if err != nil {
+			return batchCreateInvalidOptionResponse(err), nil
+		}
+		urls = append(urls, input)
 	}
 
 	result, err := s.service.SetURLs(ctx, urls)
 	if err != nil {
+		if errors.Is(err, urlsnipper.ErrInvalidOption) {
+			return batchCreateInvalidOptionResponse(err), nil
+		}
 		return batchCreateInternalErrorResponse(), nil
 	}
 
@@ -135,15 +326,15 @@ func (s *Server) BatchCreateShortURLs(ctx context.Context, req *protobuf.BatchCr
 		if err != nil {
 			return batchCreateConstructErrorResponse(), nil
 		}
-		items = append(items, batchCreateResponseItem(res.CorrelationID, shortURL))
+		items = append(items, batchCreateResponseItem(res.CorrelationID, shortURL, res.Existing))
 	}
 
 	return batchCreateSuccessResponse(items), nil
 }
 
-// GetUserURLs получает все URL пользователя
-func (s *Server) GetUserURLs(ctx context.Context, req *emptypb.Empty) (*protobuf.UserURLsResponse, error) {
-	urls, err := s.service.GetURLs(ctx)
+// GetUserURLs получает URL пользователя, при заданном tag — только ссылки с этим тегом
+func (s *Server) GetUserURLs(ctx context.Context, req *protobuf.UserURLsRequest) (*protobuf.UserURLsResponse, error) {
+	urls, err := s.service.GetURLs(ctx, &urlsnipper.URLFilter{Tag: req.Tag})
 	if err != nil {
 		return userURLsInternalErrorResponse(), nil
 	}
@@ -158,12 +349,48 @@ func (s *Server) GetUserURLs(ctx context.Context, req *emptypb.Empty) (*protobuf
 		if err != nil {
 			return userURLsConstructErrorResponse(), nil
 		}
-		items = append(items, userURLItem(shortURL, urlItem.OriginalURL))
+		items = append(items, userURLItem(shortURL, urlItem))
 	}
 
 	return userURLsFoundResponse(items), nil
 }
 
+// ListUserURLs получает страницу URL пользователя. Ссылки сортируются по времени
+// создания или числу переходов, фильтруются по тегу, подстроке оригинального URL
+// или заголовка и недоступности цели. Следующая страница запрашивается с next_cursor из ответа.
+func (s *Server) ListUserURLs(ctx context.Context, req *protobuf.ListUserURLsRequest) (*protobuf.ListUserURLsResponse, error) {
+	page, err := s.service.ListURLs(ctx, &urlsnipper.ListURLsInput{
+		Tag:    req.Tag,
+		Search: req.Search,
+		Broken: req.Broken,
+		Sort:   req.Sort,
+		Order:  req.Order,
+		Limit:  int(req.Limit),
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		if errors.Is(err, urlsnipper.ErrInvalidListQuery) {
+			return listUserURLsBadRequestResponse(err), nil
+		}
+		return listUserURLsInternalErrorResponse(), nil
+	}
+
+	if len(page.URLs) == 0 {
+		return listUserURLsNoContentResponse(), nil
+	}
+
+	items := make([]*protobuf.UserURLItem, 0, len(page.URLs))
+	for _, urlItem := range page.URLs {
+		shortURL, err := url.JoinPath(s.baseURL, urlItem.ShortURL)
+		if err != nil {
+			return listUserURLsConstructErrorResponse(), nil
+		}
+		items = append(items, userURLItem(shortURL, urlItem))
+	}
+
+	return listUserURLsFoundResponse(items, page.NextCursor), nil
+}
+
 // DeleteUserURLs удаляет URL пользователя
 func (s *Server) DeleteUserURLs(ctx context.Context, req *protobuf.DeleteUserURLsRequest) (*protobuf.DeleteResponse, error) {
 	s.service.DeleteURLs(ctx, req.UrlIds)
@@ -171,6 +398,323 @@ func (s *Server) DeleteUserURLs(ctx context.Context, req *protobuf.DeleteUserURL
 	return deleteAcceptedResponse(), nil
 }
 
+// UpdateURL изменяет настройки URL пользователя
+func (s *Server) UpdateURL(ctx context.Context, req *protobuf.UpdateURLRequest) (*protobuf.UpdateURLResponse, error) {
+	opts, err := updateURLRequestToServiceOptions(req)
+	if err != nil {
+		return updateURLInvalidOptionResponse(err), nil
+	}
+
+	updated, err := s.service.UpdateURL(ctx, req.Id, opts...)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlsnipper.ErrInvalidOption):
+			return updateURLInvalidOptionResponse(err), nil
+		case errors.Is(err, urlsnipper.ErrNotFound):
+			return updateURLNotFoundResponse(), nil
+		default:
+			return updateURLInternalErrorResponse(), nil
+		}
+	}
+
+	shortURL, err := url.JoinPath(s.baseURL, updated.ShortURL)
+	if err != nil {
+		return updateURLConstructErrorResponse(), nil
+	}
+
+	return updateURLSuccessResponse(userURLItem(shortURL, updated)), nil
+}
+
+// SetTemplate создает или заменяет шаблон UTM-меток пользователя
+func (s *Server) SetTemplate(ctx context.Context, req *protobuf.TemplateItem) (*protobuf.SetTemplateResponse, error) {
+	err := s.taggingService.SetTemplate(ctx, templateItemToServiceModel(req))
+	if err != nil {
+		if errors.Is(err, tagging.ErrInvalidTemplate) {
+			return setTemplateInvalidResponse(), nil
+		}
+		return setTemplateInternalErrorResponse(), nil
+	}
+
+	return setTemplateSuccessResponse(req), nil
+}
+
+// ListTemplates получает все шаблоны UTM-меток пользователя
+func (s *Server) ListTemplates(ctx context.Context, req *emptypb.Empty) (*protobuf.ListTemplatesResponse, error) {
+	templates, err := s.taggingService.GetTemplates(ctx)
+	if err != nil {
+		return listTemplatesInternalErrorResponse(), nil
+	}
+
+	if len(templates) == 0 {
+		return listTemplatesNoContentResponse(), nil
+	}
+
+	items := make([]*protobuf.TemplateItem, 0, len(templates))
+	for _, template := range templates {
+		items = append(items, templateItem(template))
+	}
+
+	return listTemplatesFoundResponse(items), nil
+}
+
+// DeleteTemplate удаляет шаблон UTM-меток пользователя.
+// Ссылки с этим шаблоном продолжают работать без меток.
+func (s *Server) DeleteTemplate(ctx context.Context, req *protobuf.DeleteTemplateRequest) (*protobuf.DeleteResponse, error) {
+	err := s.taggingService.DeleteTemplate(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, tagging.ErrNotFound) {
+			return deleteTemplateNotFoundResponse(), nil
+		}
+		return deleteTemplateInternalErrorResponse(), nil
+	}
+
+	return deleteTemplateSuccessResponse(), nil
+}
+
+// RegisterWebhook регистрирует вебхук пользователя.
+// Секрет подписи доставок возвращается только в этом ответе.
+func (s *Server) RegisterWebhook(ctx context.Context, req *protobuf.WebhookItem) (*protobuf.WebhookResponse, error) {
+	registered, err := s.webhookService.Register(ctx, webhookItemToServiceModel(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, webhook.ErrInvalidWebhook):
+			return webhookInvalidResponse(err.Error()), nil
+		case errors.Is(err, webhook.ErrTooManyWebhooks):
+			return webhookTooManyResponse(), nil
+		}
+		return webhookInternalErrorResponse(), nil
+	}
+
+	return webhookSuccessResponse(webhookItem(registered)), nil
+}
+
+// ListWebhooks получает все вебхуки пользователя без секретов
+func (s *Server) ListWebhooks(ctx context.Context, req *emptypb.Empty) (*protobuf.ListWebhooksResponse, error) {
+	webhooks, err := s.webhookService.GetWebhooks(ctx)
+	if err != nil {
+		return listWebhooksInternalErrorResponse(), nil
+	}
+
+	if len(webhooks) == 0 {
+		return listWebhooksNoContentResponse(), nil
+	}
+
+	items := make([]*protobuf.WebhookItem, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		items = append(items, webhookItem(webhook))
+	}
+
+	return listWebhooksFoundResponse(items), nil
+}
+
+// DeleteWebhook удаляет вебхук пользователя вместе с журналом доставок
+func (s *Server) DeleteWebhook(ctx context.Context, req *protobuf.DeleteWebhookRequest) (*protobuf.DeleteResponse, error) {
+	err := s.webhookService.DeleteWebhook(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, webhook.ErrNotFound) {
+			return deleteWebhookNotFoundResponse(), nil
+		}
+		return deleteWebhookInternalErrorResponse(), nil
+	}
+
+	return deleteWebhookSuccessResponse(), nil
+}
+
+// ListWebhookDeliveries получает последние попытки доставки вебхука пользователя, новые первыми
+func (s *Server) ListWebhookDeliveries(ctx context.Context, req *protobuf.ListWebhookDeliveriesRequest) (*protobuf.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := s.webhookService.GetDeliveries(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, webhook.ErrNotFound) {
+			return listWebhookDeliveriesNotFoundResponse(), nil
+		}
+		return listWebhookDeliveriesInternalErrorResponse(), nil
+	}
+
+	if len(deliveries) == 0 {
+		return listWebhookDeliveriesNoContentResponse(), nil
+	}
+
+	items := make([]*protobuf.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		items = append(items, webhookDeliveryItem(delivery))
+	}
+
+	return listWebhookDeliveriesFoundResponse(items), nil
+}
+
+// AddRule добавляет правило маршрутизации к URL пользователя
+func (s *Server) AddRule(ctx context.Context, req *protobuf.RuleRequest) (*protobuf.RuleResponse, error) {
+	rule, err := s.service.AddRule(ctx, req.Id, ruleItemToServiceModel(req.Rule))
+	if err != nil {
+		return ruleErrorResponseFromServiceError(err), nil
+	}
+
+	return ruleSuccessResponse(ruleItem(rule), http.StatusCreated, "Rule created successfully"), nil
+}
+
+// ListRules получает правила маршрутизации URL пользователя в порядке проверки
+func (s *Server) ListRules(ctx context.Context, req *protobuf.ListRulesRequest) (*protobuf.ListRulesResponse, error) {
+	rules, err := s.service.GetRules(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlsnipper.ErrNotFound):
+			return listRulesNotFoundResponse(), nil
+		default:
+			return listRulesInternalErrorResponse(), nil
+		}
+	}
+
+	items := make([]*protobuf.RuleItem, 0, len(rules))
+	for _, rule := range rules {
+		items = append(items, ruleItem(rule))
+	}
+
+	return listRulesSuccessResponse(items), nil
+}
+
+// UpdateRule заменяет правило маршрутизации URL пользователя
+func (s *Server) UpdateRule(ctx context.Context, req *protobuf.RuleRequest) (*protobuf.RuleResponse, error) {
+	rule, err := s.service.UpdateRule(ctx, req.Id, ruleItemToServiceModel(req.Rule))
+	if err != nil {
+		return ruleErrorResponseFromServiceError(err), nil
+	}
+
+	return ruleSuccessResponse(ruleItem(rule), http.StatusOK, "Rule updated successfully"), nil
+}
+
+// DeleteRule удаляет правило маршрутизации URL пользователя
+func (s *Server) DeleteRule(ctx context.Context, req *protobuf.DeleteRuleRequest) (*protobuf.DeleteResponse, error) {
+	err := s.service.DeleteRule(ctx, req.Id, int(req.RuleId))
+	if err != nil {
+		switch {
+		case errors.Is(err, urlsnipper.ErrNotFound), errors.Is(err, urlsnipper.ErrRuleNotFound):
+			return deleteRuleNotFoundResponse(), nil
+		default:
+			return deleteRuleInternalErrorResponse(), nil
+		}
+	}
+
+	return deleteRuleSuccessResponse(), nil
+}
+
+// GetVariantStats получает статистику показов A/B-вариантов URL пользователя
+func (s *Server) GetVariantStats(ctx context.Context, req *protobuf.VariantStatsRequest) (*protobuf.VariantStatsResponse, error) {
+	stats, err := s.service.GetVariantStats(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlsnipper.ErrNotFound):
+			return variantStatsNotFoundResponse(), nil
+		default:
+			return variantStatsInternalErrorResponse(), nil
+		}
+	}
+
+	items := make([]*protobuf.VariantStatsItem, 0, len(stats))
+	for _, stat := range stats {
+		items = append(items, variantStatsItem(stat))
+	}
+
+	return variantStatsSuccessResponse(items), nil
+}
+
+// GetClickStats получает статистику переходов URL пользователя по странам
+func (s *Server) GetClickStats(ctx context.Context, req *protobuf.ClickStatsRequest) (*protobuf.ClickStatsResponse, error) {
+	stats, err := s.service.GetClickStats(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlsnipper.ErrNotFound):
+			return clickStatsNotFoundResponse(), nil
+		default:
+			return clickStatsInternalErrorResponse(), nil
+		}
+	}
+
+	return clickStatsSuccessResponse(stats), nil
+}
+
+// GetQRCode рисует QR-код полной короткой ссылки. Расписание и лимит переходов
+// ссылки не проверяются. Если etag запроса совпадает с ETag изображения,
+// возвращается статус 304 без данных
+func (s *Server) GetQRCode(ctx context.Context, req *protobuf.QRCodeRequest) (*protobuf.QRCodeResponse, error) {
+	err := s.service.CheckURL(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, urlsnipper.ErrNotFound):
+			return qrCodeNotFoundResponse(), nil
+		case errors.Is(err, urlsnipper.ErrDeleted):
+			return qrCodeDeletedResponse(), nil
+		default:
+			return qrCodeInternalErrorResponse(), nil
+		}
+	}
+
+	shortURL, err := url.JoinPath(s.baseURL, req.Id)
+	if err != nil {
+		return qrCodeInternalErrorResponse(), nil
+	}
+
+	img, err := s.qrRenderer.Render(shortURL, qrCodeRequestToOptions(req))
+	if err != nil {
+		if errors.Is(err, qrcode.ErrInvalidOptions) {
+			return qrCodeInvalidOptionResponse(err), nil
+		}
+		return qrCodeInternalErrorResponse(), nil
+	}
+
+	if req.Etag == img.ETag {
+		return qrCodeNotModifiedResponse(img), nil
+	}
+	return qrCodeSuccessResponse(img), nil
+}
+
+// EraseUser удаляет пользователя: его ID сразу отзывается, а ссылки, правила, статистика
+// и шаблоны удаляются в фоне, переходы по ссылкам обезличиваются
+func (s *Server) EraseUser(ctx context.Context, _ *emptypb.Empty) (*protobuf.ErasureJobResponse, error) {
+	job, err := s.erasureService.Erase(ctx)
+	if err != nil {
+		return erasureJobInternalErrorResponse(), nil
+	}
+
+	return erasureJobAcceptedResponse(job), nil
+}
+
+// GetErasureJob получает состояние удаления пользователя, ID задачи служит единственным
+// подтверждением доступа, так как пользователь к этому моменту уже отозван
+func (s *Server) GetErasureJob(ctx context.Context, req *protobuf.ErasureJobRequest) (*protobuf.ErasureJobResponse, error) {
+	job, err := s.erasureService.GetJob(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, erasure.ErrNotFound) {
+			return erasureJobNotFoundResponse(), nil
+		}
+		return erasureJobInternalErrorResponse(), nil
+	}
+
+	return erasureJobFoundResponse(job), nil
+}
+
+// GetUserAuditLog получает страницу журнала аудита действий пользователя над ссылками,
+// user_id запроса не учитывается. Следующая страница запрашивается с next_cursor из ответа.
+func (s *Server) GetUserAuditLog(ctx context.Context, req *protobuf.AuditLogRequest) (*protobuf.AuditLogResponse, error) {
+	filter, err := auditLogRequestToFilter(req)
+	if err != nil {
+		return auditLogBadRequestResponse(err), nil
+	}
+
+	page, err := s.auditService.GetUserEntries(ctx, filter)
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidFilter) {
+			return auditLogBadRequestResponse(err), nil
+		}
+		return auditLogInternalErrorResponse(), nil
+	}
+
+	if len(page.Entries) == 0 {
+		return auditLogNoContentResponse(), nil
+	}
+
+	return auditLogFoundResponse(page), nil
+}
+
 // Ping проверяет состояние базы данных
 func (s *Server) Ping(ctx context.Context, req *emptypb.Empty) (*protobuf.PingResponse, error) {
 	err := s.psqlStoragePinger.Ping(ctx)
@@ -190,3 +734,62 @@ func (s *Server) GetStats(ctx context.Context, req *emptypb.Empty) (*protobuf.St
 
 	return statsSuccessResponse(stats), nil
 }
+
+// GetAuditLog получает страницу журнала аудита действий всех пользователей над ссылками.
+// Следующая страница запрашивается с next_cursor из ответа.
+func (s *Server) GetAuditLog(ctx context.Context, req *protobuf.AuditLogRequest) (*protobuf.AuditLogResponse, error) {
+	filter, err := auditLogRequestToFilter(req)
+	if err != nil {
+		return auditLogBadRequestResponse(err), nil
+	}
+
+	page, err := s.auditService.GetEntries(ctx, filter)
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidFilter) {
+			return auditLogBadRequestResponse(err), nil
+		}
+		return auditLogInternalErrorResponse(), nil
+	}
+
+	if len(page.Entries) == 0 {
+		return auditLogNoContentResponse(), nil
+	}
+
+	return auditLogFoundResponse(page), nil
+}
+
+// newClient собирает атрибуты запроса для правил маршрутизации.
+// Если база GeoIP не подключена или адрес не найден, страна остается пустой.
+func (s *Server) newClient(ctx context.Context, req *protobuf.ShortURLID) *urlsnipper.Client {
+	client := urlsnipper.NewClient(req.UserAgent, req.AcceptLanguage, time.Now())
+	if s.locator == nil {
+		return client
+	}
+
+	location, err := s.locator.Lookup(s.clientIP(ctx, req))
+	if err != nil {
+		return client
+	}
+	client.Country = location.Country
+	client.InEU = location.InEU
+	return client
+}
+
+// clientIP возвращает client_ip из запроса, если соединение пришло от
+// доверенного прокси, иначе адрес самого соединения.
+func (s *Server) clientIP(ctx context.Context, req *protobuf.ShortURLID) net.IP {
+	var peerIP net.IP
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err == nil {
+			peerIP = net.ParseIP(host)
+		}
+	}
+
+	if req.ClientIp != "" && peerIP != nil && s.proxyChecker != nil && s.proxyChecker.IsTrusted(peerIP) {
+		if ip := net.ParseIP(req.ClientIp); ip != nil {
+			return ip
+		}
+	}
+	return peerIP
+}
@@ -0,0 +1,258 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/pkg/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// streamChunkSize задает, сколько элементов StreamCreateShortURLs сохраняет за раз
+// и сколько ссылок ExportUserURLs отправляет в одном сообщении
+const streamChunkSize = 100
+
+// StreamCreateShortURLs создает короткие ссылки из потока элементов. Элементы
+// сохраняются порциями по streamChunkSize, на каждую порцию отправляется
+// подтверждение. Следующие элементы не читаются, пока порция не сохранена, поэтому
+// медленное хранилище сдерживает клиента через управление потоком gRPC. Порция с
+// некорректными настройками отклоняется целиком, поток при этом продолжается.
+// Для уже сокращенного URL подтверждается существующая ссылка с existing, а элементы,
+// для которых не удалось создать ссылку, отклоняются отдельным сообщением.
+func (s *Server) StreamCreateShortURLs(stream protobuf.SnipURLService_StreamCreateShortURLsServer) error {
+	ctx := stream.Context()
+	chunk := make([]*protobuf.BatchURLItem, 0, streamChunkSize)
+	for {
+		item, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return s.createChunk(ctx, stream, chunk)
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk = append(chunk, item)
+		if len(chunk) < streamChunkSize {
+			continue
+		}
+		if err := s.createChunk(ctx, stream, chunk); err != nil {
+			return err
+		}
+		chunk = chunk[:0]
+	}
+}
+
+func (s *Server) createChunk(ctx context.Context, stream protobuf.SnipURLService_StreamCreateShortURLsServer, chunk []*protobuf.BatchURLItem) error {
+	if len(chunk) == 0 {
+		return nil
+	}
+
+	urls := make([]*urlsnipper.SetURLsInput, 0, len(chunk))
+	for _, item := range chunk {
+		input, err := batchURLItemToServiceModel(item)
+		if err != nil {
+			return stream.Send(streamCreateRejectedResponse(err, chunk))
+		}
+		urls = append(urls, input)
+	}
+
+	// Элементы, которые не удалось сохранить, отклоняются по отдельности,
+	// уже сокращенные URL подтверждаются существующей ссылкой
+	result, err := s.service.SetURLs(ctx, urls)
+	if err != nil && !errors.Is(err, urlsnipper.ErrFailedToGenerateID) {
+		if errors.Is(err, urlsnipper.ErrInvalidOption) {
+			return stream.Send(streamCreateRejectedResponse(err, chunk))
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	items := make([]*protobuf.BatchCreateResponseItem, 0, len(result))
+	var failed []string
+	for _, item := range chunk {
+		res, ok := result[item.CorrelationId]
+		if !ok {
+			failed = append(failed, item.CorrelationId)
+			continue
+		}
+		shortURL, err := url.JoinPath(s.baseURL, res.ShortURLID)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to construct URL")
+		}
+		items = append(items, batchCreateResponseItem(res.CorrelationID, shortURL, res.Existing))
+	}
+
+	if len(items) > 0 {
+		if err := stream.Send(streamCreateSuccessResponse(items)); err != nil {
+			return err
+		}
+	}
+	if len(failed) > 0 {
+		return stream.Send(streamCreateErrorResponse(http.StatusConflict, "Failed to generate a short URL", failed))
+	}
+	return nil
+}
+
+// StreamUserURLs отправляет URL пользователя порциями по limit ссылок с учетом
+// сортировки, поиска и фильтра по тегу. Следующая порция читается из хранилища
+// только после отправки предыдущей, поэтому медленный клиент сдерживает сервер.
+// Каждая порция содержит курсор, с которым можно продолжить прерванный поток
+// через cursor запроса.
+func (s *Server) StreamUserURLs(req *protobuf.ListUserURLsRequest, stream protobuf.SnipURLService_StreamUserURLsServer) error {
+	ctx := stream.Context()
+	input := &urlsnipper.ListURLsInput{
+		Tag:    req.Tag,
+		Search: req.Search,
+		Sort:   req.Sort,
+		Order:  req.Order,
+		Limit:  int(req.Limit),
+		Cursor: req.Cursor,
+	}
+	for {
+		page, err := s.service.ListURLs(ctx, input)
+		if err != nil {
+			if errors.Is(err, urlsnipper.ErrInvalidListQuery) {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return status.FromContextError(ctxErr).Err()
+			}
+			return status.Error(codes.Internal, "Internal server error")
+		}
+
+		if len(page.URLs) > 0 {
+			items := make([]*protobuf.UserURLItem, 0, len(page.URLs))
+			for _, urlItem := range page.URLs {
+				shortURL, err := url.JoinPath(s.baseURL, urlItem.ShortURL)
+				if err != nil {
+					return status.Error(codes.Internal, "Failed to construct URL")
+				}
+				items = append(items, userURLItem(shortURL, urlItem))
+			}
+			if err := stream.Send(&protobuf.StreamUserURLsResponse{Items: items, Cursor: page.NextCursor}); err != nil {
+				return err
+			}
+		}
+
+		if page.NextCursor == "" {
+			return nil
+		}
+		input.Cursor = page.NextCursor
+	}
+}
+
+// ExportUserURLs выгружает все URL пользователя в порядке создания, включая
+// удаленные, с метаданными и числом переходов. Ссылки читаются из хранилища по мере
+// отправки порций по streamChunkSize, поэтому выгрузка не собирается в памяти целиком.
+func (s *Server) ExportUserURLs(_ *emptypb.Empty, stream protobuf.SnipURLService_ExportUserURLsServer) error {
+	ctx := stream.Context()
+	urls, err := s.service.ExportURLs(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	defer urls.Close()
+
+	items := make([]*protobuf.UserURLItem, 0, streamChunkSize)
+	for urls.Next() {
+		urlItem := urls.URL()
+		shortURL, err := url.JoinPath(s.baseURL, urlItem.ShortURL)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to construct URL")
+		}
+		items = append(items, userURLItem(shortURL, urlItem))
+		if len(items) < streamChunkSize {
+			continue
+		}
+		if err := stream.Send(&protobuf.ExportUserURLsResponse{Items: items}); err != nil {
+			return err
+		}
+		items = make([]*protobuf.UserURLItem, 0, streamChunkSize)
+	}
+	if err := urls.Err(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return stream.Send(&protobuf.ExportUserURLsResponse{Items: items})
+}
+
+// GetUserData выгружает все данные пользователя. Первое сообщение содержит ID пользователя,
+// его шаблоны, вебхуки и записи журнала аудита, ссылки с правилами и статистикой передаются
+// порциями по streamChunkSize
+func (s *Server) GetUserData(_ *emptypb.Empty, stream protobuf.SnipURLService_GetUserDataServer) error {
+	ctx := stream.Context()
+	data, err := s.service.ExportUserData(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	defer data.Links.Close()
+
+	resp := &protobuf.UserDataResponse{
+		UserId:       data.UserID,
+		Templates:    userDataTemplateItems(data.Templates),
+		Webhooks:     userDataWebhookItems(data.Webhooks),
+		AuditEntries: userDataAuditEntries(data.UserID, data.AuditEntries),
+		Links:        make([]*protobuf.UserDataLink, 0, streamChunkSize),
+	}
+	for data.Links.Next() {
+		link := data.Links.Link()
+		shortURL, err := url.JoinPath(s.baseURL, link.URL.ShortURL)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to construct URL")
+		}
+		resp.Links = append(resp.Links, userDataLink(shortURL, link))
+		if len(resp.Links) < streamChunkSize {
+			continue
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		resp = &protobuf.UserDataResponse{Links: make([]*protobuf.UserDataLink, 0, streamChunkSize)}
+	}
+	if err := data.Links.Err(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	if len(resp.Links) == 0 && resp.UserId == "" {
+		return nil
+	}
+	return stream.Send(resp)
+}
+
+// WatchClicks отправляет переходы по URL пользователя по мере их записи, пока клиент
+// не отключится или сервер не остановится. Переходы, которые клиент не успевает
+// принимать, пропускаются, а их число передается в каждом сообщении
+func (s *Server) WatchClicks(req *protobuf.WatchClicksRequest, stream protobuf.SnipURLService_WatchClicksServer) error {
+	clicks, err := s.service.WatchClicks(stream.Context(), req.Id)
+	if err != nil {
+		if errors.Is(err, urlsnipper.ErrNotFound) {
+			return status.Error(codes.NotFound, "URL not found")
+		}
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	for click := range clicks.C() {
+		shortURL, err := url.JoinPath(s.baseURL, click.ShortURL)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to construct URL")
+		}
+		if err := stream.Send(clickEvent(shortURL, click, clicks.Dropped())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,41 +2,109 @@ package rest
 
 import (
 	"context"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/internalendpoints"
 	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/pprof"
 
+	"github.com/DanilNaum/SnipURL/internal/app/service/audit"
+	"github.com/DanilNaum/SnipURL/internal/app/service/erasure"
 	"github.com/DanilNaum/SnipURL/internal/app/service/private"
+	"github.com/DanilNaum/SnipURL/internal/app/service/tagging"
 	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/internal/app/service/webhook"
 	middlewares "github.com/DanilNaum/SnipURL/internal/app/transport/rest/middlewares"
 	psqlping "github.com/DanilNaum/SnipURL/internal/app/transport/rest/psqlPing"
 	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/snipendpoint"
+	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/templateendpoint"
+	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/userendpoint"
+	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/webhookendpoint"
+	"github.com/DanilNaum/SnipURL/pkg/geoip"
+	"github.com/DanilNaum/SnipURL/pkg/qrcode"
 	"github.com/go-chi/chi/v5"
 )
 
 type logger interface {
-	Infoln(args ...any)
+	InfowContext(ctx context.Context, msg string, keysAndValues ...any)
 }
 
 type config interface {
 	GetPrefix() (string, error)
 	GetBaseURL() string
 	GetTrustedSubNet() string
+	GetRedirectType() int
 }
 
 type service interface {
-	GetURL(ctx context.Context, id string) (string, error)
-	SetURL(ctx context.Context, url string) (string, error)
+	Redirect(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error)
+	CheckURL(ctx context.Context, id string) error
+	GetURLInfo(ctx context.Context, id string) (*urlsnipper.URLInfo, error)
+	SetURL(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error)
 	SetURLs(ctx context.Context, urls []*urlsnipper.SetURLsInput) (map[string]*urlsnipper.SetURLsOutput, error)
-	GetURLs(ctx context.Context) ([]*urlsnipper.URL, error)
+	UpdateURL(ctx context.Context, id string, opts ...urlsnipper.Option) (*urlsnipper.URL, error)
+	GetURLs(ctx context.Context, filter *urlsnipper.URLFilter) ([]*urlsnipper.URL, error)
+	ListURLs(ctx context.Context, input *urlsnipper.ListURLsInput) (*urlsnipper.URLPage, error)
+	ExportURLs(ctx context.Context) (urlsnipper.URLIterator, error)
+	ExportUserData(ctx context.Context) (*urlsnipper.UserData, error)
 	DeleteURLs(ctx context.Context, ids []string)
+	AddRule(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error)
+	GetRules(ctx context.Context, id string) ([]*urlsnipper.Rule, error)
+	UpdateRule(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error)
+	DeleteRule(ctx context.Context, id string, ruleID int) error
+	GetVariantStats(ctx context.Context, id string) ([]*urlsnipper.VariantStats, error)
+	GetClickStats(ctx context.Context, id string) (*urlsnipper.ClickStats, error)
+	WatchClicks(ctx context.Context, id string) (urlsnipper.ClickStream, error)
+	CheckPassword(ctx context.Context, id, password, client string) (string, error)
+}
+
+type locator interface {
+	Lookup(ip net.IP) (*geoip.Location, error)
+}
+
+type ipResolver interface {
+	ClientIP(r *http.Request) net.IP
+}
+
+type taggingService interface {
+	SetTemplate(ctx context.Context, template *tagging.Template) error
+	GetTemplates(ctx context.Context) ([]*tagging.Template, error)
+	DeleteTemplate(ctx context.Context, name string) error
+}
+
+type webhookService interface {
+	Register(ctx context.Context, webhook *webhook.Webhook) (*webhook.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]*webhook.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	GetDeliveries(ctx context.Context, id string) ([]*webhook.Delivery, error)
+}
+
+type erasureService interface {
+	Erase(ctx context.Context) (*erasure.Job, error)
+	GetJob(ctx context.Context, id string) (*erasure.Job, error)
+	IsRevoked(ctx context.Context, userID string) (bool, error)
+}
+
+type auditService interface {
+	GetEntries(ctx context.Context, filter *audit.Filter) (*audit.Page, error)
+	GetUserEntries(ctx context.Context, filter *audit.Filter) (*audit.Page, error)
+}
+
+type qrRenderer interface {
+	Render(content string, opts qrcode.Options) (*qrcode.Image, error)
 }
 
 type internalService interface {
 	GetState(ctx context.Context) (*private.State, error)
 }
 
+// metrics records the requests and serves the metrics of the service.
+type metrics interface {
+	ObserveHTTPRequest(route, method string, code int, duration time.Duration)
+	Handler() http.Handler
+}
+
 type psqlStoragePinger interface {
 	Ping(context.Context) error
 }
@@ -54,19 +122,43 @@ type cookieManager interface {
 //   - mux: The base chi router to be configured
 //   - conf: Configuration interface for retrieving application settings
 //   - service: Service interface for URL shortening operations
+//   - taggingService: Service interface for managing tagging templates
+//   - webhookService: Service managing the users' webhooks
+//   - erasureService: Service erasing users on their request, whose user IDs are then refused
+//   - auditService: Audit log of the users' actions, queried by the trusted subnet and by the users for their own entries
 //   - psqlStoragePinger: Interface for checking PostgreSQL storage connectivity
 //   - cookieManager: Interface for managing HTTP cookies
+//   - unlockCookieManager: Signed cookie remembering unlocked password-protected links
+//   - locator: GeoIP lookup of the redirected clients' countries
+//   - ipResolver: Client address resolution behind trusted proxies
+//   - qrRenderer: QR code rendering of the short URLs
+//   - metrics: Metrics of the requests, served at /metrics to the trusted subnet
 //   - logger: Logger interface for logging information
 //
 // Returns an configured HTTP handler and an error if initialization fails.
-func NewController(mux *chi.Mux, conf config, service service, internalService internalService, psqlStoragePinger psqlStoragePinger, cookieManager cookieManager, logger logger) (http.Handler, error) {
+func NewController(mux *chi.Mux, conf config, service service, taggingService taggingService, webhookService webhookService, erasureService erasureService, auditService auditService, internalService internalService, psqlStoragePinger psqlStoragePinger, cookieManager cookieManager, unlockCookieManager cookieManager, locator locator, ipResolver ipResolver, qrRenderer qrRenderer, metrics metrics, logger logger) (http.Handler, error) {
 
-	middlewares := middlewares.NewMiddleware(logger, cookieManager, conf.GetTrustedSubNet())
+	middlewares := middlewares.NewMiddleware(logger, cookieManager, erasureService, metrics, ipResolver, conf.GetTrustedSubNet())
 
 	muxWithMiddlewares := middlewares.Register(mux)
 	// muxWithInternalMiddlewares := middlewares.RegisterForInternalReq(mux)
 
-	snipEndpoint, err := snipendpoint.NewSnipEndpoint(service, conf)
+	snipEndpoint, err := snipendpoint.NewSnipEndpoint(service, conf, locator, ipResolver, unlockCookieManager, qrRenderer)
+	if err != nil {
+		return nil, err
+	}
+
+	templateEndpoint, err := templateendpoint.NewTemplateEndpoint(taggingService, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookEndpoint, err := webhookendpoint.NewWebhookEndpoint(webhookService, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	userEndpoint, err := userendpoint.NewUserEndpoint(erasureService, auditService, conf)
 	if err != nil {
 		return nil, err
 	}
@@ -77,10 +169,16 @@ func NewController(mux *chi.Mux, conf config, service service, internalService i
 
 	snipEndpoint.Register(muxWithMiddlewares)
 
+	templateEndpoint.Register(muxWithMiddlewares)
+
+	webhookEndpoint.Register(muxWithMiddlewares)
+
+	userEndpoint.Register(muxWithMiddlewares)
+
 	pprofEndpoint := pprof.NewPProfEndpoint()
 	pprofEndpoint.Register(muxWithMiddlewares)
 
-	internalEndpoints, err := internalendpoints.NewInternalEndpoint(internalService)
+	internalEndpoints, err := internalendpoints.NewInternalEndpoint(internalService, auditService, metrics.Handler())
 	if err != nil {
 		return nil, err
 	}
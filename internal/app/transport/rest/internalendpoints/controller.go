@@ -2,13 +2,18 @@ package internalendpoints
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/DanilNaum/SnipURL/internal/app/service/audit"
 	"github.com/DanilNaum/SnipURL/internal/app/service/private"
+	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/utils/auditlog"
 	"github.com/go-chi/chi/v5"
 )
 
 const (
-	endpointStats = "/api/internal/stats"
+	endpointStats   = "/api/internal/stats"
+	endpointAudit   = "/api/internal/audit"
+	endpointMetrics = "/metrics"
 )
 
 type config interface {
@@ -20,23 +25,35 @@ type service interface {
 	GetState(ctx context.Context) (*private.State, error)
 }
 
+type auditService interface {
+	GetEntries(ctx context.Context, filter *audit.Filter) (*audit.Page, error)
+}
+
 type internalEndpoints struct {
-	service service
+	service        service
+	auditService   auditService
+	metricsHandler http.Handler
 }
 
-// NewInternalEndpoint creates a new instance of internalEndpoints with the provided service.
+// NewInternalEndpoint creates a new instance of internalEndpoints with the provided service,
+// the audit log of the users' actions and the handler serving the metrics of the service.
 // Returns the initialized internalEndpoints struct and nil error.
-func NewInternalEndpoint(service service) (*internalEndpoints, error) {
+func NewInternalEndpoint(service service, auditService auditService, metricsHandler http.Handler) (*internalEndpoints, error) {
 
 	return &internalEndpoints{
-		service: service,
+		service:        service,
+		auditService:   auditService,
+		metricsHandler: metricsHandler,
 	}, nil
 }
 
 // Register registers internal endpoint routes with the provided chi router.
-// It sets up the stats endpoint for retrieving internal statistics.
+// It sets up the stats endpoint for retrieving internal statistics, the audit endpoint
+// querying the actions of all users and the metrics endpoint in the Prometheus text format.
 func (ie *internalEndpoints) Register(r chi.Router) {
 
 	r.Get(endpointStats, ie.getStats)
+	r.Get(endpointAudit, auditlog.Handler(ie.auditService.GetEntries))
+	r.Method(http.MethodGet, endpointMetrics, ie.metricsHandler)
 
 }
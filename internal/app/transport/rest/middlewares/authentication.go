@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 
+	auditstorage "github.com/DanilNaum/SnipURL/internal/app/repository/audit"
 	"github.com/DanilNaum/SnipURL/pkg/cookie"
 	"github.com/google/uuid"
 )
@@ -15,7 +16,11 @@ type Key struct {
 	Key string
 }
 
-var key = Key{Key: "userID"}
+var (
+	key           = Key{Key: "userID"}
+	ipKey         = Key{Key: "clientIP"}
+	authMethodKey = Key{Key: "authMethod"}
+)
 
 func (m *middleware) authentication(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -25,12 +30,34 @@ func (m *middleware) authentication(next http.Handler) http.Handler {
 				w.WriteHeader(http.StatusUnauthorized)
 				return
 			}
+			userID = ""
+		}
+
+		if userID != "" {
+			revoked, err := m.revocations.IsRevoked(r.Context(), userID)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if revoked {
+				userID = ""
+			}
+		}
+
+		authMethod := auditstorage.AuthCookie
+		if userID == "" {
 			userID = uuid.NewString()
 			m.cookieManager.Set(w, userID)
-
+			authMethod = auditstorage.AuthIssued
 		}
 
 		newCtx := context.WithValue(r.Context(), key, userID)
+		newCtx = context.WithValue(newCtx, authMethodKey, authMethod)
+		if m.ipResolver != nil {
+			if ip := m.ipResolver.ClientIP(r); ip != nil {
+				newCtx = context.WithValue(newCtx, ipKey, ip.String())
+			}
+		}
 
 		next.ServeHTTP(w, r.WithContext(newCtx))
 	})
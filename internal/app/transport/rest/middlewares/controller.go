@@ -1,13 +1,16 @@
 package middlewares
 
 import (
+	"context"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 )
 
 type logger interface {
-	Infoln(args ...any)
+	InfowContext(ctx context.Context, msg string, keysAndValues ...any)
 }
 
 type cookieManager interface {
@@ -15,24 +18,53 @@ type cookieManager interface {
 	Get(r *http.Request) (string, error)
 }
 
+// revocations reports the user IDs revoked by the erasure of their users.
+type revocations interface {
+	IsRevoked(ctx context.Context, userID string) (bool, error)
+}
+
+// requestMetrics records the count and latency of the requests per route.
+type requestMetrics interface {
+	ObserveHTTPRequest(route, method string, code int, duration time.Duration)
+}
+
+// ipResolver resolves the client address of a request behind trusted proxies.
+type ipResolver interface {
+	ClientIP(r *http.Request) net.IP
+}
+
 type middleware struct {
-	logger        logger
-	cookieManager cookieManager
-	trustedSubnet string
+	logger         logger
+	cookieManager  cookieManager
+	revocations    revocations
+	requestMetrics requestMetrics
+	ipResolver     ipResolver
+	trustedSubnet  string
 }
 
 // NewMiddleware creates a new middleware instance with the provided logger and cookie manager.
-func NewMiddleware(logger logger, cookieManager cookieManager, trustedSubnet string) *middleware {
+// Every request gets a request ID, which its log records carry.
+// A cookie carrying a user ID found among the revocations is replaced with a new one.
+// Every request is recorded in the requestMetrics under the pattern of its route.
+// The client address resolved by the ipResolver is put in the context of the request
+// next to the user ID, for the audit of the user's actions.
+func NewMiddleware(logger logger, cookieManager cookieManager, revocations revocations, requestMetrics requestMetrics, ipResolver ipResolver, trustedSubnet string) *middleware {
 	return &middleware{
-		logger:        logger,
-		cookieManager: cookieManager,
-		trustedSubnet: trustedSubnet,
+		logger:         logger,
+		cookieManager:  cookieManager,
+		revocations:    revocations,
+		requestMetrics: requestMetrics,
+		ipResolver:     ipResolver,
+		trustedSubnet:  trustedSubnet,
 	}
 }
 
 // Register configures and applies middleware to the given chi router.
-// It adds authentication, logging, gzip compression, and decompression middleware.
+// It adds request ID, metrics, tracing, authentication, logging, gzip compression, and decompression middleware.
 func (m *middleware) Register(mux *chi.Mux) *chi.Mux {
+	mux.Use(m.requestID)
+	mux.Use(m.metrics)
+	mux.Use(m.tracing)
 	mux.Use(m.authentication)
 	mux.Use(m.logging)
 
@@ -19,6 +19,22 @@ func (w gzipWriter) Write(b []byte) (int, error) {
 	return w.Writer.Write(b)
 }
 
+// FlushError sends the data compressed so far to the client, so that streamed responses
+// are not held back in the gzip buffer. It is used by http.ResponseController.Flush.
+func (w gzipWriter) FlushError() error {
+	if f, ok := w.Writer.(interface{ Flush() error }); ok {
+		if err := f.Flush(); err != nil {
+			return err
+		}
+	}
+	return http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap returns the underlying ResponseWriter for http.ResponseController.
+func (w gzipWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (m *middleware) gzipPack(next http.Handler) http.Handler {
 	return http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
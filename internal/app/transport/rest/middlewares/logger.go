@@ -3,6 +3,8 @@ package middlewares
 import (
 	"net/http"
 	"time"
+
+	"github.com/DanilNaum/SnipURL/pkg/logging"
 )
 
 func (m *middleware) logging(next http.Handler) http.Handler {
@@ -21,8 +23,8 @@ func (m *middleware) logging(next http.Handler) http.Handler {
 
 			duration := time.Since(start)
 
-			m.logger.Infoln(
-				"uri", r.RequestURI,
+			m.logger.InfowContext(r.Context(), "request completed",
+				"uri", logging.RedactURL(r.RequestURI),
 				"method", r.Method,
 				"status", responseData.status,
 				"duration", duration,
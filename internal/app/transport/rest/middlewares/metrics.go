@@ -0,0 +1,34 @@
+package middlewares
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// unmatchedRoute labels the requests that matched no route, so that unknown paths
+// do not each get a series of their own.
+const unmatchedRoute = "unmatched"
+
+func (m *middleware) metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			responseData := &responseData{status: http.StatusOK}
+			writer := &loggingResponseWriter{
+				ResponseWriter: w,
+				responseData:   responseData,
+			}
+
+			next.ServeHTTP(writer, r)
+
+			route := unmatchedRoute
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				route = rctx.RoutePattern()
+			}
+			m.requestMetrics.ObserveHTTPRequest(route, r.Method, responseData.status, time.Since(start))
+		},
+	)
+}
@@ -0,0 +1,20 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/pkg/logging"
+)
+
+// requestID puts on the request context the X-Request-ID of the client, or a new one
+// when the client sent none or an unacceptable one, and returns it in the response.
+func (m *middleware) requestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			id := logging.RequestID(r.Header.Get(logging.RequestIDHeader))
+			w.Header().Set(logging.RequestIDHeader, id)
+
+			next.ServeHTTP(w, r.WithContext(logging.ContextWithRequestID(r.Context(), id)))
+		},
+	)
+}
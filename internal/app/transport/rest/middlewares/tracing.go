@@ -0,0 +1,55 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/pkg/logging"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/DanilNaum/SnipURL/internal/app/transport/rest"
+
+// tracing starts a server span per request, continuing the trace of the W3C trace context
+// headers of the caller. The span is named after the pattern of the matched route, which is
+// only known once the router has served the request.
+func (m *middleware) tracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			ctx, span := otel.Tracer(tracerName).Start(ctx, r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(r.Method),
+					semconv.URLPath(r.URL.Path),
+				),
+			)
+			defer span.End()
+			if id, ok := logging.RequestIDFromContext(ctx); ok {
+				span.SetAttributes(attribute.String("snipurl.request_id", id))
+			}
+
+			responseData := &responseData{status: http.StatusOK}
+			writer := &loggingResponseWriter{
+				ResponseWriter: w,
+				responseData:   responseData,
+			}
+
+			next.ServeHTTP(writer, r.WithContext(ctx))
+
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				span.SetName(r.Method + " " + rctx.RoutePattern())
+				span.SetAttributes(semconv.HTTPRoute(rctx.RoutePattern()))
+			}
+			span.SetAttributes(semconv.HTTPResponseStatusCode(responseData.status))
+			if responseData.status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(responseData.status))
+			}
+		},
+	)
+}
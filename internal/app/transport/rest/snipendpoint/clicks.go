@@ -0,0 +1,38 @@
+package snipendpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+)
+
+// getClickStats handles HTTP GET requests that report the click analytics of a short URL
+// owned by the current user.
+//
+// Response status codes:
+//   - 200 OK: JSON object with the total clicks and clicks per country
+//   - 404 Not Found: URL does not exist or belongs to another user
+//   - 500 Internal Server Error: Server-side error
+func (s *snipEndpoint) getClickStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := s.service.GetClickStats(r.Context(), r.PathValue("id"))
+	switch {
+	case err == nil:
+	case errors.Is(err, urlsnipper.ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := json.Marshal(clickStatsJSONFromServiceModel(stats))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
@@ -0,0 +1,77 @@
+package snipendpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+)
+
+// clickStreamKeepAlive is the interval of the comments sent to keep an idle click stream open
+// through proxies.
+const clickStreamKeepAlive = 15 * time.Second
+
+// watchClicks handles HTTP GET requests that stream the clicks of a short URL owned by
+// the current user as Server-Sent Events, one "click" event per redirect, until the client
+// disconnects or the server stops. Clicks the client does not keep up with are dropped;
+// the dropped field of every event counts them so far.
+//
+// Response status codes:
+//   - 200 OK: text/event-stream of the clicks
+//   - 404 Not Found: URL does not exist or belongs to another user
+//   - 500 Internal Server Error: Server-side error
+func (s *snipEndpoint) watchClicks(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	stream, err := s.service.WatchClicks(r.Context(), id)
+	switch {
+	case err == nil:
+	case errors.Is(err, urlsnipper.ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(clickStreamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case click, ok := <-stream.C():
+			if !ok {
+				return
+			}
+			event, err := clickEventJSONFromServiceModel(s.baseURL, click, stream.Dropped())
+			if err != nil {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "event: click\ndata: %s\n\n", data); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
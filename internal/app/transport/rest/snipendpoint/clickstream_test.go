@@ -0,0 +1,81 @@
+package snipendpoint
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/stretchr/testify/require"
+)
+
+type testClickStream struct {
+	c       chan *urlsnipper.ClickEvent
+	dropped uint64
+}
+
+func (s *testClickStream) C() <-chan *urlsnipper.ClickEvent {
+	return s.c
+}
+
+func (s *testClickStream) Dropped() uint64 {
+	return s.dropped
+}
+
+func TestSnipEndpoint_watchClicks(t *testing.T) {
+	clickTime := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		watchErr        error
+		wantCode        int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "streamed",
+			wantCode:        http.StatusOK,
+			wantContentType: "text/event-stream",
+			wantBody: "event: click\n" +
+				`data: {"short_url":"http://localhost:8080/abc123","clicks":7,"country":"DE","time":"2024-05-01T12:00:00Z","dropped":2}` + "\n\n",
+		},
+		{name: "not found", watchErr: urlsnipper.ErrNotFound, wantCode: http.StatusNotFound},
+		{name: "storage error", watchErr: errors.New("storage error"), wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &serviceMock{
+				WatchClicksFunc: func(ctx context.Context, id string) (urlsnipper.ClickStream, error) {
+					require.Equal(t, "abc123", id)
+					if tt.watchErr != nil {
+						return nil, tt.watchErr
+					}
+					stream := &testClickStream{c: make(chan *urlsnipper.ClickEvent, 1), dropped: 2}
+					stream.c <- &urlsnipper.ClickEvent{ShortURL: id, Country: "DE", Clicks: 7, Time: clickTime}
+					close(stream.c)
+					return stream, nil
+				},
+			}
+			endpoint := &snipEndpoint{service: mockService, prefix: "/", baseURL: "http://localhost:8080"}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/user/urls/abc123/events", nil)
+			req.SetPathValue("id", "abc123")
+			w := httptest.NewRecorder()
+
+			endpoint.watchClicks(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			require.Equal(t, tt.wantContentType, w.Header().Get("Content-Type"))
+			require.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+			require.Equal(t, tt.wantBody, w.Body.String())
+			require.True(t, w.Flushed)
+		})
+	}
+}
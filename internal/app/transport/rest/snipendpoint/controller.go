@@ -2,71 +2,166 @@ package snipendpoint
 
 import (
 	"context"
+	"net"
+	"net/http"
 
 	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/pkg/geoip"
+	"github.com/DanilNaum/SnipURL/pkg/qrcode"
 	"github.com/go-chi/chi/v5"
 )
 
 const (
 	endpointGetURL              = "/{id}"
+	endpointGetURLPassthrough   = "/{id}/*"
+	endpointQRCode              = "/{id}/qr"
 	endpointCreateShortURL      = "/"
 	endpointCreateShortURLJSON  = "/api/shorten"
 	endpointCreateShortURLBatch = "/api/shorten/batch"
+	endpointImportURLs          = "/api/shorten/import"
 	endpointGetUserURLs         = "/api/user/urls"
+	endpointExportURLs          = "/api/user/urls/export"
 	endpointDeleteURLs          = "/api/user/urls"
+	endpointUpdateURL           = "/api/user/urls/{id}"
+	endpointURLRules            = "/api/user/urls/{id}/rules"
+	endpointURLRule             = "/api/user/urls/{id}/rules/{ruleID}"
+	endpointURLVariants         = "/api/user/urls/{id}/variants"
+	endpointURLClicks           = "/api/user/urls/{id}/clicks"
+	endpointURLEvents           = "/api/user/urls/{id}/events"
+	endpointUserData            = "/api/user/data"
+)
+
+const (
+	// variantCookiePrefix is followed by the short URL ID in the name of the
+	// cookie that keeps the visitor on the same A/B split variant.
+	variantCookiePrefix = "snipurl_variant_"
+	variantCookieMaxAge = 30 * 24 * 60 * 60
 )
 
 type config interface {
 	GetPrefix() (string, error)
 	GetBaseURL() string
+	GetRedirectType() int
 }
 
 //go:generate moq -out service_moq_test.go . service
 type service interface {
-	SetURL(ctx context.Context, url string) (string, error)
-	GetURL(ctx context.Context, id string) (string, error)
+	SetURL(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error)
+	Redirect(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error)
+	CheckURL(ctx context.Context, id string) error
+	GetURLInfo(ctx context.Context, id string) (*urlsnipper.URLInfo, error)
 	SetURLs(ctx context.Context, urls []*urlsnipper.SetURLsInput) (map[string]*urlsnipper.SetURLsOutput, error)
-	GetURLs(ctx context.Context) ([]*urlsnipper.URL, error)
+	UpdateURL(ctx context.Context, id string, opts ...urlsnipper.Option) (*urlsnipper.URL, error)
+	GetURLs(ctx context.Context, filter *urlsnipper.URLFilter) ([]*urlsnipper.URL, error)
+	ListURLs(ctx context.Context, input *urlsnipper.ListURLsInput) (*urlsnipper.URLPage, error)
+	ExportURLs(ctx context.Context) (urlsnipper.URLIterator, error)
+	ExportUserData(ctx context.Context) (*urlsnipper.UserData, error)
 	DeleteURLs(ctx context.Context, ids []string)
+	AddRule(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error)
+	GetRules(ctx context.Context, id string) ([]*urlsnipper.Rule, error)
+	UpdateRule(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error)
+	DeleteRule(ctx context.Context, id string, ruleID int) error
+	GetVariantStats(ctx context.Context, id string) ([]*urlsnipper.VariantStats, error)
+	GetClickStats(ctx context.Context, id string) (*urlsnipper.ClickStats, error)
+	WatchClicks(ctx context.Context, id string) (urlsnipper.ClickStream, error)
+	CheckPassword(ctx context.Context, id, password, client string) (string, error)
+}
+
+type locator interface {
+	Lookup(ip net.IP) (*geoip.Location, error)
+}
+
+type ipResolver interface {
+	ClientIP(r *http.Request) net.IP
+}
+
+// qrRenderer renders QR codes of short URLs.
+type qrRenderer interface {
+	Render(content string, opts qrcode.Options) (*qrcode.Image, error)
+}
+
+// unlockCookieManager keeps the signed list of protected links the visitor has unlocked.
+type unlockCookieManager interface {
+	Set(w http.ResponseWriter, value string)
+	Get(r *http.Request) (string, error)
 }
 
 type snipEndpoint struct {
-	service service
-	prefix  string
-	baseURL string
+	service       service
+	locator       locator
+	ipResolver    ipResolver
+	unlockCookies unlockCookieManager
+	qrRenderer    qrRenderer
+	prefix        string
+	baseURL       string
+	redirectType  int
 }
 
 // NewSnipEndpoint creates a new snipEndpoint instance with the provided service and configuration.
 // It retrieves the prefix from the configuration and initializes the endpoint with the service,
-// prefix, and base URL. Returns an error if prefix retrieval fails.
-func NewSnipEndpoint(service service, conf config) (*snipEndpoint, error) {
+// prefix, and base URL. The locator and ipResolver determine the country of redirected clients,
+// unlockCookies remembers the password-protected links a visitor has unlocked,
+// and qrRenderer draws the QR codes of the short URLs.
+// Returns an error if prefix retrieval fails.
+func NewSnipEndpoint(service service, conf config, locator locator, ipResolver ipResolver, unlockCookies unlockCookieManager, qrRenderer qrRenderer) (*snipEndpoint, error) {
 	prefix, err := conf.GetPrefix()
 	if err != nil {
 		return nil, err
 	}
 	return &snipEndpoint{
-		service: service,
-		prefix:  prefix,
-		baseURL: conf.GetBaseURL(),
+		service:       service,
+		locator:       locator,
+		ipResolver:    ipResolver,
+		unlockCookies: unlockCookies,
+		qrRenderer:    qrRenderer,
+		prefix:        prefix,
+		baseURL:       conf.GetBaseURL(),
+		redirectType:  conf.GetRedirectType(),
 	}, nil
 }
 
 // Register sets up the routing for the snipEndpoint with various HTTP endpoints
 // for creating, retrieving, and managing short URLs. It configures routes for:
 // - Creating a short URL via POST
-// - Retrieving a URL by its short ID via GET
+// - Retrieving a URL by its short ID via GET, optionally followed by a passthrough path
+// - Previewing a URL with the "+" suffix, ?preview=1 or Accept: application/json
+// - Rendering the QR code of a short URL
+// - Unlocking a password-protected URL via POST of the password form
 // - Creating a short URL via JSON POST
 // - Batch creating short URLs
+// - Importing short URLs from a CSV or NDJSON file
 // - Retrieving user's URLs
+// - Exporting all of the user's URLs as CSV, JSON or NDJSON
 // - Deleting user's URLs
+// - Editing a user's URL
+// - Managing the routing rules of a user's URL
+// - Reporting the A/B split statistics of a user's URL
+// - Reporting the click analytics of a user's URL
+// - Streaming the clicks of a user's URL as Server-Sent Events
+// - Exporting everything stored about the user
 func (s *snipEndpoint) Register(r *chi.Mux) {
 	r.Route(s.prefix, func(r chi.Router) {
 		r.Post(endpointCreateShortURL, s.createShortURL)
 		r.Get(endpointGetURL, s.getURL)
+		r.Get(endpointGetURLPassthrough, s.getURL)
+		r.Get(endpointQRCode, s.getQRCode)
+		r.Post(endpointGetURL, s.unlockURL)
+		r.Post(endpointGetURLPassthrough, s.unlockURL)
 		r.Post(endpointCreateShortURLJSON, s.createShortURLJSON)
 		r.Post(endpointCreateShortURLBatch, s.createShortURLBatch)
+		r.Post(endpointImportURLs, s.importURLs)
 		r.Get(endpointGetUserURLs, s.getURLs)
+		r.Get(endpointExportURLs, s.exportURLs)
 		r.Delete(endpointDeleteURLs, s.deleteURLs)
+		r.Patch(endpointUpdateURL, s.updateURL)
+		r.Get(endpointURLRules, s.getRules)
+		r.Post(endpointURLRules, s.addRule)
+		r.Put(endpointURLRule, s.updateRule)
+		r.Delete(endpointURLRule, s.deleteRule)
+		r.Get(endpointURLVariants, s.getVariantStats)
+		r.Get(endpointURLClicks, s.getClickStats)
+		r.Get(endpointURLEvents, s.watchClicks)
+		r.Get(endpointUserData, s.exportUserData)
 
 	})
 }
@@ -11,10 +11,12 @@ import (
 
 // createShortURL handles HTTP requests to create a shortened URL.
 // It reads the original URL from the request body, generates a short ID using the URL snipper service,
-// and returns the full shortened URL path.
+// and returns the full shortened URL path. Optional redirect_type, passthrough and query_mode
+// query parameters set the corresponding link attributes.
 //
 // The response status codes are:
 //   - 201 (Created) if the URL was successfully shortened
+//   - 400 (Bad Request) if a link attribute is invalid
 //   - 409 (Conflict) if the URL already exists
 //   - 500 (Internal Server Error) if any internal error occurs
 //
@@ -29,12 +31,21 @@ func (s *snipEndpoint) createShortURL(w http.ResponseWriter, r *http.Request) {
 
 	originalURL := string(body)
 
-	id, err := s.service.SetURL(r.Context(), originalURL)
+	opts, err := createShortURLQueryToServiceOptions(r.URL.Query())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	id, err := s.service.SetURL(r.Context(), originalURL, opts...)
 	switch {
 	case err == nil:
 		w.WriteHeader(http.StatusCreated)
 	case errors.Is(err, urlsnipper.ErrConflict):
 		w.WriteHeader(http.StatusConflict)
+	case errors.Is(err, urlsnipper.ErrInvalidOption):
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
 	default:
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
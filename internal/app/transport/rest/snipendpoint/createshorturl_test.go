@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,7 +25,7 @@ func TestSnipEndpoint_createShortURL(t *testing.T) {
 		host      string
 	}
 	type mocks struct {
-		setURLFunc              func(ctx context.Context, url string) (string, error)
+		setURLFunc              func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error)
 		setURLFuncNumberOfCalls int
 	}
 	type want struct {
@@ -44,7 +45,7 @@ func TestSnipEndpoint_createShortURL(t *testing.T) {
 				host: "http://localhost:8080",
 			},
 			mocks: mocks{
-				setURLFunc: func(ctx context.Context, url string) (string, error) {
+				setURLFunc: func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 					return "abc123", nil
 				},
 				setURLFuncNumberOfCalls: 1,
@@ -61,7 +62,7 @@ func TestSnipEndpoint_createShortURL(t *testing.T) {
 				host: "https://localhost:8080",
 			},
 			mocks: mocks{
-				setURLFunc: func(ctx context.Context, url string) (string, error) {
+				setURLFunc: func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 					return "abc123", nil
 				},
 				setURLFuncNumberOfCalls: 1,
@@ -79,7 +80,7 @@ func TestSnipEndpoint_createShortURL(t *testing.T) {
 				host: "https://localhost:8080",
 			},
 			mocks: mocks{
-				setURLFunc: func(ctx context.Context, url string) (string, error) {
+				setURLFunc: func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 					return "", errors.New("service error")
 				},
 				setURLFuncNumberOfCalls: 1,
@@ -107,7 +108,7 @@ func TestSnipEndpoint_createShortURL(t *testing.T) {
 				host: "asd",
 			},
 			mocks: mocks{
-				setURLFunc: func(ctx context.Context, url string) (string, error) {
+				setURLFunc: func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 					return "", errors.New("service error")
 				},
 				setURLFuncNumberOfCalls: 1,
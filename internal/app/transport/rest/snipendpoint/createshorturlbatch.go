@@ -3,6 +3,7 @@ package snipendpoint
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/url"
 
@@ -17,7 +18,7 @@ import (
 // The response contains corresponding correlation IDs and generated short URLs.
 // If successful, it returns HTTP 201 (Created) with the JSON response.
 // If there are any errors during processing, it returns appropriate HTTP error codes:
-// - 400 Bad Request for invalid JSON input
+// - 400 Bad Request for invalid JSON input or an unsupported link attribute
 // - 500 Internal Server Error for server-side processing errors
 func (s *snipEndpoint) createShortURLBatch(w http.ResponseWriter, r *http.Request) {
 	var req []*createShortURLBatchJSONRequest
@@ -41,6 +42,10 @@ func (s *snipEndpoint) createShortURLBatch(w http.ResponseWriter, r *http.Reques
 
 	res, err := s.service.SetURLs(r.Context(), urls)
 
+	if errors.Is(err, urlsnipper.ErrInvalidOption) {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
@@ -23,7 +23,7 @@ import (
 //
 // Response status codes:
 //   - 201 Created: URL successfully shortened
-//   - 400 Bad Request: Invalid JSON request
+//   - 400 Bad Request: Invalid JSON request or link attribute
 //   - 409 Conflict: URL already exists
 //   - 500 Internal Server Error: Server-side error
 func (s *snipEndpoint) createShortURLJSON(w http.ResponseWriter, r *http.Request) {
@@ -43,7 +43,7 @@ func (s *snipEndpoint) createShortURLJSON(w http.ResponseWriter, r *http.Request
 
 	originalURL := req.URL
 
-	id, err := s.service.SetURL(r.Context(), originalURL)
+	id, err := s.service.SetURL(r.Context(), originalURL, createShortURLJSONRequestToServiceOptions(&req)...)
 	switch {
 	case err == nil:
 		w.Header().Set("Content-Type", "application/json")
@@ -52,6 +52,9 @@ func (s *snipEndpoint) createShortURLJSON(w http.ResponseWriter, r *http.Request
 	case errors.Is(err, urlsnipper.ErrConflict):
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusConflict)
+	case errors.Is(err, urlsnipper.ErrInvalidOption):
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
 	default:
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,7 +20,7 @@ func TestSnipEndpoint_createShortURLJSON(t *testing.T) {
 		host      string
 	}
 	type mocks struct {
-		setURLFunc              func(ctx context.Context, url string) (string, error)
+		setURLFunc              func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error)
 		setURLFuncNumberOfCalls int
 	}
 	type want struct {
@@ -39,7 +40,7 @@ func TestSnipEndpoint_createShortURLJSON(t *testing.T) {
 				host: "http://localhost:8080",
 			},
 			mocks: mocks{
-				setURLFunc: func(ctx context.Context, url string) (string, error) {
+				setURLFunc: func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 					return "abc123", nil
 				},
 				setURLFuncNumberOfCalls: 1,
@@ -56,7 +57,7 @@ func TestSnipEndpoint_createShortURLJSON(t *testing.T) {
 				host: "https://localhost:8080",
 			},
 			mocks: mocks{
-				setURLFunc: func(ctx context.Context, url string) (string, error) {
+				setURLFunc: func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 					return "abc123", nil
 				},
 				setURLFuncNumberOfCalls: 1,
@@ -74,7 +75,7 @@ func TestSnipEndpoint_createShortURLJSON(t *testing.T) {
 				host: "https://localhost:8080",
 			},
 			mocks: mocks{
-				setURLFunc: func(ctx context.Context, url string) (string, error) {
+				setURLFunc: func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 					return "", errors.New("service error")
 				},
 				setURLFuncNumberOfCalls: 1,
@@ -113,7 +114,7 @@ func TestSnipEndpoint_createShortURLJSON(t *testing.T) {
 				host: "asd",
 			},
 			mocks: mocks{
-				setURLFunc: func(ctx context.Context, url string) (string, error) {
+				setURLFunc: func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 					return "", errors.New("service error")
 				},
 				setURLFuncNumberOfCalls: 1,
@@ -0,0 +1,213 @@
+package snipendpoint
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	exportFormatCSV    = "csv"
+	exportFormatJSON   = "json"
+	exportFormatNDJSON = "ndjson"
+	// exportFlushEvery is the number of links written between flushes of an export.
+	exportFlushEvery = 100
+)
+
+// exportCSVHeader names the columns of a CSV export. Tags are separated by '|', as in imports.
+var exportCSVHeader = []string{
+	"short_url", "original_url", "title", "notes", "tags", "created_at", "clicks", "deleted",
+	"redirect_type", "protected", "max_clicks", "clicks_left", "not_before", "not_after", "fallback_url",
+}
+
+// exportWriter encodes the links of an export in one of the formats.
+type exportWriter interface {
+	begin() error
+	write(u *exportURLJSON) error
+	flush() error
+	end() error
+}
+
+// exportURLs handles GET /api/user/urls/export, the download of all the user's links,
+// the deleted ones included, with their metadata and click totals.
+// Query parameters:
+// - format: "json" (default) for a JSON array, "ndjson" for a link per line, or "csv"
+// The links are streamed from storage as they are written, so the export is never held
+// in memory at once. A failure after the first byte aborts the connection, so that a
+// truncated export is not mistaken for a complete one.
+// Response status codes:
+// - 200 OK with the export as an attachment
+// - 400 Bad Request if the format is unknown
+// - 500 Internal Server Error if the links cannot be read
+func (s *snipEndpoint) exportURLs(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = exportFormatJSON
+	}
+	writer, contentType, ok := newExportWriter(format, w)
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	urls, err := s.service.ExportURLs(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer urls.Close()
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="urls.%s"`, format))
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err := writer.begin(); err != nil {
+		panic(http.ErrAbortHandler)
+	}
+	for n := 1; urls.Next(); n++ {
+		item, err := getURLsJSONResponseItemFromServiceModel(s.baseURL, urls.URL())
+		if err != nil {
+			panic(http.ErrAbortHandler)
+		}
+		if err := writer.write(&exportURLJSON{getURLsJSONResponse: item, Deleted: urls.URL().Deleted}); err != nil {
+			panic(http.ErrAbortHandler)
+		}
+		if n%exportFlushEvery == 0 {
+			if err := writer.flush(); err != nil {
+				panic(http.ErrAbortHandler)
+			}
+			_ = rc.Flush()
+		}
+	}
+	if urls.Err() != nil {
+		panic(http.ErrAbortHandler)
+	}
+	if err := writer.end(); err != nil {
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func newExportWriter(format string, w io.Writer) (exportWriter, string, bool) {
+	switch format {
+	case exportFormatJSON:
+		return &jsonExportWriter{w: w}, "application/json", true
+	case exportFormatNDJSON:
+		return &ndjsonExportWriter{encoder: json.NewEncoder(w)}, "application/x-ndjson", true
+	case exportFormatCSV:
+		return &csvExportWriter{writer: csv.NewWriter(w)}, "text/csv", true
+	default:
+		return nil, "", false
+	}
+}
+
+// jsonExportWriter writes the links as the elements of a JSON array.
+type jsonExportWriter struct {
+	w       io.Writer
+	written bool
+}
+
+func (e *jsonExportWriter) begin() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonExportWriter) write(u *exportURLJSON) error {
+	item, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	if e.written {
+		if _, err := io.WriteString(e.w, ","); err != nil {
+			return err
+		}
+	}
+	e.written = true
+	_, err = e.w.Write(item)
+	return err
+}
+
+func (e *jsonExportWriter) flush() error {
+	return nil
+}
+
+func (e *jsonExportWriter) end() error {
+	_, err := io.WriteString(e.w, "]\n")
+	return err
+}
+
+// ndjsonExportWriter writes a link per line.
+type ndjsonExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (e *ndjsonExportWriter) begin() error {
+	return nil
+}
+
+func (e *ndjsonExportWriter) write(u *exportURLJSON) error {
+	return e.encoder.Encode(u)
+}
+
+func (e *ndjsonExportWriter) flush() error {
+	return nil
+}
+
+func (e *ndjsonExportWriter) end() error {
+	return nil
+}
+
+// csvExportWriter writes a header and a record per link.
+type csvExportWriter struct {
+	writer *csv.Writer
+}
+
+func (e *csvExportWriter) begin() error {
+	return e.writer.Write(exportCSVHeader)
+}
+
+func (e *csvExportWriter) write(u *exportURLJSON) error {
+	clicksLeft := ""
+	if u.ClicksLeft != nil {
+		clicksLeft = strconv.Itoa(*u.ClicksLeft)
+	}
+	return e.writer.Write([]string{
+		u.ShortURL,
+		u.OriginalURL,
+		u.Title,
+		u.Notes,
+		strings.Join(u.Tags, importTagSeparator),
+		formatCSVTime(u.CreatedAt),
+		strconv.Itoa(u.Clicks),
+		strconv.FormatBool(u.Deleted),
+		strconv.Itoa(u.RedirectType),
+		strconv.FormatBool(u.Protected),
+		strconv.Itoa(u.MaxClicks),
+		clicksLeft,
+		formatCSVTime(u.NotBefore),
+		formatCSVTime(u.NotAfter),
+		u.FallbackURL,
+	})
+}
+
+func (e *csvExportWriter) flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvExportWriter) end() error {
+	return e.flush()
+}
+
+// formatCSVTime formats an optional time as RFC 3339, nil as an empty field.
+func formatCSVTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,129 @@
+package snipendpoint
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/stretchr/testify/require"
+)
+
+type sliceURLIterator struct {
+	urls   []*urlsnipper.URL
+	pos    int
+	err    error
+	closed bool
+}
+
+func (it *sliceURLIterator) Next() bool {
+	if it.pos >= len(it.urls) {
+		return false
+	}
+	it.pos++
+	return true
+}
+
+func (it *sliceURLIterator) URL() *urlsnipper.URL { return it.urls[it.pos-1] }
+func (it *sliceURLIterator) Err() error           { return it.err }
+func (it *sliceURLIterator) Close()               { it.closed = true }
+
+func TestSnipEndpoint_exportURLs(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	urls := func() []*urlsnipper.URL {
+		return []*urlsnipper.URL{
+			{ShortURL: "a", OriginalURL: "https://example.com/a", Title: "Sale, spring", Tags: []string{"launch", "q3"}, CreatedAt: created, Clicks: 3},
+			{ShortURL: "b", OriginalURL: "https://example.com/b", MaxClicks: 5, CreatedAt: created, Deleted: true},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		urls        []*urlsnipper.URL
+		iterErr     error
+		wantCode    int
+		wantType    string
+		wantBody    string
+		wantAborted bool
+	}{
+		{
+			name:     "json",
+			urls:     urls(),
+			wantCode: http.StatusOK,
+			wantType: "application/json",
+			wantBody: `[{"short_url":"http://localhost:8080/a","original_url":"https://example.com/a","title":"Sale, spring","tags":["launch","q3"],"created_at":"2024-01-01T00:00:00Z","clicks":3,"deleted":false},` +
+				`{"short_url":"http://localhost:8080/b","original_url":"https://example.com/b","max_clicks":5,"clicks_left":0,"created_at":"2024-01-01T00:00:00Z","clicks":0,"deleted":true}]`,
+		},
+		{
+			name:     "json_empty",
+			wantCode: http.StatusOK,
+			wantType: "application/json",
+			wantBody: `[]`,
+		},
+		{
+			name:     "ndjson",
+			query:    "?format=ndjson",
+			urls:     urls()[1:],
+			wantCode: http.StatusOK,
+			wantType: "application/x-ndjson",
+			wantBody: `{"short_url":"http://localhost:8080/b","original_url":"https://example.com/b","max_clicks":5,"clicks_left":0,"created_at":"2024-01-01T00:00:00Z","clicks":0,"deleted":true}`,
+		},
+		{
+			name:     "csv",
+			query:    "?format=csv",
+			urls:     urls(),
+			wantCode: http.StatusOK,
+			wantType: "text/csv",
+			wantBody: "short_url,original_url,title,notes,tags,created_at,clicks,deleted,redirect_type,protected,max_clicks,clicks_left,not_before,not_after,fallback_url\n" +
+				"http://localhost:8080/a,https://example.com/a,\"Sale, spring\",,launch|q3,2024-01-01T00:00:00Z,3,false,0,false,0,,,,\n" +
+				"http://localhost:8080/b,https://example.com/b,,,,2024-01-01T00:00:00Z,0,true,0,false,5,0,,,",
+		},
+		{
+			name:     "unknown_format",
+			query:    "?format=xml",
+			wantCode: http.StatusBadRequest,
+			wantBody: http.StatusText(http.StatusBadRequest),
+		},
+		{
+			name:        "storage_error_mid_stream",
+			query:       "?format=ndjson",
+			urls:        urls(),
+			iterErr:     errors.New("connection reset"),
+			wantAborted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := &sliceURLIterator{urls: tt.urls, err: tt.iterErr}
+			mockService := &serviceMock{
+				ExportURLsFunc: func(ctx context.Context) (urlsnipper.URLIterator, error) {
+					return it, nil
+				},
+			}
+			endpoint := &snipEndpoint{service: mockService, prefix: "/", baseURL: "http://localhost:8080"}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/user/urls/export"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			if tt.wantAborted {
+				require.PanicsWithValue(t, http.ErrAbortHandler, func() { endpoint.exportURLs(w, req) })
+				require.True(t, it.closed)
+				return
+			}
+			endpoint.exportURLs(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			require.Equal(t, tt.wantBody, strings.TrimSpace(w.Body.String()))
+			if tt.wantType != "" {
+				require.Equal(t, tt.wantType, w.Header().Get("Content-Type"))
+				require.True(t, it.closed)
+			}
+		})
+	}
+}
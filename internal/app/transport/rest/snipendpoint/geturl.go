@@ -4,29 +4,126 @@ import (
 	"errors"
 	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
 	"net/http"
+	"time"
 )
 
 // getURL обрабатывает HTTP-запрос для получения URL по его идентификатору.
 //
 // Этот метод извлекает идентификатор из пути запроса и использует сервис
-// для получения соответствующего URL. Если URL был удален, метод возвращает
-// статус 410 Gone. В случае других ошибок возвращается статус 500 Internal Server Error.
-// Если URL успешно найден, происходит перенаправление на этот URL с кодом 302 Found.
+// для получения соответствующего URL. Если URL был удален или исчерпал
+// лимит переходов, метод возвращает статус 410 Gone. В случае других ошибок возвращается статус 500 Internal Server Error.
+// Если URL успешно найден, происходит перенаправление на этот URL с кодом,
+// заданным для ссылки, либо с кодом по умолчанию из конфигурации сервиса.
+//
+// Если у ссылки есть правила маршрутизации, они проверяются по порядку для
+// платформы из User-Agent, языков из Accept-Language, страны клиента по базе
+// GeoIP и текущего времени; первое подходящее правило заменяет целевой URL.
+// Адрес клиента берется из X-Forwarded-For/X-Real-IP только для доверенных прокси.
+//
+// Если ни одно правило не подошло, а у ссылки есть A/B-варианты, выбирается
+// вариант с учетом весов. После успешного редиректа выбор закрепляется за
+// посетителем через cookie, а показ варианта учитывается в статистике.
+//
+// Для ссылок с включенным passthrough остаток пути после идентификатора и
+// параметры запроса переносятся в целевой URL. Для остальных ссылок запрос
+// с остатком пути возвращает 404 Not Found, а остаток пути с сегментами ".."
+// отклоняется с 400 Bad Request, чтобы редирект не вышел за базовый путь ссылки.
+//
+// Для ссылок, защищенных паролем, вместо редиректа возвращается HTML-форма
+// с кодом 401 Unauthorized, пока посетитель не введет верный пароль
+// (см. unlockURL) и не получит подписанную cookie на время unlockTTL. Cookie
+// хранит ключ разблокировки текущего пароля и проверяется при каждом переходе,
+// поэтому после смены пароля ссылки его нужно ввести заново.
+// Если посетитель исчерпал число попыток ввода пароля, форма возвращается
+// с кодом 429 Too Many Requests.
+//
+// Вне окна активности (not_before/not_after) ссылка ведет на резервный URL,
+// если он задан. Иначе до начала окна возвращается 404 Not Found, а после
+// его окончания — 410 Gone.
+//
+// Для ссылок с лимитом переходов счетчик атомарно уменьшается перед
+// редиректом, поэтому одновременные запросы не превышают лимит.
+//
+// Каждый успешный переход учитывается в статистике кликов вместе со страной
+// клиента и выбранным вариантом.
+//
+// Сам переход выполняет сервис (см. urlsnipper.Redirect), общий для REST и gRPC.
+//
+// Запросы /{id}+, ?preview=1 и запросы с Accept: application/json получают
+// вместо редиректа описание ссылки (см. previewURL).
 func (s *snipEndpoint) getURL(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Accept")
+
+	id, preview := isPreviewRequest(r)
+	if preview {
+		s.previewURL(w, r, id)
+		return
+	}
 
-	id := r.PathValue("id")
+	var sticky string
+	if cookie, err := r.Cookie(variantCookiePrefix + id); err == nil {
+		sticky = cookie.Value
+	}
 
-	url, err := s.service.GetURL(r.Context(), id)
+	redirect, err := s.service.Redirect(r.Context(), &urlsnipper.RedirectRequest{
+		ID:        id,
+		Path:      r.PathValue("*"),
+		Query:     r.URL.Query(),
+		Client:    s.newClient(r),
+		Variant:   sticky,
+		UnlockKey: s.unlockKey(r, id),
+		ClientKey: s.clientKey(r),
+	})
 	if err != nil {
 		switch {
-		case errors.Is(err, urlsnipper.ErrDeleted):
+		case errors.Is(err, urlsnipper.ErrPasswordRequired):
+			s.renderPasswordForm(w, http.StatusUnauthorized, "")
+		case errors.Is(err, urlsnipper.ErrTooManyAttempts):
+			s.renderPasswordForm(w, http.StatusTooManyRequests, "Too many attempts. Try again later.")
+		case errors.Is(err, urlsnipper.ErrDeleted), errors.Is(err, urlsnipper.ErrExhausted), errors.Is(err, urlsnipper.ErrEnded):
 			http.Error(w, http.StatusText(http.StatusGone), http.StatusGone)
-			return
+		case errors.Is(err, urlsnipper.ErrNotYetActive), errors.Is(err, urlsnipper.ErrPassthroughDisabled):
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		case errors.Is(err, urlsnipper.ErrInvalidPassthroughPath):
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
 		default:
 			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			return
 		}
+		return
+	}
+
+	if redirect.Variant != "" {
+		http.SetCookie(w, &http.Cookie{
+			Name:     variantCookiePrefix + id,
+			Value:    redirect.Variant,
+			Path:     "/",
+			MaxAge:   variantCookieMaxAge,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
 	}
 
-	http.Redirect(w, r, url, http.StatusTemporaryRedirect)
+	code := redirect.RedirectType
+	if code == 0 {
+		code = s.redirectType
+	}
+
+	http.Redirect(w, r, redirect.Target, code)
+}
+
+// newClient собирает атрибуты запроса для правил маршрутизации. Если база
+// GeoIP не подключена или адрес не найден, страна остается пустой.
+func (s *snipEndpoint) newClient(r *http.Request) *urlsnipper.Client {
+	client := urlsnipper.NewClient(r.UserAgent(), r.Header.Get("Accept-Language"), time.Now())
+	if s.locator == nil || s.ipResolver == nil {
+		return client
+	}
+
+	location, err := s.locator.Lookup(s.ipResolver.ClientIP(r))
+	if err != nil {
+		return client
+	}
+	client.Country = location.Country
+	client.InEU = location.InEU
+	return client
 }
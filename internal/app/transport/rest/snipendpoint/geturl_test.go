@@ -5,24 +5,59 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/pkg/cookie"
+	"github.com/DanilNaum/SnipURL/pkg/geoip"
+	"github.com/DanilNaum/SnipURL/pkg/realip"
 	"github.com/stretchr/testify/require"
 )
 
+const geoipFixture = "../../../../../pkg/geoip/testdata/GeoLite2-Country-Test.mmdb"
+
 func TestSnipEndpoint_getURL(t *testing.T) {
+	locator, err := geoip.NewReader(geoipFixture)
+	require.NoError(t, err)
+	defer locator.Close()
+
+	ipResolver, err := realip.NewResolver([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
 	type input struct {
-		id string
+		id           string
+		path         string
+		userAgent    string
+		variant      string
+		unlockKey    string
+		remoteAddr   string
+		forwardedFor string
 	}
 	type mocks struct {
-		getURLFunc              func(ctx context.Context, id string) (string, error)
-		getURLFuncNumberOfCalls int
+		redirectFunc func(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error)
 	}
 	type want struct {
-		code   int
-		body   string
-		header http.Header
+		code      int
+		body      string
+		header    http.Header
+		path      string
+		query     url.Values
+		country   string
+		platform  string
+		variant   string
+		unlockKey string
+	}
+	redirectTo := func(redirect *urlsnipper.Redirect) func(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error) {
+		return func(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error) {
+			return redirect, nil
+		}
+	}
+	failWith := func(err error) func(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error) {
+		return func(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error) {
+			return nil, err
+		}
 	}
 	tests := []struct {
 		name  string
@@ -38,10 +73,7 @@ func TestSnipEndpoint_getURL(t *testing.T) {
 			},
 
 			mocks: mocks{
-				getURLFunc: func(ctx context.Context, id string) (string, error) {
-					return "https://example.com", nil
-				},
-				getURLFuncNumberOfCalls: 1,
+				redirectFunc: redirectTo(&urlsnipper.Redirect{Target: "https://example.com"}),
 			},
 
 			want: want{
@@ -50,15 +82,265 @@ func TestSnipEndpoint_getURL(t *testing.T) {
 			},
 		},
 		{
-			name: "service error",
+			name: "link_redirect_type",
+
 			input: input{
 				id: "123",
 			},
+
+			mocks: mocks{
+				redirectFunc: redirectTo(&urlsnipper.Redirect{Target: "https://example.com", RedirectType: http.StatusMovedPermanently}),
+			},
+
+			want: want{
+				code:   http.StatusMovedPermanently,
+				header: http.Header{"Location": []string{"https://example.com"}},
+			},
+		},
+		{
+			name: "passthrough",
+
+			input: input{
+				id:   "123",
+				path: "docs/page?x=1",
+			},
+
+			mocks: mocks{
+				redirectFunc: redirectTo(&urlsnipper.Redirect{Target: "https://example.com/docs/page?x=1"}),
+			},
+
+			want: want{
+				code:   http.StatusTemporaryRedirect,
+				header: http.Header{"Location": []string{"https://example.com/docs/page?x=1"}},
+				path:   "docs/page",
+				query:  url.Values{"x": {"1"}},
+			},
+		},
+		{
+			name: "passthrough_disabled",
+
+			input: input{
+				id:   "123",
+				path: "docs/page",
+			},
+
+			mocks: mocks{
+				redirectFunc: failWith(urlsnipper.ErrPassthroughDisabled),
+			},
+
+			want: want{
+				code: http.StatusNotFound,
+				body: "Not Found",
+				path: "docs/page",
+			},
+		},
+		{
+			name: "passthrough_dot_dot",
+
+			input: input{
+				id:   "123",
+				path: "docs/../admin",
+			},
+
+			mocks: mocks{
+				redirectFunc: failWith(urlsnipper.ErrInvalidPassthroughPath),
+			},
+
+			want: want{
+				code: http.StatusBadRequest,
+				body: "Bad Request",
+				path: "docs/../admin",
+			},
+		},
+		{
+			name: "client_platform",
+
+			input: input{
+				id:        "123",
+				userAgent: "Mozilla/5.0 (Linux; Android 14; Pixel 8)",
+			},
+
 			mocks: mocks{
-				getURLFunc: func(ctx context.Context, id string) (string, error) {
-					return "", errors.New("service error")
+				redirectFunc: redirectTo(&urlsnipper.Redirect{Target: "https://play.google.com/app"}),
+			},
+
+			want: want{
+				code:     http.StatusTemporaryRedirect,
+				header:   http.Header{"Location": []string{"https://play.google.com/app"}},
+				platform: urlsnipper.PlatformAndroid,
+			},
+		},
+		{
+			name: "country_behind_proxy",
+
+			input: input{
+				id:           "123",
+				remoteAddr:   "10.0.0.2:4000",
+				forwardedFor: "2.125.160.216",
+			},
+
+			mocks: mocks{
+				redirectFunc: redirectTo(&urlsnipper.Redirect{Target: "https://example.eu"}),
+			},
+
+			want: want{
+				code:    http.StatusTemporaryRedirect,
+				header:  http.Header{"Location": []string{"https://example.eu"}},
+				country: "DE",
+			},
+		},
+		{
+			name: "country_untrusted_forwarded_for",
+
+			input: input{
+				id:           "123",
+				remoteAddr:   "216.160.83.56:4000",
+				forwardedFor: "2.125.160.216",
+			},
+
+			mocks: mocks{
+				redirectFunc: redirectTo(&urlsnipper.Redirect{Target: "https://example.com/us"}),
+			},
+
+			want: want{
+				code:    http.StatusTemporaryRedirect,
+				header:  http.Header{"Location": []string{"https://example.com/us"}},
+				country: "US",
+			},
+		},
+		{
+			name: "sticky_variant",
+
+			input: input{
+				id:      "123",
+				variant: "b",
+			},
+
+			mocks: mocks{
+				redirectFunc: redirectTo(&urlsnipper.Redirect{Target: "https://b.example.com", Variant: "b"}),
+			},
+
+			want: want{
+				code: http.StatusTemporaryRedirect,
+				header: http.Header{
+					"Location":   []string{"https://b.example.com"},
+					"Set-Cookie": []string{"snipurl_variant_123=b; Path=/; Max-Age=2592000; HttpOnly; SameSite=Lax"},
 				},
-				getURLFuncNumberOfCalls: 1,
+				variant: "b",
+			},
+		},
+		{
+			name: "variant_click_limit_reached",
+
+			input: input{
+				id:      "123",
+				variant: "b",
+			},
+
+			mocks: mocks{
+				redirectFunc: failWith(urlsnipper.ErrExhausted),
+			},
+
+			want: want{
+				code:    http.StatusGone,
+				body:    "Gone",
+				variant: "b",
+			},
+		},
+		{
+			name: "password_protected",
+
+			input: input{
+				id: "123",
+			},
+
+			mocks: mocks{
+				redirectFunc: failWith(urlsnipper.ErrPasswordRequired),
+			},
+
+			want: want{
+				code: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "password_too_many_attempts",
+
+			input: input{
+				id: "123",
+			},
+
+			mocks: mocks{
+				redirectFunc: failWith(urlsnipper.ErrTooManyAttempts),
+			},
+
+			want: want{
+				code: http.StatusTooManyRequests,
+			},
+		},
+		{
+			name: "unlocked",
+
+			input: input{
+				id:        "123",
+				unlockKey: "0123456789abcdef",
+			},
+
+			mocks: mocks{
+				redirectFunc: redirectTo(&urlsnipper.Redirect{Target: "https://example.com"}),
+			},
+
+			want: want{
+				code:      http.StatusTemporaryRedirect,
+				header:    http.Header{"Location": []string{"https://example.com"}},
+				unlockKey: "0123456789abcdef",
+			},
+		},
+		{
+			name: "not_yet_active",
+			input: input{
+				id: "123",
+			},
+			mocks: mocks{
+				redirectFunc: failWith(urlsnipper.ErrNotYetActive),
+			},
+			want: want{
+				code: http.StatusNotFound,
+				body: "Not Found",
+			},
+		},
+		{
+			name: "ended",
+			input: input{
+				id: "123",
+			},
+			mocks: mocks{
+				redirectFunc: failWith(urlsnipper.ErrEnded),
+			},
+			want: want{
+				code: http.StatusGone,
+				body: "Gone",
+			},
+		},
+		{
+			name: "deleted",
+			input: input{
+				id: "123",
+			},
+			mocks: mocks{
+				redirectFunc: failWith(urlsnipper.ErrDeleted),
+			},
+			want: want{
+				code: http.StatusGone,
+				body: "Gone",
+			},
+		},
+		{
+			name: "service error",
+			input: input{
+				id: "123",
+			},
+			mocks: mocks{
+				redirectFunc: failWith(errors.New("service error")),
 			},
 			want: want{
 				code: http.StatusInternalServerError,
@@ -70,14 +352,37 @@ func TestSnipEndpoint_getURL(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := &serviceMock{
-				GetURLFunc: tt.mocks.getURLFunc,
+				RedirectFunc: tt.mocks.redirectFunc,
 			}
 
 			endpoint := &snipEndpoint{
-				service: mockService,
+				service:       mockService,
+				locator:       locator,
+				ipResolver:    ipResolver,
+				unlockCookies: cookie.NewCookieManager([]byte("secret"), cookie.WithName("unlock")),
+				redirectType:  http.StatusTemporaryRedirect,
 			}
 
-			req := httptest.NewRequest(http.MethodGet, "/"+tt.input.id, nil)
+			req := httptest.NewRequest(http.MethodGet, "/"+tt.input.id+"/"+tt.input.path, nil)
+			req.SetPathValue("id", tt.input.id)
+			req.SetPathValue("*", strings.Split(tt.input.path, "?")[0])
+			req.Header.Set("User-Agent", tt.input.userAgent)
+			if tt.input.remoteAddr != "" {
+				req.RemoteAddr = tt.input.remoteAddr
+			}
+			if tt.input.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.input.forwardedFor)
+			}
+			if tt.input.variant != "" {
+				req.AddCookie(&http.Cookie{Name: variantCookiePrefix + tt.input.id, Value: tt.input.variant})
+			}
+			if tt.input.unlockKey != "" {
+				unlocked := httptest.NewRecorder()
+				endpoint.unlock(unlocked, req, tt.input.id, tt.input.unlockKey)
+				for _, c := range unlocked.Result().Cookies() {
+					req.AddCookie(c)
+				}
+			}
 			w := httptest.NewRecorder()
 
 			endpoint.getURL(w, req)
@@ -85,16 +390,30 @@ func TestSnipEndpoint_getURL(t *testing.T) {
 			require.Equal(t, tt.want.code, w.Code, "Expected status code %d, got %d", tt.want.code, w.Code)
 
 			switch tt.want.code {
-			case http.StatusTemporaryRedirect:
+			case http.StatusTemporaryRedirect, http.StatusMovedPermanently:
 				for k, v := range tt.want.header {
 					require.Equal(t, v, w.Header().Values(k), "Expected header %v, got %v", v, w.Header().Values(k))
 				}
+			case http.StatusUnauthorized, http.StatusTooManyRequests:
+				require.Contains(t, w.Body.String(), `<input type="password" name="password"`)
 			default:
 				require.Equal(t, strings.TrimSpace(tt.want.body), strings.TrimSpace(w.Body.String()), "Expected body %s, got %s", tt.want.body, w.Body.String())
+				require.Empty(t, w.Header().Values("Set-Cookie"))
 			}
 
-			require.Equal(t, tt.mocks.getURLFuncNumberOfCalls, len(mockService.GetURLCalls()))
-
+			require.Len(t, mockService.RedirectCalls(), 1)
+			got := mockService.RedirectCalls()[0].Req
+			require.Equal(t, tt.input.id, got.ID)
+			require.Equal(t, tt.want.path, got.Path)
+			if tt.want.query != nil {
+				require.Equal(t, tt.want.query, got.Query)
+			}
+			require.Equal(t, tt.want.country, got.Client.Country)
+			if tt.want.platform != "" {
+				require.Equal(t, tt.want.platform, got.Client.Platform)
+			}
+			require.Equal(t, tt.want.variant, got.Variant)
+			require.Equal(t, tt.want.unlockKey, got.UnlockKey)
 		})
 	}
 }
@@ -2,26 +2,53 @@ package snipendpoint
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
 )
 
-// getURLs handles HTTP requests to retrieve a list of URLs.
+// nextCursorHeader carries the cursor of the next page of GET /api/user/urls.
+const nextCursorHeader = "X-Next-Cursor"
+
+// pagingParams are the query parameters of GET /api/user/urls that request a page.
+var pagingParams = []string{"sort", "order", "limit", "cursor"}
+
+// getURLs handles HTTP requests to retrieve the user's URLs.
+// Query parameters:
+// - tag: keep only the URLs with that tag
+// - search: keep only the URLs whose original URL or title contains the string
+// - broken: "true" keeps only the URLs whose target the health checker reported broken
+// - sort: "created" (default) or "clicks"; order: "desc" (default) or "asc"
+// - limit: page size, 100 by default and at most 1000
+// - cursor: the X-Next-Cursor header of the previous page
+// Without any of sort, order, limit and cursor all the matching URLs are returned
+// at once, oldest first, as before paging was added.
+// Every URL whose target was checked carries the result of the latest check in "health".
 // It returns a JSON response containing the URLs or appropriate HTTP status codes:
-// - 200 OK with JSON payload if URLs are found
+// - 200 OK with JSON payload if URLs are found; X-Next-Cursor is set unless it is the last page
 // - 204 No Content if no URLs exist
+// - 400 Bad Request if the query parameters or the cursor are invalid
 // - 500 Internal Server Error if any error occurs during processing
 func (s *snipEndpoint) getURLs(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	urls, err := s.service.GetURLs(r.Context())
+	page, err := s.listURLs(r)
 	if err != nil {
+		if errors.Is(err, urlsnipper.ErrInvalidListQuery) {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
-	if len(urls) == 0 {
+	if len(page.URLs) == 0 {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
-	urlsResp, err := getURLsJSONResponseFromServiceModel(s.baseURL, urls)
+	urlsResp, err := getURLsJSONResponseFromServiceModel(s.baseURL, page.URLs)
 	if err != nil {
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
@@ -32,5 +59,58 @@ func (s *snipEndpoint) getURLs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if page.NextCursor != "" {
+		w.Header().Set(nextCursorHeader, page.NextCursor)
+	}
 	w.Write(resp)
 }
+
+// listURLs returns the page of the user's URLs requested by the query, or all the
+// matching URLs if the query has no paging parameters.
+func (s *snipEndpoint) listURLs(r *http.Request) (*urlsnipper.URLPage, error) {
+	query := r.URL.Query()
+	input, err := listURLsQueryToServiceModel(query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", urlsnipper.ErrInvalidListQuery, err)
+	}
+	for _, param := range pagingParams {
+		if query.Has(param) {
+			return s.service.ListURLs(r.Context(), input)
+		}
+	}
+
+	urls, err := s.service.GetURLs(r.Context(), &urlsnipper.URLFilter{
+		Tag:    input.Tag,
+		Search: input.Search,
+		Broken: input.Broken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &urlsnipper.URLPage{URLs: urls}, nil
+}
+
+func listURLsQueryToServiceModel(query url.Values) (*urlsnipper.ListURLsInput, error) {
+	input := &urlsnipper.ListURLsInput{
+		Tag:    query.Get("tag"),
+		Search: query.Get("search"),
+		Sort:   query.Get("sort"),
+		Order:  query.Get("order"),
+		Cursor: query.Get("cursor"),
+	}
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, err
+		}
+		input.Limit = n
+	}
+	if broken := query.Get("broken"); broken != "" {
+		b, err := strconv.ParseBool(broken)
+		if err != nil {
+			return nil, err
+		}
+		input.Broken = b
+	}
+	return input, nil
+}
@@ -0,0 +1,100 @@
+package snipendpoint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnipEndpoint_getURLs(t *testing.T) {
+	urls := []*urlsnipper.URL{
+		{ShortURL: "a", OriginalURL: "https://example.com/a"},
+		{ShortURL: "b", OriginalURL: "https://example.com/b"},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		page       *urlsnipper.URLPage
+		listErr    error
+		wantFilter *urlsnipper.URLFilter
+		wantInput  *urlsnipper.ListURLsInput
+		wantCode   int
+		wantCursor string
+	}{
+		{
+			name:       "no paging parameters return all urls",
+			query:      "?tag=launch&search=example&broken=true",
+			wantFilter: &urlsnipper.URLFilter{Tag: "launch", Search: "example", Broken: true},
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:       "limit requests a page",
+			query:      "?tag=launch&limit=2",
+			page:       &urlsnipper.URLPage{URLs: urls, NextCursor: "next"},
+			wantInput:  &urlsnipper.ListURLsInput{Tag: "launch", Limit: 2},
+			wantCode:   http.StatusOK,
+			wantCursor: "next",
+		},
+		{
+			name:      "empty order requests a page",
+			query:     "?order=",
+			page:      &urlsnipper.URLPage{URLs: urls},
+			wantInput: &urlsnipper.ListURLsInput{},
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:     "malformed limit",
+			query:    "?limit=ten",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:      "invalid cursor",
+			query:     "?cursor=bad",
+			listErr:   urlsnipper.ErrInvalidCursor,
+			wantInput: &urlsnipper.ListURLsInput{Cursor: "bad"},
+			wantCode:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &serviceMock{
+				GetURLsFunc: func(ctx context.Context, filter *urlsnipper.URLFilter) ([]*urlsnipper.URL, error) {
+					return urls, nil
+				},
+				ListURLsFunc: func(ctx context.Context, input *urlsnipper.ListURLsInput) (*urlsnipper.URLPage, error) {
+					return tt.page, tt.listErr
+				},
+			}
+			endpoint := &snipEndpoint{service: mockService, prefix: "/", baseURL: "http://localhost:8080"}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/user/urls"+tt.query, nil)
+			w := httptest.NewRecorder()
+			endpoint.getURLs(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			require.Equal(t, tt.wantCursor, w.Header().Get(nextCursorHeader))
+
+			if tt.wantFilter != nil {
+				require.Len(t, mockService.GetURLsCalls(), 1)
+				require.Equal(t, tt.wantFilter, mockService.GetURLsCalls()[0].Filter)
+			} else {
+				require.Empty(t, mockService.GetURLsCalls())
+			}
+			if tt.wantInput != nil {
+				require.Len(t, mockService.ListURLsCalls(), 1)
+				require.Equal(t, tt.wantInput, mockService.ListURLsCalls()[0].Input)
+			} else {
+				require.Empty(t, mockService.ListURLsCalls())
+			}
+			if tt.wantCode == http.StatusOK {
+				require.Contains(t, w.Body.String(), `"short_url":"http://localhost:8080/b"`)
+			}
+		})
+	}
+}
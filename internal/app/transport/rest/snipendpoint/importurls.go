@@ -0,0 +1,351 @@
+package snipendpoint
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+)
+
+const (
+	// importChunkSize is the number of import rows stored with one SetURLs call.
+	importChunkSize = 100
+	// importMaxLineSize bounds a single NDJSON line of an import.
+	importMaxLineSize = 1 << 20
+	// importTagSeparator separates the tags in the tags column of a CSV import.
+	importTagSeparator = "|"
+)
+
+var (
+	errUnsupportedImportFormat = errors.New("unsupported import format")
+	errInvalidImportHeader     = errors.New("invalid import header")
+	errMalformedImportRow      = errors.New("malformed row")
+	errMissingOriginalURL      = errors.New("original_url is required")
+	errInvalidOriginalURL      = errors.New("original_url must be an absolute http or https URL")
+	errShortURLTaken           = errors.New("short URL is already taken")
+	errShortURLNotGenerated    = errors.New("failed to generate a short URL")
+)
+
+// importColumns are the CSV columns of an import, named like the NDJSON fields.
+var importColumns = map[string]struct{}{
+	"correlation_id": {},
+	"original_url":   {},
+	"alias":          {},
+	"title":          {},
+	"tags":           {},
+	"not_after":      {},
+}
+
+// importRowReader reads the rows of an import body. Next returns io.EOF after the
+// last row. An error wrapping errMalformedImportRow is reported for that row only,
+// together with whatever part of the row could be read, and reading goes on.
+type importRowReader interface {
+	Next() (*importRowJSON, error)
+}
+
+// importEntry is an import row on its way through a chunk.
+type importEntry struct {
+	input  *urlsnipper.SetURLsInput
+	result importResultJSON
+}
+
+// importURLs handles POST /api/shorten/import, the bulk creation of short URLs from
+// a CSV (text/csv) or NDJSON (application/x-ndjson) body, optionally gzip-encoded.
+//
+// Every row has an original_url, an absolute http or https URL, and may have a
+// correlation_id, alias, title, tags and not_after expiry (RFC 3339). A CSV body starts with a header naming its columns,
+// and separates tags with '|'. Rows without a correlation ID are identified by their
+// 1-based position.
+//
+// Rows are stored in chunks of importChunkSize as they are read, and the result of every
+// row is streamed back as an NDJSON line with its correlation ID and either the short URL
+// or an error. A row without an alias whose original URL has already been shortened gets
+// the existing short URL marked with existing, like the 409 Conflict of a single create.
+// A malformed or rejected row does not stop the import.
+//
+// Response status codes:
+//   - 200 OK: The import was read, the results follow
+//   - 400 Bad Request: The CSV header is missing or names an unknown column
+//   - 415 Unsupported Media Type: The body is neither CSV nor NDJSON
+func (s *snipEndpoint) importURLs(w http.ResponseWriter, r *http.Request) {
+	rows, err := newImportRowReader(r)
+	if errors.Is(err, errUnsupportedImportFormat) {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Results are written while the body is still being read.
+	rc := http.NewResponseController(w)
+	_ = rc.EnableFullDuplex()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	ctx := r.Context()
+	encoder := json.NewEncoder(w)
+	chunk := make([]*importEntry, 0, importChunkSize)
+	flush := func() bool {
+		s.storeImportChunk(ctx, chunk)
+		if ctx.Err() != nil {
+			return false
+		}
+		for _, entry := range chunk {
+			if err := encoder.Encode(&entry.result); err != nil {
+				return false
+			}
+		}
+		chunk = chunk[:0]
+		_ = rc.Flush()
+		return true
+	}
+
+	for rowNumber := 1; ; rowNumber++ {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, errMalformedImportRow) {
+			if flush() {
+				encoder.Encode(&importResultJSON{Error: fmt.Sprintf("failed to read import: %v", err)})
+			}
+			return
+		}
+
+		chunk = append(chunk, newImportEntry(rowNumber, row, err))
+		if len(chunk) == importChunkSize && !flush() {
+			return
+		}
+	}
+	flush()
+}
+
+func newImportRowReader(r *http.Request) (importRowReader, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, errUnsupportedImportFormat
+	}
+	switch mediaType {
+	case "text/csv":
+		return newCSVImportReader(r.Body)
+	case "application/x-ndjson", "application/ndjson", "application/jsonl":
+		return newNDJSONImportReader(r.Body), nil
+	default:
+		return nil, errUnsupportedImportFormat
+	}
+}
+
+func newImportEntry(rowNumber int, row *importRowJSON, err error) *importEntry {
+	entry := &importEntry{result: importResultJSON{CorrelationID: strconv.Itoa(rowNumber)}}
+	if row != nil && row.CorrelationID != "" {
+		entry.result.CorrelationID = row.CorrelationID
+	}
+	switch {
+	case err != nil:
+		entry.result.Error = err.Error()
+	case row.OriginalURL == "":
+		entry.result.Error = errMissingOriginalURL.Error()
+	case !isHTTPURL(row.OriginalURL):
+		entry.result.Error = errInvalidOriginalURL.Error()
+	default:
+		entry.input = importRowJSONToServiceModel(row)
+	}
+	return entry
+}
+
+// isHTTPURL reports whether the original URL of an import row is an absolute
+// http or https URL with a host.
+func isHTTPURL(rawURL string) bool {
+	u, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// storeImportChunk stores the well-formed rows of a chunk with one SetURLs call and
+// records the result of each. A chunk rejected as a whole, e.g. for an invalid attribute
+// of one of its rows, is stored again row by row, so that only the rows at fault fail.
+func (s *snipEndpoint) storeImportChunk(ctx context.Context, chunk []*importEntry) {
+	pending := make([]*importEntry, 0, len(chunk))
+	for _, entry := range chunk {
+		if entry.input != nil {
+			pending = append(pending, entry)
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	if err := s.storeImportEntries(ctx, pending); err == nil || len(pending) == 1 {
+		return
+	}
+	for _, entry := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+		s.storeImportEntries(ctx, []*importEntry{entry})
+	}
+}
+
+// storeImportEntries stores the entries with one SetURLs call. Entries of already shortened
+// original URLs get the existing short URL, entries skipped because their alias is taken
+// fail on their own; any other error fails them all and is returned.
+func (s *snipEndpoint) storeImportEntries(ctx context.Context, entries []*importEntry) error {
+	urls := make([]*urlsnipper.SetURLsInput, 0, len(entries))
+	for i, entry := range entries {
+		entry.result.Error = ""
+		entry.result.Existing = false
+		// Correlation IDs of the rows may repeat, the position in the call may not.
+		entry.input.CorrelationID = strconv.Itoa(i)
+		urls = append(urls, entry.input)
+	}
+
+	res, err := s.service.SetURLs(ctx, urls)
+	if err != nil && !errors.Is(err, urlsnipper.ErrFailedToGenerateID) {
+		for _, entry := range entries {
+			entry.result.Error = importErrorMessage(err)
+		}
+		return err
+	}
+
+	for i, entry := range entries {
+		out, ok := res[strconv.Itoa(i)]
+		if !ok {
+			if entry.input.Alias != "" {
+				entry.result.Error = errShortURLTaken.Error()
+			} else {
+				entry.result.Error = errShortURLNotGenerated.Error()
+			}
+			continue
+		}
+		shortURL, err := url.JoinPath(s.baseURL, out.ShortURLID)
+		if err != nil {
+			entry.result.Error = http.StatusText(http.StatusInternalServerError)
+			continue
+		}
+		entry.result.ShortURL = shortURL
+		entry.result.Existing = out.Existing
+	}
+	return nil
+}
+
+// importErrorMessage reports invalid rows with the reason and hides internal errors.
+func importErrorMessage(err error) string {
+	if errors.Is(err, urlsnipper.ErrInvalidOption) {
+		return err.Error()
+	}
+	return http.StatusText(http.StatusInternalServerError)
+}
+
+type ndjsonImportReader struct {
+	scanner *bufio.Scanner
+}
+
+func newNDJSONImportReader(r io.Reader) *ndjsonImportReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), importMaxLineSize)
+	return &ndjsonImportReader{scanner: scanner}
+}
+
+// Next reads the next non-blank line.
+func (r *ndjsonImportReader) Next() (*importRowJSON, error) {
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var row importRowJSON
+		if err := json.Unmarshal(line, &row); err != nil {
+			return nil, fmt.Errorf("%w: %w", errMalformedImportRow, err)
+		}
+		return &row, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+type csvImportReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+// newCSVImportReader reads the header of a CSV import.
+func newCSVImportReader(r io.Reader) (*csvImportReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidImportHeader, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := importColumns[name]; !ok {
+			return nil, fmt.Errorf("%w: unknown column %q", errInvalidImportHeader, name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["original_url"]; !ok {
+		return nil, fmt.Errorf("%w: no original_url column", errInvalidImportHeader)
+	}
+
+	return &csvImportReader{reader: reader, columns: columns}, nil
+}
+
+// Next reads the next record.
+func (r *csvImportReader) Next() (*importRowJSON, error) {
+	record, err := r.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, fmt.Errorf("%w: %w", errMalformedImportRow, err)
+		}
+		return nil, err
+	}
+
+	row := &importRowJSON{
+		CorrelationID: r.field(record, "correlation_id"),
+		OriginalURL:   r.field(record, "original_url"),
+		Alias:         r.field(record, "alias"),
+		Title:         r.field(record, "title"),
+	}
+	if tags := r.field(record, "tags"); tags != "" {
+		row.Tags = strings.Split(tags, importTagSeparator)
+	}
+	notAfter, err := parseTimestamp(r.field(record, "not_after"))
+	if err != nil {
+		return row, fmt.Errorf("%w: not_after: %w", errMalformedImportRow, err)
+	}
+	row.NotAfter = notAfter
+	return row, nil
+}
+
+func (r *csvImportReader) field(record []string, column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
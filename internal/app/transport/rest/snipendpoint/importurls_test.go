@@ -0,0 +1,215 @@
+package snipendpoint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnipEndpoint_importURLs(t *testing.T) {
+	// setURLs fails the whole call for an invalid alias, skips taken ones and returns
+	// the short URL of an already shortened original URL, like urlSnipperService.SetURLs.
+	setURLs := func(ctx context.Context, urls []*urlsnipper.SetURLsInput) (map[string]*urlsnipper.SetURLsOutput, error) {
+		output := make(map[string]*urlsnipper.SetURLsOutput, len(urls))
+		var err error
+		for _, u := range urls {
+			switch u.Alias {
+			case "no/slashes":
+				return nil, urlsnipper.ErrInvalidAlias
+			case "taken":
+				err = urlsnipper.ErrFailedToGenerateID
+				continue
+			}
+			switch u.OriginalURL {
+			case "https://example.com/existing":
+				err = urlsnipper.ErrFailedToGenerateID
+				output[u.CorrelationID] = &urlsnipper.SetURLsOutput{CorrelationID: u.CorrelationID, ShortURLID: "existing", Existing: true}
+				continue
+			case "https://example.com/collision":
+				err = urlsnipper.ErrFailedToGenerateID
+				continue
+			}
+			id := u.Alias
+			if id == "" {
+				id = strings.TrimPrefix(u.OriginalURL, "https://example.com/")
+			}
+			output[u.CorrelationID] = &urlsnipper.SetURLsOutput{CorrelationID: u.CorrelationID, ShortURLID: id}
+		}
+		return output, err
+	}
+
+	type want struct {
+		code          int
+		body          string
+		setURLsCalls  int
+		firstCallURLs int
+	}
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        want
+	}{
+		{
+			name:        "ndjson",
+			contentType: "application/x-ndjson",
+			body: `{"correlation_id":"a","original_url":"https://example.com/one","tags":["Launch"]}
+
+{"original_url":"https://example.com/two","alias":"spring-sale"}
+`,
+			want: want{
+				code: http.StatusOK,
+				body: `{"correlation_id":"a","short_url":"http://localhost:8080/one"}
+{"correlation_id":"2","short_url":"http://localhost:8080/spring-sale"}`,
+				setURLsCalls:  1,
+				firstCallURLs: 2,
+			},
+		},
+		{
+			name:        "ndjson_malformed_rows",
+			contentType: "application/x-ndjson; charset=utf-8",
+			body: `{"correlation_id":"a","original_url":"https://example.com/one"}
+{"correlation_id":
+{"correlation_id":"c"}
+{"correlation_id":"d","original_url":"example.com/four"}
+{"correlation_id":"e","original_url":"ftp://example.com/five"}
+{"correlation_id":"f","original_url":"https:///six"}`,
+			want: want{
+				code: http.StatusOK,
+				body: `{"correlation_id":"a","short_url":"http://localhost:8080/one"}
+{"correlation_id":"2","error":"malformed row: unexpected end of JSON input"}
+{"correlation_id":"c","error":"original_url is required"}
+{"correlation_id":"d","error":"original_url must be an absolute http or https URL"}
+{"correlation_id":"e","error":"original_url must be an absolute http or https URL"}
+{"correlation_id":"f","error":"original_url must be an absolute http or https URL"}`,
+				setURLsCalls:  1,
+				firstCallURLs: 1,
+			},
+		},
+		{
+			name:        "csv",
+			contentType: "text/csv",
+			body: "\ufeffcorrelation_id,original_url,tags,not_after\n" +
+				"a,https://example.com/one,launch|q3,2030-01-01T00:00:00Z\n" +
+				"b,https://example.com/two,,tomorrow\n",
+			want: want{
+				code: http.StatusOK,
+				body: `{"correlation_id":"a","short_url":"http://localhost:8080/one"}
+{"correlation_id":"b","error":"malformed row: not_after: parsing time \"tomorrow\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"tomorrow\" as \"2006\""}`,
+				setURLsCalls:  1,
+				firstCallURLs: 1,
+			},
+		},
+		{
+			name:        "rejected_chunk_retried_row_by_row",
+			contentType: "text/csv",
+			body: "original_url,alias\n" +
+				"https://example.com/one,\n" +
+				"https://example.com/two,no/slashes\n" +
+				"https://example.com/three,taken\n",
+			want: want{
+				code: http.StatusOK,
+				body: `{"correlation_id":"1","short_url":"http://localhost:8080/one"}
+{"correlation_id":"2","error":"invalid option: alias"}
+{"correlation_id":"3","error":"short URL is already taken"}`,
+				setURLsCalls:  4,
+				firstCallURLs: 3,
+			},
+		},
+		{
+			name:        "existing_original_url",
+			contentType: "application/x-ndjson",
+			body: `{"correlation_id":"a","original_url":"https://example.com/one"}
+{"correlation_id":"b","original_url":"https://example.com/existing"}
+{"correlation_id":"c","original_url":"https://example.com/collision"}
+{"correlation_id":"d","original_url":"https://example.com/two","alias":"taken"}`,
+			want: want{
+				code: http.StatusOK,
+				body: `{"correlation_id":"a","short_url":"http://localhost:8080/one"}
+{"correlation_id":"b","short_url":"http://localhost:8080/existing","existing":true}
+{"correlation_id":"c","error":"failed to generate a short URL"}
+{"correlation_id":"d","error":"short URL is already taken"}`,
+				setURLsCalls:  1,
+				firstCallURLs: 4,
+			},
+		},
+		{
+			name:        "unknown_csv_column",
+			contentType: "text/csv",
+			body:        "original_url,password\nhttps://example.com/one,secret\n",
+			want: want{
+				code: http.StatusBadRequest,
+				body: `invalid import header: unknown column "password"`,
+			},
+		},
+		{
+			name:        "unsupported_format",
+			contentType: "application/json",
+			body:        `[{"original_url":"https://example.com/one"}]`,
+			want: want{
+				code: http.StatusUnsupportedMediaType,
+				body: http.StatusText(http.StatusUnsupportedMediaType),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &serviceMock{SetURLsFunc: setURLs}
+			endpoint := &snipEndpoint{
+				service: mockService,
+				prefix:  "/",
+				baseURL: "http://localhost:8080",
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/shorten/import", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+
+			endpoint.importURLs(w, req)
+
+			require.Equal(t, tt.want.code, w.Code)
+			require.Equal(t, tt.want.body, strings.TrimSpace(w.Body.String()))
+			calls := mockService.SetURLsCalls()
+			require.Len(t, calls, tt.want.setURLsCalls)
+			if tt.want.firstCallURLs > 0 {
+				require.Len(t, calls[0].Urls, tt.want.firstCallURLs)
+			}
+		})
+	}
+}
+
+func TestSnipEndpoint_importURLsChunks(t *testing.T) {
+	mockService := &serviceMock{
+		SetURLsFunc: func(ctx context.Context, urls []*urlsnipper.SetURLsInput) (map[string]*urlsnipper.SetURLsOutput, error) {
+			output := make(map[string]*urlsnipper.SetURLsOutput, len(urls))
+			for _, u := range urls {
+				output[u.CorrelationID] = &urlsnipper.SetURLsOutput{CorrelationID: u.CorrelationID, ShortURLID: "id"}
+			}
+			return output, nil
+		},
+	}
+	endpoint := &snipEndpoint{service: mockService, prefix: "/", baseURL: "http://localhost:8080"}
+
+	var body strings.Builder
+	for i := 0; i < importChunkSize*2+1; i++ {
+		body.WriteString(`{"original_url":"https://example.com/"}` + "\n")
+	}
+	req := httptest.NewRequest(http.MethodPost, "/api/shorten/import", strings.NewReader(body.String()))
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	w := httptest.NewRecorder()
+
+	endpoint.importURLs(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	require.Len(t, strings.Split(strings.TrimSpace(w.Body.String()), "\n"), importChunkSize*2+1)
+	calls := mockService.SetURLsCalls()
+	require.Len(t, calls, 3)
+	require.Len(t, calls[2].Urls, 1)
+}
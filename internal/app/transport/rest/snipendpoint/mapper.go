@@ -2,17 +2,123 @@ package snipendpoint
 
 import (
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/pkg/qrcode"
 )
 
 func createShortURLBatchJSONRequestToServiceModel(req *createShortURLBatchJSONRequest) *urlsnipper.SetURLsInput {
 	return &urlsnipper.SetURLsInput{
 		CorrelationID: req.CorrelationID,
 		OriginalURL:   req.OriginalURL,
+		RedirectType:  req.RedirectType,
+		Passthrough:   req.Passthrough,
+		QueryMode:     req.QueryMode,
+		Template:      req.Template,
+		Variants:      variantsJSONToServiceModel(req.Variants),
+		Password:      req.Password,
+		MaxClicks:     req.MaxClicks,
+		NotBefore:     req.NotBefore,
+		NotAfter:      req.NotAfter,
+		FallbackURL:   req.FallbackURL,
+		Title:         req.Title,
+		Notes:         req.Notes,
+		Tags:          req.Tags,
 	}
 }
 
+func importRowJSONToServiceModel(row *importRowJSON) *urlsnipper.SetURLsInput {
+	return &urlsnipper.SetURLsInput{
+		OriginalURL: row.OriginalURL,
+		Alias:       row.Alias,
+		Title:       row.Title,
+		Tags:        row.Tags,
+		NotAfter:    row.NotAfter,
+	}
+}
+
+func createShortURLJSONRequestToServiceOptions(req *createShortURLJSONRequest) []urlsnipper.Option {
+	return []urlsnipper.Option{
+		urlsnipper.WithRedirectType(req.RedirectType),
+		urlsnipper.WithPassthrough(req.Passthrough),
+		urlsnipper.WithQueryMode(req.QueryMode),
+		urlsnipper.WithTemplate(req.Template),
+		urlsnipper.WithVariants(variantsJSONToServiceModel(req.Variants)),
+		urlsnipper.WithPassword(req.Password),
+		urlsnipper.WithMaxClicks(req.MaxClicks),
+		urlsnipper.WithNotBefore(req.NotBefore),
+		urlsnipper.WithNotAfter(req.NotAfter),
+		urlsnipper.WithFallbackURL(req.FallbackURL),
+		urlsnipper.WithTitle(req.Title),
+		urlsnipper.WithNotes(req.Notes),
+		urlsnipper.WithTags(req.Tags),
+	}
+}
+
+func createShortURLQueryToServiceOptions(query url.Values) ([]urlsnipper.Option, error) {
+	opts := make([]urlsnipper.Option, 0, 8)
+	if redirectType := query.Get("redirect_type"); redirectType != "" {
+		code, err := strconv.Atoi(redirectType)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, urlsnipper.WithRedirectType(code))
+	}
+	if passthrough := query.Get("passthrough"); passthrough != "" {
+		on, err := strconv.ParseBool(passthrough)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, urlsnipper.WithPassthrough(on))
+	}
+	if queryMode := query.Get("query_mode"); queryMode != "" {
+		opts = append(opts, urlsnipper.WithQueryMode(queryMode))
+	}
+	if template := query.Get("template"); template != "" {
+		opts = append(opts, urlsnipper.WithTemplate(template))
+	}
+	if maxClicks := query.Get("max_clicks"); maxClicks != "" {
+		n, err := strconv.Atoi(maxClicks)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, urlsnipper.WithMaxClicks(n))
+	}
+	if notBefore := query.Get("not_before"); notBefore != "" {
+		t, err := parseTimestamp(notBefore)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, urlsnipper.WithNotBefore(t))
+	}
+	if notAfter := query.Get("not_after"); notAfter != "" {
+		t, err := parseTimestamp(notAfter)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, urlsnipper.WithNotAfter(t))
+	}
+	if fallbackURL := query.Get("fallback_url"); fallbackURL != "" {
+		opts = append(opts, urlsnipper.WithFallbackURL(fallbackURL))
+	}
+	return opts, nil
+}
+
+// parseTimestamp parses an RFC 3339 timestamp. An empty string yields nil.
+func parseTimestamp(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
 func createShortURLBatchJSONResponseFromServiceModel(resp *urlsnipper.SetURLsOutput) *createShortURLBatchJSONResponse {
 	return &createShortURLBatchJSONResponse{
 		CorrelationID: resp.CorrelationID,
@@ -23,14 +129,301 @@ func createShortURLBatchJSONResponseFromServiceModel(resp *urlsnipper.SetURLsOut
 func getURLsJSONResponseFromServiceModel(baseURL string, resp []*urlsnipper.URL) ([]*getURLsJSONResponse, error) {
 	urls := make([]*getURLsJSONResponse, 0, len(resp))
 	for _, u := range resp {
-		fullShortURL, err := url.JoinPath(baseURL, u.ShortURL)
+		item, err := getURLsJSONResponseItemFromServiceModel(baseURL, u)
 		if err != nil {
 			return nil, err
 		}
-		urls = append(urls, &getURLsJSONResponse{
-			ShortURL:    fullShortURL,
-			OriginalURL: u.OriginalURL,
-		})
+		urls = append(urls, item)
 	}
 	return urls, nil
 }
+
+func getURLsJSONResponseItemFromServiceModel(baseURL string, u *urlsnipper.URL) (*getURLsJSONResponse, error) {
+	fullShortURL, err := url.JoinPath(baseURL, u.ShortURL)
+	if err != nil {
+		return nil, err
+	}
+	return &getURLsJSONResponse{
+		ShortURL:     fullShortURL,
+		OriginalURL:  u.OriginalURL,
+		RedirectType: u.RedirectType,
+		Passthrough:  u.Passthrough,
+		QueryMode:    u.QueryMode,
+		Template:     u.Template,
+		Variants:     variantsJSONFromServiceModel(u.Variants),
+		Protected:    u.Protected,
+		MaxClicks:    u.MaxClicks,
+		ClicksLeft:   clicksLeftJSON(u),
+		NotBefore:    u.NotBefore,
+		NotAfter:     u.NotAfter,
+		FallbackURL:  u.FallbackURL,
+		Title:        u.Title,
+		Notes:        u.Notes,
+		Tags:         u.Tags,
+		CreatedAt:    createdAtJSON(u),
+		Clicks:       u.Clicks,
+		Health:       healthJSONFromServiceModel(u.Health),
+	}, nil
+}
+
+func healthJSONFromServiceModel(health *urlsnipper.Health) *healthJSON {
+	if health == nil {
+		return nil
+	}
+	return &healthJSON{
+		StatusCode:   health.StatusCode,
+		LatencyMs:    health.Latency.Milliseconds(),
+		Error:        health.Error,
+		CheckedAt:    health.CheckedAt,
+		FailingSince: health.FailingSince,
+		Broken:       health.Broken,
+	}
+}
+
+// createdAtJSON omits the creation time of links restored from dumps that predate it.
+func createdAtJSON(u *urlsnipper.URL) *time.Time {
+	if u.CreatedAt.IsZero() {
+		return nil
+	}
+	createdAt := u.CreatedAt
+	return &createdAt
+}
+
+// clicksLeftJSON reports the remaining redirects only for click-limited links,
+// so that an exhausted link shows an explicit zero.
+func clicksLeftJSON(u *urlsnipper.URL) *int {
+	if u.MaxClicks == 0 {
+		return nil
+	}
+	clicksLeft := u.ClicksLeft
+	return &clicksLeft
+}
+
+func updateURLJSONRequestToServiceOptions(req *updateURLJSONRequest) ([]urlsnipper.Option, error) {
+	opts := make([]urlsnipper.Option, 0, 13)
+	if req.RedirectType != nil {
+		opts = append(opts, urlsnipper.WithRedirectType(*req.RedirectType))
+	}
+	if req.Passthrough != nil {
+		opts = append(opts, urlsnipper.WithPassthrough(*req.Passthrough))
+	}
+	if req.QueryMode != nil {
+		opts = append(opts, urlsnipper.WithQueryMode(*req.QueryMode))
+	}
+	if req.Template != nil {
+		opts = append(opts, urlsnipper.WithTemplate(*req.Template))
+	}
+	if req.Variants != nil {
+		opts = append(opts, urlsnipper.WithVariants(variantsJSONToServiceModel(*req.Variants)))
+	}
+	if req.Password != nil {
+		opts = append(opts, urlsnipper.WithPassword(*req.Password))
+	}
+	if req.MaxClicks != nil {
+		opts = append(opts, urlsnipper.WithMaxClicks(*req.MaxClicks))
+	}
+	if req.NotBefore != nil {
+		t, err := parseTimestamp(*req.NotBefore)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, urlsnipper.WithNotBefore(t))
+	}
+	if req.NotAfter != nil {
+		t, err := parseTimestamp(*req.NotAfter)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, urlsnipper.WithNotAfter(t))
+	}
+	if req.FallbackURL != nil {
+		opts = append(opts, urlsnipper.WithFallbackURL(*req.FallbackURL))
+	}
+	if req.Title != nil {
+		opts = append(opts, urlsnipper.WithTitle(*req.Title))
+	}
+	if req.Notes != nil {
+		opts = append(opts, urlsnipper.WithNotes(*req.Notes))
+	}
+	if req.Tags != nil {
+		opts = append(opts, urlsnipper.WithTags(*req.Tags))
+	}
+	return opts, nil
+}
+
+func ruleJSONToServiceModel(req *ruleJSON) *urlsnipper.Rule {
+	return &urlsnipper.Rule{
+		ID:        req.ID,
+		Position:  req.Position,
+		Platform:  req.Platform,
+		Language:  req.Language,
+		Country:   req.Country,
+		TimeFrom:  req.TimeFrom,
+		TimeTo:    req.TimeTo,
+		TargetURL: req.TargetURL,
+	}
+}
+
+func ruleJSONFromServiceModel(rule *urlsnipper.Rule) *ruleJSON {
+	return &ruleJSON{
+		ID:        rule.ID,
+		Position:  rule.Position,
+		Platform:  rule.Platform,
+		Language:  rule.Language,
+		Country:   rule.Country,
+		TimeFrom:  rule.TimeFrom,
+		TimeTo:    rule.TimeTo,
+		TargetURL: rule.TargetURL,
+	}
+}
+
+func variantsJSONToServiceModel(req []*variantJSON) []*urlsnipper.Variant {
+	variants := make([]*urlsnipper.Variant, 0, len(req))
+	for _, v := range req {
+		variants = append(variants, &urlsnipper.Variant{Name: v.Name, URL: v.URL, Weight: v.Weight})
+	}
+	return variants
+}
+
+func variantsJSONFromServiceModel(variants []*urlsnipper.Variant) []*variantJSON {
+	if len(variants) == 0 {
+		return nil
+	}
+	output := make([]*variantJSON, 0, len(variants))
+	for _, v := range variants {
+		output = append(output, &variantJSON{Name: v.Name, URL: v.URL, Weight: v.Weight})
+	}
+	return output
+}
+
+func variantStatsJSONFromServiceModel(stats []*urlsnipper.VariantStats) []*variantStatsJSON {
+	output := make([]*variantStatsJSON, 0, len(stats))
+	for _, v := range stats {
+		output = append(output, &variantStatsJSON{Name: v.Name, URL: v.URL, Weight: v.Weight, Served: v.Served})
+	}
+	return output
+}
+
+func clickStatsJSONFromServiceModel(stats *urlsnipper.ClickStats) *clickStatsJSON {
+	return &clickStatsJSON{
+		Total:     stats.Total,
+		ByCountry: stats.ByCountry,
+	}
+}
+
+func clickEventJSONFromServiceModel(baseURL string, click *urlsnipper.ClickEvent, dropped uint64) (*clickEventJSON, error) {
+	shortURL, err := url.JoinPath(baseURL, click.ShortURL)
+	if err != nil {
+		return nil, err
+	}
+	return &clickEventJSON{
+		ShortURL: shortURL,
+		Clicks:   click.Clicks,
+		Country:  click.Country,
+		Variant:  click.Variant,
+		Time:     click.Time,
+		Dropped:  dropped,
+	}, nil
+}
+
+func urlInfoJSONFromServiceModel(baseURL string, info *urlsnipper.URLInfo) (*urlInfoJSON, error) {
+	shortURL, err := url.JoinPath(baseURL, info.ShortURL)
+	if err != nil {
+		return nil, err
+	}
+	resp := &urlInfoJSON{
+		ShortURL:        shortURL,
+		Target:          info.Target,
+		MultipleTargets: info.MultipleTargets,
+		Protected:       info.Protected,
+		Status:          info.Status,
+		Clicks:          info.Clicks,
+		Safety: &safetyJSON{
+			Verdict: info.Safety.Verdict,
+			Reasons: info.Safety.Reasons,
+		},
+	}
+	if !info.CreatedAt.IsZero() {
+		createdAt := info.CreatedAt
+		resp.CreatedAt = &createdAt
+	}
+	return resp, nil
+}
+
+func userDataHeadJSONFromServiceModel(data *urlsnipper.UserData) *userDataHeadJSON {
+	head := &userDataHeadJSON{
+		UserID:    data.UserID,
+		Templates: make([]*userTemplateJSON, 0, len(data.Templates)),
+	}
+	for _, t := range data.Templates {
+		head.Templates = append(head.Templates, &userTemplateJSON{
+			Name:     t.Name,
+			Source:   t.Source,
+			Medium:   t.Medium,
+			Campaign: t.Campaign,
+			Term:     t.Term,
+			Content:  t.Content,
+		})
+	}
+	head.Webhooks = make([]*userWebhookJSON, 0, len(data.Webhooks))
+	for _, w := range data.Webhooks {
+		head.Webhooks = append(head.Webhooks, &userWebhookJSON{
+			ID:             w.ID,
+			URL:            w.URL,
+			Events:         w.Events,
+			ClickThreshold: w.ClickThreshold,
+			CreatedAt:      w.CreatedAt,
+		})
+	}
+	head.AuditEntries = make([]*userAuditEntryJSON, 0, len(data.AuditEntries))
+	for _, e := range data.AuditEntries {
+		head.AuditEntries = append(head.AuditEntries, &userAuditEntryJSON{
+			ID:         e.ID,
+			Action:     e.Action,
+			ShortURL:   e.ShortURL,
+			IP:         e.IP,
+			AuthMethod: e.AuthMethod,
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+	return head
+}
+
+func userDataLinkJSONFromServiceModel(baseURL string, link *urlsnipper.UserDataLink) (*userDataLinkJSON, error) {
+	item, err := getURLsJSONResponseItemFromServiceModel(baseURL, link.URL)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]*ruleJSON, 0, len(link.URL.Rules))
+	for _, rule := range link.URL.Rules {
+		rules = append(rules, ruleJSONFromServiceModel(rule))
+	}
+	return &userDataLinkJSON{
+		exportURLJSON: &exportURLJSON{getURLsJSONResponse: item, Deleted: link.URL.Deleted},
+		Rules:         rules,
+		VariantStats:  variantStatsJSONFromServiceModel(link.VariantStats),
+		ClickStats:    clickStatsJSONFromServiceModel(link.ClickStats),
+	}, nil
+}
+
+func qrCodeQueryToOptions(query url.Values) (qrcode.Options, error) {
+	opts := qrcode.Options{
+		Format: query.Get("format"),
+		Level:  strings.ToUpper(query.Get("level")),
+	}
+	if size := query.Get("size"); size != "" {
+		n, err := strconv.Atoi(size)
+		if err != nil {
+			return opts, err
+		}
+		opts.Size = n
+	}
+	if margin := query.Get("margin"); margin != "" {
+		n, err := strconv.Atoi(margin)
+		if err != nil {
+			return opts, err
+		}
+		opts.Margin = &n
+	}
+	return opts, nil
+}
@@ -1,7 +1,22 @@
 package snipendpoint
 
+import "time"
+
 type createShortURLJSONRequest struct {
-	URL string `json:"url"`
+	URL          string         `json:"url"`
+	RedirectType int            `json:"redirect_type,omitempty"`
+	Passthrough  bool           `json:"passthrough,omitempty"`
+	QueryMode    string         `json:"query_mode,omitempty"`
+	Template     string         `json:"template,omitempty"`
+	Variants     []*variantJSON `json:"variants,omitempty"`
+	Password     string         `json:"password,omitempty"`
+	MaxClicks    int            `json:"max_clicks,omitempty"`
+	NotBefore    *time.Time     `json:"not_before,omitempty"`
+	NotAfter     *time.Time     `json:"not_after,omitempty"`
+	FallbackURL  string         `json:"fallback_url,omitempty"`
+	Title        string         `json:"title,omitempty"`
+	Notes        string         `json:"notes,omitempty"`
+	Tags         []string       `json:"tags,omitempty"`
 }
 
 type createShortURLJSONResponse struct {
@@ -9,8 +24,21 @@ type createShortURLJSONResponse struct {
 }
 
 type createShortURLBatchJSONRequest struct {
-	CorrelationID string `json:"correlation_id"`
-	OriginalURL   string `json:"original_url"`
+	CorrelationID string         `json:"correlation_id"`
+	OriginalURL   string         `json:"original_url"`
+	RedirectType  int            `json:"redirect_type,omitempty"`
+	Passthrough   bool           `json:"passthrough,omitempty"`
+	QueryMode     string         `json:"query_mode,omitempty"`
+	Template      string         `json:"template,omitempty"`
+	Variants      []*variantJSON `json:"variants,omitempty"`
+	Password      string         `json:"password,omitempty"`
+	MaxClicks     int            `json:"max_clicks,omitempty"`
+	NotBefore     *time.Time     `json:"not_before,omitempty"`
+	NotAfter      *time.Time     `json:"not_after,omitempty"`
+	FallbackURL   string         `json:"fallback_url,omitempty"`
+	Title         string         `json:"title,omitempty"`
+	Notes         string         `json:"notes,omitempty"`
+	Tags          []string       `json:"tags,omitempty"`
 }
 
 type createShortURLBatchJSONResponse struct {
@@ -18,7 +46,176 @@ type createShortURLBatchJSONResponse struct {
 	ShortURL      string `json:"short_url"`
 }
 
+// importRowJSON is a row of a bulk import, an NDJSON line or a CSV record.
+type importRowJSON struct {
+	CorrelationID string     `json:"correlation_id"`
+	OriginalURL   string     `json:"original_url"`
+	Alias         string     `json:"alias,omitempty"`
+	Title         string     `json:"title,omitempty"`
+	Tags          []string   `json:"tags,omitempty"`
+	NotAfter      *time.Time `json:"not_after,omitempty"`
+}
+
+// importResultJSON is the outcome of an import row, streamed back as an NDJSON line.
+// A result without a correlation ID reports an error that stopped the import.
+// Existing marks the short URL of an original URL that had already been shortened.
+type importResultJSON struct {
+	CorrelationID string `json:"correlation_id,omitempty"`
+	ShortURL      string `json:"short_url,omitempty"`
+	Existing      bool   `json:"existing,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
 type getURLsJSONResponse struct {
-	ShortURL    string `json:"short_url"`
-	OriginalURL string `json:"original_url"`
+	ShortURL     string         `json:"short_url"`
+	OriginalURL  string         `json:"original_url"`
+	RedirectType int            `json:"redirect_type,omitempty"`
+	Passthrough  bool           `json:"passthrough,omitempty"`
+	QueryMode    string         `json:"query_mode,omitempty"`
+	Template     string         `json:"template,omitempty"`
+	Variants     []*variantJSON `json:"variants,omitempty"`
+	Protected    bool           `json:"protected,omitempty"`
+	MaxClicks    int            `json:"max_clicks,omitempty"`
+	ClicksLeft   *int           `json:"clicks_left,omitempty"`
+	NotBefore    *time.Time     `json:"not_before,omitempty"`
+	NotAfter     *time.Time     `json:"not_after,omitempty"`
+	FallbackURL  string         `json:"fallback_url,omitempty"`
+	Title        string         `json:"title,omitempty"`
+	Notes        string         `json:"notes,omitempty"`
+	Tags         []string       `json:"tags,omitempty"`
+	CreatedAt    *time.Time     `json:"created_at,omitempty"`
+	Clicks       int            `json:"clicks"`
+	Health       *healthJSON    `json:"health,omitempty"`
+}
+
+// healthJSON is the latest check of the original URL of a link.
+type healthJSON struct {
+	StatusCode   int        `json:"status_code,omitempty"`
+	LatencyMs    int64      `json:"latency_ms"`
+	Error        string     `json:"error,omitempty"`
+	CheckedAt    time.Time  `json:"checked_at"`
+	FailingSince *time.Time `json:"failing_since,omitempty"`
+	Broken       bool       `json:"broken"`
+}
+
+// exportURLJSON is a link of an export, which unlike listings includes the deleted ones.
+type exportURLJSON struct {
+	*getURLsJSONResponse
+	Deleted bool `json:"deleted"`
+}
+
+// userDataHeadJSON opens the document of GET /api/user/data, the links follow it.
+type userDataHeadJSON struct {
+	UserID       string                `json:"user_id"`
+	Templates    []*userTemplateJSON   `json:"templates"`
+	Webhooks     []*userWebhookJSON    `json:"webhooks"`
+	AuditEntries []*userAuditEntryJSON `json:"audit_entries"`
+}
+
+type userWebhookJSON struct {
+	ID             string    `json:"id"`
+	URL            string    `json:"url"`
+	Events         []string  `json:"events"`
+	ClickThreshold int       `json:"click_threshold,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type userAuditEntryJSON struct {
+	ID         int64     `json:"id"`
+	Action     string    `json:"action"`
+	ShortURL   string    `json:"short_url"`
+	IP         string    `json:"ip,omitempty"`
+	AuthMethod string    `json:"auth_method,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type userTemplateJSON struct {
+	Name     string `json:"name"`
+	Source   string `json:"utm_source,omitempty"`
+	Medium   string `json:"utm_medium,omitempty"`
+	Campaign string `json:"utm_campaign,omitempty"`
+	Term     string `json:"utm_term,omitempty"`
+	Content  string `json:"utm_content,omitempty"`
+}
+
+// userDataLinkJSON is a link of GET /api/user/data with its rules and statistics.
+type userDataLinkJSON struct {
+	*exportURLJSON
+	Rules        []*ruleJSON         `json:"rules"`
+	VariantStats []*variantStatsJSON `json:"variant_stats,omitempty"`
+	ClickStats   *clickStatsJSON     `json:"click_stats"`
+}
+
+type updateURLJSONRequest struct {
+	RedirectType *int            `json:"redirect_type"`
+	Passthrough  *bool           `json:"passthrough"`
+	QueryMode    *string         `json:"query_mode"`
+	Template     *string         `json:"template"`
+	Variants     *[]*variantJSON `json:"variants"`
+	Password     *string         `json:"password"`
+	MaxClicks    *int            `json:"max_clicks"`
+	// NotBefore and NotAfter are RFC 3339 timestamps, an empty string removes the bound.
+	NotBefore   *string   `json:"not_before"`
+	NotAfter    *string   `json:"not_after"`
+	FallbackURL *string   `json:"fallback_url"`
+	Title       *string   `json:"title"`
+	Notes       *string   `json:"notes"`
+	Tags        *[]string `json:"tags"`
+}
+
+type variantJSON struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Weight int    `json:"weight"`
+}
+
+type variantStatsJSON struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Weight int    `json:"weight"`
+	Served int    `json:"served"`
+}
+
+type clickStatsJSON struct {
+	Total     int            `json:"total"`
+	ByCountry map[string]int `json:"by_country"`
+}
+
+// clickEventJSON is a click streamed by watchClicks. Dropped is the number of clicks
+// left out of the stream so far.
+type clickEventJSON struct {
+	ShortURL string    `json:"short_url"`
+	Clicks   int       `json:"clicks"`
+	Country  string    `json:"country,omitempty"`
+	Variant  string    `json:"variant,omitempty"`
+	Time     time.Time `json:"time"`
+	Dropped  uint64    `json:"dropped"`
+}
+
+// urlInfoJSON is the JSON preview of a short URL.
+type urlInfoJSON struct {
+	ShortURL        string      `json:"short_url"`
+	Target          string      `json:"target,omitempty"`
+	MultipleTargets bool        `json:"multiple_targets"`
+	Protected       bool        `json:"protected"`
+	Status          string      `json:"status"`
+	CreatedAt       *time.Time  `json:"created_at,omitempty"`
+	Clicks          int         `json:"clicks"`
+	Safety          *safetyJSON `json:"safety"`
+}
+
+type safetyJSON struct {
+	Verdict string   `json:"verdict"`
+	Reasons []string `json:"reasons"`
+}
+
+type ruleJSON struct {
+	ID        int    `json:"id"`
+	Position  int    `json:"position"`
+	Platform  string `json:"platform,omitempty"`
+	Language  string `json:"language,omitempty"`
+	Country   string `json:"country,omitempty"`
+	TimeFrom  string `json:"time_from,omitempty"`
+	TimeTo    string `json:"time_to,omitempty"`
+	TargetURL string `json:"target_url"`
 }
@@ -0,0 +1,168 @@
+package snipendpoint
+
+import (
+	"errors"
+	"html/template"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+)
+
+const (
+	// unlockTTL is how long a correct password keeps a link unlocked for the visitor.
+	unlockTTL = time.Hour
+	// maxUnlockedLinks caps the number of links remembered in the unlock cookie.
+	maxUnlockedLinks = 20
+	// maxPasswordFormSize limits the body of the password form.
+	maxPasswordFormSize = 4 << 10
+)
+
+var passwordForm = template.Must(template.New("password").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Password required</title>
+</head>
+<body>
+<form method="post">
+<p>This link is protected. Enter the password to continue.</p>
+{{if .}}<p role="alert">{{.}}</p>{{end}}
+<input type="password" name="password" autofocus required>
+<button type="submit">Continue</button>
+</form>
+</body>
+</html>
+`))
+
+// unlockURL handles HTTP POST requests of the password form of a protected short URL.
+// On a correct password the link is remembered in a short-lived signed cookie together
+// with its unlock key, and the visitor is sent back to the short URL with 303 See Other,
+// so the redirect happens on GET. Changing the password of the link invalidates the cookie.
+//
+// Response status codes:
+//   - 303 See Other: Password accepted
+//   - 401 Unauthorized: Password missing or wrong, the form is shown again
+//   - 410 Gone: URL has been deleted
+//   - 429 Too Many Requests: Too many failed attempts from this client
+//   - 500 Internal Server Error: Server-side error
+func (s *snipEndpoint) unlockURL(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxPasswordFormSize)
+	password := r.PostFormValue("password")
+
+	key, err := s.service.CheckPassword(r.Context(), id, password, s.clientKey(r))
+	switch {
+	case err == nil:
+	case errors.Is(err, urlsnipper.ErrPasswordRequired):
+		s.renderPasswordForm(w, http.StatusUnauthorized, "Password required.")
+		return
+	case errors.Is(err, urlsnipper.ErrWrongPassword):
+		s.renderPasswordForm(w, http.StatusUnauthorized, "Wrong password.")
+		return
+	case errors.Is(err, urlsnipper.ErrTooManyAttempts):
+		s.renderPasswordForm(w, http.StatusTooManyRequests, "Too many attempts. Try again later.")
+		return
+	case errors.Is(err, urlsnipper.ErrDeleted):
+		http.Error(w, http.StatusText(http.StatusGone), http.StatusGone)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	s.unlock(w, r, id, key)
+	http.Redirect(w, r, r.URL.RequestURI(), http.StatusSeeOther)
+}
+
+func (s *snipEndpoint) renderPasswordForm(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	passwordForm.Execute(w, message)
+}
+
+// unlockEntry is a link remembered in the unlock cookie with the unlock key
+// of its password and the moment it has to be entered again.
+type unlockEntry struct {
+	key    string
+	expiry time.Time
+}
+
+// unlockKey returns the unlock key of the link from the visitor's unlock cookie,
+// or an empty string if the cookie holds no unexpired entry for the link.
+func (s *snipEndpoint) unlockKey(r *http.Request, id string) string {
+	entry, ok := s.unlockedLinks(r)[id]
+	if !ok || !time.Now().Before(entry.expiry) {
+		return ""
+	}
+	return entry.key
+}
+
+// unlock adds the link and its unlock key to the visitor's unlock cookie, dropping
+// expired entries and the oldest ones above maxUnlockedLinks.
+func (s *snipEndpoint) unlock(w http.ResponseWriter, r *http.Request, id, key string) {
+	now := time.Now()
+	links := s.unlockedLinks(r)
+	links[id] = unlockEntry{key: key, expiry: now.Add(unlockTTL)}
+
+	ids := make([]string, 0, len(links))
+	for linkID, entry := range links {
+		if now.Before(entry.expiry) {
+			ids = append(ids, linkID)
+		}
+	}
+	if len(ids) > maxUnlockedLinks {
+		sort.Slice(ids, func(i, j int) bool {
+			return links[ids[i]].expiry.After(links[ids[j]].expiry)
+		})
+		ids = ids[:maxUnlockedLinks]
+	}
+
+	entries := make([]string, 0, len(ids))
+	for _, linkID := range ids {
+		entry := links[linkID]
+		entries = append(entries, linkID+":"+entry.key+":"+strconv.FormatInt(entry.expiry.Unix(), 10))
+	}
+	s.unlockCookies.Set(w, strings.Join(entries, "|"))
+}
+
+func (s *snipEndpoint) unlockedLinks(r *http.Request) map[string]unlockEntry {
+	links := make(map[string]unlockEntry)
+	value, err := s.unlockCookies.Get(r)
+	if err != nil || value == "" {
+		return links
+	}
+	for _, entry := range strings.Split(value, "|") {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			continue
+		}
+		unix, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		links[parts[0]] = unlockEntry{key: parts[1], expiry: time.Unix(unix, 0)}
+	}
+	return links
+}
+
+// clientKey identifies the visitor for rate limiting of password attempts.
+func (s *snipEndpoint) clientKey(r *http.Request) string {
+	if s.ipResolver != nil {
+		if ip := s.ipResolver.ClientIP(r); ip != nil {
+			return ip.String()
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,102 @@
+package snipendpoint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/pkg/cookie"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnipEndpoint_unlockURL(t *testing.T) {
+	tests := []struct {
+		name             string
+		password         string
+		checkPassword    func(ctx context.Context, id, password, client string) (string, error)
+		wantCode         int
+		wantBodyContains string
+		wantCookie       bool
+	}{
+		{
+			name:     "correct password",
+			password: "secret",
+			checkPassword: func(ctx context.Context, id, password, client string) (string, error) {
+				return "0123456789abcdef", nil
+			},
+			wantCode:   http.StatusSeeOther,
+			wantCookie: true,
+		},
+		{
+			name:     "wrong password",
+			password: "guess",
+			checkPassword: func(ctx context.Context, id, password, client string) (string, error) {
+				return "", urlsnipper.ErrWrongPassword
+			},
+			wantCode:         http.StatusUnauthorized,
+			wantBodyContains: "Wrong password.",
+		},
+		{
+			name:     "rate limited",
+			password: "guess",
+			checkPassword: func(ctx context.Context, id, password, client string) (string, error) {
+				return "", urlsnipper.ErrTooManyAttempts
+			},
+			wantCode:         http.StatusTooManyRequests,
+			wantBodyContains: "Too many attempts.",
+		},
+		{
+			name:     "deleted",
+			password: "secret",
+			checkPassword: func(ctx context.Context, id, password, client string) (string, error) {
+				return "", urlsnipper.ErrDeleted
+			},
+			wantCode:         http.StatusGone,
+			wantBodyContains: "Gone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &serviceMock{
+				CheckPasswordFunc: tt.checkPassword,
+			}
+			endpoint := &snipEndpoint{
+				service:       mockService,
+				unlockCookies: cookie.NewCookieManager([]byte("secret"), cookie.WithName("unlock")),
+			}
+
+			form := url.Values{"password": []string{tt.password}}
+			req := httptest.NewRequest(http.MethodPost, "/abc123/docs?x=1", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.RemoteAddr = "203.0.113.7:5555"
+			req.SetPathValue("id", "abc123")
+			w := httptest.NewRecorder()
+
+			endpoint.unlockURL(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			require.Contains(t, w.Body.String(), tt.wantBodyContains)
+			require.Len(t, mockService.CheckPasswordCalls(), 1)
+			require.Equal(t, tt.password, mockService.CheckPasswordCalls()[0].Password)
+			require.Equal(t, "203.0.113.7", mockService.CheckPasswordCalls()[0].Client)
+
+			if !tt.wantCookie {
+				require.Empty(t, w.Result().Cookies())
+				return
+			}
+			require.Equal(t, "/abc123/docs?x=1", w.Header().Get("Location"))
+
+			next := httptest.NewRequest(http.MethodGet, "/abc123", nil)
+			for _, c := range w.Result().Cookies() {
+				next.AddCookie(c)
+			}
+			require.Equal(t, "0123456789abcdef", endpoint.unlockKey(next, "abc123"))
+			require.Empty(t, endpoint.unlockKey(next, "def456"))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package snipendpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"html/template"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+)
+
+// previewSuffix after the short URL ID asks for the preview instead of the redirect.
+const previewSuffix = "+"
+
+var previewPage = template.Must(template.New("preview").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Link preview</title>
+</head>
+<body>
+<h1>Where does {{.ShortURL}} go?</h1>
+<dl>
+<dt>Target</dt>
+<dd>{{if .Target}}{{.Target}}{{else if .Protected}}Hidden, the link is protected by a password{{else}}None{{end}}</dd>
+{{if .MultipleTargets}}<dd>Some visitors are sent to other targets.</dd>{{end}}
+<dt>Status</dt>
+<dd>{{.Status}}</dd>
+{{with .CreatedAt}}<dt>Created</dt>
+<dd>{{.Format "2006-01-02 15:04 MST"}}</dd>
+{{end}}<dt>Clicks</dt>
+<dd>{{.Clicks}}</dd>
+<dt>Safety</dt>
+<dd>{{.Safety.Verdict}}</dd>
+{{range .Safety.Reasons}}<dd>{{.}}</dd>
+{{end}}</dl>
+<p><a href="{{.ShortURL}}" rel="noreferrer">Continue</a></p>
+</body>
+</html>
+`))
+
+// isPreviewRequest reports whether a request of a short URL asks for its preview:
+// with the "+" suffix, with a true preview query parameter or when JSON is acceptable.
+// It returns the short URL ID without the suffix.
+func isPreviewRequest(r *http.Request) (string, bool) {
+	id := r.PathValue("id")
+	if trimmed, ok := strings.CutSuffix(id, previewSuffix); ok {
+		return trimmed, true
+	}
+	if preview, err := strconv.ParseBool(r.URL.Query().Get("preview")); err == nil && preview {
+		return id, true
+	}
+	return id, acceptsJSON(r)
+}
+
+// acceptsJSON reports whether the Accept header lists application/json.
+// Browsers never do, so their requests keep being redirected.
+func acceptsJSON(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, _, err := mime.ParseMediaType(mediaRange)
+			if err == nil && mediaType == "application/json" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// previewURL handles the preview of a short URL, requested as /{id}+, with ?preview=1
+// or with Accept: application/json. Instead of redirecting it describes the link:
+// its target, state, creation date, click count and the safety verdict of every
+// target it may redirect to. No click is counted. The target of a password-protected
+// link is not disclosed.
+//
+// The preview is JSON if the client accepts application/json and an HTML page otherwise.
+//
+// Response status codes:
+//   - 200 OK: The preview
+//   - 404 Not Found: URL does not exist
+//   - 410 Gone: URL has been deleted
+//   - 500 Internal Server Error: Server-side error
+func (s *snipEndpoint) previewURL(w http.ResponseWriter, r *http.Request, id string) {
+	info, err := s.service.GetURLInfo(r.Context(), id)
+	switch {
+	case err == nil:
+	case errors.Is(err, urlsnipper.ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	case errors.Is(err, urlsnipper.ErrDeleted):
+		http.Error(w, http.StatusText(http.StatusGone), http.StatusGone)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	preview, err := urlInfoJSONFromServiceModel(s.baseURL, info)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if acceptsJSON(r) {
+		resp, err := json.Marshal(preview)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(resp)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	previewPage.Execute(w, preview)
+}
@@ -0,0 +1,102 @@
+package snipendpoint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnipEndpoint_previewURL(t *testing.T) {
+	info := &urlsnipper.URLInfo{
+		ShortURL:  "abc123",
+		Target:    "https://example.com/<b>",
+		Status:    urlsnipper.LinkStatusActive,
+		CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		Clicks:    7,
+		Safety:    &urlsnipper.Safety{Verdict: urlsnipper.SafetyWarning, Reasons: []string{"target is not encrypted"}},
+	}
+
+	tests := []struct {
+		name            string
+		id              string
+		query           string
+		accept          string
+		infoErr         error
+		wantCode        int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "plus suffix",
+			id:              "abc123+",
+			accept:          "text/html,application/xhtml+xml,*/*;q=0.8",
+			wantCode:        http.StatusOK,
+			wantContentType: "text/html; charset=utf-8",
+			wantBody:        "https://example.com/&lt;b&gt;",
+		},
+		{
+			name:            "preview query",
+			id:              "abc123",
+			query:           "?preview=1",
+			wantCode:        http.StatusOK,
+			wantContentType: "text/html; charset=utf-8",
+			wantBody:        "target is not encrypted",
+		},
+		{
+			name:            "json",
+			id:              "abc123",
+			accept:          "application/json",
+			wantCode:        http.StatusOK,
+			wantContentType: "application/json",
+			wantBody: `{"short_url":"http://localhost:8080/abc123","target":"https://example.com/<b>","multiple_targets":false,` +
+				`"protected":false,"status":"active","created_at":"2024-05-01T12:00:00Z","clicks":7,` +
+				`"safety":{"verdict":"warning","reasons":["target is not encrypted"]}}`,
+		},
+		{name: "not found", id: "abc123+", infoErr: urlsnipper.ErrNotFound, wantCode: http.StatusNotFound},
+		{name: "deleted", id: "abc123+", infoErr: urlsnipper.ErrDeleted, wantCode: http.StatusGone},
+		{name: "storage error", id: "abc123+", infoErr: urlsnipper.ErrFailedToGetURL, wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &serviceMock{
+				GetURLInfoFunc: func(ctx context.Context, id string) (*urlsnipper.URLInfo, error) {
+					require.Equal(t, "abc123", id)
+					if tt.infoErr != nil {
+						return nil, tt.infoErr
+					}
+					return info, nil
+				},
+			}
+			endpoint := &snipEndpoint{service: mockService, prefix: "/", baseURL: "http://localhost:8080"}
+
+			req := httptest.NewRequest(http.MethodGet, "/"+tt.id+tt.query, nil)
+			req.SetPathValue("id", tt.id)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+
+			endpoint.getURL(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+			require.Equal(t, tt.wantCode, res.StatusCode)
+			require.Empty(t, mockService.RedirectCalls(), "a preview must not redirect")
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			require.Equal(t, tt.wantContentType, res.Header.Get("Content-Type"))
+			if tt.wantContentType == "application/json" {
+				require.JSONEq(t, tt.wantBody, w.Body.String())
+				return
+			}
+			require.Contains(t, w.Body.String(), tt.wantBody)
+		})
+	}
+}
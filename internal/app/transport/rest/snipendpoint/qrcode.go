@@ -0,0 +1,92 @@
+package snipendpoint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/pkg/qrcode"
+)
+
+// qrCodeMaxAge is how long clients may reuse a QR code before revalidating it with its ETag.
+const qrCodeMaxAge = "public, max-age=86400"
+
+// getQRCode handles GET /{id}/qr, a QR code image of the full short URL.
+// Query parameters:
+// - format: "png" (default) or "svg"
+// - size: width and height of the image in pixels, 256 by default
+// - level: error-correction level L, M (default), Q or H
+// - margin: width of the quiet zone in modules, 4 by default
+// The image is rendered for the link regardless of its schedule and click limit.
+// Responses carry an ETag, and a matching If-None-Match is answered with 304.
+// Response status codes:
+// - 200 OK with the image
+// - 304 Not Modified if the client already has the image
+// - 400 Bad Request if an option is invalid
+// - 404 Not Found if the link does not exist
+// - 410 Gone if the link was deleted
+// - 500 Internal Server Error on other errors
+func (s *snipEndpoint) getQRCode(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	opts, err := qrCodeQueryToOptions(r.URL.Query())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	err = s.service.CheckURL(r.Context(), id)
+	switch {
+	case err == nil:
+	case errors.Is(err, urlsnipper.ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	case errors.Is(err, urlsnipper.ErrDeleted):
+		http.Error(w, http.StatusText(http.StatusGone), http.StatusGone)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	shortURL, err := url.JoinPath(s.baseURL, id)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	img, err := s.qrRenderer.Render(shortURL, opts)
+	switch {
+	case err == nil:
+	case errors.Is(err, qrcode.ErrInvalidOptions):
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", img.ETag)
+	w.Header().Set("Cache-Control", qrCodeMaxAge)
+	if etagMatches(r.Header.Get("If-None-Match"), img.ETag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", img.ContentType)
+	w.Write(img.Data)
+}
+
+// etagMatches reports whether an If-None-Match header lists the entity tag.
+// Weak tags match their strong counterparts, as required for If-None-Match.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
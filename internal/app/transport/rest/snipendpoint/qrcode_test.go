@@ -0,0 +1,70 @@
+package snipendpoint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/DanilNaum/SnipURL/pkg/qrcode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnipEndpoint_getQRCode(t *testing.T) {
+	renderer := qrcode.NewRenderer(8)
+	png, err := renderer.Render("http://localhost:8080/abc123", qrcode.Options{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		query           string
+		ifNoneMatch     string
+		checkErr        error
+		wantCode        int
+		wantContentType string
+		wantETag        string
+	}{
+		{name: "png by default", wantCode: http.StatusOK, wantContentType: "image/png", wantETag: png.ETag},
+		{name: "svg", query: "?format=svg&size=128&level=h&margin=0", wantCode: http.StatusOK, wantContentType: "image/svg+xml"},
+		{name: "not modified", ifNoneMatch: `"other", W/` + png.ETag, wantCode: http.StatusNotModified, wantETag: png.ETag},
+		{name: "malformed size", query: "?size=big", wantCode: http.StatusBadRequest},
+		{name: "invalid level", query: "?level=X", wantCode: http.StatusBadRequest},
+		{name: "size too large", query: "?size=100000", wantCode: http.StatusBadRequest},
+		{name: "not found", checkErr: urlsnipper.ErrNotFound, wantCode: http.StatusNotFound},
+		{name: "deleted", checkErr: urlsnipper.ErrDeleted, wantCode: http.StatusGone},
+		{name: "storage error", checkErr: urlsnipper.ErrFailedToGetURL, wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &serviceMock{
+				CheckURLFunc: func(ctx context.Context, id string) error { return tt.checkErr },
+			}
+			endpoint := &snipEndpoint{service: mockService, qrRenderer: renderer, prefix: "/", baseURL: "http://localhost:8080"}
+
+			req := httptest.NewRequest(http.MethodGet, "/abc123/qr"+tt.query, nil)
+			req.SetPathValue("id", "abc123")
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			w := httptest.NewRecorder()
+
+			endpoint.getQRCode(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+			require.Equal(t, tt.wantCode, res.StatusCode)
+			if tt.wantContentType != "" {
+				require.Equal(t, tt.wantContentType, res.Header.Get("Content-Type"))
+				require.NotEmpty(t, w.Body.Bytes())
+			}
+			if tt.wantETag != "" {
+				require.Equal(t, tt.wantETag, res.Header.Get("ETag"))
+			}
+			if tt.wantCode == http.StatusNotModified {
+				require.Empty(t, w.Body.Bytes())
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package snipendpoint
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+)
+
+// getRules handles HTTP GET requests that list the routing rules of a short URL
+// owned by the current user, in evaluation order.
+//
+// Response status codes:
+//   - 200 OK: JSON array of rules, empty if the URL has none
+//   - 404 Not Found: URL does not exist or belongs to another user
+//   - 500 Internal Server Error: Server-side error
+func (s *snipEndpoint) getRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := s.service.GetRules(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeRuleError(w, err)
+		return
+	}
+
+	items := make([]*ruleJSON, 0, len(rules))
+	for _, rule := range rules {
+		items = append(items, ruleJSONFromServiceModel(rule))
+	}
+
+	writeRuleResponse(w, http.StatusOK, items)
+}
+
+// addRule handles HTTP POST requests that add a routing rule to a short URL
+// owned by the current user.
+//
+// Response status codes:
+//   - 201 Created: Rule stored, the body contains it with its ID
+//   - 400 Bad Request: Invalid JSON or rule
+//   - 404 Not Found: URL does not exist or belongs to another user
+//   - 500 Internal Server Error: Server-side error
+func (s *snipEndpoint) addRule(w http.ResponseWriter, r *http.Request) {
+	req, ok := readRuleRequest(w, r)
+	if !ok {
+		return
+	}
+
+	rule, err := s.service.AddRule(r.Context(), r.PathValue("id"), ruleJSONToServiceModel(req))
+	if err != nil {
+		writeRuleError(w, err)
+		return
+	}
+
+	writeRuleResponse(w, http.StatusCreated, ruleJSONFromServiceModel(rule))
+}
+
+// updateRule handles HTTP PUT requests that replace a routing rule of a short URL
+// owned by the current user.
+//
+// Response status codes:
+//   - 200 OK: Rule updated, the body contains it
+//   - 400 Bad Request: Invalid rule ID, JSON or rule
+//   - 404 Not Found: URL or rule does not exist, or the URL belongs to another user
+//   - 500 Internal Server Error: Server-side error
+func (s *snipEndpoint) updateRule(w http.ResponseWriter, r *http.Request) {
+	ruleID, err := strconv.Atoi(r.PathValue("ruleID"))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	req, ok := readRuleRequest(w, r)
+	if !ok {
+		return
+	}
+	req.ID = ruleID
+
+	rule, err := s.service.UpdateRule(r.Context(), r.PathValue("id"), ruleJSONToServiceModel(req))
+	if err != nil {
+		writeRuleError(w, err)
+		return
+	}
+
+	writeRuleResponse(w, http.StatusOK, ruleJSONFromServiceModel(rule))
+}
+
+// deleteRule handles HTTP DELETE requests that remove a routing rule of a short URL
+// owned by the current user.
+//
+// Response status codes:
+//   - 204 No Content: Rule deleted
+//   - 400 Bad Request: Invalid rule ID
+//   - 404 Not Found: URL or rule does not exist, or the URL belongs to another user
+//   - 500 Internal Server Error: Server-side error
+func (s *snipEndpoint) deleteRule(w http.ResponseWriter, r *http.Request) {
+	ruleID, err := strconv.Atoi(r.PathValue("ruleID"))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	err = s.service.DeleteRule(r.Context(), r.PathValue("id"), ruleID)
+	if err != nil {
+		writeRuleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func readRuleRequest(w http.ResponseWriter, r *http.Request) (*ruleJSON, bool) {
+	var req ruleJSON
+	var buf bytes.Buffer
+
+	_, err := buf.ReadFrom(r.Body)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return nil, false
+	}
+
+	if err = json.Unmarshal(buf.Bytes(), &req); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return nil, false
+	}
+	return &req, true
+}
+
+func writeRuleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, urlsnipper.ErrInvalidRule):
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+	case errors.Is(err, urlsnipper.ErrNotFound), errors.Is(err, urlsnipper.ErrRuleNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
+
+func writeRuleResponse(w http.ResponseWriter, code int, v any) {
+	resp, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(resp)
+}
@@ -19,21 +19,63 @@ var _ service = &serviceMock{}
 //
 //		// make and configure a mocked service
 //		mockedservice := &serviceMock{
+//			AddRuleFunc: func(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error) {
+//				panic("mock out the AddRule method")
+//			},
+//			CheckPasswordFunc: func(ctx context.Context, id string, password string, client string) (string, error) {
+//				panic("mock out the CheckPassword method")
+//			},
+//			CheckURLFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the CheckURL method")
+//			},
+//			DeleteRuleFunc: func(ctx context.Context, id string, ruleID int) error {
+//				panic("mock out the DeleteRule method")
+//			},
 //			DeleteURLsFunc: func(ctx context.Context, ids []string)  {
 //				panic("mock out the DeleteURLs method")
 //			},
-//			GetURLFunc: func(ctx context.Context, id string) (string, error) {
-//				panic("mock out the GetURL method")
+//			ExportURLsFunc: func(ctx context.Context) (urlsnipper.URLIterator, error) {
+//				panic("mock out the ExportURLs method")
+//			},
+//			ExportUserDataFunc: func(ctx context.Context) (*urlsnipper.UserData, error) {
+//				panic("mock out the ExportUserData method")
+//			},
+//			GetClickStatsFunc: func(ctx context.Context, id string) (*urlsnipper.ClickStats, error) {
+//				panic("mock out the GetClickStats method")
 //			},
-//			GetURLsFunc: func(ctx context.Context) ([]*urlsnipper.URL, error) {
+//			GetRulesFunc: func(ctx context.Context, id string) ([]*urlsnipper.Rule, error) {
+//				panic("mock out the GetRules method")
+//			},
+//			GetURLInfoFunc: func(ctx context.Context, id string) (*urlsnipper.URLInfo, error) {
+//				panic("mock out the GetURLInfo method")
+//			},
+//			GetURLsFunc: func(ctx context.Context, filter *urlsnipper.URLFilter) ([]*urlsnipper.URL, error) {
 //				panic("mock out the GetURLs method")
 //			},
-//			SetURLFunc: func(ctx context.Context, url string) (string, error) {
+//			GetVariantStatsFunc: func(ctx context.Context, id string) ([]*urlsnipper.VariantStats, error) {
+//				panic("mock out the GetVariantStats method")
+//			},
+//			ListURLsFunc: func(ctx context.Context, input *urlsnipper.ListURLsInput) (*urlsnipper.URLPage, error) {
+//				panic("mock out the ListURLs method")
+//			},
+//			RedirectFunc: func(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error) {
+//				panic("mock out the Redirect method")
+//			},
+//			SetURLFunc: func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 //				panic("mock out the SetURL method")
 //			},
 //			SetURLsFunc: func(ctx context.Context, urls []*urlsnipper.SetURLsInput) (map[string]*urlsnipper.SetURLsOutput, error) {
 //				panic("mock out the SetURLs method")
 //			},
+//			UpdateRuleFunc: func(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error) {
+//				panic("mock out the UpdateRule method")
+//			},
+//			UpdateURLFunc: func(ctx context.Context, id string, opts ...urlsnipper.Option) (*urlsnipper.URL, error) {
+//				panic("mock out the UpdateURL method")
+//			},
+//			WatchClicksFunc: func(ctx context.Context, id string) (urlsnipper.ClickStream, error) {
+//				panic("mock out the WatchClicks method")
+//			},
 //		}
 //
 //		// use mockedservice in code that requires service
@@ -41,23 +83,101 @@ var _ service = &serviceMock{}
 //
 //	}
 type serviceMock struct {
+	// AddRuleFunc mocks the AddRule method.
+	AddRuleFunc func(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error)
+
+	// CheckPasswordFunc mocks the CheckPassword method.
+	CheckPasswordFunc func(ctx context.Context, id string, password string, client string) (string, error)
+
+	// CheckURLFunc mocks the CheckURL method.
+	CheckURLFunc func(ctx context.Context, id string) error
+
+	// DeleteRuleFunc mocks the DeleteRule method.
+	DeleteRuleFunc func(ctx context.Context, id string, ruleID int) error
+
 	// DeleteURLsFunc mocks the DeleteURLs method.
 	DeleteURLsFunc func(ctx context.Context, ids []string)
 
-	// GetURLFunc mocks the GetURL method.
-	GetURLFunc func(ctx context.Context, id string) (string, error)
+	// ExportURLsFunc mocks the ExportURLs method.
+	ExportURLsFunc func(ctx context.Context) (urlsnipper.URLIterator, error)
+
+	// ExportUserDataFunc mocks the ExportUserData method.
+	ExportUserDataFunc func(ctx context.Context) (*urlsnipper.UserData, error)
+
+	// GetClickStatsFunc mocks the GetClickStats method.
+	GetClickStatsFunc func(ctx context.Context, id string) (*urlsnipper.ClickStats, error)
+
+	// GetRulesFunc mocks the GetRules method.
+	GetRulesFunc func(ctx context.Context, id string) ([]*urlsnipper.Rule, error)
+
+	// GetURLInfoFunc mocks the GetURLInfo method.
+	GetURLInfoFunc func(ctx context.Context, id string) (*urlsnipper.URLInfo, error)
 
 	// GetURLsFunc mocks the GetURLs method.
-	GetURLsFunc func(ctx context.Context) ([]*urlsnipper.URL, error)
+	GetURLsFunc func(ctx context.Context, filter *urlsnipper.URLFilter) ([]*urlsnipper.URL, error)
+
+	// GetVariantStatsFunc mocks the GetVariantStats method.
+	GetVariantStatsFunc func(ctx context.Context, id string) ([]*urlsnipper.VariantStats, error)
+
+	// ListURLsFunc mocks the ListURLs method.
+	ListURLsFunc func(ctx context.Context, input *urlsnipper.ListURLsInput) (*urlsnipper.URLPage, error)
+
+	// RedirectFunc mocks the Redirect method.
+	RedirectFunc func(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error)
 
 	// SetURLFunc mocks the SetURL method.
-	SetURLFunc func(ctx context.Context, url string) (string, error)
+	SetURLFunc func(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error)
 
 	// SetURLsFunc mocks the SetURLs method.
 	SetURLsFunc func(ctx context.Context, urls []*urlsnipper.SetURLsInput) (map[string]*urlsnipper.SetURLsOutput, error)
 
+	// UpdateRuleFunc mocks the UpdateRule method.
+	UpdateRuleFunc func(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error)
+
+	// UpdateURLFunc mocks the UpdateURL method.
+	UpdateURLFunc func(ctx context.Context, id string, opts ...urlsnipper.Option) (*urlsnipper.URL, error)
+
+	// WatchClicksFunc mocks the WatchClicks method.
+	WatchClicksFunc func(ctx context.Context, id string) (urlsnipper.ClickStream, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddRule holds details about calls to the AddRule method.
+		AddRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Rule is the rule argument value.
+			Rule *urlsnipper.Rule
+		}
+		// CheckPassword holds details about calls to the CheckPassword method.
+		CheckPassword []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Password is the password argument value.
+			Password string
+			// Client is the client argument value.
+			Client string
+		}
+		// CheckURL holds details about calls to the CheckURL method.
+		CheckURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// DeleteRule holds details about calls to the DeleteRule method.
+		DeleteRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// RuleID is the ruleID argument value.
+			RuleID int
+		}
 		// DeleteURLs holds details about calls to the DeleteURLs method.
 		DeleteURLs []struct {
 			// Ctx is the ctx argument value.
@@ -65,8 +185,32 @@ type serviceMock struct {
 			// Ids is the ids argument value.
 			Ids []string
 		}
-		// GetURL holds details about calls to the GetURL method.
-		GetURL []struct {
+		// ExportURLs holds details about calls to the ExportURLs method.
+		ExportURLs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ExportUserData holds details about calls to the ExportUserData method.
+		ExportUserData []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetClickStats holds details about calls to the GetClickStats method.
+		GetClickStats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetRules holds details about calls to the GetRules method.
+		GetRules []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetURLInfo holds details about calls to the GetURLInfo method.
+		GetURLInfo []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// ID is the id argument value.
@@ -76,6 +220,29 @@ type serviceMock struct {
 		GetURLs []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter *urlsnipper.URLFilter
+		}
+		// GetVariantStats holds details about calls to the GetVariantStats method.
+		GetVariantStats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// ListURLs holds details about calls to the ListURLs method.
+		ListURLs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input *urlsnipper.ListURLsInput
+		}
+		// Redirect holds details about calls to the Redirect method.
+		Redirect []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *urlsnipper.RedirectRequest
 		}
 		// SetURL holds details about calls to the SetURL method.
 		SetURL []struct {
@@ -83,6 +250,8 @@ type serviceMock struct {
 			Ctx context.Context
 			// URL is the url argument value.
 			URL string
+			// Opts is the opts argument value.
+			Opts []urlsnipper.Option
 		}
 		// SetURLs holds details about calls to the SetURLs method.
 		SetURLs []struct {
@@ -91,12 +260,211 @@ type serviceMock struct {
 			// Urls is the urls argument value.
 			Urls []*urlsnipper.SetURLsInput
 		}
+		// UpdateRule holds details about calls to the UpdateRule method.
+		UpdateRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Rule is the rule argument value.
+			Rule *urlsnipper.Rule
+		}
+		// UpdateURL holds details about calls to the UpdateURL method.
+		UpdateURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Opts is the opts argument value.
+			Opts []urlsnipper.Option
+		}
+		// WatchClicks holds details about calls to the WatchClicks method.
+		WatchClicks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
 	}
-	lockDeleteURLs sync.RWMutex
-	lockGetURL     sync.RWMutex
-	lockGetURLs    sync.RWMutex
-	lockSetURL     sync.RWMutex
-	lockSetURLs    sync.RWMutex
+	lockAddRule         sync.RWMutex
+	lockCheckPassword   sync.RWMutex
+	lockCheckURL        sync.RWMutex
+	lockDeleteRule      sync.RWMutex
+	lockDeleteURLs      sync.RWMutex
+	lockExportURLs      sync.RWMutex
+	lockExportUserData  sync.RWMutex
+	lockGetClickStats   sync.RWMutex
+	lockGetRules        sync.RWMutex
+	lockGetURLInfo      sync.RWMutex
+	lockGetURLs         sync.RWMutex
+	lockGetVariantStats sync.RWMutex
+	lockListURLs        sync.RWMutex
+	lockRedirect        sync.RWMutex
+	lockSetURL          sync.RWMutex
+	lockSetURLs         sync.RWMutex
+	lockUpdateRule      sync.RWMutex
+	lockUpdateURL       sync.RWMutex
+	lockWatchClicks     sync.RWMutex
+}
+
+// AddRule calls AddRuleFunc.
+func (mock *serviceMock) AddRule(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error) {
+	if mock.AddRuleFunc == nil {
+		panic("serviceMock.AddRuleFunc: method is nil but service.AddRule was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   string
+		Rule *urlsnipper.Rule
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Rule: rule,
+	}
+	mock.lockAddRule.Lock()
+	mock.calls.AddRule = append(mock.calls.AddRule, callInfo)
+	mock.lockAddRule.Unlock()
+	return mock.AddRuleFunc(ctx, id, rule)
+}
+
+// AddRuleCalls gets all the calls that were made to AddRule.
+// Check the length with:
+//
+//	len(mockedservice.AddRuleCalls())
+func (mock *serviceMock) AddRuleCalls() []struct {
+	Ctx  context.Context
+	ID   string
+	Rule *urlsnipper.Rule
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   string
+		Rule *urlsnipper.Rule
+	}
+	mock.lockAddRule.RLock()
+	calls = mock.calls.AddRule
+	mock.lockAddRule.RUnlock()
+	return calls
+}
+
+// CheckPassword calls CheckPasswordFunc.
+func (mock *serviceMock) CheckPassword(ctx context.Context, id string, password string, client string) (string, error) {
+	if mock.CheckPasswordFunc == nil {
+		panic("serviceMock.CheckPasswordFunc: method is nil but service.CheckPassword was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ID       string
+		Password string
+		Client   string
+	}{
+		Ctx:      ctx,
+		ID:       id,
+		Password: password,
+		Client:   client,
+	}
+	mock.lockCheckPassword.Lock()
+	mock.calls.CheckPassword = append(mock.calls.CheckPassword, callInfo)
+	mock.lockCheckPassword.Unlock()
+	return mock.CheckPasswordFunc(ctx, id, password, client)
+}
+
+// CheckPasswordCalls gets all the calls that were made to CheckPassword.
+// Check the length with:
+//
+//	len(mockedservice.CheckPasswordCalls())
+func (mock *serviceMock) CheckPasswordCalls() []struct {
+	Ctx      context.Context
+	ID       string
+	Password string
+	Client   string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ID       string
+		Password string
+		Client   string
+	}
+	mock.lockCheckPassword.RLock()
+	calls = mock.calls.CheckPassword
+	mock.lockCheckPassword.RUnlock()
+	return calls
+}
+
+// CheckURL calls CheckURLFunc.
+func (mock *serviceMock) CheckURL(ctx context.Context, id string) error {
+	if mock.CheckURLFunc == nil {
+		panic("serviceMock.CheckURLFunc: method is nil but service.CheckURL was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockCheckURL.Lock()
+	mock.calls.CheckURL = append(mock.calls.CheckURL, callInfo)
+	mock.lockCheckURL.Unlock()
+	return mock.CheckURLFunc(ctx, id)
+}
+
+// CheckURLCalls gets all the calls that were made to CheckURL.
+// Check the length with:
+//
+//	len(mockedservice.CheckURLCalls())
+func (mock *serviceMock) CheckURLCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockCheckURL.RLock()
+	calls = mock.calls.CheckURL
+	mock.lockCheckURL.RUnlock()
+	return calls
+}
+
+// DeleteRule calls DeleteRuleFunc.
+func (mock *serviceMock) DeleteRule(ctx context.Context, id string, ruleID int) error {
+	if mock.DeleteRuleFunc == nil {
+		panic("serviceMock.DeleteRuleFunc: method is nil but service.DeleteRule was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     string
+		RuleID int
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		RuleID: ruleID,
+	}
+	mock.lockDeleteRule.Lock()
+	mock.calls.DeleteRule = append(mock.calls.DeleteRule, callInfo)
+	mock.lockDeleteRule.Unlock()
+	return mock.DeleteRuleFunc(ctx, id, ruleID)
+}
+
+// DeleteRuleCalls gets all the calls that were made to DeleteRule.
+// Check the length with:
+//
+//	len(mockedservice.DeleteRuleCalls())
+func (mock *serviceMock) DeleteRuleCalls() []struct {
+	Ctx    context.Context
+	ID     string
+	RuleID int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     string
+		RuleID int
+	}
+	mock.lockDeleteRule.RLock()
+	calls = mock.calls.DeleteRule
+	mock.lockDeleteRule.RUnlock()
+	return calls
 }
 
 // DeleteURLs calls DeleteURLsFunc.
@@ -135,10 +503,110 @@ func (mock *serviceMock) DeleteURLsCalls() []struct {
 	return calls
 }
 
-// GetURL calls GetURLFunc.
-func (mock *serviceMock) GetURL(ctx context.Context, id string) (string, error) {
-	if mock.GetURLFunc == nil {
-		panic("serviceMock.GetURLFunc: method is nil but service.GetURL was just called")
+// ExportURLs calls ExportURLsFunc.
+func (mock *serviceMock) ExportURLs(ctx context.Context) (urlsnipper.URLIterator, error) {
+	if mock.ExportURLsFunc == nil {
+		panic("serviceMock.ExportURLsFunc: method is nil but service.ExportURLs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockExportURLs.Lock()
+	mock.calls.ExportURLs = append(mock.calls.ExportURLs, callInfo)
+	mock.lockExportURLs.Unlock()
+	return mock.ExportURLsFunc(ctx)
+}
+
+// ExportURLsCalls gets all the calls that were made to ExportURLs.
+// Check the length with:
+//
+//	len(mockedservice.ExportURLsCalls())
+func (mock *serviceMock) ExportURLsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockExportURLs.RLock()
+	calls = mock.calls.ExportURLs
+	mock.lockExportURLs.RUnlock()
+	return calls
+}
+
+// ExportUserData calls ExportUserDataFunc.
+func (mock *serviceMock) ExportUserData(ctx context.Context) (*urlsnipper.UserData, error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("serviceMock.ExportUserDataFunc: method is nil but service.ExportUserData was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockExportUserData.Lock()
+	mock.calls.ExportUserData = append(mock.calls.ExportUserData, callInfo)
+	mock.lockExportUserData.Unlock()
+	return mock.ExportUserDataFunc(ctx)
+}
+
+// ExportUserDataCalls gets all the calls that were made to ExportUserData.
+// Check the length with:
+//
+//	len(mockedservice.ExportUserDataCalls())
+func (mock *serviceMock) ExportUserDataCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockExportUserData.RLock()
+	calls = mock.calls.ExportUserData
+	mock.lockExportUserData.RUnlock()
+	return calls
+}
+
+// GetClickStats calls GetClickStatsFunc.
+func (mock *serviceMock) GetClickStats(ctx context.Context, id string) (*urlsnipper.ClickStats, error) {
+	if mock.GetClickStatsFunc == nil {
+		panic("serviceMock.GetClickStatsFunc: method is nil but service.GetClickStats was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetClickStats.Lock()
+	mock.calls.GetClickStats = append(mock.calls.GetClickStats, callInfo)
+	mock.lockGetClickStats.Unlock()
+	return mock.GetClickStatsFunc(ctx, id)
+}
+
+// GetClickStatsCalls gets all the calls that were made to GetClickStats.
+// Check the length with:
+//
+//	len(mockedservice.GetClickStatsCalls())
+func (mock *serviceMock) GetClickStatsCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetClickStats.RLock()
+	calls = mock.calls.GetClickStats
+	mock.lockGetClickStats.RUnlock()
+	return calls
+}
+
+// GetRules calls GetRulesFunc.
+func (mock *serviceMock) GetRules(ctx context.Context, id string) ([]*urlsnipper.Rule, error) {
+	if mock.GetRulesFunc == nil {
+		panic("serviceMock.GetRulesFunc: method is nil but service.GetRules was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
@@ -147,17 +615,17 @@ func (mock *serviceMock) GetURL(ctx context.Context, id string) (string, error)
 		Ctx: ctx,
 		ID:  id,
 	}
-	mock.lockGetURL.Lock()
-	mock.calls.GetURL = append(mock.calls.GetURL, callInfo)
-	mock.lockGetURL.Unlock()
-	return mock.GetURLFunc(ctx, id)
+	mock.lockGetRules.Lock()
+	mock.calls.GetRules = append(mock.calls.GetRules, callInfo)
+	mock.lockGetRules.Unlock()
+	return mock.GetRulesFunc(ctx, id)
 }
 
-// GetURLCalls gets all the calls that were made to GetURL.
+// GetRulesCalls gets all the calls that were made to GetRules.
 // Check the length with:
 //
-//	len(mockedservice.GetURLCalls())
-func (mock *serviceMock) GetURLCalls() []struct {
+//	len(mockedservice.GetRulesCalls())
+func (mock *serviceMock) GetRulesCalls() []struct {
 	Ctx context.Context
 	ID  string
 } {
@@ -165,26 +633,64 @@ func (mock *serviceMock) GetURLCalls() []struct {
 		Ctx context.Context
 		ID  string
 	}
-	mock.lockGetURL.RLock()
-	calls = mock.calls.GetURL
-	mock.lockGetURL.RUnlock()
+	mock.lockGetRules.RLock()
+	calls = mock.calls.GetRules
+	mock.lockGetRules.RUnlock()
+	return calls
+}
+
+// GetURLInfo calls GetURLInfoFunc.
+func (mock *serviceMock) GetURLInfo(ctx context.Context, id string) (*urlsnipper.URLInfo, error) {
+	if mock.GetURLInfoFunc == nil {
+		panic("serviceMock.GetURLInfoFunc: method is nil but service.GetURLInfo was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetURLInfo.Lock()
+	mock.calls.GetURLInfo = append(mock.calls.GetURLInfo, callInfo)
+	mock.lockGetURLInfo.Unlock()
+	return mock.GetURLInfoFunc(ctx, id)
+}
+
+// GetURLInfoCalls gets all the calls that were made to GetURLInfo.
+// Check the length with:
+//
+//	len(mockedservice.GetURLInfoCalls())
+func (mock *serviceMock) GetURLInfoCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetURLInfo.RLock()
+	calls = mock.calls.GetURLInfo
+	mock.lockGetURLInfo.RUnlock()
 	return calls
 }
 
 // GetURLs calls GetURLsFunc.
-func (mock *serviceMock) GetURLs(ctx context.Context) ([]*urlsnipper.URL, error) {
+func (mock *serviceMock) GetURLs(ctx context.Context, filter *urlsnipper.URLFilter) ([]*urlsnipper.URL, error) {
 	if mock.GetURLsFunc == nil {
 		panic("serviceMock.GetURLsFunc: method is nil but service.GetURLs was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
+		Ctx    context.Context
+		Filter *urlsnipper.URLFilter
 	}{
-		Ctx: ctx,
+		Ctx:    ctx,
+		Filter: filter,
 	}
 	mock.lockGetURLs.Lock()
 	mock.calls.GetURLs = append(mock.calls.GetURLs, callInfo)
 	mock.lockGetURLs.Unlock()
-	return mock.GetURLsFunc(ctx)
+	return mock.GetURLsFunc(ctx, filter)
 }
 
 // GetURLsCalls gets all the calls that were made to GetURLs.
@@ -192,10 +698,12 @@ func (mock *serviceMock) GetURLs(ctx context.Context) ([]*urlsnipper.URL, error)
 //
 //	len(mockedservice.GetURLsCalls())
 func (mock *serviceMock) GetURLsCalls() []struct {
-	Ctx context.Context
+	Ctx    context.Context
+	Filter *urlsnipper.URLFilter
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx    context.Context
+		Filter *urlsnipper.URLFilter
 	}
 	mock.lockGetURLs.RLock()
 	calls = mock.calls.GetURLs
@@ -203,22 +711,132 @@ func (mock *serviceMock) GetURLsCalls() []struct {
 	return calls
 }
 
+// GetVariantStats calls GetVariantStatsFunc.
+func (mock *serviceMock) GetVariantStats(ctx context.Context, id string) ([]*urlsnipper.VariantStats, error) {
+	if mock.GetVariantStatsFunc == nil {
+		panic("serviceMock.GetVariantStatsFunc: method is nil but service.GetVariantStats was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetVariantStats.Lock()
+	mock.calls.GetVariantStats = append(mock.calls.GetVariantStats, callInfo)
+	mock.lockGetVariantStats.Unlock()
+	return mock.GetVariantStatsFunc(ctx, id)
+}
+
+// GetVariantStatsCalls gets all the calls that were made to GetVariantStats.
+// Check the length with:
+//
+//	len(mockedservice.GetVariantStatsCalls())
+func (mock *serviceMock) GetVariantStatsCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetVariantStats.RLock()
+	calls = mock.calls.GetVariantStats
+	mock.lockGetVariantStats.RUnlock()
+	return calls
+}
+
+// ListURLs calls ListURLsFunc.
+func (mock *serviceMock) ListURLs(ctx context.Context, input *urlsnipper.ListURLsInput) (*urlsnipper.URLPage, error) {
+	if mock.ListURLsFunc == nil {
+		panic("serviceMock.ListURLsFunc: method is nil but service.ListURLs was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *urlsnipper.ListURLsInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListURLs.Lock()
+	mock.calls.ListURLs = append(mock.calls.ListURLs, callInfo)
+	mock.lockListURLs.Unlock()
+	return mock.ListURLsFunc(ctx, input)
+}
+
+// ListURLsCalls gets all the calls that were made to ListURLs.
+// Check the length with:
+//
+//	len(mockedservice.ListURLsCalls())
+func (mock *serviceMock) ListURLsCalls() []struct {
+	Ctx   context.Context
+	Input *urlsnipper.ListURLsInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *urlsnipper.ListURLsInput
+	}
+	mock.lockListURLs.RLock()
+	calls = mock.calls.ListURLs
+	mock.lockListURLs.RUnlock()
+	return calls
+}
+
+// Redirect calls RedirectFunc.
+func (mock *serviceMock) Redirect(ctx context.Context, req *urlsnipper.RedirectRequest) (*urlsnipper.Redirect, error) {
+	if mock.RedirectFunc == nil {
+		panic("serviceMock.RedirectFunc: method is nil but service.Redirect was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req *urlsnipper.RedirectRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockRedirect.Lock()
+	mock.calls.Redirect = append(mock.calls.Redirect, callInfo)
+	mock.lockRedirect.Unlock()
+	return mock.RedirectFunc(ctx, req)
+}
+
+// RedirectCalls gets all the calls that were made to Redirect.
+// Check the length with:
+//
+//	len(mockedservice.RedirectCalls())
+func (mock *serviceMock) RedirectCalls() []struct {
+	Ctx context.Context
+	Req *urlsnipper.RedirectRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req *urlsnipper.RedirectRequest
+	}
+	mock.lockRedirect.RLock()
+	calls = mock.calls.Redirect
+	mock.lockRedirect.RUnlock()
+	return calls
+}
+
 // SetURL calls SetURLFunc.
-func (mock *serviceMock) SetURL(ctx context.Context, url string) (string, error) {
+func (mock *serviceMock) SetURL(ctx context.Context, url string, opts ...urlsnipper.Option) (string, error) {
 	if mock.SetURLFunc == nil {
 		panic("serviceMock.SetURLFunc: method is nil but service.SetURL was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		URL string
+		Ctx  context.Context
+		URL  string
+		Opts []urlsnipper.Option
 	}{
-		Ctx: ctx,
-		URL: url,
+		Ctx:  ctx,
+		URL:  url,
+		Opts: opts,
 	}
 	mock.lockSetURL.Lock()
 	mock.calls.SetURL = append(mock.calls.SetURL, callInfo)
 	mock.lockSetURL.Unlock()
-	return mock.SetURLFunc(ctx, url)
+	return mock.SetURLFunc(ctx, url, opts...)
 }
 
 // SetURLCalls gets all the calls that were made to SetURL.
@@ -226,12 +844,14 @@ func (mock *serviceMock) SetURL(ctx context.Context, url string) (string, error)
 //
 //	len(mockedservice.SetURLCalls())
 func (mock *serviceMock) SetURLCalls() []struct {
-	Ctx context.Context
-	URL string
+	Ctx  context.Context
+	URL  string
+	Opts []urlsnipper.Option
 } {
 	var calls []struct {
-		Ctx context.Context
-		URL string
+		Ctx  context.Context
+		URL  string
+		Opts []urlsnipper.Option
 	}
 	mock.lockSetURL.RLock()
 	calls = mock.calls.SetURL
@@ -274,3 +894,119 @@ func (mock *serviceMock) SetURLsCalls() []struct {
 	mock.lockSetURLs.RUnlock()
 	return calls
 }
+
+// UpdateRule calls UpdateRuleFunc.
+func (mock *serviceMock) UpdateRule(ctx context.Context, id string, rule *urlsnipper.Rule) (*urlsnipper.Rule, error) {
+	if mock.UpdateRuleFunc == nil {
+		panic("serviceMock.UpdateRuleFunc: method is nil but service.UpdateRule was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   string
+		Rule *urlsnipper.Rule
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Rule: rule,
+	}
+	mock.lockUpdateRule.Lock()
+	mock.calls.UpdateRule = append(mock.calls.UpdateRule, callInfo)
+	mock.lockUpdateRule.Unlock()
+	return mock.UpdateRuleFunc(ctx, id, rule)
+}
+
+// UpdateRuleCalls gets all the calls that were made to UpdateRule.
+// Check the length with:
+//
+//	len(mockedservice.UpdateRuleCalls())
+func (mock *serviceMock) UpdateRuleCalls() []struct {
+	Ctx  context.Context
+	ID   string
+	Rule *urlsnipper.Rule
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   string
+		Rule *urlsnipper.Rule
+	}
+	mock.lockUpdateRule.RLock()
+	calls = mock.calls.UpdateRule
+	mock.lockUpdateRule.RUnlock()
+	return calls
+}
+
+// UpdateURL calls UpdateURLFunc.
+func (mock *serviceMock) UpdateURL(ctx context.Context, id string, opts ...urlsnipper.Option) (*urlsnipper.URL, error) {
+	if mock.UpdateURLFunc == nil {
+		panic("serviceMock.UpdateURLFunc: method is nil but service.UpdateURL was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   string
+		Opts []urlsnipper.Option
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Opts: opts,
+	}
+	mock.lockUpdateURL.Lock()
+	mock.calls.UpdateURL = append(mock.calls.UpdateURL, callInfo)
+	mock.lockUpdateURL.Unlock()
+	return mock.UpdateURLFunc(ctx, id, opts...)
+}
+
+// UpdateURLCalls gets all the calls that were made to UpdateURL.
+// Check the length with:
+//
+//	len(mockedservice.UpdateURLCalls())
+func (mock *serviceMock) UpdateURLCalls() []struct {
+	Ctx  context.Context
+	ID   string
+	Opts []urlsnipper.Option
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   string
+		Opts []urlsnipper.Option
+	}
+	mock.lockUpdateURL.RLock()
+	calls = mock.calls.UpdateURL
+	mock.lockUpdateURL.RUnlock()
+	return calls
+}
+
+// WatchClicks calls WatchClicksFunc.
+func (mock *serviceMock) WatchClicks(ctx context.Context, id string) (urlsnipper.ClickStream, error) {
+	if mock.WatchClicksFunc == nil {
+		panic("serviceMock.WatchClicksFunc: method is nil but service.WatchClicks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockWatchClicks.Lock()
+	mock.calls.WatchClicks = append(mock.calls.WatchClicks, callInfo)
+	mock.lockWatchClicks.Unlock()
+	return mock.WatchClicksFunc(ctx, id)
+}
+
+// WatchClicksCalls gets all the calls that were made to WatchClicks.
+// Check the length with:
+//
+//	len(mockedservice.WatchClicksCalls())
+func (mock *serviceMock) WatchClicksCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockWatchClicks.RLock()
+	calls = mock.calls.WatchClicks
+	mock.lockWatchClicks.RUnlock()
+	return calls
+}
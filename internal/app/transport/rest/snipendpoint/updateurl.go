@@ -0,0 +1,69 @@
+package snipendpoint
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+)
+
+// updateURL handles HTTP PATCH requests that edit a short URL owned by the current user.
+// The JSON body lists the attributes to change; omitted attributes stay as they are.
+//
+// Response status codes:
+//   - 200 OK: URL updated, the body contains the URL after the update
+//   - 400 Bad Request: Invalid JSON request or attribute value
+//   - 404 Not Found: URL does not exist or belongs to another user
+//   - 500 Internal Server Error: Server-side error
+func (s *snipEndpoint) updateURL(w http.ResponseWriter, r *http.Request) {
+	var req updateURLJSONRequest
+	var buf bytes.Buffer
+
+	_, err := buf.ReadFrom(r.Body)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if err = json.Unmarshal(buf.Bytes(), &req); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	opts, err := updateURLJSONRequestToServiceOptions(&req)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	updated, err := s.service.UpdateURL(r.Context(), r.PathValue("id"), opts...)
+	switch {
+	case err == nil:
+	case errors.Is(err, urlsnipper.ErrInvalidOption):
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	case errors.Is(err, urlsnipper.ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	item, err := getURLsJSONResponseItemFromServiceModel(s.baseURL, updated)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := json.Marshal(item)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
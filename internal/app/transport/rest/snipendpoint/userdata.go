@@ -0,0 +1,70 @@
+package snipendpoint
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// exportUserData handles GET /api/user/data, the download of everything stored about
+// the user: their tagging templates, webhooks, audit log entries and all their links,
+// the deleted ones included, with the links' routing rules, A/B split statistics and
+// click analytics. The document is a JSON object with user_id, templates, webhooks,
+// audit_entries and links. The links are
+// streamed from storage as they are written, and a failure after the first byte
+// aborts the connection, so that a truncated document is not mistaken for a complete one.
+// Response status codes:
+// - 200 OK with the document as an attachment
+// - 500 Internal Server Error if the data cannot be read
+func (s *snipEndpoint) exportUserData(w http.ResponseWriter, r *http.Request) {
+	data, err := s.service.ExportUserData(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer data.Links.Close()
+
+	head, err := json.Marshal(userDataHeadJSONFromServiceModel(data))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="user-data.json"`)
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	// The links are added to the head object in place of its closing brace.
+	if _, err := w.Write(head[:len(head)-1]); err != nil {
+		panic(http.ErrAbortHandler)
+	}
+	if _, err := io.WriteString(w, `,"links":[`); err != nil {
+		panic(http.ErrAbortHandler)
+	}
+	for n := 1; data.Links.Next(); n++ {
+		link, err := userDataLinkJSONFromServiceModel(s.baseURL, data.Links.Link())
+		if err != nil {
+			panic(http.ErrAbortHandler)
+		}
+		item, err := json.Marshal(link)
+		if err != nil {
+			panic(http.ErrAbortHandler)
+		}
+		if n > 1 {
+			item = append([]byte{','}, item...)
+		}
+		if _, err := w.Write(item); err != nil {
+			panic(http.ErrAbortHandler)
+		}
+		if n%exportFlushEvery == 0 {
+			_ = rc.Flush()
+		}
+	}
+	if data.Links.Err() != nil {
+		panic(http.ErrAbortHandler)
+	}
+	if _, err := io.WriteString(w, "]}\n"); err != nil {
+		panic(http.ErrAbortHandler)
+	}
+}
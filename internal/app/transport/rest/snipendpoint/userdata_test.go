@@ -0,0 +1,120 @@
+package snipendpoint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/urlsnipper"
+	"github.com/stretchr/testify/require"
+)
+
+type sliceUserDataIterator struct {
+	links  []*urlsnipper.UserDataLink
+	pos    int
+	closed bool
+}
+
+func (it *sliceUserDataIterator) Next() bool {
+	if it.pos >= len(it.links) {
+		return false
+	}
+	it.pos++
+	return true
+}
+
+func (it *sliceUserDataIterator) Link() *urlsnipper.UserDataLink { return it.links[it.pos-1] }
+func (it *sliceUserDataIterator) Err() error                     { return nil }
+func (it *sliceUserDataIterator) Close()                         { it.closed = true }
+
+func TestSnipEndpoint_exportUserData(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		links      []*urlsnipper.UserDataLink
+		serviceErr error
+		wantCode   int
+		wantBody   string
+	}{
+		{
+			name: "links",
+			links: []*urlsnipper.UserDataLink{
+				{
+					URL: &urlsnipper.URL{
+						ShortURL:    "a",
+						OriginalURL: "https://example.com/a",
+						Rules:       []*urlsnipper.Rule{{ID: 1, Platform: "ios", TargetURL: "https://example.com/ios"}},
+						CreatedAt:   created,
+						Clicks:      2,
+					},
+					VariantStats: []*urlsnipper.VariantStats{},
+					ClickStats:   &urlsnipper.ClickStats{Total: 2, ByCountry: map[string]int{"DE": 2}},
+				},
+				{
+					URL:        &urlsnipper.URL{ShortURL: "b", OriginalURL: "https://example.com/b", CreatedAt: created, Deleted: true},
+					ClickStats: &urlsnipper.ClickStats{ByCountry: map[string]int{}},
+				},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"user_id":"user","templates":[{"name":"spring","utm_source":"newsletter"}],` +
+				`"webhooks":[{"id":"hook","url":"https://example.com/hook","events":["link.created"],"created_at":"2024-01-01T00:00:00Z"}],` +
+				`"audit_entries":[{"id":7,"action":"create","short_url":"a","ip":"192.0.2.1","auth_method":"cookie","created_at":"2024-01-01T00:00:00Z"}],"links":[` +
+				`{"short_url":"http://localhost:8080/a","original_url":"https://example.com/a","created_at":"2024-01-01T00:00:00Z","clicks":2,"deleted":false,` +
+				`"rules":[{"id":1,"position":0,"platform":"ios","target_url":"https://example.com/ios"}],"click_stats":{"total":2,"by_country":{"DE":2}}},` +
+				`{"short_url":"http://localhost:8080/b","original_url":"https://example.com/b","created_at":"2024-01-01T00:00:00Z","clicks":0,"deleted":true,` +
+				`"rules":[],"click_stats":{"total":0,"by_country":{}}}]}`,
+		},
+		{
+			name:     "no_links",
+			wantCode: http.StatusOK,
+			wantBody: `{"user_id":"user","templates":[{"name":"spring","utm_source":"newsletter"}],` +
+				`"webhooks":[{"id":"hook","url":"https://example.com/hook","events":["link.created"],"created_at":"2024-01-01T00:00:00Z"}],` +
+				`"audit_entries":[{"id":7,"action":"create","short_url":"a","ip":"192.0.2.1","auth_method":"cookie","created_at":"2024-01-01T00:00:00Z"}],"links":[]}`,
+		},
+		{
+			name:       "service_error",
+			serviceErr: errors.New("storage failure"),
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := &sliceUserDataIterator{links: tt.links}
+			mockService := &serviceMock{
+				ExportUserDataFunc: func(ctx context.Context) (*urlsnipper.UserData, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &urlsnipper.UserData{
+						UserID:    "user",
+						Templates: []*urlsnipper.Template{{Name: "spring", Source: "newsletter"}},
+						Webhooks:  []*urlsnipper.Webhook{{ID: "hook", URL: "https://example.com/hook", Events: []string{"link.created"}, CreatedAt: created}},
+						AuditEntries: []*urlsnipper.AuditEntry{
+							{ID: 7, Action: "create", ShortURL: "a", IP: "192.0.2.1", AuthMethod: "cookie", CreatedAt: created},
+						},
+						Links: it,
+					}, nil
+				},
+			}
+			endpoint := &snipEndpoint{service: mockService, prefix: "/", baseURL: "http://localhost:8080"}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/user/data", nil)
+			w := httptest.NewRecorder()
+			endpoint.exportUserData(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.wantBody == "" {
+				return
+			}
+			require.True(t, json.Valid(w.Body.Bytes()))
+			require.JSONEq(t, tt.wantBody, w.Body.String())
+			require.True(t, it.closed)
+		})
+	}
+}
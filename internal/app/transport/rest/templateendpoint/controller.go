@@ -0,0 +1,54 @@
+package templateendpoint
+
+import (
+	"context"
+	"path"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/tagging"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	endpointSetTemplate    = "/api/user/templates"
+	endpointGetTemplates   = "/api/user/templates"
+	endpointDeleteTemplate = "/api/user/templates/{name}"
+)
+
+type config interface {
+	GetPrefix() (string, error)
+}
+
+type service interface {
+	SetTemplate(ctx context.Context, template *tagging.Template) error
+	GetTemplates(ctx context.Context) ([]*tagging.Template, error)
+	DeleteTemplate(ctx context.Context, name string) error
+}
+
+type templateEndpoint struct {
+	service service
+	prefix  string
+}
+
+// NewTemplateEndpoint creates a new templateEndpoint instance with the provided tagging service.
+// Returns an error if prefix retrieval from the configuration fails.
+func NewTemplateEndpoint(service service, conf config) (*templateEndpoint, error) {
+	prefix, err := conf.GetPrefix()
+	if err != nil {
+		return nil, err
+	}
+	return &templateEndpoint{
+		service: service,
+		prefix:  prefix,
+	}, nil
+}
+
+// Register sets up the routing for managing the current user's tagging templates:
+// - Creating or replacing a template via POST
+// - Listing the user's templates via GET
+// - Deleting a template by name via DELETE
+// The routes are added to the mux itself, as the prefix is already mounted by the snipEndpoint.
+func (t *templateEndpoint) Register(r *chi.Mux) {
+	r.Post(path.Join(t.prefix, endpointSetTemplate), t.setTemplate)
+	r.Get(path.Join(t.prefix, endpointGetTemplates), t.getTemplates)
+	r.Delete(path.Join(t.prefix, endpointDeleteTemplate), t.deleteTemplate)
+}
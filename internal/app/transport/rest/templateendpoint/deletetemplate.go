@@ -0,0 +1,25 @@
+package templateendpoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/tagging"
+)
+
+// deleteTemplate handles HTTP DELETE requests that remove one of the current user's templates.
+// Links still referencing the template redirect without UTM parameters afterwards.
+// - 204 No Content if the template was deleted
+// - 404 Not Found if the user has no template with that name
+// - 500 Internal Server Error if any error occurs during processing
+func (t *templateEndpoint) deleteTemplate(w http.ResponseWriter, r *http.Request) {
+	err := t.service.DeleteTemplate(r.Context(), r.PathValue("name"))
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, tagging.ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,36 @@
+package templateendpoint
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// getTemplates handles HTTP GET requests that list the current user's tagging templates.
+// - 200 OK with JSON payload if the user has templates
+// - 204 No Content if the user has none
+// - 500 Internal Server Error if any error occurs during processing
+func (t *templateEndpoint) getTemplates(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	templates, err := t.service.GetTemplates(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if len(templates) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	items := make([]*templateJSON, 0, len(templates))
+	for _, template := range templates {
+		items = append(items, templateJSONFromServiceModel(template))
+	}
+
+	resp, err := json.Marshal(items)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(resp)
+}
@@ -0,0 +1,25 @@
+package templateendpoint
+
+import "github.com/DanilNaum/SnipURL/internal/app/service/tagging"
+
+func templateJSONToServiceModel(req *templateJSON) *tagging.Template {
+	return &tagging.Template{
+		Name:     req.Name,
+		Source:   req.Source,
+		Medium:   req.Medium,
+		Campaign: req.Campaign,
+		Term:     req.Term,
+		Content:  req.Content,
+	}
+}
+
+func templateJSONFromServiceModel(template *tagging.Template) *templateJSON {
+	return &templateJSON{
+		Name:     template.Name,
+		Source:   template.Source,
+		Medium:   template.Medium,
+		Campaign: template.Campaign,
+		Term:     template.Term,
+		Content:  template.Content,
+	}
+}
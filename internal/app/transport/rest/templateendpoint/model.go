@@ -0,0 +1,10 @@
+package templateendpoint
+
+type templateJSON struct {
+	Name     string `json:"name"`
+	Source   string `json:"utm_source,omitempty"`
+	Medium   string `json:"utm_medium,omitempty"`
+	Campaign string `json:"utm_campaign,omitempty"`
+	Term     string `json:"utm_term,omitempty"`
+	Content  string `json:"utm_content,omitempty"`
+}
@@ -0,0 +1,54 @@
+package templateendpoint
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/tagging"
+)
+
+// setTemplate handles HTTP POST requests that create a tagging template for the current user.
+// A template with the same name is replaced, and links using it pick up the new parameters.
+//
+// Response status codes:
+//   - 201 Created: Template stored, the body echoes it
+//   - 400 Bad Request: Invalid JSON, empty name or no UTM parameters
+//   - 500 Internal Server Error: Server-side error
+func (t *templateEndpoint) setTemplate(w http.ResponseWriter, r *http.Request) {
+	var req templateJSON
+	var buf bytes.Buffer
+
+	_, err := buf.ReadFrom(r.Body)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if err = json.Unmarshal(buf.Bytes(), &req); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	err = t.service.SetTemplate(r.Context(), templateJSONToServiceModel(&req))
+	switch {
+	case err == nil:
+	case errors.Is(err, tagging.ErrInvalidTemplate):
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := json.Marshal(req)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	w.Write(resp)
+}
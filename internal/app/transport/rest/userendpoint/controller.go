@@ -0,0 +1,63 @@
+package userendpoint
+
+import (
+	"context"
+	"path"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/audit"
+	"github.com/DanilNaum/SnipURL/internal/app/service/erasure"
+	"github.com/DanilNaum/SnipURL/internal/app/transport/rest/utils/auditlog"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	endpointEraseUser      = "/api/user"
+	endpointGetErasureJob  = "/api/user/erasures/{id}"
+	endpointGetAudit       = "/api/user/audit"
+	erasureJobLocationPath = "/api/user/erasures/"
+)
+
+type config interface {
+	GetPrefix() (string, error)
+}
+
+type service interface {
+	Erase(ctx context.Context) (*erasure.Job, error)
+	GetJob(ctx context.Context, id string) (*erasure.Job, error)
+}
+
+type auditService interface {
+	GetUserEntries(ctx context.Context, filter *audit.Filter) (*audit.Page, error)
+}
+
+type userEndpoint struct {
+	service      service
+	auditService auditService
+	prefix       string
+}
+
+// NewUserEndpoint creates a new userEndpoint instance with the provided erasure service
+// and the audit log of the users' actions.
+// Returns an error if prefix retrieval from the configuration fails.
+func NewUserEndpoint(service service, auditService auditService, conf config) (*userEndpoint, error) {
+	prefix, err := conf.GetPrefix()
+	if err != nil {
+		return nil, err
+	}
+	return &userEndpoint{
+		service:      service,
+		auditService: auditService,
+		prefix:       prefix,
+	}, nil
+}
+
+// Register sets up the routing for the current user's own account:
+// - Erasing the user and their data via DELETE
+// - Tracking an erasure by its job ID via GET
+// - Querying the user's own entries of the audit log via GET
+// The routes are added to the mux itself, as the prefix is already mounted by the snipEndpoint.
+func (u *userEndpoint) Register(r *chi.Mux) {
+	r.Delete(path.Join(u.prefix, endpointEraseUser), u.eraseUser)
+	r.Get(path.Join(u.prefix, endpointGetErasureJob), u.getErasureJob)
+	r.Get(path.Join(u.prefix, endpointGetAudit), auditlog.Handler(u.auditService.GetUserEntries))
+}
@@ -0,0 +1,38 @@
+package userendpoint
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// eraseUser handles HTTP DELETE requests that erase the current user. The user ID is
+// revoked at once, so the client gets a new one on its next request, and the user's
+// links, rules, statistics and templates are deleted in the background while their
+// clicks are anonymised. The Location header points to the job tracking the erasure.
+// - 202 Accepted with the JSON of the erasure job
+// - 500 Internal Server Error if any error occurs during processing
+func (u *userEndpoint) eraseUser(w http.ResponseWriter, r *http.Request) {
+	job, err := u.service.Erase(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := json.Marshal(erasureJobJSONFromServiceModel(job))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	location, err := url.JoinPath(u.prefix, erasureJobLocationPath, job.ID)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusAccepted)
+	w.Write(resp)
+}
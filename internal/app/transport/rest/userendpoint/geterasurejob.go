@@ -0,0 +1,35 @@
+package userendpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/erasure"
+)
+
+// getErasureJob handles HTTP GET requests that report the progress of an erasure.
+// The job ID returned by the erasure is the only credential, as the user is revoked.
+// - 200 OK with the JSON of the erasure job
+// - 404 Not Found if there is no job with that ID
+// - 500 Internal Server Error if any error occurs during processing
+func (u *userEndpoint) getErasureJob(w http.ResponseWriter, r *http.Request) {
+	job, err := u.service.GetJob(r.Context(), r.PathValue("id"))
+	if err != nil {
+		if errors.Is(err, erasure.ErrNotFound) {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := json.Marshal(erasureJobJSONFromServiceModel(job))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
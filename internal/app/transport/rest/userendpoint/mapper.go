@@ -0,0 +1,13 @@
+package userendpoint
+
+import "github.com/DanilNaum/SnipURL/internal/app/service/erasure"
+
+func erasureJobJSONFromServiceModel(job *erasure.Job) *erasureJobJSON {
+	return &erasureJobJSON{
+		ID:         job.ID,
+		Status:     job.Status,
+		Error:      job.Error,
+		CreatedAt:  job.CreatedAt,
+		FinishedAt: job.FinishedAt,
+	}
+}
@@ -0,0 +1,11 @@
+package userendpoint
+
+import "time"
+
+type erasureJobJSON struct {
+	ID         string     `json:"id"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
@@ -0,0 +1,131 @@
+// Package auditlog serves pages of the audit log over HTTP, for the admin API and for
+// the users' own entries.
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/audit"
+)
+
+// nextCursorHeader carries the cursor of the next page of the audit log.
+const nextCursorHeader = "X-Next-Cursor"
+
+// GetEntriesFunc returns the page of the audit log selected by the filter.
+type GetEntriesFunc func(ctx context.Context, filter *audit.Filter) (*audit.Page, error)
+
+type entryJSON struct {
+	ID         int64     `json:"id"`
+	UserID     string    `json:"user_id"`
+	Action     string    `json:"action"`
+	ShortURL   string    `json:"short_url"`
+	IP         string    `json:"ip,omitempty"`
+	AuthMethod string    `json:"auth_method,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Handler returns a handler of HTTP GET requests for a page of the audit log, newest
+// entries first. Query parameters:
+// - user_id, short_url, action: keep only the entries with that user, link or action
+// - from, to: RFC 3339 bounds of the time of the entries, to exclusive
+// - limit: page size, 100 by default and at most 1000
+// - cursor: the X-Next-Cursor header of the previous page
+// It responds with:
+// - 200 OK with the JSON of the entries; X-Next-Cursor is set unless it is the last page
+// - 204 No Content if no entries match
+// - 400 Bad Request if the query parameters are invalid
+// - 401 Unauthorized if the request carries no user where one is required
+// - 500 Internal Server Error if any error occurs during processing
+func Handler(getEntries GetEntriesFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := filterFromQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		page, err := getEntries(r.Context(), filter)
+		if err != nil {
+			switch {
+			case errors.Is(err, audit.ErrInvalidFilter):
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			case errors.Is(err, audit.ErrNoUser):
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			default:
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+			return
+		}
+		if len(page.Entries) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		entries := make([]*entryJSON, 0, len(page.Entries))
+		for _, entry := range page.Entries {
+			entries = append(entries, &entryJSON{
+				ID:         entry.ID,
+				UserID:     entry.UserID,
+				Action:     entry.Action,
+				ShortURL:   entry.ShortURL,
+				IP:         entry.IP,
+				AuthMethod: entry.AuthMethod,
+				CreatedAt:  entry.CreatedAt,
+			})
+		}
+		resp, err := json.Marshal(entries)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if page.Next != 0 {
+			w.Header().Set(nextCursorHeader, strconv.FormatInt(page.Next, 10))
+		}
+		w.Write(resp)
+	}
+}
+
+func filterFromQuery(query url.Values) (*audit.Filter, error) {
+	filter := &audit.Filter{
+		UserID:   query.Get("user_id"),
+		ShortURL: query.Get("short_url"),
+		Action:   query.Get("action"),
+	}
+	if from := query.Get("from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return nil, err
+		}
+		filter.From = t
+	}
+	if to := query.Get("to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return nil, err
+		}
+		filter.To = t
+	}
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, err
+		}
+		filter.Limit = n
+	}
+	if cursor := query.Get("cursor"); cursor != "" {
+		n, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		filter.Before = n
+	}
+	return filter, nil
+}
@@ -0,0 +1,58 @@
+package webhookendpoint
+
+import (
+	"context"
+	"path"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/webhook"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	endpointRegisterWebhook = "/api/user/webhooks"
+	endpointGetWebhooks     = "/api/user/webhooks"
+	endpointDeleteWebhook   = "/api/user/webhooks/{id}"
+	endpointGetDeliveries   = "/api/user/webhooks/{id}/deliveries"
+)
+
+type config interface {
+	GetPrefix() (string, error)
+}
+
+type service interface {
+	Register(ctx context.Context, webhook *webhook.Webhook) (*webhook.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]*webhook.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	GetDeliveries(ctx context.Context, id string) ([]*webhook.Delivery, error)
+}
+
+type webhookEndpoint struct {
+	service service
+	prefix  string
+}
+
+// NewWebhookEndpoint creates a new webhookEndpoint instance with the provided webhook service.
+// Returns an error if prefix retrieval from the configuration fails.
+func NewWebhookEndpoint(service service, conf config) (*webhookEndpoint, error) {
+	prefix, err := conf.GetPrefix()
+	if err != nil {
+		return nil, err
+	}
+	return &webhookEndpoint{
+		service: service,
+		prefix:  prefix,
+	}, nil
+}
+
+// Register sets up the routing for managing the current user's webhooks:
+// - Registering a webhook via POST
+// - Listing the user's webhooks via GET
+// - Deleting a webhook by ID via DELETE
+// - Listing the latest deliveries of a webhook via GET
+// The routes are added to the mux itself, as the prefix is already mounted by the snipEndpoint.
+func (e *webhookEndpoint) Register(r *chi.Mux) {
+	r.Post(path.Join(e.prefix, endpointRegisterWebhook), e.registerWebhook)
+	r.Get(path.Join(e.prefix, endpointGetWebhooks), e.getWebhooks)
+	r.Delete(path.Join(e.prefix, endpointDeleteWebhook), e.deleteWebhook)
+	r.Get(path.Join(e.prefix, endpointGetDeliveries), e.getDeliveries)
+}
@@ -0,0 +1,25 @@
+package webhookendpoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/webhook"
+)
+
+// deleteWebhook handles HTTP DELETE requests that remove one of the current user's webhooks
+// together with its delivery log. Events still queued for it are not delivered.
+// - 204 No Content if the webhook was deleted
+// - 404 Not Found if the user has no webhook with that ID
+// - 500 Internal Server Error if any error occurs during processing
+func (e *webhookEndpoint) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	err := e.service.DeleteWebhook(r.Context(), r.PathValue("id"))
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, webhook.ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
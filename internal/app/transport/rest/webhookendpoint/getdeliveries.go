@@ -0,0 +1,46 @@
+package webhookendpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/webhook"
+)
+
+// getDeliveries handles HTTP GET requests that list the latest delivery attempts of one of
+// the current user's webhooks, newest first.
+// - 200 OK with JSON payload if the webhook has deliveries
+// - 204 No Content if it has none
+// - 404 Not Found if the user has no webhook with that ID
+// - 500 Internal Server Error if any error occurs during processing
+func (e *webhookEndpoint) getDeliveries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	deliveries, err := e.service.GetDeliveries(r.Context(), r.PathValue("id"))
+	switch {
+	case err == nil:
+	case errors.Is(err, webhook.ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if len(deliveries) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	items := make([]*deliveryJSON, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		items = append(items, deliveryJSONFromServiceModel(delivery))
+	}
+
+	resp, err := json.Marshal(items)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(resp)
+}
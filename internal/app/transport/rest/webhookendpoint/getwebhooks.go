@@ -0,0 +1,36 @@
+package webhookendpoint
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// getWebhooks handles HTTP GET requests that list the current user's webhooks, without their secrets.
+// - 200 OK with JSON payload if the user has webhooks
+// - 204 No Content if the user has none
+// - 500 Internal Server Error if any error occurs during processing
+func (e *webhookEndpoint) getWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	webhooks, err := e.service.GetWebhooks(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if len(webhooks) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	items := make([]*webhookJSON, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		items = append(items, webhookJSONFromServiceModel(webhook))
+	}
+
+	resp, err := json.Marshal(items)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(resp)
+}
@@ -0,0 +1,36 @@
+package webhookendpoint
+
+import "github.com/DanilNaum/SnipURL/internal/app/service/webhook"
+
+func webhookJSONToServiceModel(req *webhookJSON) *webhook.Webhook {
+	return &webhook.Webhook{
+		URL:            req.URL,
+		Events:         req.Events,
+		ClickThreshold: req.ClickThreshold,
+	}
+}
+
+func webhookJSONFromServiceModel(webhook *webhook.Webhook) *webhookJSON {
+	return &webhookJSON{
+		ID:             webhook.ID,
+		URL:            webhook.URL,
+		Events:         webhook.Events,
+		ClickThreshold: webhook.ClickThreshold,
+		Secret:         webhook.Secret,
+		CreatedAt:      webhook.CreatedAt,
+	}
+}
+
+func deliveryJSONFromServiceModel(delivery *webhook.Delivery) *deliveryJSON {
+	return &deliveryJSON{
+		ID:         delivery.ID,
+		EventID:    delivery.EventID,
+		Event:      delivery.Event,
+		Attempt:    delivery.Attempt,
+		StatusCode: delivery.StatusCode,
+		Error:      delivery.Error,
+		Success:    delivery.Success,
+		DurationMs: delivery.Duration.Milliseconds(),
+		CreatedAt:  delivery.CreatedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package webhookendpoint
+
+import "time"
+
+type webhookJSON struct {
+	ID             string    `json:"id,omitempty"`
+	URL            string    `json:"url"`
+	Events         []string  `json:"events"`
+	ClickThreshold int       `json:"click_threshold,omitempty"`
+	Secret         string    `json:"secret,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type deliveryJSON struct {
+	ID         string    `json:"id"`
+	EventID    string    `json:"event_id"`
+	Event      string    `json:"event"`
+	Attempt    int       `json:"attempt"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Success    bool      `json:"success"`
+	DurationMs int64     `json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at"`
+}
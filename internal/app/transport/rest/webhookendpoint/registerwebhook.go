@@ -0,0 +1,58 @@
+package webhookendpoint
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/DanilNaum/SnipURL/internal/app/service/webhook"
+)
+
+// registerWebhook handles HTTP POST requests that register a webhook for the current user.
+// The response carries the secret signing the deliveries; it is not returned again.
+//
+// Response status codes:
+//   - 201 Created: Webhook registered, the body holds it with its ID and secret
+//   - 400 Bad Request: Invalid JSON, URL, events or click threshold
+//   - 409 Conflict: The user already has the maximum number of webhooks
+//   - 500 Internal Server Error: Server-side error
+func (e *webhookEndpoint) registerWebhook(w http.ResponseWriter, r *http.Request) {
+	var req webhookJSON
+	var buf bytes.Buffer
+
+	_, err := buf.ReadFrom(r.Body)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if err = json.Unmarshal(buf.Bytes(), &req); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	registered, err := e.service.Register(r.Context(), webhookJSONToServiceModel(&req))
+	switch {
+	case err == nil:
+	case errors.Is(err, webhook.ErrInvalidWebhook):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, webhook.ErrTooManyWebhooks):
+		http.Error(w, http.StatusText(http.StatusConflict), http.StatusConflict)
+		return
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := json.Marshal(webhookJSONFromServiceModel(registered))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	w.Write(resp)
+}
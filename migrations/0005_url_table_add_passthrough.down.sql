@@ -0,0 +1,2 @@
+ALTER TABLE url DROP COLUMN query_mode;
+ALTER TABLE url DROP COLUMN passthrough;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE url ADD COLUMN passthrough BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE url ADD COLUMN query_mode TEXT NOT NULL DEFAULT '';
\ No newline at end of file
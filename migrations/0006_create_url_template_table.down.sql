@@ -0,0 +1,2 @@
+ALTER TABLE url DROP COLUMN template;
+DROP TABLE IF EXISTS url_template;
\ No newline at end of file
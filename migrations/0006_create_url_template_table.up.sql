@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS url_template(
+    user_uuid TEXT NOT NULL,
+    name TEXT NOT NULL,
+    utm_source TEXT NOT NULL DEFAULT '',
+    utm_medium TEXT NOT NULL DEFAULT '',
+    utm_campaign TEXT NOT NULL DEFAULT '',
+    utm_term TEXT NOT NULL DEFAULT '',
+    utm_content TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (user_uuid, name)
+);
+ALTER TABLE url ADD COLUMN template TEXT NOT NULL DEFAULT '';
\ No newline at end of file
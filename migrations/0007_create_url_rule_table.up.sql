@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS url_rule(
+    id integer generated by default as identity primary key,
+    url_id TEXT NOT NULL REFERENCES url(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    platform TEXT NOT NULL DEFAULT '',
+    language TEXT NOT NULL DEFAULT '',
+    time_from TEXT NOT NULL DEFAULT '',
+    time_to TEXT NOT NULL DEFAULT '',
+    target_url TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS url_rule_url_id_idx ON url_rule(url_id, position, id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS url_variant_stat;
+ALTER TABLE url DROP COLUMN variants;
\ No newline at end of file
@@ -0,0 +1,7 @@
+ALTER TABLE url ADD COLUMN variants JSONB NOT NULL DEFAULT '[]';
+CREATE TABLE IF NOT EXISTS url_variant_stat(
+    url_id TEXT NOT NULL REFERENCES url(id) ON DELETE CASCADE,
+    variant TEXT NOT NULL,
+    served BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (url_id, variant)
+);
\ No newline at end of file
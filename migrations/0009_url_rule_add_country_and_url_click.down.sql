@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS url_click;
+ALTER TABLE url_rule DROP COLUMN country;
\ No newline at end of file
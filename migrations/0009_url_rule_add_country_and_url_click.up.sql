@@ -0,0 +1,9 @@
+ALTER TABLE url_rule ADD COLUMN country TEXT NOT NULL DEFAULT '';
+CREATE TABLE IF NOT EXISTS url_click(
+    id bigint generated by default as identity primary key,
+    url_id TEXT NOT NULL REFERENCES url(id) ON DELETE CASCADE,
+    clicked_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    country TEXT NOT NULL DEFAULT '',
+    variant TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS url_click_url_id_idx ON url_click(url_id, country);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE url ADD COLUMN password_hash TEXT NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE url DROP COLUMN fallback_url;
+ALTER TABLE url DROP COLUMN not_after;
+ALTER TABLE url DROP COLUMN not_before;
\ No newline at end of file
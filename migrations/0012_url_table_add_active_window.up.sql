@@ -0,0 +1,3 @@
+ALTER TABLE url ADD COLUMN not_before TIMESTAMPTZ;
+ALTER TABLE url ADD COLUMN not_after TIMESTAMPTZ;
+ALTER TABLE url ADD COLUMN fallback_url TEXT NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS url_tags_idx;
+ALTER TABLE url DROP COLUMN tags;
+ALTER TABLE url DROP COLUMN notes;
+ALTER TABLE url DROP COLUMN title;
\ No newline at end of file
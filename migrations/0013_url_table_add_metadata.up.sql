@@ -0,0 +1,4 @@
+ALTER TABLE url ADD COLUMN title TEXT NOT NULL DEFAULT '';
+ALTER TABLE url ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+ALTER TABLE url ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+CREATE INDEX IF NOT EXISTS url_tags_idx ON url USING GIN (tags);
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS url_user_clicks_idx;
+DROP INDEX IF EXISTS url_user_created_idx;
+ALTER TABLE url DROP COLUMN clicks;
+ALTER TABLE url DROP COLUMN created_at;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE url ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE url ADD COLUMN clicks INTEGER NOT NULL DEFAULT 0;
+UPDATE url SET clicks = c.total FROM (SELECT url_id, COUNT(*) AS total FROM url_click GROUP BY url_id) c WHERE c.url_id = url.id;
+CREATE INDEX IF NOT EXISTS url_user_created_idx ON url(user_uuid, created_at, id) WHERE deleted = false;
+CREATE INDEX IF NOT EXISTS url_user_clicks_idx ON url(user_uuid, clicks, id) WHERE deleted = false;
\ No newline at end of file
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS user_erasure_job;
+DROP TABLE IF EXISTS revoked_user;
+DELETE FROM url_click WHERE url_id IS NULL;
+ALTER TABLE url_click DROP CONSTRAINT IF EXISTS url_click_url_id_fkey;
+ALTER TABLE url_click ADD CONSTRAINT url_click_url_id_fkey FOREIGN KEY (url_id) REFERENCES url(id) ON DELETE CASCADE;
+ALTER TABLE url_click ALTER COLUMN url_id SET NOT NULL;
\ No newline at end of file
@@ -0,0 +1,15 @@
+ALTER TABLE url_click ALTER COLUMN url_id DROP NOT NULL;
+ALTER TABLE url_click DROP CONSTRAINT IF EXISTS url_click_url_id_fkey;
+ALTER TABLE url_click ADD CONSTRAINT url_click_url_id_fkey FOREIGN KEY (url_id) REFERENCES url(id) ON DELETE SET NULL;
+CREATE TABLE IF NOT EXISTS revoked_user(
+    user_uuid TEXT PRIMARY KEY,
+    revoked_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE TABLE IF NOT EXISTS user_erasure_job(
+    id TEXT PRIMARY KEY,
+    user_uuid TEXT NOT NULL,
+    status TEXT NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    finished_at TIMESTAMPTZ
+);
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS url_health_checked_idx;
+ALTER TABLE url DROP COLUMN health_broken;
+ALTER TABLE url DROP COLUMN health_failing_since;
+ALTER TABLE url DROP COLUMN health_error;
+ALTER TABLE url DROP COLUMN health_latency_ms;
+ALTER TABLE url DROP COLUMN health_status;
+ALTER TABLE url DROP COLUMN health_checked_at;
\ No newline at end of file
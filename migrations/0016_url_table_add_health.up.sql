@@ -0,0 +1,7 @@
+ALTER TABLE url ADD COLUMN health_checked_at TIMESTAMPTZ;
+ALTER TABLE url ADD COLUMN health_status INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE url ADD COLUMN health_latency_ms INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE url ADD COLUMN health_error TEXT NOT NULL DEFAULT '';
+ALTER TABLE url ADD COLUMN health_failing_since TIMESTAMPTZ;
+ALTER TABLE url ADD COLUMN health_broken BOOLEAN NOT NULL DEFAULT false;
+CREATE INDEX IF NOT EXISTS url_health_checked_idx ON url(health_checked_at NULLS FIRST, id) WHERE deleted = false;
\ No newline at end of file
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS webhook(
+    id TEXT PRIMARY KEY,
+    user_uuid TEXT NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    events TEXT[] NOT NULL DEFAULT '{}',
+    click_threshold INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS webhook_user_uuid_idx ON webhook(user_uuid, created_at);
+CREATE INDEX IF NOT EXISTS webhook_click_threshold_idx ON webhook(click_threshold) WHERE click_threshold > 0;
+CREATE TABLE IF NOT EXISTS webhook_delivery(
+    id TEXT PRIMARY KEY,
+    webhook_id TEXT NOT NULL REFERENCES webhook(id) ON DELETE CASCADE,
+    event_id TEXT NOT NULL,
+    event TEXT NOT NULL,
+    attempt INTEGER NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    success BOOLEAN NOT NULL DEFAULT false,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS webhook_delivery_webhook_id_idx ON webhook_delivery(webhook_id, created_at DESC);
\ No newline at end of file
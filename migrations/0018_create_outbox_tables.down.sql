@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS outbox_checkpoint;
+DROP TABLE IF EXISTS outbox_event;
\ No newline at end of file
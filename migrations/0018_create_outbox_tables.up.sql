@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS outbox_event(
+    seq BIGSERIAL PRIMARY KEY,
+    id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    user_uuid TEXT NOT NULL DEFAULT '',
+    short_url TEXT NOT NULL,
+    original_url TEXT NOT NULL DEFAULT '',
+    clicks INTEGER NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS outbox_event_user_uuid_idx ON outbox_event(user_uuid);
+CREATE TABLE IF NOT EXISTS outbox_checkpoint(
+    subscriber TEXT PRIMARY KEY,
+    seq BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
\ No newline at end of file
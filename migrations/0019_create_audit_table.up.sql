@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS audit_log(
+    id BIGSERIAL PRIMARY KEY,
+    user_uuid TEXT NOT NULL,
+    action TEXT NOT NULL,
+    short_url TEXT NOT NULL,
+    ip TEXT NOT NULL DEFAULT '',
+    auth_method TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS audit_log_user_uuid_idx ON audit_log(user_uuid, id);
+CREATE INDEX IF NOT EXISTS audit_log_short_url_idx ON audit_log(short_url, id);
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log(created_at);
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
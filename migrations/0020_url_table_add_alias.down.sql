@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS url_url_key;
+ALTER TABLE url ADD CONSTRAINT url_url_key UNIQUE (url);
+ALTER TABLE url DROP COLUMN alias;
@@ -0,0 +1,3 @@
+ALTER TABLE url ADD COLUMN alias BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE url DROP CONSTRAINT IF EXISTS url_url_key;
+CREATE UNIQUE INDEX IF NOT EXISTS url_url_key ON url(url) WHERE alias = false;
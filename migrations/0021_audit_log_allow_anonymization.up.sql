@@ -0,0 +1,15 @@
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND NEW.id = OLD.id
+        AND NEW.action = OLD.action
+        AND NEW.short_url = OLD.short_url
+        AND NEW.auth_method = OLD.auth_method
+        AND NEW.created_at = OLD.created_at
+        AND NEW.ip = ''
+        AND NEW.user_uuid LIKE 'erased-%' THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS url_title_trgm_idx;
+DROP INDEX IF EXISTS url_url_trgm_idx;
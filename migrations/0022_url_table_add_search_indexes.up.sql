@@ -0,0 +1,3 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS url_url_trgm_idx ON url USING gin (url gin_trgm_ops) WHERE deleted = false;
+CREATE INDEX IF NOT EXISTS url_title_trgm_idx ON url USING gin (title gin_trgm_ops) WHERE deleted = false;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS url_window_end_idx;
+ALTER TABLE url DROP COLUMN end_emitted;
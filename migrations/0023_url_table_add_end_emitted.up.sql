@@ -0,0 +1,3 @@
+ALTER TABLE url ADD COLUMN end_emitted TIMESTAMPTZ;
+UPDATE url SET end_emitted = not_after WHERE not_after <= now();
+CREATE INDEX IF NOT EXISTS url_window_end_idx ON url(not_after, id) WHERE deleted = false AND end_emitted IS DISTINCT FROM not_after;
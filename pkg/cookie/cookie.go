@@ -22,6 +22,7 @@ type options struct {
 	secure   bool
 	httpOnly bool
 	sameSite http.SameSite
+	maxAge   int
 }
 type opt func(o *options)
 
@@ -63,6 +64,14 @@ func WithSameSite(sameSite http.SameSite) opt {
 	}
 }
 
+// WithMaxAge sets the lifetime of the cookie in seconds.
+// Defaults to 0 (a session cookie) if not specified.
+func WithMaxAge(maxAge int) opt {
+	return func(o *options) {
+		o.maxAge = maxAge
+	}
+}
+
 // NewCookieManager creates a new cookie manager with the given secret and optional configuration.
 // It allows customization of cookie settings such as name, path, security, and same-site policy.
 func NewCookieManager(secret []byte, opts ...opt) *cookieManager {
@@ -85,7 +94,7 @@ func NewCookieManager(secret []byte, opts ...opt) *cookieManager {
 // Set creates a new cookie with the given value and sets it in the HTTP response.
 // The cookie value is signed using HMAC-SHA256 to ensure integrity.
 // The signature is base64 URL-encoded and appended to the value with a dot separator.
-// The cookie settings (name, path, secure, httpOnly, sameSite) are taken from the cookieManager options.
+// The cookie settings (name, path, secure, httpOnly, sameSite, maxAge) are taken from the cookieManager options.
 func (c *cookieManager) Set(w http.ResponseWriter, value string) {
 
 	// Create signature for the encoded value
@@ -99,6 +108,7 @@ func (c *cookieManager) Set(w http.ResponseWriter, value string) {
 		Secure:   c.options.secure,
 		HttpOnly: c.options.httpOnly,
 		SameSite: c.options.sameSite,
+		MaxAge:   c.options.maxAge,
 	}
 	http.SetCookie(w, cookie)
 }
@@ -0,0 +1,107 @@
+package fanout
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+type hub[K comparable, V any] struct {
+	mu            sync.RWMutex
+	subscriptions map[K]map[*subscription[V]]struct{}
+	bufferSize    int
+	// done is closed when the hub stops, ending every subscription.
+	done <-chan struct{}
+}
+
+type subscription[V any] struct {
+	c       chan V
+	dropped atomic.Uint64
+}
+
+// Subscription receives the values published under a key.
+type Subscription[V any] interface {
+	// C returns the channel of the values, closed once the subscription ends.
+	C() <-chan V
+	// Dropped returns the number of values dropped so far because the buffer was full.
+	Dropped() uint64
+}
+
+// NewHub creates a hub fanning out the values published under a key to every
+// subscription of the key. Every subscription has a buffer of bufferSize values;
+// a value published while the buffer is full is dropped for that subscription, so
+// that a slow subscriber neither blocks the publisher nor holds up the others.
+// When ctx is done every subscription ends, so that long-lived subscribers such as
+// streaming responses do not hold up a graceful shutdown.
+//
+// Parameters:
+//   - ctx: Context for controlling the hub's lifecycle
+//   - bufferSize: Number of values buffered for every subscription
+//
+// Returns a pointer to the created hub.
+func NewHub[K comparable, V any](ctx context.Context, bufferSize int) *hub[K, V] {
+	return &hub[K, V]{
+		subscriptions: make(map[K]map[*subscription[V]]struct{}),
+		bufferSize:    bufferSize,
+		done:          ctx.Done(),
+	}
+}
+
+// Subscribe subscribes to the values published under the key until ctx is done
+// or the hub stops, when the subscription is removed and its channel closed.
+func (h *hub[K, V]) Subscribe(ctx context.Context, key K) Subscription[V] {
+	s := &subscription[V]{c: make(chan V, h.bufferSize)}
+
+	h.mu.Lock()
+	if h.subscriptions[key] == nil {
+		h.subscriptions[key] = make(map[*subscription[V]]struct{})
+	}
+	h.subscriptions[key][s] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-h.done:
+		}
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subscriptions[key], s)
+		if len(h.subscriptions[key]) == 0 {
+			delete(h.subscriptions, key)
+		}
+		close(s.c)
+	}()
+	return s
+}
+
+// Publish hands the value to every subscription of the key without blocking,
+// dropping it for those whose buffer is full.
+func (h *hub[K, V]) Publish(key K, value V) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for s := range h.subscriptions[key] {
+		select {
+		case s.c <- value:
+		default:
+			s.dropped.Add(1)
+		}
+	}
+}
+
+// Subscribers returns the number of subscriptions of the key.
+func (h *hub[K, V]) Subscribers(key K) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.subscriptions[key])
+}
+
+func (s *subscription[V]) C() <-chan V {
+	return s.c
+}
+
+func (s *subscription[V]) Dropped() uint64 {
+	return s.dropped.Load()
+}
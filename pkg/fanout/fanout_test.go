@@ -0,0 +1,69 @@
+package fanout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_Publish(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h := NewHub[string, int](ctx, 2)
+
+	fast := h.Subscribe(ctx, "a")
+	slow := h.Subscribe(ctx, "a")
+	other := h.Subscribe(ctx, "b")
+	require.Equal(t, 2, h.Subscribers("a"))
+
+	h.Publish("a", 1)
+	require.Equal(t, 1, <-fast.C())
+	h.Publish("a", 2)
+	require.Equal(t, 2, <-fast.C())
+	h.Publish("a", 3)
+	require.Equal(t, 3, <-fast.C())
+
+	// The slow subscription kept the first values and dropped the rest.
+	require.Equal(t, 1, <-slow.C())
+	require.Equal(t, 2, <-slow.C())
+	require.Empty(t, slow.C())
+	require.Equal(t, uint64(1), slow.Dropped())
+	require.Zero(t, fast.Dropped())
+	require.Empty(t, other.C())
+}
+
+func TestHub_Subscribe(t *testing.T) {
+	tests := []struct {
+		name   string
+		cancel func(subscription, hub context.CancelFunc)
+	}{
+		{name: "subscription ended", cancel: func(subscription, hub context.CancelFunc) { subscription() }},
+		{name: "hub stopped", cancel: func(subscription, hub context.CancelFunc) { hub() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hubCtx, hubCancel := context.WithCancel(context.Background())
+			defer hubCancel()
+			h := NewHub[string, int](hubCtx, 1)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			s := h.Subscribe(ctx, "a")
+			tt.cancel(cancel, hubCancel)
+
+			select {
+			case _, ok := <-s.C():
+				require.False(t, ok)
+			case <-time.After(time.Second):
+				require.FailNow(t, "subscription was not closed")
+			}
+			require.Zero(t, h.Subscribers("a"))
+
+			// Publishing without subscriptions does nothing.
+			h.Publish("a", 1)
+		})
+	}
+}
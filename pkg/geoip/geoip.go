@@ -0,0 +1,68 @@
+package geoip
+
+import (
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// Location is the country an IP address belongs to.
+// Country is an ISO 3166-1 alpha-2 code and is empty when the address is not in the database.
+type Location struct {
+	Country string
+	InEU    bool
+}
+
+type countryRecord struct {
+	Country struct {
+		ISOCode           string `maxminddb:"iso_code"`
+		IsInEuropeanUnion bool   `maxminddb:"is_in_european_union"`
+	} `maxminddb:"country"`
+}
+
+type reader struct {
+	db *maxminddb.Reader
+}
+
+// NewReader opens a MaxMind-format (.mmdb) country or city database.
+// The file is loaded into memory and must be released with Close.
+// An empty path disables geo lookups and returns a nil reader.
+func NewReader(path string) (*reader, error) {
+	if path == "" {
+		return nil, nil
+	}
+	db, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		db: db,
+	}, nil
+}
+
+// Lookup returns the location of the IP address.
+// A nil reader or a nil address resolves to an empty location, so callers can
+// keep geo lookups in place when no database is configured.
+func (r *reader) Lookup(ip net.IP) (*Location, error) {
+	if r == nil || ip == nil {
+		return &Location{}, nil
+	}
+
+	var record countryRecord
+	if err := r.db.Lookup(ip, &record); err != nil {
+		return nil, err
+	}
+
+	return &Location{
+		Country: record.Country.ISOCode,
+		InEU:    record.Country.IsInEuropeanUnion,
+	}, nil
+}
+
+// Close releases the database.
+func (r *reader) Close() error {
+	if r == nil {
+		return nil
+	}
+	return r.db.Close()
+}
@@ -0,0 +1,50 @@
+package geoip
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader_Lookup(t *testing.T) {
+	r, err := NewReader("testdata/GeoLite2-Country-Test.mmdb")
+	require.NoError(t, err)
+	defer r.Close()
+
+	tests := []struct {
+		name string
+		ip   string
+		want *Location
+	}{
+		{name: "us", ip: "216.160.83.56", want: &Location{Country: "US"}},
+		{name: "eu", ip: "2.125.160.216", want: &Location{Country: "DE", InEU: true}},
+		{name: "non-eu europe", ip: "81.2.69.142", want: &Location{Country: "GB"}},
+		{name: "ipv6", ip: "2001:db8:1::1", want: &Location{Country: "FR", InEU: true}},
+		{name: "unknown", ip: "10.0.0.1", want: &Location{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Lookup(net.ParseIP(tt.ip))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReader_LookupWithoutDatabase(t *testing.T) {
+	r, err := NewReader("")
+	require.NoError(t, err)
+	require.Nil(t, r)
+
+	got, err := r.Lookup(net.ParseIP("216.160.83.56"))
+	require.NoError(t, err)
+	require.Equal(t, &Location{}, got)
+	require.NoError(t, r.Close())
+}
+
+func TestNewReader_MissingFile(t *testing.T) {
+	_, err := NewReader("testdata/missing.mmdb")
+	require.Error(t, err)
+}
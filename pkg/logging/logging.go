@@ -0,0 +1,119 @@
+// Package logging builds the structured logger of the service. Its records are redacted
+// of sensitive fields and, when logged with a context, carry the request ID and the
+// trace of the context.
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Formats of the log records.
+const (
+	// FormatConsole writes human-readable records, for development.
+	FormatConsole = "console"
+	// FormatJSON writes a JSON object per record, for log collectors.
+	FormatJSON = "json"
+)
+
+// Sampling keeps the first samplingInitial records with the same level and message
+// every second, then every samplingThereafter-th of them.
+const (
+	samplingInitial    = 100
+	samplingThereafter = 100
+)
+
+// ErrUnknownFormat indicates a format other than the Format constants.
+var ErrUnknownFormat = errors.New("unknown log format")
+
+// Config selects the format and the minimal level of the records, and whether the
+// repeated records are sampled.
+type Config struct {
+	Format   string
+	Level    string
+	Sampling bool
+}
+
+// Logger is a sugared zap logger that also logs with the request-scoped fields of
+// a context.
+type Logger struct {
+	*zap.SugaredLogger
+}
+
+// New builds the logger of the configuration.
+func New(conf Config) (*Logger, error) {
+	var zapConf zap.Config
+	switch conf.Format {
+	case FormatConsole, "":
+		zapConf = zap.NewDevelopmentConfig()
+	case FormatJSON:
+		zapConf = zap.NewProductionConfig()
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, conf.Format)
+	}
+
+	level, err := zap.ParseAtomicLevel(conf.Level)
+	if err != nil {
+		return nil, err
+	}
+	zapConf.Level = level
+
+	// The sampler wraps the redaction, since the redacting core writes the records it
+	// checks without consulting the core it wraps.
+	zapConf.Sampling = nil
+	logger, err := zapConf.Build(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		core = &redactingCore{Core: core}
+		if conf.Sampling {
+			core = zapcore.NewSamplerWithOptions(core, time.Second, samplingInitial, samplingThereafter)
+		}
+		return core
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return &Logger{SugaredLogger: logger.Sugar()}, nil
+}
+
+// WithContext returns the logger with the request ID and the trace and span IDs of ctx,
+// those ctx has.
+func (l *Logger) WithContext(ctx context.Context) *zap.SugaredLogger {
+	fields := make([]any, 0, 6)
+	if id, ok := RequestIDFromContext(ctx); ok {
+		fields = append(fields, "request_id", id)
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields = append(fields, "trace_id", spanContext.TraceID().String(), "span_id", spanContext.SpanID().String())
+	}
+	if len(fields) == 0 {
+		return l.SugaredLogger
+	}
+	return l.With(fields...)
+}
+
+// InfofContext logs a formatted message at the info level with the fields of ctx.
+func (l *Logger) InfofContext(ctx context.Context, format string, args ...any) {
+	l.callerContext(ctx).Infof(format, args...)
+}
+
+// ErrorfContext logs a formatted message at the error level with the fields of ctx.
+func (l *Logger) ErrorfContext(ctx context.Context, format string, args ...any) {
+	l.callerContext(ctx).Errorf(format, args...)
+}
+
+// InfowContext logs a message with the key-value pairs at the info level with the
+// fields of ctx.
+func (l *Logger) InfowContext(ctx context.Context, msg string, keysAndValues ...any) {
+	l.callerContext(ctx).Infow(msg, keysAndValues...)
+}
+
+// callerContext returns the logger with the fields of ctx that reports the caller of
+// the Context methods rather than the methods themselves.
+func (l *Logger) callerContext(ctx context.Context) *zap.SugaredLogger {
+	return l.WithContext(ctx).WithOptions(zap.AddCallerSkip(1))
+}
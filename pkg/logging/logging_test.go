@@ -0,0 +1,150 @@
+package logging
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newObservedLogger() (*Logger, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	return &Logger{SugaredLogger: zap.New(&redactingCore{Core: core}).Sugar()}, logs
+}
+
+func TestLogger_Redaction(t *testing.T) {
+	logger, logs := newObservedLogger()
+
+	logger.With("session_cookie", "abc").Infow("request",
+		"password", "hunter2",
+		"Authorization", "Bearer token",
+		"uri", "/api/shorten",
+	)
+
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	require.Equal(t, map[string]any{
+		"session_cookie": redacted,
+		"password":       redacted,
+		"Authorization":  redacted,
+		"uri":            "/api/shorten",
+	}, entries[0].ContextMap())
+}
+
+func TestLogger_WithContext(t *testing.T) {
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	require.NoError(t, err)
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	require.NoError(t, err)
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		wantFields map[string]any
+	}{
+		{
+			name:       "no request fields",
+			ctx:        context.Background(),
+			wantFields: map[string]any{},
+		},
+		{
+			name:       "request ID",
+			ctx:        ContextWithRequestID(context.Background(), "req-1"),
+			wantFields: map[string]any{"request_id": "req-1"},
+		},
+		{
+			name: "request ID and trace",
+			ctx:  trace.ContextWithSpanContext(ContextWithRequestID(context.Background(), "req-1"), spanContext),
+			wantFields: map[string]any{
+				"request_id": "req-1",
+				"trace_id":   "4bf92f3577b34da6a3ce929d0e0e4736",
+				"span_id":    "00f067aa0ba902b7",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, logs := newObservedLogger()
+
+			logger.ErrorfContext(tt.ctx, "failed: %v", "boom")
+
+			entries := logs.All()
+			require.Len(t, entries, 1)
+			require.Equal(t, "failed: boom", entries[0].Message)
+			require.Equal(t, tt.wantFields, entries[0].ContextMap())
+		})
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		sent     string
+		wantSent bool
+	}{
+		{name: "valid", sent: "3f1c9a7e-req", wantSent: true},
+		{name: "empty", sent: ""},
+		{name: "with newline", sent: "abc\ninjected"},
+		{name: "with space", sent: "abc def"},
+		{name: "too long", sent: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := RequestID(tt.sent)
+			if tt.wantSent {
+				require.Equal(t, tt.sent, id)
+				return
+			}
+			require.NotEqual(t, tt.sent, id)
+			require.True(t, isValidRequestID(id))
+		})
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "no query", url: "/abc", want: "/abc"},
+		{name: "nothing sensitive", url: "/abc?utm_source=x&b=2", want: "/abc?utm_source=x&b=2"},
+		{name: "password", url: "/abc?password=secret&b=2", want: "/abc?b=2&password=REDACTED"},
+		{name: "access token", url: "/abc?access_token=t", want: "/abc?access_token=REDACTED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, RedactURL(tt.url))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    Config
+		wantErr bool
+	}{
+		{name: "console", conf: Config{Format: FormatConsole, Level: "debug"}},
+		{name: "json with sampling", conf: Config{Format: FormatJSON, Level: "info", Sampling: true}},
+		{name: "unknown format", conf: Config{Format: "xml", Level: "info"}, wantErr: true},
+		{name: "unknown level", conf: Config{Format: FormatJSON, Level: "loud"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, err := New(tt.conf)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, logger)
+		})
+	}
+}
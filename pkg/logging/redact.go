@@ -0,0 +1,88 @@
+package logging
+
+import (
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// redacted replaces the values of the sensitive fields and query parameters.
+const redacted = "REDACTED"
+
+// sensitiveKeys are the parts of the field and parameter names whose values are
+// never logged.
+var sensitiveKeys = []string{"password", "cookie", "authorization", "token", "secret"}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactURL returns the URL with the values of its sensitive query parameters redacted.
+// A URL that does not parse is returned as is.
+func RedactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.RawQuery == "" {
+		return rawURL
+	}
+	query := u.Query()
+	changed := false
+	for key := range query {
+		if isSensitive(key) {
+			query[key] = []string{redacted}
+			changed = true
+		}
+	}
+	if !changed {
+		return rawURL
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// redactingCore replaces the values of the sensitive fields before they reach
+// the wrapped core.
+type redactingCore struct {
+	zapcore.Core
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(redactFields(fields))}
+}
+
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, redactFields(fields))
+}
+
+// redactFields returns the fields with the sensitive ones redacted, copying them only
+// when there is one.
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	var out []zapcore.Field
+	for i, field := range fields {
+		if !isSensitive(field.Key) {
+			continue
+		}
+		if out == nil {
+			out = append(make([]zapcore.Field, 0, len(fields)), fields...)
+		}
+		out[i] = zap.String(field.Key, redacted)
+	}
+	if out == nil {
+		return fields
+	}
+	return out
+}
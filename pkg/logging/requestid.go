@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader is the HTTP header, and in lower case the gRPC metadata key, that
+// carries the request ID.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds the length of the request IDs accepted from clients.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of ctx carrying the request ID.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID ctx carries, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok
+}
+
+// RequestID returns the request ID sent by the client when it is acceptable, and
+// a new one otherwise. An acceptable ID is at most 128 visible ASCII characters, so
+// that it cannot forge log records.
+func RequestID(sent string) string {
+	if isValidRequestID(sent) {
+		return sent
+	}
+	return uuid.NewString()
+}
+
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
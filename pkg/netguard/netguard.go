@@ -0,0 +1,28 @@
+// Package netguard keeps the requests the service makes on behalf of its users,
+// such as link checks and webhook deliveries, away from the internal network.
+package netguard
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+// ErrBlockedAddress indicates a target resolving to an address that is not public.
+var ErrBlockedAddress = errors.New("target address is not public")
+
+// Control is a net.Dialer control that refuses loopback, private, link-local,
+// unspecified and multicast addresses with ErrBlockedAddress. It runs on the resolved
+// address, so host names pointing to the internal network are refused too.
+func Control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return ErrBlockedAddress
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package netguard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestControl(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		wantErr bool
+	}{
+		{name: "public v4", address: "93.184.216.34:443"},
+		{name: "public v6", address: "[2606:2800:220:1::1]:443"},
+		{name: "loopback", address: "127.0.0.1:80", wantErr: true},
+		{name: "loopback v6", address: "[::1]:80", wantErr: true},
+		{name: "private", address: "10.1.2.3:80", wantErr: true},
+		{name: "unique local v6", address: "[fd00::1]:80", wantErr: true},
+		{name: "link-local metadata", address: "169.254.169.254:80", wantErr: true},
+		{name: "unspecified", address: "0.0.0.0:80", wantErr: true},
+		{name: "multicast", address: "224.0.0.1:80", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Control("tcp", tt.address, nil)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrBlockedAddress)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -124,7 +124,20 @@ type ShortURLRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Url          string     `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	RedirectType int32      `protobuf:"varint,2,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"` // HTTP-код редиректа, 0 - значение по умолчанию
+	Passthrough  bool       `protobuf:"varint,3,opt,name=passthrough,proto3" json:"passthrough,omitempty"`                       // Переносить путь и параметры запроса в целевой URL
+	QueryMode    string     `protobuf:"bytes,4,opt,name=query_mode,json=queryMode,proto3" json:"query_mode,omitempty"`           // append, override или drop
+	Template     string     `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"`                              // Имя шаблона UTM-меток пользователя
+	Variants     []*Variant `protobuf:"bytes,6,rep,name=variants,proto3" json:"variants,omitempty"`                              // A/B-варианты с весами
+	Password     string     `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`                              // Пароль для перехода по ссылке, пусто - без пароля
+	MaxClicks    int32      `protobuf:"varint,8,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`          // Лимит переходов, 0 - без ограничений
+	NotBefore    string     `protobuf:"bytes,9,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`           // Начало окна активности в RFC 3339, пусто - без ограничения
+	NotAfter     string     `protobuf:"bytes,10,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`             // Конец окна активности в RFC 3339, пусто - без ограничения
+	FallbackUrl  string     `protobuf:"bytes,11,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`    // Куда вести вне окна активности, пусто - ссылка недоступна
+	Title        string     `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`
+	Notes        string     `protobuf:"bytes,13,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags         []string   `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (x *ShortURLRequest) Reset() {
@@ -164,6 +177,97 @@ func (x *ShortURLRequest) GetUrl() string {
 	return ""
 }
 
+func (x *ShortURLRequest) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
+}
+
+func (x *ShortURLRequest) GetPassthrough() bool {
+	if x != nil {
+		return x.Passthrough
+	}
+	return false
+}
+
+func (x *ShortURLRequest) GetQueryMode() string {
+	if x != nil {
+		return x.QueryMode
+	}
+	return ""
+}
+
+func (x *ShortURLRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *ShortURLRequest) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *ShortURLRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ShortURLRequest) GetMaxClicks() int32 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *ShortURLRequest) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *ShortURLRequest) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+func (x *ShortURLRequest) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *ShortURLRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortURLRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ShortURLRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type ShortURLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -301,7 +405,14 @@ type ShortURLID struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Path           string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                           // Путь после ID для ссылок с passthrough
+	Query          string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                                         // Строка запроса для ссылок с passthrough
+	UserAgent      string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`                // User-Agent клиента для правил маршрутизации
+	AcceptLanguage string `protobuf:"bytes,5,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"` // Accept-Language клиента для правил маршрутизации
+	Variant        string `protobuf:"bytes,6,opt,name=variant,proto3" json:"variant,omitempty"`                                     // A/B-вариант, выбранный клиенту ранее
+	ClientIp       string `protobuf:"bytes,7,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`                   // IP клиента, учитывается только от доверенного прокси
+	Password       string `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`                                   // Пароль для ссылок, защищенных паролем
 }
 
 func (x *ShortURLID) Reset() {
@@ -341,6 +452,55 @@ func (x *ShortURLID) GetId() string {
 	return ""
 }
 
+func (x *ShortURLID) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ShortURLID) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ShortURLID) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ShortURLID) GetAcceptLanguage() string {
+	if x != nil {
+		return x.AcceptLanguage
+	}
+	return ""
+}
+
+func (x *ShortURLID) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ShortURLID) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *ShortURLID) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
 type OriginalURLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -425,8 +585,10 @@ type SuccessOriginalURL struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status      *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	OriginalUrl string  `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"` // Для 302 редиректа
+	Status       *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	OriginalUrl  string  `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`     // Для 302 редиректа
+	RedirectType int32   `protobuf:"varint,3,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"` // HTTP-код, с которым нужно выполнить редирект
+	Variant      string  `protobuf:"bytes,4,opt,name=variant,proto3" json:"variant,omitempty"`                                // Показанный A/B-вариант, клиенту стоит сохранить его
 }
 
 func (x *SuccessOriginalURL) Reset() {
@@ -473,28 +635,42 @@ func (x *SuccessOriginalURL) GetOriginalUrl() string {
 	return ""
 }
 
-type JsonShortURLRequest struct {
+func (x *SuccessOriginalURL) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
+}
+
+func (x *SuccessOriginalURL) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+type URLInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *JsonShortURLRequest) Reset() {
-	*x = JsonShortURLRequest{}
+func (x *URLInfoRequest) Reset() {
+	*x = URLInfoRequest{}
 	mi := &file_snipurl_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JsonShortURLRequest) String() string {
+func (x *URLInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JsonShortURLRequest) ProtoMessage() {}
+func (*URLInfoRequest) ProtoMessage() {}
 
-func (x *JsonShortURLRequest) ProtoReflect() protoreflect.Message {
+func (x *URLInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_snipurl_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -506,44 +682,44 @@ func (x *JsonShortURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JsonShortURLRequest.ProtoReflect.Descriptor instead.
-func (*JsonShortURLRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use URLInfoRequest.ProtoReflect.Descriptor instead.
+func (*URLInfoRequest) Descriptor() ([]byte, []int) {
 	return file_snipurl_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *JsonShortURLRequest) GetUrl() string {
+func (x *URLInfoRequest) GetId() string {
 	if x != nil {
-		return x.Url
+		return x.Id
 	}
 	return ""
 }
 
-type JsonShortURLResponse struct {
+type URLInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*JsonShortURLResponse_Success
-	//	*JsonShortURLResponse_Error
-	Response isJsonShortURLResponse_Response `protobuf_oneof:"response"`
+	//	*URLInfoResponse_Success
+	//	*URLInfoResponse_Error
+	Response isURLInfoResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *JsonShortURLResponse) Reset() {
-	*x = JsonShortURLResponse{}
+func (x *URLInfoResponse) Reset() {
+	*x = URLInfoResponse{}
 	mi := &file_snipurl_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JsonShortURLResponse) String() string {
+func (x *URLInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JsonShortURLResponse) ProtoMessage() {}
+func (*URLInfoResponse) ProtoMessage() {}
 
-func (x *JsonShortURLResponse) ProtoReflect() protoreflect.Message {
+func (x *URLInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_snipurl_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -555,71 +731,78 @@ func (x *JsonShortURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JsonShortURLResponse.ProtoReflect.Descriptor instead.
-func (*JsonShortURLResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use URLInfoResponse.ProtoReflect.Descriptor instead.
+func (*URLInfoResponse) Descriptor() ([]byte, []int) {
 	return file_snipurl_proto_rawDescGZIP(), []int{9}
 }
 
-func (m *JsonShortURLResponse) GetResponse() isJsonShortURLResponse_Response {
+func (m *URLInfoResponse) GetResponse() isURLInfoResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *JsonShortURLResponse) GetSuccess() *SuccessJsonShortURL {
-	if x, ok := x.GetResponse().(*JsonShortURLResponse_Success); ok {
+func (x *URLInfoResponse) GetSuccess() *SuccessURLInfo {
+	if x, ok := x.GetResponse().(*URLInfoResponse_Success); ok {
 		return x.Success
 	}
 	return nil
 }
 
-func (x *JsonShortURLResponse) GetError() *Error {
-	if x, ok := x.GetResponse().(*JsonShortURLResponse_Error); ok {
+func (x *URLInfoResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*URLInfoResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isJsonShortURLResponse_Response interface {
-	isJsonShortURLResponse_Response()
+type isURLInfoResponse_Response interface {
+	isURLInfoResponse_Response()
 }
 
-type JsonShortURLResponse_Success struct {
-	Success *SuccessJsonShortURL `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+type URLInfoResponse_Success struct {
+	Success *SuccessURLInfo `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
 }
 
-type JsonShortURLResponse_Error struct {
+type URLInfoResponse_Error struct {
 	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*JsonShortURLResponse_Success) isJsonShortURLResponse_Response() {}
+func (*URLInfoResponse_Success) isURLInfoResponse_Response() {}
 
-func (*JsonShortURLResponse_Error) isJsonShortURLResponse_Response() {}
+func (*URLInfoResponse_Error) isURLInfoResponse_Response() {}
 
-type SuccessJsonShortURL struct {
+type SuccessURLInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status   *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	ShortUrl string  `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	Status          *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ShortUrl        string  `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	Target          string  `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`                                           // Пусто для ссылок с паролем и неактивных ссылок без резервного URL
+	MultipleTargets bool    `protobuf:"varint,4,opt,name=multiple_targets,json=multipleTargets,proto3" json:"multiple_targets,omitempty"` // Правила или A/B-варианты ведут часть посетителей в другое место
+	Protected       bool    `protobuf:"varint,5,opt,name=protected,proto3" json:"protected,omitempty"`
+	LinkStatus      string  `protobuf:"bytes,6,opt,name=link_status,json=linkStatus,proto3" json:"link_status,omitempty"` // active, scheduled, ended или exhausted
+	CreatedAt       string  `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // RFC 3339
+	Clicks          int32   `protobuf:"varint,8,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	Safety          *Safety `protobuf:"bytes,9,opt,name=safety,proto3" json:"safety,omitempty"`
 }
 
-func (x *SuccessJsonShortURL) Reset() {
-	*x = SuccessJsonShortURL{}
+func (x *SuccessURLInfo) Reset() {
+	*x = SuccessURLInfo{}
 	mi := &file_snipurl_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuccessJsonShortURL) String() string {
+func (x *SuccessURLInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuccessJsonShortURL) ProtoMessage() {}
+func (*SuccessURLInfo) ProtoMessage() {}
 
-func (x *SuccessJsonShortURL) ProtoReflect() protoreflect.Message {
+func (x *SuccessURLInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_snipurl_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -631,101 +814,98 @@ func (x *SuccessJsonShortURL) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuccessJsonShortURL.ProtoReflect.Descriptor instead.
-func (*SuccessJsonShortURL) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuccessURLInfo.ProtoReflect.Descriptor instead.
+func (*SuccessURLInfo) Descriptor() ([]byte, []int) {
 	return file_snipurl_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SuccessJsonShortURL) GetStatus() *Status {
+func (x *SuccessURLInfo) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *SuccessJsonShortURL) GetShortUrl() string {
+func (x *SuccessURLInfo) GetShortUrl() string {
 	if x != nil {
 		return x.ShortUrl
 	}
 	return ""
 }
 
-type BatchURLItem struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
-	OriginalUrl   string `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+func (x *SuccessURLInfo) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
 }
 
-func (x *BatchURLItem) Reset() {
-	*x = BatchURLItem{}
-	mi := &file_snipurl_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SuccessURLInfo) GetMultipleTargets() bool {
+	if x != nil {
+		return x.MultipleTargets
+	}
+	return false
 }
 
-func (x *BatchURLItem) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SuccessURLInfo) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
 }
 
-func (*BatchURLItem) ProtoMessage() {}
-
-func (x *BatchURLItem) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[11]
+func (x *SuccessURLInfo) GetLinkStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LinkStatus
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use BatchURLItem.ProtoReflect.Descriptor instead.
-func (*BatchURLItem) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{11}
+func (x *SuccessURLInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
-func (x *BatchURLItem) GetCorrelationId() string {
+func (x *SuccessURLInfo) GetClicks() int32 {
 	if x != nil {
-		return x.CorrelationId
+		return x.Clicks
 	}
-	return ""
+	return 0
 }
 
-func (x *BatchURLItem) GetOriginalUrl() string {
+func (x *SuccessURLInfo) GetSafety() *Safety {
 	if x != nil {
-		return x.OriginalUrl
+		return x.Safety
 	}
-	return ""
+	return nil
 }
 
-type BatchCreateRequest struct {
+type Safety struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*BatchURLItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Verdict string   `protobuf:"bytes,1,opt,name=verdict,proto3" json:"verdict,omitempty"` // safe, warning или dangerous
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
 }
 
-func (x *BatchCreateRequest) Reset() {
-	*x = BatchCreateRequest{}
-	mi := &file_snipurl_proto_msgTypes[12]
+func (x *Safety) Reset() {
+	*x = Safety{}
+	mi := &file_snipurl_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchCreateRequest) String() string {
+func (x *Safety) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateRequest) ProtoMessage() {}
+func (*Safety) ProtoMessage() {}
 
-func (x *BatchCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[12]
+func (x *Safety) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,42 +916,61 @@ func (x *BatchCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateRequest.ProtoReflect.Descriptor instead.
-func (*BatchCreateRequest) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Safety.ProtoReflect.Descriptor instead.
+func (*Safety) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BatchCreateRequest) GetItems() []*BatchURLItem {
+func (x *Safety) GetVerdict() string {
 	if x != nil {
-		return x.Items
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *Safety) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
 	}
 	return nil
 }
 
-type BatchCreateResponseItem struct {
+type JsonShortURLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
-	ShortUrl      string `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	Url          string     `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	RedirectType int32      `protobuf:"varint,2,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"` // HTTP-код редиректа, 0 - значение по умолчанию
+	Passthrough  bool       `protobuf:"varint,3,opt,name=passthrough,proto3" json:"passthrough,omitempty"`                       // Переносить путь и параметры запроса в целевой URL
+	QueryMode    string     `protobuf:"bytes,4,opt,name=query_mode,json=queryMode,proto3" json:"query_mode,omitempty"`           // append, override или drop
+	Template     string     `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"`                              // Имя шаблона UTM-меток пользователя
+	Variants     []*Variant `protobuf:"bytes,6,rep,name=variants,proto3" json:"variants,omitempty"`                              // A/B-варианты с весами
+	Password     string     `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`                              // Пароль для перехода по ссылке, пусто - без пароля
+	MaxClicks    int32      `protobuf:"varint,8,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`          // Лимит переходов, 0 - без ограничений
+	NotBefore    string     `protobuf:"bytes,9,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`           // Начало окна активности в RFC 3339, пусто - без ограничения
+	NotAfter     string     `protobuf:"bytes,10,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`             // Конец окна активности в RFC 3339, пусто - без ограничения
+	FallbackUrl  string     `protobuf:"bytes,11,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`    // Куда вести вне окна активности, пусто - ссылка недоступна
+	Title        string     `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`
+	Notes        string     `protobuf:"bytes,13,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags         []string   `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *BatchCreateResponseItem) Reset() {
-	*x = BatchCreateResponseItem{}
-	mi := &file_snipurl_proto_msgTypes[13]
+func (x *JsonShortURLRequest) Reset() {
+	*x = JsonShortURLRequest{}
+	mi := &file_snipurl_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchCreateResponseItem) String() string {
+func (x *JsonShortURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateResponseItem) ProtoMessage() {}
+func (*JsonShortURLRequest) ProtoMessage() {}
 
-func (x *BatchCreateResponseItem) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[13]
+func (x *JsonShortURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,23 +981,502 @@ func (x *BatchCreateResponseItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateResponseItem.ProtoReflect.Descriptor instead.
-func (*BatchCreateResponseItem) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use JsonShortURLRequest.ProtoReflect.Descriptor instead.
+func (*JsonShortURLRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BatchCreateResponseItem) GetCorrelationId() string {
+func (x *JsonShortURLRequest) GetUrl() string {
 	if x != nil {
-		return x.CorrelationId
+		return x.Url
 	}
 	return ""
 }
 
-func (x *BatchCreateResponseItem) GetShortUrl() string {
+func (x *JsonShortURLRequest) GetRedirectType() int32 {
 	if x != nil {
-		return x.ShortUrl
+		return x.RedirectType
 	}
-	return ""
+	return 0
+}
+
+func (x *JsonShortURLRequest) GetPassthrough() bool {
+	if x != nil {
+		return x.Passthrough
+	}
+	return false
+}
+
+func (x *JsonShortURLRequest) GetQueryMode() string {
+	if x != nil {
+		return x.QueryMode
+	}
+	return ""
+}
+
+func (x *JsonShortURLRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *JsonShortURLRequest) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *JsonShortURLRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *JsonShortURLRequest) GetMaxClicks() int32 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *JsonShortURLRequest) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *JsonShortURLRequest) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+func (x *JsonShortURLRequest) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *JsonShortURLRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *JsonShortURLRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *JsonShortURLRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type JsonShortURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*JsonShortURLResponse_Success
+	//	*JsonShortURLResponse_Error
+	Response isJsonShortURLResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *JsonShortURLResponse) Reset() {
+	*x = JsonShortURLResponse{}
+	mi := &file_snipurl_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonShortURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonShortURLResponse) ProtoMessage() {}
+
+func (x *JsonShortURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonShortURLResponse.ProtoReflect.Descriptor instead.
+func (*JsonShortURLResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *JsonShortURLResponse) GetResponse() isJsonShortURLResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *JsonShortURLResponse) GetSuccess() *SuccessJsonShortURL {
+	if x, ok := x.GetResponse().(*JsonShortURLResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *JsonShortURLResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*JsonShortURLResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isJsonShortURLResponse_Response interface {
+	isJsonShortURLResponse_Response()
+}
+
+type JsonShortURLResponse_Success struct {
+	Success *SuccessJsonShortURL `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type JsonShortURLResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*JsonShortURLResponse_Success) isJsonShortURLResponse_Response() {}
+
+func (*JsonShortURLResponse_Error) isJsonShortURLResponse_Response() {}
+
+type SuccessJsonShortURL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ShortUrl string  `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+}
+
+func (x *SuccessJsonShortURL) Reset() {
+	*x = SuccessJsonShortURL{}
+	mi := &file_snipurl_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessJsonShortURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessJsonShortURL) ProtoMessage() {}
+
+func (x *SuccessJsonShortURL) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessJsonShortURL.ProtoReflect.Descriptor instead.
+func (*SuccessJsonShortURL) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SuccessJsonShortURL) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessJsonShortURL) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+type BatchURLItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrelationId string     `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	OriginalUrl   string     `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	RedirectType  int32      `protobuf:"varint,3,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"` // HTTP-код редиректа, 0 - значение по умолчанию
+	Passthrough   bool       `protobuf:"varint,4,opt,name=passthrough,proto3" json:"passthrough,omitempty"`
+	QueryMode     string     `protobuf:"bytes,5,opt,name=query_mode,json=queryMode,proto3" json:"query_mode,omitempty"`
+	Template      string     `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`
+	Variants      []*Variant `protobuf:"bytes,7,rep,name=variants,proto3" json:"variants,omitempty"`
+	Password      string     `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	MaxClicks     int32      `protobuf:"varint,9,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`
+	NotBefore     string     `protobuf:"bytes,10,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter      string     `protobuf:"bytes,11,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	FallbackUrl   string     `protobuf:"bytes,12,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
+	Title         string     `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string     `protobuf:"bytes,14,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags          []string   `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *BatchURLItem) Reset() {
+	*x = BatchURLItem{}
+	mi := &file_snipurl_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchURLItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchURLItem) ProtoMessage() {}
+
+func (x *BatchURLItem) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchURLItem.ProtoReflect.Descriptor instead.
+func (*BatchURLItem) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchURLItem) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
+}
+
+func (x *BatchURLItem) GetPassthrough() bool {
+	if x != nil {
+		return x.Passthrough
+	}
+	return false
+}
+
+func (x *BatchURLItem) GetQueryMode() string {
+	if x != nil {
+		return x.QueryMode
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *BatchURLItem) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetMaxClicks() int32 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *BatchURLItem) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *BatchURLItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type BatchCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*BatchURLItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchCreateRequest) Reset() {
+	*x = BatchCreateRequest{}
+	mi := &file_snipurl_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateRequest) ProtoMessage() {}
+
+func (x *BatchCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchCreateRequest) GetItems() []*BatchURLItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchCreateResponseItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	ShortUrl      string `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	Existing      bool   `protobuf:"varint,3,opt,name=existing,proto3" json:"existing,omitempty"` // URL уже был сокращен, short_url - существующая ссылка
+}
+
+func (x *BatchCreateResponseItem) Reset() {
+	*x = BatchCreateResponseItem{}
+	mi := &file_snipurl_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateResponseItem) ProtoMessage() {}
+
+func (x *BatchCreateResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateResponseItem.ProtoReflect.Descriptor instead.
+func (*BatchCreateResponseItem) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchCreateResponseItem) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *BatchCreateResponseItem) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *BatchCreateResponseItem) GetExisting() bool {
+	if x != nil {
+		return x.Existing
+	}
+	return false
 }
 
 type BatchCreateResponse struct {
@@ -808,26 +1486,4247 @@ type BatchCreateResponse struct {
 
 	// Types that are assignable to Response:
 	//
-	//	*BatchCreateResponse_Success
-	//	*BatchCreateResponse_Error
-	Response isBatchCreateResponse_Response `protobuf_oneof:"response"`
+	//	*BatchCreateResponse_Success
+	//	*BatchCreateResponse_Error
+	Response isBatchCreateResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *BatchCreateResponse) Reset() {
+	*x = BatchCreateResponse{}
+	mi := &file_snipurl_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateResponse) ProtoMessage() {}
+
+func (x *BatchCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *BatchCreateResponse) GetResponse() isBatchCreateResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *BatchCreateResponse) GetSuccess() *SuccessBatchCreate {
+	if x, ok := x.GetResponse().(*BatchCreateResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *BatchCreateResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*BatchCreateResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isBatchCreateResponse_Response interface {
+	isBatchCreateResponse_Response()
+}
+
+type BatchCreateResponse_Success struct {
+	Success *SuccessBatchCreate `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type BatchCreateResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*BatchCreateResponse_Success) isBatchCreateResponse_Response() {}
+
+func (*BatchCreateResponse_Error) isBatchCreateResponse_Response() {}
+
+type SuccessBatchCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status                    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Items  []*BatchCreateResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SuccessBatchCreate) Reset() {
+	*x = SuccessBatchCreate{}
+	mi := &file_snipurl_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessBatchCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessBatchCreate) ProtoMessage() {}
+
+func (x *SuccessBatchCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessBatchCreate.ProtoReflect.Descriptor instead.
+func (*SuccessBatchCreate) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SuccessBatchCreate) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessBatchCreate) GetItems() []*BatchCreateResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type StreamCreateShortURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*StreamCreateShortURLsResponse_Success
+	//	*StreamCreateShortURLsResponse_Error
+	Response isStreamCreateShortURLsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *StreamCreateShortURLsResponse) Reset() {
+	*x = StreamCreateShortURLsResponse{}
+	mi := &file_snipurl_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamCreateShortURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCreateShortURLsResponse) ProtoMessage() {}
+
+func (x *StreamCreateShortURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCreateShortURLsResponse.ProtoReflect.Descriptor instead.
+func (*StreamCreateShortURLsResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{20}
+}
+
+func (m *StreamCreateShortURLsResponse) GetResponse() isStreamCreateShortURLsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *StreamCreateShortURLsResponse) GetSuccess() *SuccessBatchCreate {
+	if x, ok := x.GetResponse().(*StreamCreateShortURLsResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *StreamCreateShortURLsResponse) GetError() *StreamCreateError {
+	if x, ok := x.GetResponse().(*StreamCreateShortURLsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isStreamCreateShortURLsResponse_Response interface {
+	isStreamCreateShortURLsResponse_Response()
+}
+
+type StreamCreateShortURLsResponse_Success struct {
+	Success *SuccessBatchCreate `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type StreamCreateShortURLsResponse_Error struct {
+	Error *StreamCreateError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*StreamCreateShortURLsResponse_Success) isStreamCreateShortURLsResponse_Response() {}
+
+func (*StreamCreateShortURLsResponse_Error) isStreamCreateShortURLsResponse_Response() {}
+
+type StreamCreateError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CorrelationIds []string `protobuf:"bytes,2,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"` // Отклоненные элементы порции
+}
+
+func (x *StreamCreateError) Reset() {
+	*x = StreamCreateError{}
+	mi := &file_snipurl_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamCreateError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCreateError) ProtoMessage() {}
+
+func (x *StreamCreateError) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCreateError.ProtoReflect.Descriptor instead.
+func (*StreamCreateError) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamCreateError) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *StreamCreateError) GetCorrelationIds() []string {
+	if x != nil {
+		return x.CorrelationIds
+	}
+	return nil
+}
+
+type UserURLItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortUrl     string      `protobuf:"bytes,1,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	OriginalUrl  string      `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	RedirectType int32       `protobuf:"varint,3,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"` // 0, если используется значение по умолчанию
+	Passthrough  bool        `protobuf:"varint,4,opt,name=passthrough,proto3" json:"passthrough,omitempty"`
+	QueryMode    string      `protobuf:"bytes,5,opt,name=query_mode,json=queryMode,proto3" json:"query_mode,omitempty"`
+	Template     string      `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`
+	Variants     []*Variant  `protobuf:"bytes,7,rep,name=variants,proto3" json:"variants,omitempty"`
+	Protected    bool        `protobuf:"varint,8,opt,name=protected,proto3" json:"protected,omitempty"`                      // Ссылка защищена паролем
+	MaxClicks    int32       `protobuf:"varint,9,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`     // Лимит переходов, 0 - без ограничений
+	ClicksLeft   int32       `protobuf:"varint,10,opt,name=clicks_left,json=clicksLeft,proto3" json:"clicks_left,omitempty"` // Оставшиеся переходы для ссылок с лимитом
+	NotBefore    string      `protobuf:"bytes,11,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`     // RFC 3339, пусто - без ограничения
+	NotAfter     string      `protobuf:"bytes,12,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	FallbackUrl  string      `protobuf:"bytes,13,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
+	Title        string      `protobuf:"bytes,14,opt,name=title,proto3" json:"title,omitempty"`
+	Notes        string      `protobuf:"bytes,15,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags         []string    `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedAt    string      `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
+	Clicks       int32       `protobuf:"varint,18,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	Deleted      bool        `protobuf:"varint,19,opt,name=deleted,proto3" json:"deleted,omitempty"` // Только в выгрузке ExportUserURLs
+	Health       *LinkHealth `protobuf:"bytes,20,opt,name=health,proto3" json:"health,omitempty"`    // Последняя проверка оригинального URL, не задано - не проверялся
+}
+
+func (x *UserURLItem) Reset() {
+	*x = UserURLItem{}
+	mi := &file_snipurl_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserURLItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserURLItem) ProtoMessage() {}
+
+func (x *UserURLItem) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserURLItem.ProtoReflect.Descriptor instead.
+func (*UserURLItem) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserURLItem) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
+}
+
+func (x *UserURLItem) GetPassthrough() bool {
+	if x != nil {
+		return x.Passthrough
+	}
+	return false
+}
+
+func (x *UserURLItem) GetQueryMode() string {
+	if x != nil {
+		return x.QueryMode
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *UserURLItem) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *UserURLItem) GetMaxClicks() int32 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *UserURLItem) GetClicksLeft() int32 {
+	if x != nil {
+		return x.ClicksLeft
+	}
+	return 0
+}
+
+func (x *UserURLItem) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UserURLItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserURLItem) GetClicks() int32 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *UserURLItem) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *UserURLItem) GetHealth() *LinkHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// LinkHealth - результат фоновой проверки оригинального URL ссылки.
+type LinkHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode   int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 0, если ответ не получен
+	LatencyMs    int64  `protobuf:"varint,2,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                                   // Ошибка запроса, пусто - ответ получен
+	CheckedAt    string `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`          // RFC 3339
+	FailingSince string `protobuf:"bytes,5,opt,name=failing_since,json=failingSince,proto3" json:"failing_since,omitempty"` // RFC 3339, начало серии неудачных проверок, пусто - цель доступна
+	Broken       bool   `protobuf:"varint,6,opt,name=broken,proto3" json:"broken,omitempty"`                                // Цель недоступна дольше допустимого
+}
+
+func (x *LinkHealth) Reset() {
+	*x = LinkHealth{}
+	mi := &file_snipurl_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkHealth) ProtoMessage() {}
+
+func (x *LinkHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkHealth.ProtoReflect.Descriptor instead.
+func (*LinkHealth) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LinkHealth) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *LinkHealth) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *LinkHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LinkHealth) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
+func (x *LinkHealth) GetFailingSince() string {
+	if x != nil {
+		return x.FailingSince
+	}
+	return ""
+}
+
+func (x *LinkHealth) GetBroken() bool {
+	if x != nil {
+		return x.Broken
+	}
+	return false
+}
+
+type UserURLsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"` // Вернуть только ссылки с этим тегом, пусто - все ссылки
+}
+
+func (x *UserURLsRequest) Reset() {
+	*x = UserURLsRequest{}
+	mi := &file_snipurl_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserURLsRequest) ProtoMessage() {}
+
+func (x *UserURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserURLsRequest.ProtoReflect.Descriptor instead.
+func (*UserURLsRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UserURLsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type ListUserURLsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag    string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Search string `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`  // Подстрока оригинального URL или заголовка без учета регистра
+	Sort   string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`      // created (по умолчанию) или clicks
+	Order  string `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`    // desc (по умолчанию) или asc
+	Limit  int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`   // Размер страницы, 0 - 100, не больше 1000
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`  // next_cursor предыдущей страницы, пусто - первая страница
+	Broken bool   `protobuf:"varint,7,opt,name=broken,proto3" json:"broken,omitempty"` // Вернуть только ссылки с недоступной целью
+}
+
+func (x *ListUserURLsRequest) Reset() {
+	*x = ListUserURLsRequest{}
+	mi := &file_snipurl_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserURLsRequest) ProtoMessage() {}
+
+func (x *ListUserURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserURLsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserURLsRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUserURLsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListUserURLsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListUserURLsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListUserURLsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListUserURLsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUserURLsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListUserURLsRequest) GetBroken() bool {
+	if x != nil {
+		return x.Broken
+	}
+	return false
+}
+
+type ListUserURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ListUserURLsResponse_Success
+	//	*ListUserURLsResponse_Error
+	Response isListUserURLsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ListUserURLsResponse) Reset() {
+	*x = ListUserURLsResponse{}
+	mi := &file_snipurl_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserURLsResponse) ProtoMessage() {}
+
+func (x *ListUserURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserURLsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserURLsResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{26}
+}
+
+func (m *ListUserURLsResponse) GetResponse() isListUserURLsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ListUserURLsResponse) GetSuccess() *SuccessListUserURLs {
+	if x, ok := x.GetResponse().(*ListUserURLsResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *ListUserURLsResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*ListUserURLsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isListUserURLsResponse_Response interface {
+	isListUserURLsResponse_Response()
+}
+
+type ListUserURLsResponse_Success struct {
+	Success *SuccessListUserURLs `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type ListUserURLsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListUserURLsResponse_Success) isListUserURLsResponse_Response() {}
+
+func (*ListUserURLsResponse_Error) isListUserURLsResponse_Response() {}
+
+type SuccessListUserURLs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     *Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Items      []*UserURLItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor string         `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Пусто на последней странице
+}
+
+func (x *SuccessListUserURLs) Reset() {
+	*x = SuccessListUserURLs{}
+	mi := &file_snipurl_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessListUserURLs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessListUserURLs) ProtoMessage() {}
+
+func (x *SuccessListUserURLs) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessListUserURLs.ProtoReflect.Descriptor instead.
+func (*SuccessListUserURLs) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SuccessListUserURLs) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessListUserURLs) GetItems() []*UserURLItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SuccessListUserURLs) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type StreamUserURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items  []*UserURLItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Cursor string         `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // Курсор для продолжения после этой порции, пусто на последней
+}
+
+func (x *StreamUserURLsResponse) Reset() {
+	*x = StreamUserURLsResponse{}
+	mi := &file_snipurl_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamUserURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUserURLsResponse) ProtoMessage() {}
+
+func (x *StreamUserURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUserURLsResponse.ProtoReflect.Descriptor instead.
+func (*StreamUserURLsResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StreamUserURLsResponse) GetItems() []*UserURLItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StreamUserURLsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ExportUserURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*UserURLItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ExportUserURLsResponse) Reset() {
+	*x = ExportUserURLsResponse{}
+	mi := &file_snipurl_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserURLsResponse) ProtoMessage() {}
+
+func (x *ExportUserURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserURLsResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserURLsResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportUserURLsResponse) GetItems() []*UserURLItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UserURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*UserURLsResponse_Success
+	//	*UserURLsResponse_Error
+	Response isUserURLsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *UserURLsResponse) Reset() {
+	*x = UserURLsResponse{}
+	mi := &file_snipurl_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserURLsResponse) ProtoMessage() {}
+
+func (x *UserURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserURLsResponse.ProtoReflect.Descriptor instead.
+func (*UserURLsResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{30}
+}
+
+func (m *UserURLsResponse) GetResponse() isUserURLsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UserURLsResponse) GetSuccess() *SuccessUserURLs {
+	if x, ok := x.GetResponse().(*UserURLsResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *UserURLsResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*UserURLsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUserURLsResponse_Response interface {
+	isUserURLsResponse_Response()
+}
+
+type UserURLsResponse_Success struct {
+	Success *SuccessUserURLs `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type UserURLsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UserURLsResponse_Success) isUserURLsResponse_Response() {}
+
+func (*UserURLsResponse_Error) isUserURLsResponse_Response() {}
+
+type SuccessUserURLs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Items  []*UserURLItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SuccessUserURLs) Reset() {
+	*x = SuccessUserURLs{}
+	mi := &file_snipurl_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessUserURLs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessUserURLs) ProtoMessage() {}
+
+func (x *SuccessUserURLs) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessUserURLs.ProtoReflect.Descriptor instead.
+func (*SuccessUserURLs) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SuccessUserURLs) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessUserURLs) GetItems() []*UserURLItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteUserURLsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UrlIds []string `protobuf:"bytes,1,rep,name=url_ids,json=urlIds,proto3" json:"url_ids,omitempty"`
+}
+
+func (x *DeleteUserURLsRequest) Reset() {
+	*x = DeleteUserURLsRequest{}
+	mi := &file_snipurl_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserURLsRequest) ProtoMessage() {}
+
+func (x *DeleteUserURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserURLsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserURLsRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteUserURLsRequest) GetUrlIds() []string {
+	if x != nil {
+		return x.UrlIds
+	}
+	return nil
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_snipurl_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type UpdateURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RedirectType *int32       `protobuf:"varint,2,opt,name=redirect_type,json=redirectType,proto3,oneof" json:"redirect_type,omitempty"` // Не задано - оставить без изменений
+	Passthrough  *bool        `protobuf:"varint,3,opt,name=passthrough,proto3,oneof" json:"passthrough,omitempty"`
+	QueryMode    *string      `protobuf:"bytes,4,opt,name=query_mode,json=queryMode,proto3,oneof" json:"query_mode,omitempty"`
+	Template     *string      `protobuf:"bytes,5,opt,name=template,proto3,oneof" json:"template,omitempty"`                           // Пустая строка - отвязать шаблон
+	Variants     *VariantList `protobuf:"bytes,6,opt,name=variants,proto3" json:"variants,omitempty"`                                 // Не задано - оставить без изменений, пустой список - убрать варианты
+	Password     *string      `protobuf:"bytes,7,opt,name=password,proto3,oneof" json:"password,omitempty"`                           // Пустая строка - снять защиту паролем
+	MaxClicks    *int32       `protobuf:"varint,8,opt,name=max_clicks,json=maxClicks,proto3,oneof" json:"max_clicks,omitempty"`       // 0 - снять лимит переходов
+	NotBefore    *string      `protobuf:"bytes,9,opt,name=not_before,json=notBefore,proto3,oneof" json:"not_before,omitempty"`        // RFC 3339, пустая строка - снять ограничение
+	NotAfter     *string      `protobuf:"bytes,10,opt,name=not_after,json=notAfter,proto3,oneof" json:"not_after,omitempty"`          // RFC 3339, пустая строка - снять ограничение
+	FallbackUrl  *string      `protobuf:"bytes,11,opt,name=fallback_url,json=fallbackUrl,proto3,oneof" json:"fallback_url,omitempty"` // Пустая строка - убрать резервный URL
+	Title        *string      `protobuf:"bytes,12,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Notes        *string      `protobuf:"bytes,13,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	Tags         *TagList     `protobuf:"bytes,14,opt,name=tags,proto3" json:"tags,omitempty"` // Не задано - оставить без изменений, пустой список - убрать теги
+}
+
+func (x *UpdateURLRequest) Reset() {
+	*x = UpdateURLRequest{}
+	mi := &file_snipurl_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateURLRequest) ProtoMessage() {}
+
+func (x *UpdateURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateURLRequest.ProtoReflect.Descriptor instead.
+func (*UpdateURLRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateURLRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetRedirectType() int32 {
+	if x != nil && x.RedirectType != nil {
+		return *x.RedirectType
+	}
+	return 0
+}
+
+func (x *UpdateURLRequest) GetPassthrough() bool {
+	if x != nil && x.Passthrough != nil {
+		return *x.Passthrough
+	}
+	return false
+}
+
+func (x *UpdateURLRequest) GetQueryMode() string {
+	if x != nil && x.QueryMode != nil {
+		return *x.QueryMode
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetTemplate() string {
+	if x != nil && x.Template != nil {
+		return *x.Template
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetVariants() *VariantList {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetMaxClicks() int32 {
+	if x != nil && x.MaxClicks != nil {
+		return *x.MaxClicks
+	}
+	return 0
+}
+
+func (x *UpdateURLRequest) GetNotBefore() string {
+	if x != nil && x.NotBefore != nil {
+		return *x.NotBefore
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetNotAfter() string {
+	if x != nil && x.NotAfter != nil {
+		return *x.NotAfter
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetFallbackUrl() string {
+	if x != nil && x.FallbackUrl != nil {
+		return *x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetTags() *TagList {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *TagList) Reset() {
+	*x = TagList{}
+	mi := &file_snipurl_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagList) ProtoMessage() {}
+
+func (x *TagList) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagList.ProtoReflect.Descriptor instead.
+func (*TagList) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TagList) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Variant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url    string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Weight int32  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	mi := &file_snipurl_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Variant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Variant) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Variant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type VariantList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Variant `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *VariantList) Reset() {
+	*x = VariantList{}
+	mi := &file_snipurl_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantList) ProtoMessage() {}
+
+func (x *VariantList) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantList.ProtoReflect.Descriptor instead.
+func (*VariantList) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VariantList) GetItems() []*Variant {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*UpdateURLResponse_Success
+	//	*UpdateURLResponse_Error
+	Response isUpdateURLResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *UpdateURLResponse) Reset() {
+	*x = UpdateURLResponse{}
+	mi := &file_snipurl_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateURLResponse) ProtoMessage() {}
+
+func (x *UpdateURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateURLResponse.ProtoReflect.Descriptor instead.
+func (*UpdateURLResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{38}
+}
+
+func (m *UpdateURLResponse) GetResponse() isUpdateURLResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UpdateURLResponse) GetSuccess() *SuccessUpdateURL {
+	if x, ok := x.GetResponse().(*UpdateURLResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *UpdateURLResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*UpdateURLResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUpdateURLResponse_Response interface {
+	isUpdateURLResponse_Response()
+}
+
+type UpdateURLResponse_Success struct {
+	Success *SuccessUpdateURL `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type UpdateURLResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UpdateURLResponse_Success) isUpdateURLResponse_Response() {}
+
+func (*UpdateURLResponse_Error) isUpdateURLResponse_Response() {}
+
+type SuccessUpdateURL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Item   *UserURLItem `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *SuccessUpdateURL) Reset() {
+	*x = SuccessUpdateURL{}
+	mi := &file_snipurl_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessUpdateURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessUpdateURL) ProtoMessage() {}
+
+func (x *SuccessUpdateURL) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessUpdateURL.ProtoReflect.Descriptor instead.
+func (*SuccessUpdateURL) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SuccessUpdateURL) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessUpdateURL) GetItem() *UserURLItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type TemplateItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UtmSource   string `protobuf:"bytes,2,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty"`
+	UtmMedium   string `protobuf:"bytes,3,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty"`
+	UtmCampaign string `protobuf:"bytes,4,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty"`
+	UtmTerm     string `protobuf:"bytes,5,opt,name=utm_term,json=utmTerm,proto3" json:"utm_term,omitempty"`
+	UtmContent  string `protobuf:"bytes,6,opt,name=utm_content,json=utmContent,proto3" json:"utm_content,omitempty"`
+}
+
+func (x *TemplateItem) Reset() {
+	*x = TemplateItem{}
+	mi := &file_snipurl_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateItem) ProtoMessage() {}
+
+func (x *TemplateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateItem.ProtoReflect.Descriptor instead.
+func (*TemplateItem) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TemplateItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateItem) GetUtmSource() string {
+	if x != nil {
+		return x.UtmSource
+	}
+	return ""
+}
+
+func (x *TemplateItem) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
+}
+
+func (x *TemplateItem) GetUtmCampaign() string {
+	if x != nil {
+		return x.UtmCampaign
+	}
+	return ""
+}
+
+func (x *TemplateItem) GetUtmTerm() string {
+	if x != nil {
+		return x.UtmTerm
+	}
+	return ""
+}
+
+func (x *TemplateItem) GetUtmContent() string {
+	if x != nil {
+		return x.UtmContent
+	}
+	return ""
+}
+
+type SetTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*SetTemplateResponse_Success
+	//	*SetTemplateResponse_Error
+	Response isSetTemplateResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *SetTemplateResponse) Reset() {
+	*x = SetTemplateResponse{}
+	mi := &file_snipurl_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTemplateResponse) ProtoMessage() {}
+
+func (x *SetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{41}
+}
+
+func (m *SetTemplateResponse) GetResponse() isSetTemplateResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SetTemplateResponse) GetSuccess() *SuccessSetTemplate {
+	if x, ok := x.GetResponse().(*SetTemplateResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *SetTemplateResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*SetTemplateResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSetTemplateResponse_Response interface {
+	isSetTemplateResponse_Response()
+}
+
+type SetTemplateResponse_Success struct {
+	Success *SuccessSetTemplate `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type SetTemplateResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SetTemplateResponse_Success) isSetTemplateResponse_Response() {}
+
+func (*SetTemplateResponse_Error) isSetTemplateResponse_Response() {}
+
+type SuccessSetTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Item   *TemplateItem `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *SuccessSetTemplate) Reset() {
+	*x = SuccessSetTemplate{}
+	mi := &file_snipurl_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessSetTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessSetTemplate) ProtoMessage() {}
+
+func (x *SuccessSetTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessSetTemplate.ProtoReflect.Descriptor instead.
+func (*SuccessSetTemplate) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SuccessSetTemplate) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessSetTemplate) GetItem() *TemplateItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ListTemplatesResponse_Success
+	//	*ListTemplatesResponse_Error
+	Response isListTemplatesResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_snipurl_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{43}
+}
+
+func (m *ListTemplatesResponse) GetResponse() isListTemplatesResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ListTemplatesResponse) GetSuccess() *SuccessListTemplates {
+	if x, ok := x.GetResponse().(*ListTemplatesResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *ListTemplatesResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*ListTemplatesResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isListTemplatesResponse_Response interface {
+	isListTemplatesResponse_Response()
+}
+
+type ListTemplatesResponse_Success struct {
+	Success *SuccessListTemplates `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type ListTemplatesResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListTemplatesResponse_Success) isListTemplatesResponse_Response() {}
+
+func (*ListTemplatesResponse_Error) isListTemplatesResponse_Response() {}
+
+type SuccessListTemplates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Items  []*TemplateItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SuccessListTemplates) Reset() {
+	*x = SuccessListTemplates{}
+	mi := &file_snipurl_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessListTemplates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessListTemplates) ProtoMessage() {}
+
+func (x *SuccessListTemplates) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessListTemplates.ProtoReflect.Descriptor instead.
+func (*SuccessListTemplates) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SuccessListTemplates) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessListTemplates) GetItems() []*TemplateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_snipurl_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WebhookItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url            string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events         []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`                                        // link.created, link.deleted, link.expired, link.clicks_threshold
+	ClickThreshold int32    `protobuf:"varint,4,opt,name=click_threshold,json=clickThreshold,proto3" json:"click_threshold,omitempty"` // Обязателен для link.clicks_threshold
+	Secret         string   `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`                                        // Только в ответе на регистрацию
+	CreatedAt      string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                 // RFC 3339
+}
+
+func (x *WebhookItem) Reset() {
+	*x = WebhookItem{}
+	mi := &file_snipurl_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookItem) ProtoMessage() {}
+
+func (x *WebhookItem) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookItem.ProtoReflect.Descriptor instead.
+func (*WebhookItem) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WebhookItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookItem) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookItem) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookItem) GetClickThreshold() int32 {
+	if x != nil {
+		return x.ClickThreshold
+	}
+	return 0
+}
+
+func (x *WebhookItem) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type WebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*WebhookResponse_Success
+	//	*WebhookResponse_Error
+	Response isWebhookResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *WebhookResponse) Reset() {
+	*x = WebhookResponse{}
+	mi := &file_snipurl_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookResponse) ProtoMessage() {}
+
+func (x *WebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookResponse.ProtoReflect.Descriptor instead.
+func (*WebhookResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{47}
+}
+
+func (m *WebhookResponse) GetResponse() isWebhookResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *WebhookResponse) GetSuccess() *SuccessWebhook {
+	if x, ok := x.GetResponse().(*WebhookResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *WebhookResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*WebhookResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isWebhookResponse_Response interface {
+	isWebhookResponse_Response()
+}
+
+type WebhookResponse_Success struct {
+	Success *SuccessWebhook `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type WebhookResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*WebhookResponse_Success) isWebhookResponse_Response() {}
+
+func (*WebhookResponse_Error) isWebhookResponse_Response() {}
+
+type SuccessWebhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Item   *WebhookItem `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *SuccessWebhook) Reset() {
+	*x = SuccessWebhook{}
+	mi := &file_snipurl_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessWebhook) ProtoMessage() {}
+
+func (x *SuccessWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessWebhook.ProtoReflect.Descriptor instead.
+func (*SuccessWebhook) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SuccessWebhook) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessWebhook) GetItem() *WebhookItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ListWebhooksResponse_Success
+	//	*ListWebhooksResponse_Error
+	Response isListWebhooksResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_snipurl_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{49}
+}
+
+func (m *ListWebhooksResponse) GetResponse() isListWebhooksResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetSuccess() *SuccessListWebhooks {
+	if x, ok := x.GetResponse().(*ListWebhooksResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*ListWebhooksResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isListWebhooksResponse_Response interface {
+	isListWebhooksResponse_Response()
+}
+
+type ListWebhooksResponse_Success struct {
+	Success *SuccessListWebhooks `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type ListWebhooksResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListWebhooksResponse_Success) isListWebhooksResponse_Response() {}
+
+func (*ListWebhooksResponse_Error) isListWebhooksResponse_Response() {}
+
+type SuccessListWebhooks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Items  []*WebhookItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SuccessListWebhooks) Reset() {
+	*x = SuccessListWebhooks{}
+	mi := &file_snipurl_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessListWebhooks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessListWebhooks) ProtoMessage() {}
+
+func (x *SuccessListWebhooks) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessListWebhooks.ProtoReflect.Descriptor instead.
+func (*SuccessListWebhooks) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SuccessListWebhooks) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessListWebhooks) GetItems() []*WebhookItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_snipurl_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID вебхука
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_snipurl_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId    string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // Общий для всех попыток доставки события
+	Event      string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Attempt    int32  `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	StatusCode int32  `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 0 - ответ не получен
+	Error      string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Success    bool   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	DurationMs int64  `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CreatedAt  string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_snipurl_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WebhookDelivery) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ListWebhookDeliveriesResponse_Success
+	//	*ListWebhookDeliveriesResponse_Error
+	Response isListWebhookDeliveriesResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_snipurl_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{54}
+}
+
+func (m *ListWebhookDeliveriesResponse) GetResponse() isListWebhookDeliveriesResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetSuccess() *SuccessListWebhookDeliveries {
+	if x, ok := x.GetResponse().(*ListWebhookDeliveriesResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*ListWebhookDeliveriesResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isListWebhookDeliveriesResponse_Response interface {
+	isListWebhookDeliveriesResponse_Response()
+}
+
+type ListWebhookDeliveriesResponse_Success struct {
+	Success *SuccessListWebhookDeliveries `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type ListWebhookDeliveriesResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListWebhookDeliveriesResponse_Success) isListWebhookDeliveriesResponse_Response() {}
+
+func (*ListWebhookDeliveriesResponse_Error) isListWebhookDeliveriesResponse_Response() {}
+
+type SuccessListWebhookDeliveries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Items  []*WebhookDelivery `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SuccessListWebhookDeliveries) Reset() {
+	*x = SuccessListWebhookDeliveries{}
+	mi := &file_snipurl_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessListWebhookDeliveries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessListWebhookDeliveries) ProtoMessage() {}
+
+func (x *SuccessListWebhookDeliveries) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessListWebhookDeliveries.ProtoReflect.Descriptor instead.
+func (*SuccessListWebhookDeliveries) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SuccessListWebhookDeliveries) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessListWebhookDeliveries) GetItems() []*WebhookDelivery {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RuleItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position  int32  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Platform  string `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`                 // ios, android, windows, macos, linux или пусто - любая
+	Language  string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`                 // Языковой тег из Accept-Language, например en
+	TimeFrom  string `protobuf:"bytes,5,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"` // HH:MM по UTC
+	TimeTo    string `protobuf:"bytes,6,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`       // HH:MM по UTC, интервал может переходить через полночь
+	TargetUrl string `protobuf:"bytes,7,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`
+	Country   string `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"` // Коды стран ISO 3166-1 через запятую, EU - любая страна ЕС
+}
+
+func (x *RuleItem) Reset() {
+	*x = RuleItem{}
+	mi := &file_snipurl_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleItem) ProtoMessage() {}
+
+func (x *RuleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleItem.ProtoReflect.Descriptor instead.
+func (*RuleItem) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RuleItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RuleItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RuleItem) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *RuleItem) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *RuleItem) GetTimeFrom() string {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return ""
+}
+
+func (x *RuleItem) GetTimeTo() string {
+	if x != nil {
+		return x.TimeTo
+	}
+	return ""
+}
+
+func (x *RuleItem) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *RuleItem) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type RuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID короткой ссылки
+	Rule *RuleItem `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *RuleRequest) Reset() {
+	*x = RuleRequest{}
+	mi := &file_snipurl_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleRequest) ProtoMessage() {}
+
+func (x *RuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleRequest.ProtoReflect.Descriptor instead.
+func (*RuleRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RuleRequest) GetRule() *RuleItem {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type RuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RuleResponse_Success
+	//	*RuleResponse_Error
+	Response isRuleResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RuleResponse) Reset() {
+	*x = RuleResponse{}
+	mi := &file_snipurl_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleResponse) ProtoMessage() {}
+
+func (x *RuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleResponse.ProtoReflect.Descriptor instead.
+func (*RuleResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{58}
+}
+
+func (m *RuleResponse) GetResponse() isRuleResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RuleResponse) GetSuccess() *SuccessRule {
+	if x, ok := x.GetResponse().(*RuleResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *RuleResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*RuleResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRuleResponse_Response interface {
+	isRuleResponse_Response()
+}
+
+type RuleResponse_Success struct {
+	Success *SuccessRule `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type RuleResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RuleResponse_Success) isRuleResponse_Response() {}
+
+func (*RuleResponse_Error) isRuleResponse_Response() {}
+
+type SuccessRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Rule   *RuleItem `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *SuccessRule) Reset() {
+	*x = SuccessRule{}
+	mi := &file_snipurl_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessRule) ProtoMessage() {}
+
+func (x *SuccessRule) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessRule.ProtoReflect.Descriptor instead.
+func (*SuccessRule) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SuccessRule) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessRule) GetRule() *RuleItem {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListRulesRequest) Reset() {
+	*x = ListRulesRequest{}
+	mi := &file_snipurl_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesRequest) ProtoMessage() {}
+
+func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListRulesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ListRulesResponse_Success
+	//	*ListRulesResponse_Error
+	Response isListRulesResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ListRulesResponse) Reset() {
+	*x = ListRulesResponse{}
+	mi := &file_snipurl_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesResponse) ProtoMessage() {}
+
+func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{61}
+}
+
+func (m *ListRulesResponse) GetResponse() isListRulesResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ListRulesResponse) GetSuccess() *SuccessListRules {
+	if x, ok := x.GetResponse().(*ListRulesResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *ListRulesResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*ListRulesResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isListRulesResponse_Response interface {
+	isListRulesResponse_Response()
+}
+
+type ListRulesResponse_Success struct {
+	Success *SuccessListRules `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type ListRulesResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListRulesResponse_Success) isListRulesResponse_Response() {}
+
+func (*ListRulesResponse_Error) isListRulesResponse_Response() {}
+
+type SuccessListRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Items  []*RuleItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SuccessListRules) Reset() {
+	*x = SuccessListRules{}
+	mi := &file_snipurl_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessListRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessListRules) ProtoMessage() {}
+
+func (x *SuccessListRules) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessListRules.ProtoReflect.Descriptor instead.
+func (*SuccessListRules) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SuccessListRules) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessListRules) GetItems() []*RuleItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId int32  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+}
+
+func (x *DeleteRuleRequest) Reset() {
+	*x = DeleteRuleRequest{}
+	mi := &file_snipurl_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteRuleRequest) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type VariantStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *VariantStatsRequest) Reset() {
+	*x = VariantStatsRequest{}
+	mi := &file_snipurl_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantStatsRequest) ProtoMessage() {}
+
+func (x *VariantStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantStatsRequest.ProtoReflect.Descriptor instead.
+func (*VariantStatsRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *VariantStatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VariantStatsItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variant *Variant `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	Served  int64    `protobuf:"varint,2,opt,name=served,proto3" json:"served,omitempty"`
+}
+
+func (x *VariantStatsItem) Reset() {
+	*x = VariantStatsItem{}
+	mi := &file_snipurl_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantStatsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantStatsItem) ProtoMessage() {}
+
+func (x *VariantStatsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantStatsItem.ProtoReflect.Descriptor instead.
+func (*VariantStatsItem) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *VariantStatsItem) GetVariant() *Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+func (x *VariantStatsItem) GetServed() int64 {
+	if x != nil {
+		return x.Served
+	}
+	return 0
+}
+
+type VariantStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*VariantStatsResponse_Success
+	//	*VariantStatsResponse_Error
+	Response isVariantStatsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *VariantStatsResponse) Reset() {
+	*x = VariantStatsResponse{}
+	mi := &file_snipurl_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantStatsResponse) ProtoMessage() {}
+
+func (x *VariantStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantStatsResponse.ProtoReflect.Descriptor instead.
+func (*VariantStatsResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{66}
+}
+
+func (m *VariantStatsResponse) GetResponse() isVariantStatsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *VariantStatsResponse) GetSuccess() *SuccessVariantStats {
+	if x, ok := x.GetResponse().(*VariantStatsResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *VariantStatsResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*VariantStatsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isVariantStatsResponse_Response interface {
+	isVariantStatsResponse_Response()
+}
+
+type VariantStatsResponse_Success struct {
+	Success *SuccessVariantStats `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type VariantStatsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*VariantStatsResponse_Success) isVariantStatsResponse_Response() {}
+
+func (*VariantStatsResponse_Error) isVariantStatsResponse_Response() {}
+
+type SuccessVariantStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Items  []*VariantStatsItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SuccessVariantStats) Reset() {
+	*x = SuccessVariantStats{}
+	mi := &file_snipurl_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessVariantStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessVariantStats) ProtoMessage() {}
+
+func (x *SuccessVariantStats) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessVariantStats.ProtoReflect.Descriptor instead.
+func (*SuccessVariantStats) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SuccessVariantStats) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessVariantStats) GetItems() []*VariantStatsItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ClickStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ClickStatsRequest) Reset() {
+	*x = ClickStatsRequest{}
+	mi := &file_snipurl_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClickStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickStatsRequest) ProtoMessage() {}
+
+func (x *ClickStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickStatsRequest.ProtoReflect.Descriptor instead.
+func (*ClickStatsRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ClickStatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ClickStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ClickStatsResponse_Success
+	//	*ClickStatsResponse_Error
+	Response isClickStatsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ClickStatsResponse) Reset() {
+	*x = ClickStatsResponse{}
+	mi := &file_snipurl_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClickStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickStatsResponse) ProtoMessage() {}
+
+func (x *ClickStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickStatsResponse.ProtoReflect.Descriptor instead.
+func (*ClickStatsResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{69}
+}
+
+func (m *ClickStatsResponse) GetResponse() isClickStatsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ClickStatsResponse) GetSuccess() *SuccessClickStats {
+	if x, ok := x.GetResponse().(*ClickStatsResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *ClickStatsResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*ClickStatsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isClickStatsResponse_Response interface {
+	isClickStatsResponse_Response()
+}
+
+type ClickStatsResponse_Success struct {
+	Success *SuccessClickStats `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type ClickStatsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ClickStatsResponse_Success) isClickStatsResponse_Response() {}
+
+func (*ClickStatsResponse_Error) isClickStatsResponse_Response() {}
+
+type SuccessClickStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    *Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Total     int64            `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ByCountry map[string]int64 `protobuf:"bytes,3,rep,name=by_country,json=byCountry,proto3" json:"by_country,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Пустой ключ - страна не определена
+}
+
+func (x *SuccessClickStats) Reset() {
+	*x = SuccessClickStats{}
+	mi := &file_snipurl_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessClickStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessClickStats) ProtoMessage() {}
+
+func (x *SuccessClickStats) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessClickStats.ProtoReflect.Descriptor instead.
+func (*SuccessClickStats) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SuccessClickStats) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessClickStats) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SuccessClickStats) GetByCountry() map[string]int64 {
+	if x != nil {
+		return x.ByCountry
+	}
+	return nil
+}
+
+type WatchClicksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WatchClicksRequest) Reset() {
+	*x = WatchClicksRequest{}
+	mi := &file_snipurl_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchClicksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchClicksRequest) ProtoMessage() {}
+
+func (x *WatchClicksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchClicksRequest.ProtoReflect.Descriptor instead.
+func (*WatchClicksRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *WatchClicksRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ClickEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortUrl string `protobuf:"bytes,1,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	Clicks   int64  `protobuf:"varint,2,opt,name=clicks,proto3" json:"clicks,omitempty"`   // Число переходов по ссылке с учетом этого
+	Country  string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`  // Пусто - страна не определена
+	Variant  string `protobuf:"bytes,4,opt,name=variant,proto3" json:"variant,omitempty"`  // Пусто - ссылка без A/B-вариантов
+	Time     string `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`        // RFC 3339
+	Dropped  uint64 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"` // Число пропущенных к этому моменту переходов
+}
+
+func (x *ClickEvent) Reset() {
+	*x = ClickEvent{}
+	mi := &file_snipurl_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClickEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickEvent) ProtoMessage() {}
+
+func (x *ClickEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickEvent.ProtoReflect.Descriptor instead.
+func (*ClickEvent) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ClickEvent) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *ClickEvent) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *ClickEvent) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type QRCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`        // png (по умолчанию) или svg
+	Size   int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`           // Размер изображения в пикселях, 0 - 256
+	Level  string `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`          // Уровень коррекции ошибок L, M (по умолчанию), Q или H
+	Margin *int32 `protobuf:"varint,5,opt,name=margin,proto3,oneof" json:"margin,omitempty"` // Ширина поля в модулях, не задано - 4
+	Etag   string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`            // ETag уже полученного клиентом QR-кода
+}
+
+func (x *QRCodeRequest) Reset() {
+	*x = QRCodeRequest{}
+	mi := &file_snipurl_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QRCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QRCodeRequest) ProtoMessage() {}
+
+func (x *QRCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QRCodeRequest.ProtoReflect.Descriptor instead.
+func (*QRCodeRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *QRCodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QRCodeRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *QRCodeRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *QRCodeRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *QRCodeRequest) GetMargin() int32 {
+	if x != nil && x.Margin != nil {
+		return *x.Margin
+	}
+	return 0
+}
+
+func (x *QRCodeRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type QRCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*QRCodeResponse_Success
+	//	*QRCodeResponse_Error
+	Response isQRCodeResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *QRCodeResponse) Reset() {
+	*x = QRCodeResponse{}
+	mi := &file_snipurl_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QRCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QRCodeResponse) ProtoMessage() {}
+
+func (x *QRCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QRCodeResponse.ProtoReflect.Descriptor instead.
+func (*QRCodeResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{74}
+}
+
+func (m *QRCodeResponse) GetResponse() isQRCodeResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *QRCodeResponse) GetSuccess() *SuccessQRCode {
+	if x, ok := x.GetResponse().(*QRCodeResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *QRCodeResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*QRCodeResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isQRCodeResponse_Response interface {
+	isQRCodeResponse_Response()
+}
+
+type QRCodeResponse_Success struct {
+	Success *SuccessQRCode `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type QRCodeResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*QRCodeResponse_Success) isQRCodeResponse_Response() {}
+
+func (*QRCodeResponse_Error) isQRCodeResponse_Response() {}
+
+type SuccessQRCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // 304, если etag запроса совпал, тогда data не заполняется
+	Data        []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType string  `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Etag        string  `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *SuccessQRCode) Reset() {
+	*x = SuccessQRCode{}
+	mi := &file_snipurl_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuccessQRCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessQRCode) ProtoMessage() {}
+
+func (x *SuccessQRCode) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessQRCode.ProtoReflect.Descriptor instead.
+func (*SuccessQRCode) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SuccessQRCode) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SuccessQRCode) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SuccessQRCode) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *SuccessQRCode) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type UserDataLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url             *UserURLItem        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Rules           []*RuleItem         `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	VariantStats    []*VariantStatsItem `protobuf:"bytes,3,rep,name=variant_stats,json=variantStats,proto3" json:"variant_stats,omitempty"`
+	ClicksTotal     int64               `protobuf:"varint,4,opt,name=clicks_total,json=clicksTotal,proto3" json:"clicks_total,omitempty"`
+	ClicksByCountry map[string]int64    `protobuf:"bytes,5,rep,name=clicks_by_country,json=clicksByCountry,proto3" json:"clicks_by_country,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Пустой ключ - страна не определена
+}
+
+func (x *UserDataLink) Reset() {
+	*x = UserDataLink{}
+	mi := &file_snipurl_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataLink) ProtoMessage() {}
+
+func (x *UserDataLink) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataLink.ProtoReflect.Descriptor instead.
+func (*UserDataLink) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UserDataLink) GetUrl() *UserURLItem {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *UserDataLink) GetRules() []*RuleItem {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *UserDataLink) GetVariantStats() []*VariantStatsItem {
+	if x != nil {
+		return x.VariantStats
+	}
+	return nil
+}
+
+func (x *UserDataLink) GetClicksTotal() int64 {
+	if x != nil {
+		return x.ClicksTotal
+	}
+	return 0
+}
+
+func (x *UserDataLink) GetClicksByCountry() map[string]int64 {
+	if x != nil {
+		return x.ClicksByCountry
+	}
+	return nil
+}
+
+type UserDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       string          `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Только в первом сообщении
+	Templates    []*TemplateItem `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`         // Только в первом сообщении
+	Links        []*UserDataLink `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty"`
+	Webhooks     []*WebhookItem  `protobuf:"bytes,4,rep,name=webhooks,proto3" json:"webhooks,omitempty"`                             // Только в первом сообщении, без секрета
+	AuditEntries []*AuditEntry   `protobuf:"bytes,5,rep,name=audit_entries,json=auditEntries,proto3" json:"audit_entries,omitempty"` // Только в первом сообщении, новые записи первыми
+}
+
+func (x *UserDataResponse) Reset() {
+	*x = UserDataResponse{}
+	mi := &file_snipurl_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataResponse) ProtoMessage() {}
+
+func (x *UserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataResponse.ProtoReflect.Descriptor instead.
+func (*UserDataResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UserDataResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDataResponse) GetTemplates() []*TemplateItem {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *UserDataResponse) GetLinks() []*UserDataLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *UserDataResponse) GetWebhooks() []*WebhookItem {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *UserDataResponse) GetAuditEntries() []*AuditEntry {
+	if x != nil {
+		return x.AuditEntries
+	}
+	return nil
+}
+
+type ErasureJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ErasureJobRequest) Reset() {
+	*x = ErasureJobRequest{}
+	mi := &file_snipurl_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErasureJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasureJobRequest) ProtoMessage() {}
+
+func (x *ErasureJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasureJobRequest.ProtoReflect.Descriptor instead.
+func (*ErasureJobRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ErasureJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ErasureJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status     string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                           // pending, done или failed
+	Error      string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                             // Причина неудачи для failed
+	CreatedAt  string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // RFC 3339
+	FinishedAt string `protobuf:"bytes,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // RFC 3339, пусто - задача не завершена
+}
+
+func (x *ErasureJob) Reset() {
+	*x = ErasureJob{}
+	mi := &file_snipurl_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErasureJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasureJob) ProtoMessage() {}
+
+func (x *ErasureJob) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasureJob.ProtoReflect.Descriptor instead.
+func (*ErasureJob) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ErasureJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ErasureJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ErasureJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ErasureJob) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ErasureJob) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+type ErasureJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ErasureJobResponse_Success
+	//	*ErasureJobResponse_Error
+	Response isErasureJobResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *BatchCreateResponse) Reset() {
-	*x = BatchCreateResponse{}
-	mi := &file_snipurl_proto_msgTypes[14]
+func (x *ErasureJobResponse) Reset() {
+	*x = ErasureJobResponse{}
+	mi := &file_snipurl_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchCreateResponse) String() string {
+func (x *ErasureJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCreateResponse) ProtoMessage() {}
+func (*ErasureJobResponse) ProtoMessage() {}
 
-func (x *BatchCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[14]
+func (x *ErasureJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,72 +5737,72 @@ func (x *BatchCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCreateResponse.ProtoReflect.Descriptor instead.
-func (*BatchCreateResponse) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ErasureJobResponse.ProtoReflect.Descriptor instead.
+func (*ErasureJobResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{80}
 }
 
-func (m *BatchCreateResponse) GetResponse() isBatchCreateResponse_Response {
+func (m *ErasureJobResponse) GetResponse() isErasureJobResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *BatchCreateResponse) GetSuccess() *SuccessBatchCreate {
-	if x, ok := x.GetResponse().(*BatchCreateResponse_Success); ok {
+func (x *ErasureJobResponse) GetSuccess() *SuccessErasureJob {
+	if x, ok := x.GetResponse().(*ErasureJobResponse_Success); ok {
 		return x.Success
 	}
 	return nil
 }
 
-func (x *BatchCreateResponse) GetError() *Error {
-	if x, ok := x.GetResponse().(*BatchCreateResponse_Error); ok {
+func (x *ErasureJobResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*ErasureJobResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isBatchCreateResponse_Response interface {
-	isBatchCreateResponse_Response()
+type isErasureJobResponse_Response interface {
+	isErasureJobResponse_Response()
 }
 
-type BatchCreateResponse_Success struct {
-	Success *SuccessBatchCreate `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+type ErasureJobResponse_Success struct {
+	Success *SuccessErasureJob `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
 }
 
-type BatchCreateResponse_Error struct {
+type ErasureJobResponse_Error struct {
 	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*BatchCreateResponse_Success) isBatchCreateResponse_Response() {}
+func (*ErasureJobResponse_Success) isErasureJobResponse_Response() {}
 
-func (*BatchCreateResponse_Error) isBatchCreateResponse_Response() {}
+func (*ErasureJobResponse_Error) isErasureJobResponse_Response() {}
 
-type SuccessBatchCreate struct {
+type SuccessErasureJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status                    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Items  []*BatchCreateResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Status *Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Job    *ErasureJob `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *SuccessBatchCreate) Reset() {
-	*x = SuccessBatchCreate{}
-	mi := &file_snipurl_proto_msgTypes[15]
+func (x *SuccessErasureJob) Reset() {
+	*x = SuccessErasureJob{}
+	mi := &file_snipurl_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuccessBatchCreate) String() string {
+func (x *SuccessErasureJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuccessBatchCreate) ProtoMessage() {}
+func (*SuccessErasureJob) ProtoMessage() {}
 
-func (x *SuccessBatchCreate) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[15]
+func (x *SuccessErasureJob) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,49 +5813,48 @@ func (x *SuccessBatchCreate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuccessBatchCreate.ProtoReflect.Descriptor instead.
-func (*SuccessBatchCreate) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SuccessErasureJob.ProtoReflect.Descriptor instead.
+func (*SuccessErasureJob) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *SuccessBatchCreate) GetStatus() *Status {
+func (x *SuccessErasureJob) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *SuccessBatchCreate) GetItems() []*BatchCreateResponseItem {
+func (x *SuccessErasureJob) GetJob() *ErasureJob {
 	if x != nil {
-		return x.Items
+		return x.Job
 	}
 	return nil
 }
 
-type UserURLItem struct {
+type PingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShortUrl    string `protobuf:"bytes,1,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
-	OriginalUrl string `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UserURLItem) Reset() {
-	*x = UserURLItem{}
-	mi := &file_snipurl_proto_msgTypes[16]
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_snipurl_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserURLItem) String() string {
+func (x *PingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserURLItem) ProtoMessage() {}
+func (*PingResponse) ProtoMessage() {}
 
-func (x *UserURLItem) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[16]
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -967,52 +5865,45 @@ func (x *UserURLItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserURLItem.ProtoReflect.Descriptor instead.
-func (*UserURLItem) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *UserURLItem) GetShortUrl() string {
-	if x != nil {
-		return x.ShortUrl
-	}
-	return ""
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *UserURLItem) GetOriginalUrl() string {
+func (x *PingResponse) GetStatus() *Status {
 	if x != nil {
-		return x.OriginalUrl
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type UserURLsResponse struct {
+type StatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*UserURLsResponse_Success
-	//	*UserURLsResponse_Error
-	Response isUserURLsResponse_Response `protobuf_oneof:"response"`
+	//	*StatsResponse_Success
+	//	*StatsResponse_Error
+	Response isStatsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *UserURLsResponse) Reset() {
-	*x = UserURLsResponse{}
-	mi := &file_snipurl_proto_msgTypes[17]
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	mi := &file_snipurl_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserURLsResponse) String() string {
+func (x *StatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserURLsResponse) ProtoMessage() {}
+func (*StatsResponse) ProtoMessage() {}
 
-func (x *UserURLsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[17]
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,72 +5914,72 @@ func (x *UserURLsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserURLsResponse.ProtoReflect.Descriptor instead.
-func (*UserURLsResponse) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{83}
 }
 
-func (m *UserURLsResponse) GetResponse() isUserURLsResponse_Response {
+func (m *StatsResponse) GetResponse() isStatsResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *UserURLsResponse) GetSuccess() *SuccessUserURLs {
-	if x, ok := x.GetResponse().(*UserURLsResponse_Success); ok {
+func (x *StatsResponse) GetSuccess() *SuccessStats {
+	if x, ok := x.GetResponse().(*StatsResponse_Success); ok {
 		return x.Success
 	}
 	return nil
 }
 
-func (x *UserURLsResponse) GetError() *Error {
-	if x, ok := x.GetResponse().(*UserURLsResponse_Error); ok {
+func (x *StatsResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*StatsResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isUserURLsResponse_Response interface {
-	isUserURLsResponse_Response()
+type isStatsResponse_Response interface {
+	isStatsResponse_Response()
 }
 
-type UserURLsResponse_Success struct {
-	Success *SuccessUserURLs `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+type StatsResponse_Success struct {
+	Success *SuccessStats `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
 }
 
-type UserURLsResponse_Error struct {
+type StatsResponse_Error struct {
 	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*UserURLsResponse_Success) isUserURLsResponse_Response() {}
+func (*StatsResponse_Success) isStatsResponse_Response() {}
 
-func (*UserURLsResponse_Error) isUserURLsResponse_Response() {}
+func (*StatsResponse_Error) isStatsResponse_Response() {}
 
-type SuccessUserURLs struct {
+type SuccessStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Items  []*UserURLItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Status *Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data   *StatsData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *SuccessUserURLs) Reset() {
-	*x = SuccessUserURLs{}
-	mi := &file_snipurl_proto_msgTypes[18]
+func (x *SuccessStats) Reset() {
+	*x = SuccessStats{}
+	mi := &file_snipurl_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuccessUserURLs) String() string {
+func (x *SuccessStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuccessUserURLs) ProtoMessage() {}
+func (*SuccessStats) ProtoMessage() {}
 
-func (x *SuccessUserURLs) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[18]
+func (x *SuccessStats) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,48 +5990,107 @@ func (x *SuccessUserURLs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuccessUserURLs.ProtoReflect.Descriptor instead.
-func (*SuccessUserURLs) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SuccessStats.ProtoReflect.Descriptor instead.
+func (*SuccessStats) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *SuccessUserURLs) GetStatus() *Status {
+func (x *SuccessStats) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *SuccessUserURLs) GetItems() []*UserURLItem {
+func (x *SuccessStats) GetData() *StatsData {
 	if x != nil {
-		return x.Items
+		return x.Data
+	}
+	return nil
+}
+
+type StatsData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Urls  int32 `protobuf:"varint,1,opt,name=urls,proto3" json:"urls,omitempty"`
+	Users int32 `protobuf:"varint,2,opt,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *StatsData) Reset() {
+	*x = StatsData{}
+	mi := &file_snipurl_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsData) ProtoMessage() {}
+
+func (x *StatsData) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsData.ProtoReflect.Descriptor instead.
+func (*StatsData) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *StatsData) GetUrls() int32 {
+	if x != nil {
+		return x.Urls
+	}
+	return 0
+}
+
+func (x *StatsData) GetUsers() int32 {
+	if x != nil {
+		return x.Users
 	}
-	return nil
+	return 0
 }
 
-type DeleteUserURLsRequest struct {
+type AuditLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UrlIds []string `protobuf:"bytes,1,rep,name=url_ids,json=urlIds,proto3" json:"url_ids,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Только для GetAuditLog
+	ShortUrl string `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	Action   string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`  // create, update или delete
+	From     string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`      // RFC 3339
+	To       string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`          // RFC 3339, не включая
+	Limit    int32  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`   // Размер страницы, 0 - 100, не больше 1000
+	Cursor   int64  `protobuf:"varint,7,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor предыдущей страницы, 0 - первая страница
 }
 
-func (x *DeleteUserURLsRequest) Reset() {
-	*x = DeleteUserURLsRequest{}
-	mi := &file_snipurl_proto_msgTypes[19]
+func (x *AuditLogRequest) Reset() {
+	*x = AuditLogRequest{}
+	mi := &file_snipurl_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserURLsRequest) String() string {
+func (x *AuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserURLsRequest) ProtoMessage() {}
+func (*AuditLogRequest) ProtoMessage() {}
 
-func (x *DeleteUserURLsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[19]
+func (x *AuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,86 +6101,89 @@ func (x *DeleteUserURLsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserURLsRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserURLsRequest) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use AuditLogRequest.ProtoReflect.Descriptor instead.
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DeleteUserURLsRequest) GetUrlIds() []string {
+func (x *AuditLogRequest) GetUserId() string {
 	if x != nil {
-		return x.UrlIds
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type DeleteResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+func (x *AuditLogRequest) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
 }
 
-func (x *DeleteResponse) Reset() {
-	*x = DeleteResponse{}
-	mi := &file_snipurl_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *DeleteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditLogRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
 }
 
-func (*DeleteResponse) ProtoMessage() {}
-
-func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[20]
+func (x *AuditLogRequest) GetTo() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.To
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{20}
+func (x *AuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *DeleteResponse) GetStatus() *Status {
+func (x *AuditLogRequest) GetCursor() int64 {
 	if x != nil {
-		return x.Status
+		return x.Cursor
 	}
-	return nil
+	return 0
 }
 
-type PingResponse struct {
+type AuditEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action     string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	ShortUrl   string `protobuf:"bytes,4,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	Ip         string `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	AuthMethod string `protobuf:"bytes,6,opt,name=auth_method,json=authMethod,proto3" json:"auth_method,omitempty"` // cookie, metadata или issued - ID выдан с этим запросом
+	CreatedAt  string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // RFC 3339
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
-	mi := &file_snipurl_proto_msgTypes[21]
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_snipurl_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingResponse) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[21]
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,45 +6194,87 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *PingResponse) GetStatus() *Status {
+func (x *AuditEntry) GetId() int64 {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type StatsResponse struct {
+func (x *AuditEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAuthMethod() string {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AuditLogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
 	//
-	//	*StatsResponse_Success
-	//	*StatsResponse_Error
-	Response isStatsResponse_Response `protobuf_oneof:"response"`
+	//	*AuditLogResponse_Success
+	//	*AuditLogResponse_Error
+	Response isAuditLogResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *StatsResponse) Reset() {
-	*x = StatsResponse{}
-	mi := &file_snipurl_proto_msgTypes[22]
+func (x *AuditLogResponse) Reset() {
+	*x = AuditLogResponse{}
+	mi := &file_snipurl_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsResponse) String() string {
+func (x *AuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResponse) ProtoMessage() {}
+func (*AuditLogResponse) ProtoMessage() {}
 
-func (x *StatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[22]
+func (x *AuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,72 +6285,73 @@ func (x *StatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
-func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AuditLogResponse.ProtoReflect.Descriptor instead.
+func (*AuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{88}
 }
 
-func (m *StatsResponse) GetResponse() isStatsResponse_Response {
+func (m *AuditLogResponse) GetResponse() isAuditLogResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *StatsResponse) GetSuccess() *SuccessStats {
-	if x, ok := x.GetResponse().(*StatsResponse_Success); ok {
+func (x *AuditLogResponse) GetSuccess() *SuccessAuditLog {
+	if x, ok := x.GetResponse().(*AuditLogResponse_Success); ok {
 		return x.Success
 	}
 	return nil
 }
 
-func (x *StatsResponse) GetError() *Error {
-	if x, ok := x.GetResponse().(*StatsResponse_Error); ok {
+func (x *AuditLogResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*AuditLogResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isStatsResponse_Response interface {
-	isStatsResponse_Response()
+type isAuditLogResponse_Response interface {
+	isAuditLogResponse_Response()
 }
 
-type StatsResponse_Success struct {
-	Success *SuccessStats `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+type AuditLogResponse_Success struct {
+	Success *SuccessAuditLog `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
 }
 
-type StatsResponse_Error struct {
+type AuditLogResponse_Error struct {
 	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*StatsResponse_Success) isStatsResponse_Response() {}
+func (*AuditLogResponse_Success) isAuditLogResponse_Response() {}
 
-func (*StatsResponse_Error) isStatsResponse_Response() {}
+func (*AuditLogResponse_Error) isAuditLogResponse_Response() {}
 
-type SuccessStats struct {
+type SuccessAuditLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Data   *StatsData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Status     *Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Entries    []*AuditEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor int64         `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 0 на последней странице
 }
 
-func (x *SuccessStats) Reset() {
-	*x = SuccessStats{}
-	mi := &file_snipurl_proto_msgTypes[23]
+func (x *SuccessAuditLog) Reset() {
+	*x = SuccessAuditLog{}
+	mi := &file_snipurl_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuccessStats) String() string {
+func (x *SuccessAuditLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuccessStats) ProtoMessage() {}
+func (*SuccessAuditLog) ProtoMessage() {}
 
-func (x *SuccessStats) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[23]
+func (x *SuccessAuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_snipurl_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,74 +6362,28 @@ func (x *SuccessStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuccessStats.ProtoReflect.Descriptor instead.
-func (*SuccessStats) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SuccessAuditLog.ProtoReflect.Descriptor instead.
+func (*SuccessAuditLog) Descriptor() ([]byte, []int) {
+	return file_snipurl_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *SuccessStats) GetStatus() *Status {
+func (x *SuccessAuditLog) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *SuccessStats) GetData() *StatsData {
+func (x *SuccessAuditLog) GetEntries() []*AuditEntry {
 	if x != nil {
-		return x.Data
+		return x.Entries
 	}
 	return nil
 }
 
-type StatsData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Urls  int32 `protobuf:"varint,1,opt,name=urls,proto3" json:"urls,omitempty"`
-	Users int32 `protobuf:"varint,2,opt,name=users,proto3" json:"users,omitempty"`
-}
-
-func (x *StatsData) Reset() {
-	*x = StatsData{}
-	mi := &file_snipurl_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *StatsData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StatsData) ProtoMessage() {}
-
-func (x *StatsData) ProtoReflect() protoreflect.Message {
-	mi := &file_snipurl_proto_msgTypes[24]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StatsData.ProtoReflect.Descriptor instead.
-func (*StatsData) Descriptor() ([]byte, []int) {
-	return file_snipurl_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *StatsData) GetUrls() int32 {
-	if x != nil {
-		return x.Urls
-	}
-	return 0
-}
-
-func (x *StatsData) GetUsers() int32 {
+func (x *SuccessAuditLog) GetNextCursor() int64 {
 	if x != nil {
-		return x.Users
+		return x.NextCursor
 	}
 	return 0
 }
@@ -1451,171 +6401,950 @@ var file_snipurl_proto_rawDesc = []byte{
 	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
 	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x23, 0x0a, 0x0f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x6c, 0x22, 0x7c, 0x0a, 0x10, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x69, 0x70,
-	0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74,
-	0x55, 0x52, 0x4c, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x57, 0x0a, 0x0f, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x1c, 0x0a, 0x0a, 0x53,
-	0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x4f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x48,
-	0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70,
-	0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60,
-	0x0a, 0x12, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x6c, 0x55, 0x52, 0x4c, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c,
-	0x22, 0x27, 0x0a, 0x13, 0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x4a, 0x73,
-	0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52,
-	0x4c, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e,
-	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x5b, 0x0a, 0x13, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x53,
-	0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72,
-	0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x58, 0x0a,
-	0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0x41, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
-	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x52, 0x4c, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0xad, 0x03, 0x0a, 0x0f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61,
+	0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x6e, 0x69, 0x70,
+	0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22,
+	0x7c, 0x0a, 0x10, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x48, 0x00,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75,
+	0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a,
+	0x0f, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c,
+	0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xe1, 0x01, 0x0a, 0x0a, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x55, 0x52, 0x4c, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c,
+	0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9f, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55,
+	0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x22, 0x20, 0x0a, 0x0e, 0x55, 0x52, 0x4c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x7a, 0x0a, 0x0f, 0x55, 0x52, 0x4c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72,
+	0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x52, 0x4c, 0x49, 0x6e, 0x66, 0x6f,
+	0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xb8, 0x02, 0x0a, 0x0e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x52, 0x4c, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69,
+	0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b,
+	0x73, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x61, 0x66, 0x65,
+	0x74, 0x79, 0x52, 0x06, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x22, 0x3c, 0x0a, 0x06, 0x53, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0xb1, 0x03, 0x0a, 0x13, 0x4a, 0x73, 0x6f,
+	0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x74,
+	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x61,
+	0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x84, 0x01, 0x0a,
+	0x14, 0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x55, 0x52, 0x4c, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4a, 0x73,
+	0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c,
+	0x22, 0xe2, 0x03, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75,
+	0x67, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63,
+	0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x41, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x79, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x12, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x98, 0x01, 0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
-	0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70,
-	0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75,
-	0x0a, 0x12, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
-	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4d, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c,
-	0x49, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72,
-	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x6c, 0x55, 0x72, 0x6c, 0x22, 0x7c, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x69, 0x70,
-	0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x55,
-	0x52, 0x4c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x66, 0x0a, 0x0f, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65,
-	0x72, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a,
-	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x30, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x39, 0x0a, 0x0e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6e, 0x69, 0x70,
+	0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x11, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x22, 0xf8, 0x04, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72,
+	0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68,
+	0x72, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x61, 0x73,
+	0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0xbe, 0x01, 0x0a,
+	0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x23, 0x0a,
+	0x0f, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x22, 0xaf, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x72,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x13,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x52, 0x4c, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e,
+	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x5c, 0x0a, 0x16, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x52, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x7c, 0x0a,
+	0x10, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x0f, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x27,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
 	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x30, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x72, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x72, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x39, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72,
 	0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x76, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x0c, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x22, 0x95, 0x05, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0c,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f,
+	0x75, 0x67, 0x68, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x08, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x04, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x6e, 0x6f, 0x74,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08,
+	0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x54,
+	0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x5f, 0x75, 0x72, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x1f, 0x0a, 0x07, 0x54, 0x61, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x47, 0x0a, 0x07, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x35, 0x0a, 0x0b, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x7e, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x10, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x27, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0xbf, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x74, 0x6d, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x74, 0x6d, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x74, 0x6d, 0x5f, 0x6d, 0x65, 0x64, 0x69,
+	0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x74, 0x6d, 0x4d, 0x65, 0x64,
+	0x69, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x74, 0x6d, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x74, 0x6d, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x74, 0x6d, 0x5f, 0x74, 0x65,
+	0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x74, 0x6d, 0x54, 0x65, 0x72,
+	0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x74, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x74, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6e,
+	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x65, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x12, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x22, 0x86, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x7a, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x48, 0x00, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x0e, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x27, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0x84, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x13, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x27,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfd, 0x01, 0x0a, 0x0f,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x1c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xdd, 0x01,
+	0x0a, 0x08, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69,
+	0x6d, 0x65, 0x54, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x44, 0x0a,
+	0x0b, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x22, 0x74, 0x0a, 0x0c, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x0b, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75,
 	0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x09, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x32, 0xc2, 0x04, 0x0a, 0x0e, 0x53, 0x6e, 0x69, 0x70, 0x55, 0x52, 0x4c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x18, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
-	0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55,
-	0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x13, 0x2e, 0x73,
-	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x49,
-	0x44, 0x1a, 0x1c, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x51, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52,
-	0x4c, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
-	0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x4a, 0x73,
-	0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x6e, 0x69,
-	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72,
-	0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x55, 0x52, 0x4c, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x73,
-	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x6e, 0x69, 0x70,
-	0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52,
-	0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x6e, 0x69, 0x70,
-	0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x50, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
-	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7e, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72,
+	0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x10,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75,
+	0x72, 0x6c, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x3c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0x25, 0x0a, 0x13, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x10, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x07, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x07,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x22,
+	0x84, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6e, 0x69, 0x70,
+	0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x13, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x80, 0x01, 0x0a,
+	0x12, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xda, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x69, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75,
+	0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x2e, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x62, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x24, 0x0a, 0x12,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x51, 0x52, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x06,
+	0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06,
+	0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x22, 0x78, 0x0a, 0x0e, 0x51, 0x52, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6e,
+	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x51, 0x52, 0x43,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x51, 0x52,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0xde, 0x02, 0x0a, 0x0c, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c,
+	0x69, 0x63, 0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x56, 0x0a,
+	0x11, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75,
+	0x72, 0x6c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x2e,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x1a, 0x42, 0x0a, 0x14, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x42,
+	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf9, 0x01, 0x0a, 0x10, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6e,
+	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e,
+	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x45,
+	0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x45, 0x72, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x48, 0x00, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x11, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x12,
+	0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22,
+	0x37, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x76, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e,
+	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x5f, 0x0a, 0x0c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72,
+	0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x35, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x75, 0x72,
+	0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55,
+	0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xba, 0x01, 0x0a,
+	0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7c, 0x0a, 0x10, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0f, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x27, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e,
+	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x32, 0x96, 0x13, 0x0a, 0x0e, 0x53, 0x6e, 0x69, 0x70, 0x55, 0x52, 0x4c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x18, 0x2e, 0x73, 0x6e, 0x69, 0x70,
+	0x75, 0x72, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c,
+	0x12, 0x13, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x55, 0x52, 0x4c, 0x49, 0x44, 0x1a, 0x1c, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x17, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75,
+	0x72, 0x6c, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x12,
+	0x1b, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
+	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x15, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55,
+	0x52, 0x4c, 0x73, 0x12, 0x15, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x55, 0x52, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x26, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52,
+	0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75,
+	0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x6e, 0x69, 0x70,
+	0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72,
+	0x6c, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19,
+	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x09, 0x45,
+	0x72, 0x61, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1b, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x1a,
+	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x2e, 0x73, 0x6e, 0x69,
+	0x70, 0x75, 0x72, 0x6c, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c,
+	0x73, 0x12, 0x1e, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72,
+	0x6c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x0b, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x1a, 0x1c, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53,
+	0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x73, 0x6e,
+	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x2e, 0x73, 0x6e, 0x69, 0x70,
+	0x75, 0x72, 0x6c, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x1a,
+	0x18, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1d, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x47, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x1d, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x6e, 0x69, 0x70,
+	0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x2e, 0x73,
+	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x6e,
+	0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c,
+	0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73,
+	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x73,
+	0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75,
+	0x72, 0x6c, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c,
+	0x69, 0x63, 0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x43, 0x6c, 0x69, 0x63,
+	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x51,
+	0x52, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e,
+	0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c,
+	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x16, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75,
+	0x72, 0x6c, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x6e, 0x69, 0x70, 0x75, 0x72, 0x6c, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a,
+	0x0a, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1630,82 +7359,283 @@ func file_snipurl_proto_rawDescGZIP() []byte {
 	return file_snipurl_proto_rawDescData
 }
 
-var file_snipurl_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_snipurl_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
 var file_snipurl_proto_goTypes = []any{
-	(*Status)(nil),                  // 0: snipurl.Status
-	(*Error)(nil),                   // 1: snipurl.Error
-	(*ShortURLRequest)(nil),         // 2: snipurl.ShortURLRequest
-	(*ShortURLResponse)(nil),        // 3: snipurl.ShortURLResponse
-	(*SuccessShortURL)(nil),         // 4: snipurl.SuccessShortURL
-	(*ShortURLID)(nil),              // 5: snipurl.ShortURLID
-	(*OriginalURLResponse)(nil),     // 6: snipurl.OriginalURLResponse
-	(*SuccessOriginalURL)(nil),      // 7: snipurl.SuccessOriginalURL
-	(*JsonShortURLRequest)(nil),     // 8: snipurl.JsonShortURLRequest
-	(*JsonShortURLResponse)(nil),    // 9: snipurl.JsonShortURLResponse
-	(*SuccessJsonShortURL)(nil),     // 10: snipurl.SuccessJsonShortURL
-	(*BatchURLItem)(nil),            // 11: snipurl.BatchURLItem
-	(*BatchCreateRequest)(nil),      // 12: snipurl.BatchCreateRequest
-	(*BatchCreateResponseItem)(nil), // 13: snipurl.BatchCreateResponseItem
-	(*BatchCreateResponse)(nil),     // 14: snipurl.BatchCreateResponse
-	(*SuccessBatchCreate)(nil),      // 15: snipurl.SuccessBatchCreate
-	(*UserURLItem)(nil),             // 16: snipurl.UserURLItem
-	(*UserURLsResponse)(nil),        // 17: snipurl.UserURLsResponse
-	(*SuccessUserURLs)(nil),         // 18: snipurl.SuccessUserURLs
-	(*DeleteUserURLsRequest)(nil),   // 19: snipurl.DeleteUserURLsRequest
-	(*DeleteResponse)(nil),          // 20: snipurl.DeleteResponse
-	(*PingResponse)(nil),            // 21: snipurl.PingResponse
-	(*StatsResponse)(nil),           // 22: snipurl.StatsResponse
-	(*SuccessStats)(nil),            // 23: snipurl.SuccessStats
-	(*StatsData)(nil),               // 24: snipurl.StatsData
-	(*emptypb.Empty)(nil),           // 25: google.protobuf.Empty
+	(*Status)(nil),                        // 0: snipurl.Status
+	(*Error)(nil),                         // 1: snipurl.Error
+	(*ShortURLRequest)(nil),               // 2: snipurl.ShortURLRequest
+	(*ShortURLResponse)(nil),              // 3: snipurl.ShortURLResponse
+	(*SuccessShortURL)(nil),               // 4: snipurl.SuccessShortURL
+	(*ShortURLID)(nil),                    // 5: snipurl.ShortURLID
+	(*OriginalURLResponse)(nil),           // 6: snipurl.OriginalURLResponse
+	(*SuccessOriginalURL)(nil),            // 7: snipurl.SuccessOriginalURL
+	(*URLInfoRequest)(nil),                // 8: snipurl.URLInfoRequest
+	(*URLInfoResponse)(nil),               // 9: snipurl.URLInfoResponse
+	(*SuccessURLInfo)(nil),                // 10: snipurl.SuccessURLInfo
+	(*Safety)(nil),                        // 11: snipurl.Safety
+	(*JsonShortURLRequest)(nil),           // 12: snipurl.JsonShortURLRequest
+	(*JsonShortURLResponse)(nil),          // 13: snipurl.JsonShortURLResponse
+	(*SuccessJsonShortURL)(nil),           // 14: snipurl.SuccessJsonShortURL
+	(*BatchURLItem)(nil),                  // 15: snipurl.BatchURLItem
+	(*BatchCreateRequest)(nil),            // 16: snipurl.BatchCreateRequest
+	(*BatchCreateResponseItem)(nil),       // 17: snipurl.BatchCreateResponseItem
+	(*BatchCreateResponse)(nil),           // 18: snipurl.BatchCreateResponse
+	(*SuccessBatchCreate)(nil),            // 19: snipurl.SuccessBatchCreate
+	(*StreamCreateShortURLsResponse)(nil), // 20: snipurl.StreamCreateShortURLsResponse
+	(*StreamCreateError)(nil),             // 21: snipurl.StreamCreateError
+	(*UserURLItem)(nil),                   // 22: snipurl.UserURLItem
+	(*LinkHealth)(nil),                    // 23: snipurl.LinkHealth
+	(*UserURLsRequest)(nil),               // 24: snipurl.UserURLsRequest
+	(*ListUserURLsRequest)(nil),           // 25: snipurl.ListUserURLsRequest
+	(*ListUserURLsResponse)(nil),          // 26: snipurl.ListUserURLsResponse
+	(*SuccessListUserURLs)(nil),           // 27: snipurl.SuccessListUserURLs
+	(*StreamUserURLsResponse)(nil),        // 28: snipurl.StreamUserURLsResponse
+	(*ExportUserURLsResponse)(nil),        // 29: snipurl.ExportUserURLsResponse
+	(*UserURLsResponse)(nil),              // 30: snipurl.UserURLsResponse
+	(*SuccessUserURLs)(nil),               // 31: snipurl.SuccessUserURLs
+	(*DeleteUserURLsRequest)(nil),         // 32: snipurl.DeleteUserURLsRequest
+	(*DeleteResponse)(nil),                // 33: snipurl.DeleteResponse
+	(*UpdateURLRequest)(nil),              // 34: snipurl.UpdateURLRequest
+	(*TagList)(nil),                       // 35: snipurl.TagList
+	(*Variant)(nil),                       // 36: snipurl.Variant
+	(*VariantList)(nil),                   // 37: snipurl.VariantList
+	(*UpdateURLResponse)(nil),             // 38: snipurl.UpdateURLResponse
+	(*SuccessUpdateURL)(nil),              // 39: snipurl.SuccessUpdateURL
+	(*TemplateItem)(nil),                  // 40: snipurl.TemplateItem
+	(*SetTemplateResponse)(nil),           // 41: snipurl.SetTemplateResponse
+	(*SuccessSetTemplate)(nil),            // 42: snipurl.SuccessSetTemplate
+	(*ListTemplatesResponse)(nil),         // 43: snipurl.ListTemplatesResponse
+	(*SuccessListTemplates)(nil),          // 44: snipurl.SuccessListTemplates
+	(*DeleteTemplateRequest)(nil),         // 45: snipurl.DeleteTemplateRequest
+	(*WebhookItem)(nil),                   // 46: snipurl.WebhookItem
+	(*WebhookResponse)(nil),               // 47: snipurl.WebhookResponse
+	(*SuccessWebhook)(nil),                // 48: snipurl.SuccessWebhook
+	(*ListWebhooksResponse)(nil),          // 49: snipurl.ListWebhooksResponse
+	(*SuccessListWebhooks)(nil),           // 50: snipurl.SuccessListWebhooks
+	(*DeleteWebhookRequest)(nil),          // 51: snipurl.DeleteWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),  // 52: snipurl.ListWebhookDeliveriesRequest
+	(*WebhookDelivery)(nil),               // 53: snipurl.WebhookDelivery
+	(*ListWebhookDeliveriesResponse)(nil), // 54: snipurl.ListWebhookDeliveriesResponse
+	(*SuccessListWebhookDeliveries)(nil),  // 55: snipurl.SuccessListWebhookDeliveries
+	(*RuleItem)(nil),                      // 56: snipurl.RuleItem
+	(*RuleRequest)(nil),                   // 57: snipurl.RuleRequest
+	(*RuleResponse)(nil),                  // 58: snipurl.RuleResponse
+	(*SuccessRule)(nil),                   // 59: snipurl.SuccessRule
+	(*ListRulesRequest)(nil),              // 60: snipurl.ListRulesRequest
+	(*ListRulesResponse)(nil),             // 61: snipurl.ListRulesResponse
+	(*SuccessListRules)(nil),              // 62: snipurl.SuccessListRules
+	(*DeleteRuleRequest)(nil),             // 63: snipurl.DeleteRuleRequest
+	(*VariantStatsRequest)(nil),           // 64: snipurl.VariantStatsRequest
+	(*VariantStatsItem)(nil),              // 65: snipurl.VariantStatsItem
+	(*VariantStatsResponse)(nil),          // 66: snipurl.VariantStatsResponse
+	(*SuccessVariantStats)(nil),           // 67: snipurl.SuccessVariantStats
+	(*ClickStatsRequest)(nil),             // 68: snipurl.ClickStatsRequest
+	(*ClickStatsResponse)(nil),            // 69: snipurl.ClickStatsResponse
+	(*SuccessClickStats)(nil),             // 70: snipurl.SuccessClickStats
+	(*WatchClicksRequest)(nil),            // 71: snipurl.WatchClicksRequest
+	(*ClickEvent)(nil),                    // 72: snipurl.ClickEvent
+	(*QRCodeRequest)(nil),                 // 73: snipurl.QRCodeRequest
+	(*QRCodeResponse)(nil),                // 74: snipurl.QRCodeResponse
+	(*SuccessQRCode)(nil),                 // 75: snipurl.SuccessQRCode
+	(*UserDataLink)(nil),                  // 76: snipurl.UserDataLink
+	(*UserDataResponse)(nil),              // 77: snipurl.UserDataResponse
+	(*ErasureJobRequest)(nil),             // 78: snipurl.ErasureJobRequest
+	(*ErasureJob)(nil),                    // 79: snipurl.ErasureJob
+	(*ErasureJobResponse)(nil),            // 80: snipurl.ErasureJobResponse
+	(*SuccessErasureJob)(nil),             // 81: snipurl.SuccessErasureJob
+	(*PingResponse)(nil),                  // 82: snipurl.PingResponse
+	(*StatsResponse)(nil),                 // 83: snipurl.StatsResponse
+	(*SuccessStats)(nil),                  // 84: snipurl.SuccessStats
+	(*StatsData)(nil),                     // 85: snipurl.StatsData
+	(*AuditLogRequest)(nil),               // 86: snipurl.AuditLogRequest
+	(*AuditEntry)(nil),                    // 87: snipurl.AuditEntry
+	(*AuditLogResponse)(nil),              // 88: snipurl.AuditLogResponse
+	(*SuccessAuditLog)(nil),               // 89: snipurl.SuccessAuditLog
+	nil,                                   // 90: snipurl.SuccessClickStats.ByCountryEntry
+	nil,                                   // 91: snipurl.UserDataLink.ClicksByCountryEntry
+	(*emptypb.Empty)(nil),                 // 92: google.protobuf.Empty
 }
 var file_snipurl_proto_depIdxs = []int32{
-	0,  // 0: snipurl.Error.status:type_name -> snipurl.Status
-	4,  // 1: snipurl.ShortURLResponse.success:type_name -> snipurl.SuccessShortURL
-	1,  // 2: snipurl.ShortURLResponse.error:type_name -> snipurl.Error
-	0,  // 3: snipurl.SuccessShortURL.status:type_name -> snipurl.Status
-	7,  // 4: snipurl.OriginalURLResponse.success:type_name -> snipurl.SuccessOriginalURL
-	1,  // 5: snipurl.OriginalURLResponse.error:type_name -> snipurl.Error
-	0,  // 6: snipurl.SuccessOriginalURL.status:type_name -> snipurl.Status
-	10, // 7: snipurl.JsonShortURLResponse.success:type_name -> snipurl.SuccessJsonShortURL
-	1,  // 8: snipurl.JsonShortURLResponse.error:type_name -> snipurl.Error
-	0,  // 9: snipurl.SuccessJsonShortURL.status:type_name -> snipurl.Status
-	11, // 10: snipurl.BatchCreateRequest.items:type_name -> snipurl.BatchURLItem
-	15, // 11: snipurl.BatchCreateResponse.success:type_name -> snipurl.SuccessBatchCreate
-	1,  // 12: snipurl.BatchCreateResponse.error:type_name -> snipurl.Error
-	0,  // 13: snipurl.SuccessBatchCreate.status:type_name -> snipurl.Status
-	13, // 14: snipurl.SuccessBatchCreate.items:type_name -> snipurl.BatchCreateResponseItem
-	18, // 15: snipurl.UserURLsResponse.success:type_name -> snipurl.SuccessUserURLs
-	1,  // 16: snipurl.UserURLsResponse.error:type_name -> snipurl.Error
-	0,  // 17: snipurl.SuccessUserURLs.status:type_name -> snipurl.Status
-	16, // 18: snipurl.SuccessUserURLs.items:type_name -> snipurl.UserURLItem
-	0,  // 19: snipurl.DeleteResponse.status:type_name -> snipurl.Status
-	0,  // 20: snipurl.PingResponse.status:type_name -> snipurl.Status
-	23, // 21: snipurl.StatsResponse.success:type_name -> snipurl.SuccessStats
-	1,  // 22: snipurl.StatsResponse.error:type_name -> snipurl.Error
-	0,  // 23: snipurl.SuccessStats.status:type_name -> snipurl.Status
-	24, // 24: snipurl.SuccessStats.data:type_name -> snipurl.StatsData
-	2,  // 25: snipurl.SnipURLService.CreateShortURL:input_type -> snipurl.ShortURLRequest
-	5,  // 26: snipurl.SnipURLService.GetOriginalURL:input_type -> snipurl.ShortURLID
-	8,  // 27: snipurl.SnipURLService.CreateShortURLJson:input_type -> snipurl.JsonShortURLRequest
-	12, // 28: snipurl.SnipURLService.BatchCreateShortURLs:input_type -> snipurl.BatchCreateRequest
-	25, // 29: snipurl.SnipURLService.GetUserURLs:input_type -> google.protobuf.Empty
-	19, // 30: snipurl.SnipURLService.DeleteUserURLs:input_type -> snipurl.DeleteUserURLsRequest
-	25, // 31: snipurl.SnipURLService.Ping:input_type -> google.protobuf.Empty
-	25, // 32: snipurl.SnipURLService.GetStats:input_type -> google.protobuf.Empty
-	3,  // 33: snipurl.SnipURLService.CreateShortURL:output_type -> snipurl.ShortURLResponse
-	6,  // 34: snipurl.SnipURLService.GetOriginalURL:output_type -> snipurl.OriginalURLResponse
-	9,  // 35: snipurl.SnipURLService.CreateShortURLJson:output_type -> snipurl.JsonShortURLResponse
-	14, // 36: snipurl.SnipURLService.BatchCreateShortURLs:output_type -> snipurl.BatchCreateResponse
-	17, // 37: snipurl.SnipURLService.GetUserURLs:output_type -> snipurl.UserURLsResponse
-	20, // 38: snipurl.SnipURLService.DeleteUserURLs:output_type -> snipurl.DeleteResponse
-	21, // 39: snipurl.SnipURLService.Ping:output_type -> snipurl.PingResponse
-	22, // 40: snipurl.SnipURLService.GetStats:output_type -> snipurl.StatsResponse
-	33, // [33:41] is the sub-list for method output_type
-	25, // [25:33] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	0,   // 0: snipurl.Error.status:type_name -> snipurl.Status
+	36,  // 1: snipurl.ShortURLRequest.variants:type_name -> snipurl.Variant
+	4,   // 2: snipurl.ShortURLResponse.success:type_name -> snipurl.SuccessShortURL
+	1,   // 3: snipurl.ShortURLResponse.error:type_name -> snipurl.Error
+	0,   // 4: snipurl.SuccessShortURL.status:type_name -> snipurl.Status
+	7,   // 5: snipurl.OriginalURLResponse.success:type_name -> snipurl.SuccessOriginalURL
+	1,   // 6: snipurl.OriginalURLResponse.error:type_name -> snipurl.Error
+	0,   // 7: snipurl.SuccessOriginalURL.status:type_name -> snipurl.Status
+	10,  // 8: snipurl.URLInfoResponse.success:type_name -> snipurl.SuccessURLInfo
+	1,   // 9: snipurl.URLInfoResponse.error:type_name -> snipurl.Error
+	0,   // 10: snipurl.SuccessURLInfo.status:type_name -> snipurl.Status
+	11,  // 11: snipurl.SuccessURLInfo.safety:type_name -> snipurl.Safety
+	36,  // 12: snipurl.JsonShortURLRequest.variants:type_name -> snipurl.Variant
+	14,  // 13: snipurl.JsonShortURLResponse.success:type_name -> snipurl.SuccessJsonShortURL
+	1,   // 14: snipurl.JsonShortURLResponse.error:type_name -> snipurl.Error
+	0,   // 15: snipurl.SuccessJsonShortURL.status:type_name -> snipurl.Status
+	36,  // 16: snipurl.BatchURLItem.variants:type_name -> snipurl.Variant
+	15,  // 17: snipurl.BatchCreateRequest.items:type_name -> snipurl.BatchURLItem
+	19,  // 18: snipurl.BatchCreateResponse.success:type_name -> snipurl.SuccessBatchCreate
+	1,   // 19: snipurl.BatchCreateResponse.error:type_name -> snipurl.Error
+	0,   // 20: snipurl.SuccessBatchCreate.status:type_name -> snipurl.Status
+	17,  // 21: snipurl.SuccessBatchCreate.items:type_name -> snipurl.BatchCreateResponseItem
+	19,  // 22: snipurl.StreamCreateShortURLsResponse.success:type_name -> snipurl.SuccessBatchCreate
+	21,  // 23: snipurl.StreamCreateShortURLsResponse.error:type_name -> snipurl.StreamCreateError
+	0,   // 24: snipurl.StreamCreateError.status:type_name -> snipurl.Status
+	36,  // 25: snipurl.UserURLItem.variants:type_name -> snipurl.Variant
+	23,  // 26: snipurl.UserURLItem.health:type_name -> snipurl.LinkHealth
+	27,  // 27: snipurl.ListUserURLsResponse.success:type_name -> snipurl.SuccessListUserURLs
+	1,   // 28: snipurl.ListUserURLsResponse.error:type_name -> snipurl.Error
+	0,   // 29: snipurl.SuccessListUserURLs.status:type_name -> snipurl.Status
+	22,  // 30: snipurl.SuccessListUserURLs.items:type_name -> snipurl.UserURLItem
+	22,  // 31: snipurl.StreamUserURLsResponse.items:type_name -> snipurl.UserURLItem
+	22,  // 32: snipurl.ExportUserURLsResponse.items:type_name -> snipurl.UserURLItem
+	31,  // 33: snipurl.UserURLsResponse.success:type_name -> snipurl.SuccessUserURLs
+	1,   // 34: snipurl.UserURLsResponse.error:type_name -> snipurl.Error
+	0,   // 35: snipurl.SuccessUserURLs.status:type_name -> snipurl.Status
+	22,  // 36: snipurl.SuccessUserURLs.items:type_name -> snipurl.UserURLItem
+	0,   // 37: snipurl.DeleteResponse.status:type_name -> snipurl.Status
+	37,  // 38: snipurl.UpdateURLRequest.variants:type_name -> snipurl.VariantList
+	35,  // 39: snipurl.UpdateURLRequest.tags:type_name -> snipurl.TagList
+	36,  // 40: snipurl.VariantList.items:type_name -> snipurl.Variant
+	39,  // 41: snipurl.UpdateURLResponse.success:type_name -> snipurl.SuccessUpdateURL
+	1,   // 42: snipurl.UpdateURLResponse.error:type_name -> snipurl.Error
+	0,   // 43: snipurl.SuccessUpdateURL.status:type_name -> snipurl.Status
+	22,  // 44: snipurl.SuccessUpdateURL.item:type_name -> snipurl.UserURLItem
+	42,  // 45: snipurl.SetTemplateResponse.success:type_name -> snipurl.SuccessSetTemplate
+	1,   // 46: snipurl.SetTemplateResponse.error:type_name -> snipurl.Error
+	0,   // 47: snipurl.SuccessSetTemplate.status:type_name -> snipurl.Status
+	40,  // 48: snipurl.SuccessSetTemplate.item:type_name -> snipurl.TemplateItem
+	44,  // 49: snipurl.ListTemplatesResponse.success:type_name -> snipurl.SuccessListTemplates
+	1,   // 50: snipurl.ListTemplatesResponse.error:type_name -> snipurl.Error
+	0,   // 51: snipurl.SuccessListTemplates.status:type_name -> snipurl.Status
+	40,  // 52: snipurl.SuccessListTemplates.items:type_name -> snipurl.TemplateItem
+	48,  // 53: snipurl.WebhookResponse.success:type_name -> snipurl.SuccessWebhook
+	1,   // 54: snipurl.WebhookResponse.error:type_name -> snipurl.Error
+	0,   // 55: snipurl.SuccessWebhook.status:type_name -> snipurl.Status
+	46,  // 56: snipurl.SuccessWebhook.item:type_name -> snipurl.WebhookItem
+	50,  // 57: snipurl.ListWebhooksResponse.success:type_name -> snipurl.SuccessListWebhooks
+	1,   // 58: snipurl.ListWebhooksResponse.error:type_name -> snipurl.Error
+	0,   // 59: snipurl.SuccessListWebhooks.status:type_name -> snipurl.Status
+	46,  // 60: snipurl.SuccessListWebhooks.items:type_name -> snipurl.WebhookItem
+	55,  // 61: snipurl.ListWebhookDeliveriesResponse.success:type_name -> snipurl.SuccessListWebhookDeliveries
+	1,   // 62: snipurl.ListWebhookDeliveriesResponse.error:type_name -> snipurl.Error
+	0,   // 63: snipurl.SuccessListWebhookDeliveries.status:type_name -> snipurl.Status
+	53,  // 64: snipurl.SuccessListWebhookDeliveries.items:type_name -> snipurl.WebhookDelivery
+	56,  // 65: snipurl.RuleRequest.rule:type_name -> snipurl.RuleItem
+	59,  // 66: snipurl.RuleResponse.success:type_name -> snipurl.SuccessRule
+	1,   // 67: snipurl.RuleResponse.error:type_name -> snipurl.Error
+	0,   // 68: snipurl.SuccessRule.status:type_name -> snipurl.Status
+	56,  // 69: snipurl.SuccessRule.rule:type_name -> snipurl.RuleItem
+	62,  // 70: snipurl.ListRulesResponse.success:type_name -> snipurl.SuccessListRules
+	1,   // 71: snipurl.ListRulesResponse.error:type_name -> snipurl.Error
+	0,   // 72: snipurl.SuccessListRules.status:type_name -> snipurl.Status
+	56,  // 73: snipurl.SuccessListRules.items:type_name -> snipurl.RuleItem
+	36,  // 74: snipurl.VariantStatsItem.variant:type_name -> snipurl.Variant
+	67,  // 75: snipurl.VariantStatsResponse.success:type_name -> snipurl.SuccessVariantStats
+	1,   // 76: snipurl.VariantStatsResponse.error:type_name -> snipurl.Error
+	0,   // 77: snipurl.SuccessVariantStats.status:type_name -> snipurl.Status
+	65,  // 78: snipurl.SuccessVariantStats.items:type_name -> snipurl.VariantStatsItem
+	70,  // 79: snipurl.ClickStatsResponse.success:type_name -> snipurl.SuccessClickStats
+	1,   // 80: snipurl.ClickStatsResponse.error:type_name -> snipurl.Error
+	0,   // 81: snipurl.SuccessClickStats.status:type_name -> snipurl.Status
+	90,  // 82: snipurl.SuccessClickStats.by_country:type_name -> snipurl.SuccessClickStats.ByCountryEntry
+	75,  // 83: snipurl.QRCodeResponse.success:type_name -> snipurl.SuccessQRCode
+	1,   // 84: snipurl.QRCodeResponse.error:type_name -> snipurl.Error
+	0,   // 85: snipurl.SuccessQRCode.status:type_name -> snipurl.Status
+	22,  // 86: snipurl.UserDataLink.url:type_name -> snipurl.UserURLItem
+	56,  // 87: snipurl.UserDataLink.rules:type_name -> snipurl.RuleItem
+	65,  // 88: snipurl.UserDataLink.variant_stats:type_name -> snipurl.VariantStatsItem
+	91,  // 89: snipurl.UserDataLink.clicks_by_country:type_name -> snipurl.UserDataLink.ClicksByCountryEntry
+	40,  // 90: snipurl.UserDataResponse.templates:type_name -> snipurl.TemplateItem
+	76,  // 91: snipurl.UserDataResponse.links:type_name -> snipurl.UserDataLink
+	46,  // 92: snipurl.UserDataResponse.webhooks:type_name -> snipurl.WebhookItem
+	87,  // 93: snipurl.UserDataResponse.audit_entries:type_name -> snipurl.AuditEntry
+	81,  // 94: snipurl.ErasureJobResponse.success:type_name -> snipurl.SuccessErasureJob
+	1,   // 95: snipurl.ErasureJobResponse.error:type_name -> snipurl.Error
+	0,   // 96: snipurl.SuccessErasureJob.status:type_name -> snipurl.Status
+	79,  // 97: snipurl.SuccessErasureJob.job:type_name -> snipurl.ErasureJob
+	0,   // 98: snipurl.PingResponse.status:type_name -> snipurl.Status
+	84,  // 99: snipurl.StatsResponse.success:type_name -> snipurl.SuccessStats
+	1,   // 100: snipurl.StatsResponse.error:type_name -> snipurl.Error
+	0,   // 101: snipurl.SuccessStats.status:type_name -> snipurl.Status
+	85,  // 102: snipurl.SuccessStats.data:type_name -> snipurl.StatsData
+	89,  // 103: snipurl.AuditLogResponse.success:type_name -> snipurl.SuccessAuditLog
+	1,   // 104: snipurl.AuditLogResponse.error:type_name -> snipurl.Error
+	0,   // 105: snipurl.SuccessAuditLog.status:type_name -> snipurl.Status
+	87,  // 106: snipurl.SuccessAuditLog.entries:type_name -> snipurl.AuditEntry
+	2,   // 107: snipurl.SnipURLService.CreateShortURL:input_type -> snipurl.ShortURLRequest
+	5,   // 108: snipurl.SnipURLService.GetOriginalURL:input_type -> snipurl.ShortURLID
+	8,   // 109: snipurl.SnipURLService.GetURLInfo:input_type -> snipurl.URLInfoRequest
+	12,  // 110: snipurl.SnipURLService.CreateShortURLJson:input_type -> snipurl.JsonShortURLRequest
+	16,  // 111: snipurl.SnipURLService.BatchCreateShortURLs:input_type -> snipurl.BatchCreateRequest
+	15,  // 112: snipurl.SnipURLService.StreamCreateShortURLs:input_type -> snipurl.BatchURLItem
+	24,  // 113: snipurl.SnipURLService.GetUserURLs:input_type -> snipurl.UserURLsRequest
+	25,  // 114: snipurl.SnipURLService.ListUserURLs:input_type -> snipurl.ListUserURLsRequest
+	25,  // 115: snipurl.SnipURLService.StreamUserURLs:input_type -> snipurl.ListUserURLsRequest
+	92,  // 116: snipurl.SnipURLService.ExportUserURLs:input_type -> google.protobuf.Empty
+	92,  // 117: snipurl.SnipURLService.GetUserData:input_type -> google.protobuf.Empty
+	92,  // 118: snipurl.SnipURLService.EraseUser:input_type -> google.protobuf.Empty
+	78,  // 119: snipurl.SnipURLService.GetErasureJob:input_type -> snipurl.ErasureJobRequest
+	86,  // 120: snipurl.SnipURLService.GetUserAuditLog:input_type -> snipurl.AuditLogRequest
+	32,  // 121: snipurl.SnipURLService.DeleteUserURLs:input_type -> snipurl.DeleteUserURLsRequest
+	34,  // 122: snipurl.SnipURLService.UpdateURL:input_type -> snipurl.UpdateURLRequest
+	40,  // 123: snipurl.SnipURLService.SetTemplate:input_type -> snipurl.TemplateItem
+	92,  // 124: snipurl.SnipURLService.ListTemplates:input_type -> google.protobuf.Empty
+	45,  // 125: snipurl.SnipURLService.DeleteTemplate:input_type -> snipurl.DeleteTemplateRequest
+	46,  // 126: snipurl.SnipURLService.RegisterWebhook:input_type -> snipurl.WebhookItem
+	92,  // 127: snipurl.SnipURLService.ListWebhooks:input_type -> google.protobuf.Empty
+	51,  // 128: snipurl.SnipURLService.DeleteWebhook:input_type -> snipurl.DeleteWebhookRequest
+	52,  // 129: snipurl.SnipURLService.ListWebhookDeliveries:input_type -> snipurl.ListWebhookDeliveriesRequest
+	57,  // 130: snipurl.SnipURLService.AddRule:input_type -> snipurl.RuleRequest
+	60,  // 131: snipurl.SnipURLService.ListRules:input_type -> snipurl.ListRulesRequest
+	57,  // 132: snipurl.SnipURLService.UpdateRule:input_type -> snipurl.RuleRequest
+	63,  // 133: snipurl.SnipURLService.DeleteRule:input_type -> snipurl.DeleteRuleRequest
+	64,  // 134: snipurl.SnipURLService.GetVariantStats:input_type -> snipurl.VariantStatsRequest
+	68,  // 135: snipurl.SnipURLService.GetClickStats:input_type -> snipurl.ClickStatsRequest
+	71,  // 136: snipurl.SnipURLService.WatchClicks:input_type -> snipurl.WatchClicksRequest
+	73,  // 137: snipurl.SnipURLService.GetQRCode:input_type -> snipurl.QRCodeRequest
+	92,  // 138: snipurl.SnipURLService.Ping:input_type -> google.protobuf.Empty
+	92,  // 139: snipurl.SnipURLService.GetStats:input_type -> google.protobuf.Empty
+	86,  // 140: snipurl.SnipURLService.GetAuditLog:input_type -> snipurl.AuditLogRequest
+	3,   // 141: snipurl.SnipURLService.CreateShortURL:output_type -> snipurl.ShortURLResponse
+	6,   // 142: snipurl.SnipURLService.GetOriginalURL:output_type -> snipurl.OriginalURLResponse
+	9,   // 143: snipurl.SnipURLService.GetURLInfo:output_type -> snipurl.URLInfoResponse
+	13,  // 144: snipurl.SnipURLService.CreateShortURLJson:output_type -> snipurl.JsonShortURLResponse
+	18,  // 145: snipurl.SnipURLService.BatchCreateShortURLs:output_type -> snipurl.BatchCreateResponse
+	20,  // 146: snipurl.SnipURLService.StreamCreateShortURLs:output_type -> snipurl.StreamCreateShortURLsResponse
+	30,  // 147: snipurl.SnipURLService.GetUserURLs:output_type -> snipurl.UserURLsResponse
+	26,  // 148: snipurl.SnipURLService.ListUserURLs:output_type -> snipurl.ListUserURLsResponse
+	28,  // 149: snipurl.SnipURLService.StreamUserURLs:output_type -> snipurl.StreamUserURLsResponse
+	29,  // 150: snipurl.SnipURLService.ExportUserURLs:output_type -> snipurl.ExportUserURLsResponse
+	77,  // 151: snipurl.SnipURLService.GetUserData:output_type -> snipurl.UserDataResponse
+	80,  // 152: snipurl.SnipURLService.EraseUser:output_type -> snipurl.ErasureJobResponse
+	80,  // 153: snipurl.SnipURLService.GetErasureJob:output_type -> snipurl.ErasureJobResponse
+	88,  // 154: snipurl.SnipURLService.GetUserAuditLog:output_type -> snipurl.AuditLogResponse
+	33,  // 155: snipurl.SnipURLService.DeleteUserURLs:output_type -> snipurl.DeleteResponse
+	38,  // 156: snipurl.SnipURLService.UpdateURL:output_type -> snipurl.UpdateURLResponse
+	41,  // 157: snipurl.SnipURLService.SetTemplate:output_type -> snipurl.SetTemplateResponse
+	43,  // 158: snipurl.SnipURLService.ListTemplates:output_type -> snipurl.ListTemplatesResponse
+	33,  // 159: snipurl.SnipURLService.DeleteTemplate:output_type -> snipurl.DeleteResponse
+	47,  // 160: snipurl.SnipURLService.RegisterWebhook:output_type -> snipurl.WebhookResponse
+	49,  // 161: snipurl.SnipURLService.ListWebhooks:output_type -> snipurl.ListWebhooksResponse
+	33,  // 162: snipurl.SnipURLService.DeleteWebhook:output_type -> snipurl.DeleteResponse
+	54,  // 163: snipurl.SnipURLService.ListWebhookDeliveries:output_type -> snipurl.ListWebhookDeliveriesResponse
+	58,  // 164: snipurl.SnipURLService.AddRule:output_type -> snipurl.RuleResponse
+	61,  // 165: snipurl.SnipURLService.ListRules:output_type -> snipurl.ListRulesResponse
+	58,  // 166: snipurl.SnipURLService.UpdateRule:output_type -> snipurl.RuleResponse
+	33,  // 167: snipurl.SnipURLService.DeleteRule:output_type -> snipurl.DeleteResponse
+	66,  // 168: snipurl.SnipURLService.GetVariantStats:output_type -> snipurl.VariantStatsResponse
+	69,  // 169: snipurl.SnipURLService.GetClickStats:output_type -> snipurl.ClickStatsResponse
+	72,  // 170: snipurl.SnipURLService.WatchClicks:output_type -> snipurl.ClickEvent
+	74,  // 171: snipurl.SnipURLService.GetQRCode:output_type -> snipurl.QRCodeResponse
+	82,  // 172: snipurl.SnipURLService.Ping:output_type -> snipurl.PingResponse
+	83,  // 173: snipurl.SnipURLService.GetStats:output_type -> snipurl.StatsResponse
+	88,  // 174: snipurl.SnipURLService.GetAuditLog:output_type -> snipurl.AuditLogResponse
+	141, // [141:175] is the sub-list for method output_type
+	107, // [107:141] is the sub-list for method input_type
+	107, // [107:107] is the sub-list for extension type_name
+	107, // [107:107] is the sub-list for extension extendee
+	0,   // [0:107] is the sub-list for field type_name
 }
 
 func init() { file_snipurl_proto_init() }
@@ -1722,28 +7652,94 @@ func file_snipurl_proto_init() {
 		(*OriginalURLResponse_Error)(nil),
 	}
 	file_snipurl_proto_msgTypes[9].OneofWrappers = []any{
+		(*URLInfoResponse_Success)(nil),
+		(*URLInfoResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[13].OneofWrappers = []any{
 		(*JsonShortURLResponse_Success)(nil),
 		(*JsonShortURLResponse_Error)(nil),
 	}
-	file_snipurl_proto_msgTypes[14].OneofWrappers = []any{
+	file_snipurl_proto_msgTypes[18].OneofWrappers = []any{
 		(*BatchCreateResponse_Success)(nil),
 		(*BatchCreateResponse_Error)(nil),
 	}
-	file_snipurl_proto_msgTypes[17].OneofWrappers = []any{
+	file_snipurl_proto_msgTypes[20].OneofWrappers = []any{
+		(*StreamCreateShortURLsResponse_Success)(nil),
+		(*StreamCreateShortURLsResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[26].OneofWrappers = []any{
+		(*ListUserURLsResponse_Success)(nil),
+		(*ListUserURLsResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[30].OneofWrappers = []any{
 		(*UserURLsResponse_Success)(nil),
 		(*UserURLsResponse_Error)(nil),
 	}
-	file_snipurl_proto_msgTypes[22].OneofWrappers = []any{
+	file_snipurl_proto_msgTypes[34].OneofWrappers = []any{}
+	file_snipurl_proto_msgTypes[38].OneofWrappers = []any{
+		(*UpdateURLResponse_Success)(nil),
+		(*UpdateURLResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[41].OneofWrappers = []any{
+		(*SetTemplateResponse_Success)(nil),
+		(*SetTemplateResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[43].OneofWrappers = []any{
+		(*ListTemplatesResponse_Success)(nil),
+		(*ListTemplatesResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[47].OneofWrappers = []any{
+		(*WebhookResponse_Success)(nil),
+		(*WebhookResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[49].OneofWrappers = []any{
+		(*ListWebhooksResponse_Success)(nil),
+		(*ListWebhooksResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[54].OneofWrappers = []any{
+		(*ListWebhookDeliveriesResponse_Success)(nil),
+		(*ListWebhookDeliveriesResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[58].OneofWrappers = []any{
+		(*RuleResponse_Success)(nil),
+		(*RuleResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[61].OneofWrappers = []any{
+		(*ListRulesResponse_Success)(nil),
+		(*ListRulesResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[66].OneofWrappers = []any{
+		(*VariantStatsResponse_Success)(nil),
+		(*VariantStatsResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[69].OneofWrappers = []any{
+		(*ClickStatsResponse_Success)(nil),
+		(*ClickStatsResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[73].OneofWrappers = []any{}
+	file_snipurl_proto_msgTypes[74].OneofWrappers = []any{
+		(*QRCodeResponse_Success)(nil),
+		(*QRCodeResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[80].OneofWrappers = []any{
+		(*ErasureJobResponse_Success)(nil),
+		(*ErasureJobResponse_Error)(nil),
+	}
+	file_snipurl_proto_msgTypes[83].OneofWrappers = []any{
 		(*StatsResponse_Success)(nil),
 		(*StatsResponse_Error)(nil),
 	}
+	file_snipurl_proto_msgTypes[88].OneofWrappers = []any{
+		(*AuditLogResponse_Success)(nil),
+		(*AuditLogResponse_Error)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_snipurl_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   92,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
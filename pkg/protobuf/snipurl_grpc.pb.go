@@ -20,14 +20,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SnipURLService_CreateShortURL_FullMethodName       = "/snipurl.SnipURLService/CreateShortURL"
-	SnipURLService_GetOriginalURL_FullMethodName       = "/snipurl.SnipURLService/GetOriginalURL"
-	SnipURLService_CreateShortURLJson_FullMethodName   = "/snipurl.SnipURLService/CreateShortURLJson"
-	SnipURLService_BatchCreateShortURLs_FullMethodName = "/snipurl.SnipURLService/BatchCreateShortURLs"
-	SnipURLService_GetUserURLs_FullMethodName          = "/snipurl.SnipURLService/GetUserURLs"
-	SnipURLService_DeleteUserURLs_FullMethodName       = "/snipurl.SnipURLService/DeleteUserURLs"
-	SnipURLService_Ping_FullMethodName                 = "/snipurl.SnipURLService/Ping"
-	SnipURLService_GetStats_FullMethodName             = "/snipurl.SnipURLService/GetStats"
+	SnipURLService_CreateShortURL_FullMethodName        = "/snipurl.SnipURLService/CreateShortURL"
+	SnipURLService_GetOriginalURL_FullMethodName        = "/snipurl.SnipURLService/GetOriginalURL"
+	SnipURLService_GetURLInfo_FullMethodName            = "/snipurl.SnipURLService/GetURLInfo"
+	SnipURLService_CreateShortURLJson_FullMethodName    = "/snipurl.SnipURLService/CreateShortURLJson"
+	SnipURLService_BatchCreateShortURLs_FullMethodName  = "/snipurl.SnipURLService/BatchCreateShortURLs"
+	SnipURLService_StreamCreateShortURLs_FullMethodName = "/snipurl.SnipURLService/StreamCreateShortURLs"
+	SnipURLService_GetUserURLs_FullMethodName           = "/snipurl.SnipURLService/GetUserURLs"
+	SnipURLService_ListUserURLs_FullMethodName          = "/snipurl.SnipURLService/ListUserURLs"
+	SnipURLService_StreamUserURLs_FullMethodName        = "/snipurl.SnipURLService/StreamUserURLs"
+	SnipURLService_ExportUserURLs_FullMethodName        = "/snipurl.SnipURLService/ExportUserURLs"
+	SnipURLService_GetUserData_FullMethodName           = "/snipurl.SnipURLService/GetUserData"
+	SnipURLService_EraseUser_FullMethodName             = "/snipurl.SnipURLService/EraseUser"
+	SnipURLService_GetErasureJob_FullMethodName         = "/snipurl.SnipURLService/GetErasureJob"
+	SnipURLService_GetUserAuditLog_FullMethodName       = "/snipurl.SnipURLService/GetUserAuditLog"
+	SnipURLService_DeleteUserURLs_FullMethodName        = "/snipurl.SnipURLService/DeleteUserURLs"
+	SnipURLService_UpdateURL_FullMethodName             = "/snipurl.SnipURLService/UpdateURL"
+	SnipURLService_SetTemplate_FullMethodName           = "/snipurl.SnipURLService/SetTemplate"
+	SnipURLService_ListTemplates_FullMethodName         = "/snipurl.SnipURLService/ListTemplates"
+	SnipURLService_DeleteTemplate_FullMethodName        = "/snipurl.SnipURLService/DeleteTemplate"
+	SnipURLService_RegisterWebhook_FullMethodName       = "/snipurl.SnipURLService/RegisterWebhook"
+	SnipURLService_ListWebhooks_FullMethodName          = "/snipurl.SnipURLService/ListWebhooks"
+	SnipURLService_DeleteWebhook_FullMethodName         = "/snipurl.SnipURLService/DeleteWebhook"
+	SnipURLService_ListWebhookDeliveries_FullMethodName = "/snipurl.SnipURLService/ListWebhookDeliveries"
+	SnipURLService_AddRule_FullMethodName               = "/snipurl.SnipURLService/AddRule"
+	SnipURLService_ListRules_FullMethodName             = "/snipurl.SnipURLService/ListRules"
+	SnipURLService_UpdateRule_FullMethodName            = "/snipurl.SnipURLService/UpdateRule"
+	SnipURLService_DeleteRule_FullMethodName            = "/snipurl.SnipURLService/DeleteRule"
+	SnipURLService_GetVariantStats_FullMethodName       = "/snipurl.SnipURLService/GetVariantStats"
+	SnipURLService_GetClickStats_FullMethodName         = "/snipurl.SnipURLService/GetClickStats"
+	SnipURLService_WatchClicks_FullMethodName           = "/snipurl.SnipURLService/WatchClicks"
+	SnipURLService_GetQRCode_FullMethodName             = "/snipurl.SnipURLService/GetQRCode"
+	SnipURLService_Ping_FullMethodName                  = "/snipurl.SnipURLService/Ping"
+	SnipURLService_GetStats_FullMethodName              = "/snipurl.SnipURLService/GetStats"
+	SnipURLService_GetAuditLog_FullMethodName           = "/snipurl.SnipURLService/GetAuditLog"
 )
 
 // SnipURLServiceClient is the client API for SnipURLService service.
@@ -38,18 +64,73 @@ type SnipURLServiceClient interface {
 	CreateShortURL(ctx context.Context, in *ShortURLRequest, opts ...grpc.CallOption) (*ShortURLResponse, error)
 	// Получить оригинальный URL по короткому ID
 	GetOriginalURL(ctx context.Context, in *ShortURLID, opts ...grpc.CallOption) (*OriginalURLResponse, error)
+	// Получить описание ссылки без перехода: цель, состояние, дату создания,
+	// число переходов и оценку безопасности
+	GetURLInfo(ctx context.Context, in *URLInfoRequest, opts ...grpc.CallOption) (*URLInfoResponse, error)
 	// Создать короткую ссылку (JSON)
 	CreateShortURLJson(ctx context.Context, in *JsonShortURLRequest, opts ...grpc.CallOption) (*JsonShortURLResponse, error)
 	// Создать короткие ссылки пакетно
 	BatchCreateShortURLs(ctx context.Context, in *BatchCreateRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error)
-	// Получить все URL пользователя
-	GetUserURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserURLsResponse, error)
+	// Создать короткие ссылки потоком: элементы сохраняются порциями,
+	// на каждую порцию приходит подтверждение
+	StreamCreateShortURLs(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchURLItem, StreamCreateShortURLsResponse], error)
+	// Получить URL пользователя, при заданном теге - только ссылки с ним
+	GetUserURLs(ctx context.Context, in *UserURLsRequest, opts ...grpc.CallOption) (*UserURLsResponse, error)
+	// Получить страницу URL пользователя с сортировкой, поиском и фильтром по тегу
+	ListUserURLs(ctx context.Context, in *ListUserURLsRequest, opts ...grpc.CallOption) (*ListUserURLsResponse, error)
+	// Получить все URL пользователя потоком порций, limit задает размер порции
+	StreamUserURLs(ctx context.Context, in *ListUserURLsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamUserURLsResponse], error)
+	// Выгрузить все URL пользователя, включая удаленные, потоком порций
+	ExportUserURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportUserURLsResponse], error)
+	// Выгрузить все данные пользователя: шаблоны, затем ссылки с правилами и статистикой потоком порций
+	GetUserData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserDataResponse], error)
+	// Удалить пользователя и все его данные, удаление выполняется в фоне
+	EraseUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ErasureJobResponse, error)
+	// Получить состояние удаления пользователя по ID задачи
+	GetErasureJob(ctx context.Context, in *ErasureJobRequest, opts ...grpc.CallOption) (*ErasureJobResponse, error)
+	// Получить журнал аудита действий пользователя над ссылками, новые записи первыми
+	GetUserAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
 	// Удалить URL пользователя
 	DeleteUserURLs(ctx context.Context, in *DeleteUserURLsRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Изменить настройки URL пользователя
+	UpdateURL(ctx context.Context, in *UpdateURLRequest, opts ...grpc.CallOption) (*UpdateURLResponse, error)
+	// Создать или заменить шаблон UTM-меток пользователя
+	SetTemplate(ctx context.Context, in *TemplateItem, opts ...grpc.CallOption) (*SetTemplateResponse, error)
+	// Получить шаблоны UTM-меток пользователя
+	ListTemplates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	// Удалить шаблон UTM-меток пользователя
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Зарегистрировать вебхук пользователя, секрет подписи возвращается только в ответе
+	RegisterWebhook(ctx context.Context, in *WebhookItem, opts ...grpc.CallOption) (*WebhookResponse, error)
+	// Получить вебхуки пользователя без секретов
+	ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// Удалить вебхук пользователя вместе с журналом доставок
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Получить последние попытки доставки вебхука пользователя, новые первыми
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Добавить правило маршрутизации к URL пользователя
+	AddRule(ctx context.Context, in *RuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	// Получить правила маршрутизации URL пользователя
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	// Заменить правило маршрутизации URL пользователя
+	UpdateRule(ctx context.Context, in *RuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	// Удалить правило маршрутизации URL пользователя
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Получить статистику показов A/B-вариантов URL пользователя
+	GetVariantStats(ctx context.Context, in *VariantStatsRequest, opts ...grpc.CallOption) (*VariantStatsResponse, error)
+	// Получить статистику переходов URL пользователя по странам
+	GetClickStats(ctx context.Context, in *ClickStatsRequest, opts ...grpc.CallOption) (*ClickStatsResponse, error)
+	// Следить за переходами по URL пользователя в реальном времени, пока клиент не отключится.
+	// Переходы, которые клиент не успевает принимать, пропускаются
+	WatchClicks(ctx context.Context, in *WatchClicksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClickEvent], error)
+	// Получить QR-код короткой ссылки в формате PNG или SVG
+	GetQRCode(ctx context.Context, in *QRCodeRequest, opts ...grpc.CallOption) (*QRCodeResponse, error)
 	// Проверка состояния базы данных
 	Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PingResponse, error)
 	// Получить статистику сервиса
 	GetStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StatsResponse, error)
+	// Получить журнал аудита действий всех пользователей над ссылками, новые записи первыми
+	GetAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
 }
 
 type snipURLServiceClient struct {
@@ -80,6 +161,16 @@ func (c *snipURLServiceClient) GetOriginalURL(ctx context.Context, in *ShortURLI
 	return out, nil
 }
 
+func (c *snipURLServiceClient) GetURLInfo(ctx context.Context, in *URLInfoRequest, opts ...grpc.CallOption) (*URLInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(URLInfoResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_GetURLInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *snipURLServiceClient) CreateShortURLJson(ctx context.Context, in *JsonShortURLRequest, opts ...grpc.CallOption) (*JsonShortURLResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(JsonShortURLResponse)
@@ -100,7 +191,20 @@ func (c *snipURLServiceClient) BatchCreateShortURLs(ctx context.Context, in *Bat
 	return out, nil
 }
 
-func (c *snipURLServiceClient) GetUserURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserURLsResponse, error) {
+func (c *snipURLServiceClient) StreamCreateShortURLs(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchURLItem, StreamCreateShortURLsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SnipURLService_ServiceDesc.Streams[0], SnipURLService_StreamCreateShortURLs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BatchURLItem, StreamCreateShortURLsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_StreamCreateShortURLsClient = grpc.BidiStreamingClient[BatchURLItem, StreamCreateShortURLsResponse]
+
+func (c *snipURLServiceClient) GetUserURLs(ctx context.Context, in *UserURLsRequest, opts ...grpc.CallOption) (*UserURLsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserURLsResponse)
 	err := c.cc.Invoke(ctx, SnipURLService_GetUserURLs_FullMethodName, in, out, cOpts...)
@@ -110,6 +214,103 @@ func (c *snipURLServiceClient) GetUserURLs(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *snipURLServiceClient) ListUserURLs(ctx context.Context, in *ListUserURLsRequest, opts ...grpc.CallOption) (*ListUserURLsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserURLsResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_ListUserURLs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) StreamUserURLs(ctx context.Context, in *ListUserURLsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamUserURLsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SnipURLService_ServiceDesc.Streams[1], SnipURLService_StreamUserURLs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListUserURLsRequest, StreamUserURLsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_StreamUserURLsClient = grpc.ServerStreamingClient[StreamUserURLsResponse]
+
+func (c *snipURLServiceClient) ExportUserURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportUserURLsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SnipURLService_ServiceDesc.Streams[2], SnipURLService_ExportUserURLs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, ExportUserURLsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_ExportUserURLsClient = grpc.ServerStreamingClient[ExportUserURLsResponse]
+
+func (c *snipURLServiceClient) GetUserData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserDataResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SnipURLService_ServiceDesc.Streams[3], SnipURLService_GetUserData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, UserDataResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_GetUserDataClient = grpc.ServerStreamingClient[UserDataResponse]
+
+func (c *snipURLServiceClient) EraseUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ErasureJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ErasureJobResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_EraseUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) GetErasureJob(ctx context.Context, in *ErasureJobRequest, opts ...grpc.CallOption) (*ErasureJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ErasureJobResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_GetErasureJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) GetUserAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_GetUserAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *snipURLServiceClient) DeleteUserURLs(ctx context.Context, in *DeleteUserURLsRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteResponse)
@@ -120,6 +321,175 @@ func (c *snipURLServiceClient) DeleteUserURLs(ctx context.Context, in *DeleteUse
 	return out, nil
 }
 
+func (c *snipURLServiceClient) UpdateURL(ctx context.Context, in *UpdateURLRequest, opts ...grpc.CallOption) (*UpdateURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateURLResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_UpdateURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) SetTemplate(ctx context.Context, in *TemplateItem, opts ...grpc.CallOption) (*SetTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTemplateResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_SetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) ListTemplates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_DeleteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) RegisterWebhook(ctx context.Context, in *WebhookItem, opts ...grpc.CallOption) (*WebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_RegisterWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) AddRule(ctx context.Context, in *RuleRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_AddRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_ListRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) UpdateRule(ctx context.Context, in *RuleRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_UpdateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_DeleteRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) GetVariantStats(ctx context.Context, in *VariantStatsRequest, opts ...grpc.CallOption) (*VariantStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantStatsResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_GetVariantStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) GetClickStats(ctx context.Context, in *ClickStatsRequest, opts ...grpc.CallOption) (*ClickStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClickStatsResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_GetClickStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snipURLServiceClient) WatchClicks(ctx context.Context, in *WatchClicksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClickEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SnipURLService_ServiceDesc.Streams[4], SnipURLService_WatchClicks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchClicksRequest, ClickEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_WatchClicksClient = grpc.ServerStreamingClient[ClickEvent]
+
+func (c *snipURLServiceClient) GetQRCode(ctx context.Context, in *QRCodeRequest, opts ...grpc.CallOption) (*QRCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QRCodeResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_GetQRCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *snipURLServiceClient) Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PingResponse)
@@ -140,6 +510,16 @@ func (c *snipURLServiceClient) GetStats(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *snipURLServiceClient) GetAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, SnipURLService_GetAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SnipURLServiceServer is the server API for SnipURLService service.
 // All implementations must embed UnimplementedSnipURLServiceServer
 // for forward compatibility.
@@ -148,18 +528,73 @@ type SnipURLServiceServer interface {
 	CreateShortURL(context.Context, *ShortURLRequest) (*ShortURLResponse, error)
 	// Получить оригинальный URL по короткому ID
 	GetOriginalURL(context.Context, *ShortURLID) (*OriginalURLResponse, error)
+	// Получить описание ссылки без перехода: цель, состояние, дату создания,
+	// число переходов и оценку безопасности
+	GetURLInfo(context.Context, *URLInfoRequest) (*URLInfoResponse, error)
 	// Создать короткую ссылку (JSON)
 	CreateShortURLJson(context.Context, *JsonShortURLRequest) (*JsonShortURLResponse, error)
 	// Создать короткие ссылки пакетно
 	BatchCreateShortURLs(context.Context, *BatchCreateRequest) (*BatchCreateResponse, error)
-	// Получить все URL пользователя
-	GetUserURLs(context.Context, *emptypb.Empty) (*UserURLsResponse, error)
+	// Создать короткие ссылки потоком: элементы сохраняются порциями,
+	// на каждую порцию приходит подтверждение
+	StreamCreateShortURLs(grpc.BidiStreamingServer[BatchURLItem, StreamCreateShortURLsResponse]) error
+	// Получить URL пользователя, при заданном теге - только ссылки с ним
+	GetUserURLs(context.Context, *UserURLsRequest) (*UserURLsResponse, error)
+	// Получить страницу URL пользователя с сортировкой, поиском и фильтром по тегу
+	ListUserURLs(context.Context, *ListUserURLsRequest) (*ListUserURLsResponse, error)
+	// Получить все URL пользователя потоком порций, limit задает размер порции
+	StreamUserURLs(*ListUserURLsRequest, grpc.ServerStreamingServer[StreamUserURLsResponse]) error
+	// Выгрузить все URL пользователя, включая удаленные, потоком порций
+	ExportUserURLs(*emptypb.Empty, grpc.ServerStreamingServer[ExportUserURLsResponse]) error
+	// Выгрузить все данные пользователя: шаблоны, затем ссылки с правилами и статистикой потоком порций
+	GetUserData(*emptypb.Empty, grpc.ServerStreamingServer[UserDataResponse]) error
+	// Удалить пользователя и все его данные, удаление выполняется в фоне
+	EraseUser(context.Context, *emptypb.Empty) (*ErasureJobResponse, error)
+	// Получить состояние удаления пользователя по ID задачи
+	GetErasureJob(context.Context, *ErasureJobRequest) (*ErasureJobResponse, error)
+	// Получить журнал аудита действий пользователя над ссылками, новые записи первыми
+	GetUserAuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
 	// Удалить URL пользователя
 	DeleteUserURLs(context.Context, *DeleteUserURLsRequest) (*DeleteResponse, error)
+	// Изменить настройки URL пользователя
+	UpdateURL(context.Context, *UpdateURLRequest) (*UpdateURLResponse, error)
+	// Создать или заменить шаблон UTM-меток пользователя
+	SetTemplate(context.Context, *TemplateItem) (*SetTemplateResponse, error)
+	// Получить шаблоны UTM-меток пользователя
+	ListTemplates(context.Context, *emptypb.Empty) (*ListTemplatesResponse, error)
+	// Удалить шаблон UTM-меток пользователя
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteResponse, error)
+	// Зарегистрировать вебхук пользователя, секрет подписи возвращается только в ответе
+	RegisterWebhook(context.Context, *WebhookItem) (*WebhookResponse, error)
+	// Получить вебхуки пользователя без секретов
+	ListWebhooks(context.Context, *emptypb.Empty) (*ListWebhooksResponse, error)
+	// Удалить вебхук пользователя вместе с журналом доставок
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteResponse, error)
+	// Получить последние попытки доставки вебхука пользователя, новые первыми
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Добавить правило маршрутизации к URL пользователя
+	AddRule(context.Context, *RuleRequest) (*RuleResponse, error)
+	// Получить правила маршрутизации URL пользователя
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	// Заменить правило маршрутизации URL пользователя
+	UpdateRule(context.Context, *RuleRequest) (*RuleResponse, error)
+	// Удалить правило маршрутизации URL пользователя
+	DeleteRule(context.Context, *DeleteRuleRequest) (*DeleteResponse, error)
+	// Получить статистику показов A/B-вариантов URL пользователя
+	GetVariantStats(context.Context, *VariantStatsRequest) (*VariantStatsResponse, error)
+	// Получить статистику переходов URL пользователя по странам
+	GetClickStats(context.Context, *ClickStatsRequest) (*ClickStatsResponse, error)
+	// Следить за переходами по URL пользователя в реальном времени, пока клиент не отключится.
+	// Переходы, которые клиент не успевает принимать, пропускаются
+	WatchClicks(*WatchClicksRequest, grpc.ServerStreamingServer[ClickEvent]) error
+	// Получить QR-код короткой ссылки в формате PNG или SVG
+	GetQRCode(context.Context, *QRCodeRequest) (*QRCodeResponse, error)
 	// Проверка состояния базы данных
 	Ping(context.Context, *emptypb.Empty) (*PingResponse, error)
 	// Получить статистику сервиса
 	GetStats(context.Context, *emptypb.Empty) (*StatsResponse, error)
+	// Получить журнал аудита действий всех пользователей над ссылками, новые записи первыми
+	GetAuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
 	mustEmbedUnimplementedSnipURLServiceServer()
 }
 
@@ -176,24 +611,102 @@ func (UnimplementedSnipURLServiceServer) CreateShortURL(context.Context, *ShortU
 func (UnimplementedSnipURLServiceServer) GetOriginalURL(context.Context, *ShortURLID) (*OriginalURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOriginalURL not implemented")
 }
+func (UnimplementedSnipURLServiceServer) GetURLInfo(context.Context, *URLInfoRequest) (*URLInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetURLInfo not implemented")
+}
 func (UnimplementedSnipURLServiceServer) CreateShortURLJson(context.Context, *JsonShortURLRequest) (*JsonShortURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShortURLJson not implemented")
 }
 func (UnimplementedSnipURLServiceServer) BatchCreateShortURLs(context.Context, *BatchCreateRequest) (*BatchCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateShortURLs not implemented")
 }
-func (UnimplementedSnipURLServiceServer) GetUserURLs(context.Context, *emptypb.Empty) (*UserURLsResponse, error) {
+func (UnimplementedSnipURLServiceServer) StreamCreateShortURLs(grpc.BidiStreamingServer[BatchURLItem, StreamCreateShortURLsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCreateShortURLs not implemented")
+}
+func (UnimplementedSnipURLServiceServer) GetUserURLs(context.Context, *UserURLsRequest) (*UserURLsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserURLs not implemented")
 }
+func (UnimplementedSnipURLServiceServer) ListUserURLs(context.Context, *ListUserURLsRequest) (*ListUserURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserURLs not implemented")
+}
+func (UnimplementedSnipURLServiceServer) StreamUserURLs(*ListUserURLsRequest, grpc.ServerStreamingServer[StreamUserURLsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUserURLs not implemented")
+}
+func (UnimplementedSnipURLServiceServer) ExportUserURLs(*emptypb.Empty, grpc.ServerStreamingServer[ExportUserURLsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserURLs not implemented")
+}
+func (UnimplementedSnipURLServiceServer) GetUserData(*emptypb.Empty, grpc.ServerStreamingServer[UserDataResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetUserData not implemented")
+}
+func (UnimplementedSnipURLServiceServer) EraseUser(context.Context, *emptypb.Empty) (*ErasureJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUser not implemented")
+}
+func (UnimplementedSnipURLServiceServer) GetErasureJob(context.Context, *ErasureJobRequest) (*ErasureJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetErasureJob not implemented")
+}
+func (UnimplementedSnipURLServiceServer) GetUserAuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAuditLog not implemented")
+}
 func (UnimplementedSnipURLServiceServer) DeleteUserURLs(context.Context, *DeleteUserURLsRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserURLs not implemented")
 }
+func (UnimplementedSnipURLServiceServer) UpdateURL(context.Context, *UpdateURLRequest) (*UpdateURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateURL not implemented")
+}
+func (UnimplementedSnipURLServiceServer) SetTemplate(context.Context, *TemplateItem) (*SetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTemplate not implemented")
+}
+func (UnimplementedSnipURLServiceServer) ListTemplates(context.Context, *emptypb.Empty) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedSnipURLServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedSnipURLServiceServer) RegisterWebhook(context.Context, *WebhookItem) (*WebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (UnimplementedSnipURLServiceServer) ListWebhooks(context.Context, *emptypb.Empty) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedSnipURLServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedSnipURLServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedSnipURLServiceServer) AddRule(context.Context, *RuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRule not implemented")
+}
+func (UnimplementedSnipURLServiceServer) ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRules not implemented")
+}
+func (UnimplementedSnipURLServiceServer) UpdateRule(context.Context, *RuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
+}
+func (UnimplementedSnipURLServiceServer) DeleteRule(context.Context, *DeleteRuleRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedSnipURLServiceServer) GetVariantStats(context.Context, *VariantStatsRequest) (*VariantStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVariantStats not implemented")
+}
+func (UnimplementedSnipURLServiceServer) GetClickStats(context.Context, *ClickStatsRequest) (*ClickStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClickStats not implemented")
+}
+func (UnimplementedSnipURLServiceServer) WatchClicks(*WatchClicksRequest, grpc.ServerStreamingServer[ClickEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchClicks not implemented")
+}
+func (UnimplementedSnipURLServiceServer) GetQRCode(context.Context, *QRCodeRequest) (*QRCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQRCode not implemented")
+}
 func (UnimplementedSnipURLServiceServer) Ping(context.Context, *emptypb.Empty) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
 func (UnimplementedSnipURLServiceServer) GetStats(context.Context, *emptypb.Empty) (*StatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
 }
+func (UnimplementedSnipURLServiceServer) GetAuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
 func (UnimplementedSnipURLServiceServer) mustEmbedUnimplementedSnipURLServiceServer() {}
 func (UnimplementedSnipURLServiceServer) testEmbeddedByValue()                        {}
 
@@ -251,6 +764,24 @@ func _SnipURLService_GetOriginalURL_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SnipURLService_GetURLInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(URLInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).GetURLInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_GetURLInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).GetURLInfo(ctx, req.(*URLInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SnipURLService_CreateShortURLJson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JsonShortURLRequest)
 	if err := dec(in); err != nil {
@@ -287,8 +818,15 @@ func _SnipURLService_BatchCreateShortURLs_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SnipURLService_StreamCreateShortURLs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SnipURLServiceServer).StreamCreateShortURLs(&grpc.GenericServerStream[BatchURLItem, StreamCreateShortURLsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_StreamCreateShortURLsServer = grpc.BidiStreamingServer[BatchURLItem, StreamCreateShortURLsResponse]
+
 func _SnipURLService_GetUserURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(UserURLsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -300,36 +838,422 @@ func _SnipURLService_GetUserURLs_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: SnipURLService_GetUserURLs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnipURLServiceServer).GetUserURLs(ctx, req.(*emptypb.Empty))
+		return srv.(SnipURLServiceServer).GetUserURLs(ctx, req.(*UserURLsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SnipURLService_DeleteUserURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserURLsRequest)
+func _SnipURLService_ListUserURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserURLsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnipURLServiceServer).DeleteUserURLs(ctx, in)
+		return srv.(SnipURLServiceServer).ListUserURLs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SnipURLService_DeleteUserURLs_FullMethodName,
+		FullMethod: SnipURLService_ListUserURLs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnipURLServiceServer).DeleteUserURLs(ctx, req.(*DeleteUserURLsRequest))
+		return srv.(SnipURLServiceServer).ListUserURLs(ctx, req.(*ListUserURLsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SnipURLService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SnipURLServiceServer).Ping(ctx, in)
+func _SnipURLService_StreamUserURLs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListUserURLsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnipURLServiceServer).StreamUserURLs(m, &grpc.GenericServerStream[ListUserURLsRequest, StreamUserURLsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_StreamUserURLsServer = grpc.ServerStreamingServer[StreamUserURLsResponse]
+
+func _SnipURLService_ExportUserURLs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnipURLServiceServer).ExportUserURLs(m, &grpc.GenericServerStream[emptypb.Empty, ExportUserURLsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_ExportUserURLsServer = grpc.ServerStreamingServer[ExportUserURLsResponse]
+
+func _SnipURLService_GetUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnipURLServiceServer).GetUserData(m, &grpc.GenericServerStream[emptypb.Empty, UserDataResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_GetUserDataServer = grpc.ServerStreamingServer[UserDataResponse]
+
+func _SnipURLService_EraseUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).EraseUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_EraseUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).EraseUser(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_GetErasureJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ErasureJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).GetErasureJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_GetErasureJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).GetErasureJob(ctx, req.(*ErasureJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_GetUserAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).GetUserAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_GetUserAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).GetUserAuditLog(ctx, req.(*AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_DeleteUserURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).DeleteUserURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_DeleteUserURLs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).DeleteUserURLs(ctx, req.(*DeleteUserURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_UpdateURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).UpdateURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_UpdateURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).UpdateURL(ctx, req.(*UpdateURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_SetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).SetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_SetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).SetTemplate(ctx, req.(*TemplateItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).ListTemplates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_RegisterWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).RegisterWebhook(ctx, req.(*WebhookItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).ListWebhooks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_AddRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).AddRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_AddRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).AddRule(ctx, req.(*RuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_ListRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_UpdateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).UpdateRule(ctx, req.(*RuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_GetVariantStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariantStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).GetVariantStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_GetVariantStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).GetVariantStats(ctx, req.(*VariantStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_GetClickStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClickStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).GetClickStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_GetClickStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).GetClickStats(ctx, req.(*ClickStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_WatchClicks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClicksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnipURLServiceServer).WatchClicks(m, &grpc.GenericServerStream[WatchClicksRequest, ClickEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SnipURLService_WatchClicksServer = grpc.ServerStreamingServer[ClickEvent]
+
+func _SnipURLService_GetQRCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QRCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).GetQRCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_GetQRCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).GetQRCode(ctx, req.(*QRCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnipURLService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).Ping(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -359,6 +1283,24 @@ func _SnipURLService_GetStats_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SnipURLService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnipURLServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SnipURLService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnipURLServiceServer).GetAuditLog(ctx, req.(*AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SnipURLService_ServiceDesc is the grpc.ServiceDesc for SnipURLService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -374,6 +1316,10 @@ var SnipURLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOriginalURL",
 			Handler:    _SnipURLService_GetOriginalURL_Handler,
 		},
+		{
+			MethodName: "GetURLInfo",
+			Handler:    _SnipURLService_GetURLInfo_Handler,
+		},
 		{
 			MethodName: "CreateShortURLJson",
 			Handler:    _SnipURLService_CreateShortURLJson_Handler,
@@ -386,10 +1332,86 @@ var SnipURLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserURLs",
 			Handler:    _SnipURLService_GetUserURLs_Handler,
 		},
+		{
+			MethodName: "ListUserURLs",
+			Handler:    _SnipURLService_ListUserURLs_Handler,
+		},
+		{
+			MethodName: "EraseUser",
+			Handler:    _SnipURLService_EraseUser_Handler,
+		},
+		{
+			MethodName: "GetErasureJob",
+			Handler:    _SnipURLService_GetErasureJob_Handler,
+		},
+		{
+			MethodName: "GetUserAuditLog",
+			Handler:    _SnipURLService_GetUserAuditLog_Handler,
+		},
 		{
 			MethodName: "DeleteUserURLs",
 			Handler:    _SnipURLService_DeleteUserURLs_Handler,
 		},
+		{
+			MethodName: "UpdateURL",
+			Handler:    _SnipURLService_UpdateURL_Handler,
+		},
+		{
+			MethodName: "SetTemplate",
+			Handler:    _SnipURLService_SetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _SnipURLService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _SnipURLService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _SnipURLService_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _SnipURLService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _SnipURLService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _SnipURLService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "AddRule",
+			Handler:    _SnipURLService_AddRule_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _SnipURLService_ListRules_Handler,
+		},
+		{
+			MethodName: "UpdateRule",
+			Handler:    _SnipURLService_UpdateRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _SnipURLService_DeleteRule_Handler,
+		},
+		{
+			MethodName: "GetVariantStats",
+			Handler:    _SnipURLService_GetVariantStats_Handler,
+		},
+		{
+			MethodName: "GetClickStats",
+			Handler:    _SnipURLService_GetClickStats_Handler,
+		},
+		{
+			MethodName: "GetQRCode",
+			Handler:    _SnipURLService_GetQRCode_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _SnipURLService_Ping_Handler,
@@ -398,7 +1420,38 @@ var SnipURLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStats",
 			Handler:    _SnipURLService_GetStats_Handler,
 		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _SnipURLService_GetAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCreateShortURLs",
+			Handler:       _SnipURLService_StreamCreateShortURLs_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamUserURLs",
+			Handler:       _SnipURLService_StreamUserURLs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportUserURLs",
+			Handler:       _SnipURLService_ExportUserURLs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetUserData",
+			Handler:       _SnipURLService_GetUserData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchClicks",
+			Handler:       _SnipURLService_WatchClicks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "snipurl.proto",
 }
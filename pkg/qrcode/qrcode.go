@@ -0,0 +1,269 @@
+package qrcode
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strconv"
+	"sync"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// Output formats.
+const (
+	FormatPNG = "png"
+	FormatSVG = "svg"
+)
+
+// Error-correction levels, recovering about 7%, 15%, 25% and 30% of the symbol.
+const (
+	LevelLow      = "L"
+	LevelMedium   = "M"
+	LevelQuartile = "Q"
+	LevelHigh     = "H"
+)
+
+// Defaults and limits of the rendering options.
+const (
+	DefaultSize   = 256
+	MaxSize       = 2048
+	DefaultMargin = 4
+	MaxMargin     = 32
+)
+
+var (
+	// ErrInvalidOptions indicates rendering options that cannot be used.
+	ErrInvalidOptions = errors.New("invalid QR code options")
+	// ErrInvalidFormat indicates an output format other than png or svg.
+	ErrInvalidFormat = fmt.Errorf("%w: format", ErrInvalidOptions)
+	// ErrInvalidSize indicates a size out of range or too small to fit the symbol.
+	ErrInvalidSize = fmt.Errorf("%w: size", ErrInvalidOptions)
+	// ErrInvalidLevel indicates an unknown error-correction level.
+	ErrInvalidLevel = fmt.Errorf("%w: level", ErrInvalidOptions)
+	// ErrInvalidMargin indicates a negative or too wide quiet zone.
+	ErrInvalidMargin = fmt.Errorf("%w: margin", ErrInvalidOptions)
+)
+
+var levels = map[string]qrcode.RecoveryLevel{
+	LevelLow:      qrcode.Low,
+	LevelMedium:   qrcode.Medium,
+	LevelQuartile: qrcode.High,
+	LevelHigh:     qrcode.Highest,
+}
+
+var contentTypes = map[string]string{
+	FormatPNG: "image/png",
+	FormatSVG: "image/svg+xml",
+}
+
+// Options configure a rendered QR code. Zero values select the defaults:
+// PNG, DefaultSize pixels, level M and a DefaultMargin modules wide quiet zone.
+type Options struct {
+	// Format is FormatPNG or FormatSVG.
+	Format string
+	// Size is the width and height of the image in pixels.
+	Size int
+	// Level is one of LevelLow, LevelMedium, LevelQuartile or LevelHigh.
+	Level string
+	// Margin is the width of the quiet zone in modules; nil selects DefaultMargin.
+	Margin *int
+}
+
+// Image is a rendered QR code. Images are shared through the cache and must not be modified.
+type Image struct {
+	Data        []byte
+	ContentType string
+	// ETag is a strong entity tag derived from the content and the options.
+	ETag string
+}
+
+type renderer struct {
+	mu        sync.Mutex
+	cacheSize int
+	cache     map[string]*list.Element
+	order     *list.List
+}
+
+// NewRenderer creates a QR code renderer keeping up to cacheSize recently
+// rendered images in memory. A cacheSize of zero disables caching.
+func NewRenderer(cacheSize int) *renderer {
+	return &renderer{
+		cacheSize: cacheSize,
+		cache:     make(map[string]*list.Element),
+		order:     list.New(),
+	}
+}
+
+// Render encodes content as a QR code image.
+// Returns ErrInvalidOptions wrapped by the specific option error if the options are invalid.
+func (r *renderer) Render(content string, opts Options) (*Image, error) {
+	opts, err := normalize(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	etag := entityTag(content, opts)
+	if img, ok := r.get(etag); ok {
+		return img, nil
+	}
+
+	code, err := qrcode.New(content, levels[opts.Level])
+	if err != nil {
+		return nil, err
+	}
+	code.DisableBorder = true
+	bitmap := code.Bitmap()
+
+	var data []byte
+	switch opts.Format {
+	case FormatSVG:
+		data, err = renderSVG(bitmap, opts.Size, *opts.Margin)
+	default:
+		data, err = renderPNG(bitmap, opts.Size, *opts.Margin)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	img := &Image{
+		Data:        data,
+		ContentType: contentTypes[opts.Format],
+		ETag:        etag,
+	}
+	r.put(img)
+	return img, nil
+}
+
+func normalize(opts Options) (Options, error) {
+	if opts.Format == "" {
+		opts.Format = FormatPNG
+	}
+	if _, ok := contentTypes[opts.Format]; !ok {
+		return opts, ErrInvalidFormat
+	}
+	if opts.Size == 0 {
+		opts.Size = DefaultSize
+	}
+	if opts.Size < 0 || opts.Size > MaxSize {
+		return opts, ErrInvalidSize
+	}
+	if opts.Level == "" {
+		opts.Level = LevelMedium
+	}
+	if _, ok := levels[opts.Level]; !ok {
+		return opts, ErrInvalidLevel
+	}
+	if opts.Margin == nil {
+		margin := DefaultMargin
+		opts.Margin = &margin
+	}
+	if *opts.Margin < 0 || *opts.Margin > MaxMargin {
+		return opts, ErrInvalidMargin
+	}
+	return opts, nil
+}
+
+func entityTag(content string, opts Options) string {
+	h := sha256.New()
+	for _, part := range []string{content, opts.Format, strconv.Itoa(opts.Size), opts.Level, strconv.Itoa(*opts.Margin)} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// renderPNG draws every module as a square of whole pixels so the code stays
+// sharp, and centers the symbol when size is not a multiple of the module count.
+func renderPNG(bitmap [][]bool, size, margin int) ([]byte, error) {
+	modules := len(bitmap) + 2*margin
+	scale := size / modules
+	if scale < 1 {
+		return nil, ErrInvalidSize
+	}
+	offset := (size-scale*modules)/2 + margin*scale
+
+	img := image.NewPaletted(image.Rect(0, 0, size, size), color.Palette{color.White, color.Black})
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if !dark {
+				continue
+			}
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					img.SetColorIndex(offset+x*scale+dx, offset+y*scale+dy, 1)
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renderSVG draws the symbol in module units scaled by the viewBox, joining
+// horizontal runs of dark modules into single path segments.
+func renderSVG(bitmap [][]bool, size, margin int) ([]byte, error) {
+	modules := len(bitmap) + 2*margin
+	if size < modules {
+		return nil, ErrInvalidSize
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<?xml version="1.0" encoding="UTF-8"?>`+"\n")
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, modules, modules)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, modules, modules)
+	for y, row := range bitmap {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			fmt.Fprintf(&buf, "M%d %dh%dv1h-%dz", start+margin, y+margin, x-start, x-start)
+		}
+	}
+	buf.WriteString(`"/></svg>`)
+	return buf.Bytes(), nil
+}
+
+func (r *renderer) get(etag string) (*Image, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	elem, ok := r.cache[etag]
+	if !ok {
+		return nil, false
+	}
+	r.order.MoveToFront(elem)
+	return elem.Value.(*Image), true
+}
+
+func (r *renderer) put(img *Image) {
+	if r.cacheSize <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if elem, ok := r.cache[img.ETag]; ok {
+		r.order.MoveToFront(elem)
+		return
+	}
+	r.cache[img.ETag] = r.order.PushFront(img)
+	for r.order.Len() > r.cacheSize {
+		oldest := r.order.Back()
+		r.order.Remove(oldest)
+		delete(r.cache, oldest.Value.(*Image).ETag)
+	}
+}
@@ -0,0 +1,94 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestRenderer_RenderPNG(t *testing.T) {
+	r := NewRenderer(0)
+
+	img, err := r.Render("http://localhost:8080/abc123", Options{Size: 300})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", img.ContentType)
+
+	decoded, err := png.Decode(bytes.NewReader(img.Data))
+	require.NoError(t, err)
+	require.Equal(t, 300, decoded.Bounds().Dx())
+	require.Equal(t, 300, decoded.Bounds().Dy())
+
+	// Version 3 symbols are 29 modules wide, 37 with the default quiet zone,
+	// so modules are 8 pixels wide and the symbol starts 2+4*8 pixels in.
+	require.Equal(t, color.Gray16{Y: 0xffff}, color.Gray16Model.Convert(decoded.At(0, 0)))
+	require.Equal(t, color.Gray16{Y: 0xffff}, color.Gray16Model.Convert(decoded.At(33, 33)))
+	require.Equal(t, color.Gray16{Y: 0}, color.Gray16Model.Convert(decoded.At(34, 34)))
+}
+
+func TestRenderer_RenderSVG(t *testing.T) {
+	r := NewRenderer(0)
+
+	img, err := r.Render("http://localhost:8080/abc123", Options{Format: FormatSVG, Size: 128, Level: LevelHigh, Margin: intPtr(0)})
+	require.NoError(t, err)
+	require.Equal(t, "image/svg+xml", img.ContentType)
+
+	svg := string(img.Data)
+	require.Contains(t, svg, `width="128" height="128"`)
+	// Level H needs a version 4 symbol, 33 modules wide, whose top row
+	// starts with the 7 modules of a finder pattern.
+	require.Contains(t, svg, `viewBox="0 0 33 33"`)
+	require.Contains(t, svg, `d="M0 0h7v1h-7z`)
+}
+
+func TestRenderer_RenderInvalidOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr error
+	}{
+		{name: "format", opts: Options{Format: "gif"}, wantErr: ErrInvalidFormat},
+		{name: "negative size", opts: Options{Size: -1}, wantErr: ErrInvalidSize},
+		{name: "size too large", opts: Options{Size: MaxSize + 1}, wantErr: ErrInvalidSize},
+		{name: "size smaller than symbol", opts: Options{Size: 20}, wantErr: ErrInvalidSize},
+		{name: "level", opts: Options{Level: "X"}, wantErr: ErrInvalidLevel},
+		{name: "negative margin", opts: Options{Margin: intPtr(-1)}, wantErr: ErrInvalidMargin},
+		{name: "margin too wide", opts: Options{Margin: intPtr(MaxMargin + 1)}, wantErr: ErrInvalidMargin},
+	}
+
+	r := NewRenderer(0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.Render("http://localhost:8080/abc123", tt.opts)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.ErrorIs(t, err, ErrInvalidOptions)
+		})
+	}
+}
+
+func TestRenderer_Cache(t *testing.T) {
+	r := NewRenderer(1)
+
+	first, err := r.Render("http://localhost:8080/abc123", Options{})
+	require.NoError(t, err)
+
+	again, err := r.Render("http://localhost:8080/abc123", Options{Format: FormatPNG, Size: DefaultSize, Level: LevelMedium, Margin: intPtr(DefaultMargin)})
+	require.NoError(t, err)
+	require.Same(t, first, again, "default options must hit the cache")
+
+	other, err := r.Render("http://localhost:8080/abc123", Options{Level: LevelLow})
+	require.NoError(t, err)
+	require.NotEqual(t, first.ETag, other.ETag)
+
+	evicted, err := r.Render("http://localhost:8080/abc123", Options{})
+	require.NoError(t, err)
+	require.NotSame(t, first, evicted, "the oldest image must be evicted")
+	require.Equal(t, first.ETag, evicted.ETag)
+	require.Equal(t, first.Data, evicted.Data)
+}
@@ -0,0 +1,95 @@
+package realip
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+type resolver struct {
+	trusted []*net.IPNet
+}
+
+// NewResolver creates a client IP resolver that trusts forwarding headers only when
+// they come from one of the given proxy networks. Entries are CIDRs or single addresses.
+// Returns an error if an entry cannot be parsed.
+func NewResolver(trustedProxies []string) (*resolver, error) {
+	trusted := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, &net.ParseError{Type: "IP address", Text: proxy}
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			trusted = append(trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, err
+		}
+		trusted = append(trusted, ipNet)
+	}
+	return &resolver{
+		trusted: trusted,
+	}, nil
+}
+
+// ClientIP returns the address of the client that made the request.
+// When the direct peer is a trusted proxy, X-Forwarded-For is walked from the right
+// and the first address that is not a trusted proxy is returned; X-Real-IP is used
+// if there is no X-Forwarded-For. Headers from untrusted peers are ignored.
+// Returns nil if no address can be parsed.
+func (rs *resolver) ClientIP(r *http.Request) net.IP {
+	peer := parseIP(r.RemoteAddr)
+	if peer == nil || !rs.IsTrusted(peer) {
+		return peer
+	}
+
+	if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		hops := strings.Split(strings.Join(forwarded, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := parseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				return peer
+			}
+			if !rs.IsTrusted(ip) {
+				return ip
+			}
+			peer = ip
+		}
+		return peer
+	}
+
+	if realIP := parseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); realIP != nil {
+		return realIP
+	}
+	return peer
+}
+
+// IsTrusted reports whether the address belongs to one of the trusted proxy networks.
+func (rs *resolver) IsTrusted(ip net.IP) bool {
+	for _, ipNet := range rs.trusted {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIP accepts both bare addresses and host:port pairs.
+func parseIP(addr string) net.IP {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(addr)
+}
@@ -0,0 +1,70 @@
+package realip
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ClientIP(t *testing.T) {
+	rs, err := NewResolver([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		want       string
+	}{
+		{
+			name:       "direct client",
+			remoteAddr: "203.0.113.7:5555",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "headers from untrusted peer ignored",
+			remoteAddr: "203.0.113.7:5555",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "forwarded through trusted proxies",
+			remoteAddr: "10.0.0.2:5555",
+			headers:    map[string]string{"X-Forwarded-For": "1.1.1.1, 198.51.100.1, 192.168.1.1"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "real ip from trusted proxy",
+			remoteAddr: "192.168.1.1:5555",
+			headers:    map[string]string{"X-Real-IP": "198.51.100.2"},
+			want:       "198.51.100.2",
+		},
+		{
+			name:       "malformed hop stops the walk",
+			remoteAddr: "10.0.0.2:5555",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.1, garbage"},
+			want:       "10.0.0.2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			require.Equal(t, tt.want, rs.ClientIP(req).String())
+		})
+	}
+}
+
+func TestNewResolver_InvalidProxy(t *testing.T) {
+	_, err := NewResolver([]string{"not-an-ip"})
+	require.Error(t, err)
+
+	_, err = NewResolver([]string{"10.0.0.0/33"})
+	require.Error(t, err)
+}
@@ -0,0 +1,114 @@
+// Package tracing sets up the OpenTelemetry tracer provider of the service and
+// the W3C trace context propagation.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Exporters of the spans.
+const (
+	// ExporterNone records no spans; the trace context is still propagated.
+	ExporterNone = "none"
+	// ExporterStdout writes the spans as JSON to a file, or to the standard output
+	// without one, to check traces locally without a collector.
+	ExporterStdout = "stdout"
+	// ExporterOTLP sends the spans to an OpenTelemetry collector over gRPC.
+	ExporterOTLP = "otlp"
+)
+
+// ErrUnknownExporter indicates an exporter other than the Exporter constants.
+var ErrUnknownExporter = errors.New("unknown trace exporter")
+
+// Config selects the exporter of the spans. File is the file of ExporterStdout,
+// Endpoint is the collector address of ExporterOTLP.
+type Config struct {
+	ServiceName string
+	Version     string
+	Exporter    string
+	File        string
+	Endpoint    string
+}
+
+// Setup installs the global tracer provider exporting the spans as configured and the
+// W3C trace context and baggage propagator. The returned function flushes the spans
+// not exported yet and releases the exporter; it is to be called on shutdown.
+func Setup(ctx context.Context, conf Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporter, closeOutput, err := newExporter(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(conf.ServiceName),
+		semconv.ServiceVersion(conf.Version),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closeOutput != nil {
+			err = errors.Join(err, closeOutput.Close())
+		}
+		return err
+	}, nil
+}
+
+// newExporter returns the exporter of the configuration, nil for ExporterNone, and the
+// file it writes to, if any.
+func newExporter(ctx context.Context, conf Config) (sdktrace.SpanExporter, io.Closer, error) {
+	switch conf.Exporter {
+	case ExporterNone, "":
+		return nil, nil, nil
+	case ExporterStdout:
+		if conf.File == "" {
+			exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+			return exporter, nil, err
+		}
+		file, err := os.OpenFile(conf.File, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0666)
+		if err != nil {
+			return nil, nil, err
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			file.Close()
+			return nil, nil, err
+		}
+		return exporter, file, nil
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithInsecure()}
+		if conf.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(conf.Endpoint))
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		return exporter, nil, err
+	default:
+		return nil, nil, fmt.Errorf("%w: %q", ErrUnknownExporter, conf.Exporter)
+	}
+}
@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestSetup(t *testing.T) {
+	tests := []struct {
+		name        string
+		exporter    string
+		wantErr     error
+		wantNoSpans bool
+	}{
+		{
+			name:        "none",
+			exporter:    ExporterNone,
+			wantNoSpans: true,
+		},
+		{
+			name:     "stdout to file",
+			exporter: ExporterStdout,
+		},
+		{
+			name:     "unknown exporter",
+			exporter: "zipkin",
+			wantErr:  ErrUnknownExporter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "spans.json")
+			shutdown, err := Setup(context.Background(), Config{
+				ServiceName: "test",
+				Exporter:    tt.exporter,
+				File:        file,
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// The trace context is propagated even without an exporter.
+			carrier := propagation.MapCarrier{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"}
+			ctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+			_, span := otel.Tracer("test").Start(ctx, "operation")
+			span.End()
+
+			require.NoError(t, shutdown(context.Background()))
+
+			data, err := os.ReadFile(file)
+			if tt.wantNoSpans {
+				require.ErrorIs(t, err, os.ErrNotExist)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, string(data), `"Name":"operation"`)
+			require.Contains(t, string(data), "0af7651916cd43dd8448eb211c80319c")
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"errors"
 	"io"
 	"os"
+	"path/filepath"
+	"sync"
+	"time"
 )
 
 //go:generate moq -out logger_moq_test.go . logger
@@ -14,58 +17,212 @@ type logger interface {
 }
 
 type dumper struct {
-	file   *os.File
-	logger logger
+	// mu keeps Add from appending to the file while Remove rewrites it.
+	mu           sync.Mutex
+	path         string
+	file         *os.File
+	logger       logger
+	onWriteError func(err error)
+}
+
+// Option configures the dumper.
+type Option func(d *dumper)
+
+// WithOnWriteError sets a function called with every error of writing to the file,
+// for example to count them. The error is returned to the caller as well.
+func WithOnWriteError(fn func(err error)) Option {
+	return func(d *dumper) {
+		d.onWriteError = fn
+	}
 }
 
 // URLRecord represents a mapping between a unique identifier, a shortened URL, and its original URL.
 // It is used for storing and serializing URL shortening records with JSON tags for marshaling.
 type URLRecord struct {
-	UUID        int    `json:"uuid"`
-	ShortURL    string `json:"short_url"`
-	OriginalURL string `json:"original_url"`
+	UUID         int          `json:"uuid"`
+	ShortURL     string       `json:"short_url"`
+	OriginalURL  string       `json:"original_url"`
+	UserID       string       `json:"user_id,omitempty"`
+	RedirectType int          `json:"redirect_type,omitempty"`
+	Passthrough  bool         `json:"passthrough,omitempty"`
+	QueryMode    string       `json:"query_mode,omitempty"`
+	Template     string       `json:"template,omitempty"`
+	Variants     []URLVariant `json:"variants,omitempty"`
+	PasswordHash string       `json:"password_hash,omitempty"`
+	MaxClicks    int          `json:"max_clicks,omitempty"`
+	ClicksLeft   int          `json:"clicks_left,omitempty"`
+	NotBefore    *time.Time   `json:"not_before,omitempty"`
+	NotAfter     *time.Time   `json:"not_after,omitempty"`
+	EndEmitted   *time.Time   `json:"end_emitted,omitempty"`
+	FallbackURL  string       `json:"fallback_url,omitempty"`
+	Title        string       `json:"title,omitempty"`
+	Notes        string       `json:"notes,omitempty"`
+	Tags         []string     `json:"tags,omitempty"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+// URLVariant is a weighted target of an A/B split record.
+type URLVariant struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Weight int    `json:"weight"`
+}
+
+// Event is a domain event of the outbox, written to the file next to the URL records.
+type Event struct {
+	Seq         int64     `json:"seq"`
+	ID          string    `json:"id"`
+	Type        string    `json:"type"`
+	UserID      string    `json:"user_id,omitempty"`
+	ShortURL    string    `json:"short_url"`
+	OriginalURL string    `json:"original_url,omitempty"`
+	Clicks      int       `json:"clicks,omitempty"`
+	Reason      string    `json:"reason,omitempty"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+// Checkpoint is the sequence number of the last outbox event handled by a subscriber.
+// The latest checkpoint of a subscriber in the file is its current one.
+type Checkpoint struct {
+	Subscriber string `json:"subscriber"`
+	Seq        int64  `json:"seq"`
+}
+
+// State is a piece of the state of an in-memory storage, such as a revoked user, identified
+// by its kind and key. The latest state of a kind and key in the file is its current one,
+// and a deleted state has no value.
+type State struct {
+	Kind    string          `json:"kind"`
+	Key     string          `json:"key"`
+	Value   json.RawMessage `json:"value,omitempty"`
+	Deleted bool            `json:"deleted,omitempty"`
+}
+
+// NewState returns the state of the kind and key holding the JSON encoding of value.
+func NewState(kind, key string, value any) (*State, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &State{Kind: kind, Key: key, Value: data}, nil
+}
+
+// DeletedState returns the state marking the state of the kind and key as deleted.
+func DeletedState(kind, key string) *State {
+	return &State{Kind: kind, Key: key, Deleted: true}
+}
+
+// stateID identifies the state of a kind and key.
+type stateID struct {
+	kind, key string
+}
+
+// entry is a line of the file holding an event, a checkpoint or a state rather than a URL record.
+type entry struct {
+	Event      *Event      `json:"event,omitempty"`
+	Checkpoint *Checkpoint `json:"checkpoint,omitempty"`
+	State      *State      `json:"state,omitempty"`
+}
+
+// parseEntry returns the entry of the line, or nil if the line holds a URL record.
+func parseEntry(data []byte) *entry {
+	var e entry
+	if json.Unmarshal(data, &e) != nil || (e.Event == nil && e.Checkpoint == nil && e.State == nil) {
+		return nil
+	}
+	return &e
 }
 
 // NewDumper creates a new dumper with the specified file path and logger.
 // It opens the file in append, read-write, and create modes with 0666 permissions.
 // Returns a pointer to the dumper and an error if file opening fails.
-func NewDumper(path string, log logger) (*dumper, error) {
-	file, err := os.OpenFile(path, os.O_APPEND|os.O_RDWR|os.O_CREATE, 0666)
+func NewDumper(path string, log logger, opts ...Option) (*dumper, error) {
+	file, err := openFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dumper{
+	d := &dumper{
+		path:   path,
 		file:   file,
 		logger: log,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d, nil
+}
+
+func openFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_APPEND|os.O_RDWR|os.O_CREATE, 0666)
 }
 
 // Add writes a URLRecord to the file as a JSON-encoded line.
 // It marshals the record to JSON, appends a newline, and writes to the file.
 // Returns an error if JSON marshaling or file writing fails.
 func (d *dumper) Add(record *URLRecord) error {
-	data, err := json.Marshal(record)
+	return d.write(record)
+}
 
-	if err != nil {
-		return err
+// AddEvents writes outbox events to the file as JSON-encoded lines with a single write,
+// so that the events of one change are not interleaved with other lines.
+func (d *dumper) AddEvents(events ...*Event) error {
+	entries := make([]any, 0, len(events))
+	for _, event := range events {
+		entries = append(entries, &entry{Event: event})
 	}
+	return d.write(entries...)
+}
+
+// AddCheckpoint writes the checkpoint of a subscriber to the file as a JSON-encoded line.
+func (d *dumper) AddCheckpoint(checkpoint *Checkpoint) error {
+	return d.write(&entry{Checkpoint: checkpoint})
+}
 
-	data = append(data, '\n')
+// AddStates writes states to the file as JSON-encoded lines with a single write.
+func (d *dumper) AddStates(states ...*State) error {
+	entries := make([]any, 0, len(states))
+	for _, state := range states {
+		entries = append(entries, &entry{State: state})
+	}
+	return d.write(entries...)
+}
 
-	_, err = d.file.Write(data)
-	if err != nil {
-		return err
+func (d *dumper) write(values ...any) error {
+	var data []byte
+	for _, v := range values {
+		line, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		data = append(append(data, line...), '\n')
 	}
 
-	return nil
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, err := d.file.Write(data)
+	return d.writeFailed(err)
+}
+
+// writeFailed reports a non-nil error of writing to the file to onWriteError and returns it.
+func (d *dumper) writeFailed(err error) error {
+	if err != nil && d.onWriteError != nil {
+		d.onWriteError(err)
+	}
+	return err
 }
 
 // ReadAll reads all URLRecords from the file asynchronously.
 // It returns a channel of URLRecords and an error.
 // Each record is read line by line, unmarshaled from JSON, and sent to the channel.
+// Lines holding outbox events and checkpoints are skipped.
 // Logs fatal errors for read or unmarshal failures.
+// The file is read from its start, wherever an earlier read has left off.
 func (d *dumper) ReadAll() (chan URLRecord, error) {
+	if _, err := d.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
 	c := make(chan URLRecord, 10)
 	go func() {
 		defer close(c)
@@ -75,6 +232,9 @@ func (d *dumper) ReadAll() (chan URLRecord, error) {
 				d.logger.Fatalf("error read data from file: %s", err)
 			}
 
+			if parseEntry(data) != nil {
+				continue
+			}
 			var record URLRecord
 			err := json.Unmarshal(data, &record)
 			if err != nil {
@@ -87,6 +247,248 @@ func (d *dumper) ReadAll() (chan URLRecord, error) {
 	return c, nil
 }
 
+// ReadEvents reads the outbox events in the order they were written, and the
+// latest checkpoint of every subscriber, from the whole file.
+func (d *dumper) ReadEvents() ([]*Event, []*Checkpoint, error) {
+	var events []*Event
+	checkpoints := make(map[string]*Checkpoint)
+	var order []string
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	err := d.scan(func(data []byte) error {
+		e := parseEntry(data)
+		switch {
+		case e == nil:
+		case e.Event != nil:
+			events = append(events, e.Event)
+		case e.Checkpoint != nil:
+			if _, ok := checkpoints[e.Checkpoint.Subscriber]; !ok {
+				order = append(order, e.Checkpoint.Subscriber)
+			}
+			checkpoints[e.Checkpoint.Subscriber] = e.Checkpoint
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	latest := make([]*Checkpoint, 0, len(order))
+	for _, subscriber := range order {
+		latest = append(latest, checkpoints[subscriber])
+	}
+	return events, latest, nil
+}
+
+// ReadStates reads the current states of the kind from the whole file, in the order
+// their keys first appear in it. Deleted states are left out.
+func (d *dumper) ReadStates(kind string) ([]*State, error) {
+	states := make(map[string]*State)
+	var order []string
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	err := d.scan(func(data []byte) error {
+		e := parseEntry(data)
+		if e == nil || e.State == nil || e.State.Kind != kind {
+			return nil
+		}
+		if _, ok := states[e.State.Key]; !ok {
+			order = append(order, e.State.Key)
+		}
+		states[e.State.Key] = e.State
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	current := make([]*State, 0, len(order))
+	for _, key := range order {
+		if state := states[key]; !state.Deleted {
+			current = append(current, state)
+		}
+	}
+	return current, nil
+}
+
+// Remove rewrites the file without the records and outbox events of the given short URLs,
+// so that they are neither restored nor left on disk. Lines that cannot be parsed are kept.
+func (d *dumper) Remove(shortURLs []string) error {
+	remove := make(map[string]struct{}, len(shortURLs))
+	for _, shortURL := range shortURLs {
+		remove[shortURL] = struct{}{}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.writeFailed(d.rewrite(func(data []byte) bool {
+		if e := parseEntry(data); e != nil {
+			if e.Event == nil {
+				return true
+			}
+			_, ok := remove[e.Event.ShortURL]
+			return !ok
+		}
+		var record URLRecord
+		if json.Unmarshal(data, &record) != nil {
+			return true
+		}
+		_, ok := remove[record.ShortURL]
+		return !ok
+	}, nil))
+}
+
+// RemoveEvents rewrites the file without the outbox events up to the given sequence number,
+// as every subscriber has handled them, and replaces the checkpoint lines with the given ones.
+func (d *dumper) RemoveEvents(upTo int64, checkpoints []*Checkpoint) error {
+	var data []byte
+	for _, checkpoint := range checkpoints {
+		line, err := json.Marshal(&entry{Checkpoint: checkpoint})
+		if err != nil {
+			return err
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.writeFailed(d.rewrite(func(data []byte) bool {
+		e := parseEntry(data)
+		switch {
+		case e == nil:
+			return true
+		case e.Event != nil:
+			return e.Event.Seq > upTo
+		default:
+			return false
+		}
+	}, data))
+}
+
+// scan calls fn with every line of the file from its start. The caller holds d.mu.
+func (d *dumper) scan(fn func(data []byte) error) error {
+	if _, err := d.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	reader := bufio.NewReader(d.file)
+	for {
+		data, err := reader.ReadBytes('\n')
+		if len(data) > 0 {
+			if err := fn(data); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// rewrite replaces the file with the lines for which keep returns true, followed by extra.
+// States are compacted on the way: only the current state of every kind and key is kept,
+// unless it is deleted. The lines are written to a temporary file in the same directory,
+// which is synced and renamed over the file, so a crash or a full disk leaves either the
+// old or the new file in place rather than a truncated one. The caller holds d.mu.
+func (d *dumper) rewrite(keep func(data []byte) bool, extra []byte) (err error) {
+	info, err := d.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	// The line of the current state of every kind and key.
+	current := make(map[stateID]int)
+	line := 0
+	err = d.scan(func(data []byte) error {
+		if e := parseEntry(data); e != nil && e.State != nil {
+			current[stateID{kind: e.State.Kind, key: e.State.Key}] = line
+		}
+		line++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	keepLine := func(data []byte, line int) bool {
+		if e := parseEntry(data); e != nil && e.State != nil {
+			return !e.State.Deleted && current[stateID{kind: e.State.Kind, key: e.State.Key}] == line
+		}
+		return keep(data)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(d.path), filepath.Base(d.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	writer := bufio.NewWriter(tmp)
+	line = 0
+	err = d.scan(func(data []byte) error {
+		line++
+		if !keepLine(data, line-1) {
+			return nil
+		}
+		_, err := writer.Write(data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(extra); err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if err := tmp.Chmod(info.Mode().Perm()); err != nil {
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), d.path); err != nil {
+		return err
+	}
+
+	// The old handle refers to the replaced file, so later writes go to the new one.
+	file, err := openFile(d.path)
+	if err != nil {
+		d.logger.Fatalf("error reopen file after rewrite: %s", err)
+		return err
+	}
+	d.file.Close()
+	d.file = file
+
+	// The rename is durable once the directory entry is synced.
+	return syncDir(filepath.Dir(d.path))
+}
+
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
+
 // Close closes the underlying file.
 func (d *dumper) Close() error {
 	return d.file.Close()
@@ -0,0 +1,199 @@
+package dumper
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDumper(t *testing.T) *dumper {
+	t.Helper()
+
+	d, err := NewDumper(filepath.Join(t.TempDir(), "dump.json"), &loggerMock{
+		FatalfFunc: func(format string, v ...any) { t.Fatalf(format, v...) },
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { d.Close() })
+	return d
+}
+
+func readAll(t *testing.T, d *dumper) []URLRecord {
+	t.Helper()
+
+	c, err := d.ReadAll()
+	require.NoError(t, err)
+	var records []URLRecord
+	for record := range c {
+		records = append(records, record)
+	}
+	return records
+}
+
+func shortURLs(records []URLRecord) []string {
+	output := make([]string, 0, len(records))
+	for _, record := range records {
+		output = append(output, record.ShortURL)
+	}
+	return output
+}
+
+func TestDumper_ReadEvents(t *testing.T) {
+	d := newTestDumper(t)
+
+	require.NoError(t, d.Add(&URLRecord{ShortURL: "a", OriginalURL: "https://example.com/a"}))
+	require.NoError(t, d.AddEvents(&Event{Seq: 1, ShortURL: "a"}, &Event{Seq: 2, ShortURL: "a"}))
+	require.NoError(t, d.AddCheckpoint(&Checkpoint{Subscriber: "webhooks", Seq: 1}))
+	require.NoError(t, d.AddCheckpoint(&Checkpoint{Subscriber: "clicks", Seq: 2}))
+	require.NoError(t, d.AddCheckpoint(&Checkpoint{Subscriber: "webhooks", Seq: 2}))
+	require.NoError(t, d.AddEvents(&Event{Seq: 3, ShortURL: "b"}))
+
+	events, checkpoints, err := d.ReadEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for i, event := range events {
+		require.Equal(t, int64(i+1), event.Seq)
+	}
+	require.Equal(t, []*Checkpoint{
+		{Subscriber: "webhooks", Seq: 2},
+		{Subscriber: "clicks", Seq: 2},
+	}, checkpoints)
+
+	require.Equal(t, []string{"a"}, shortURLs(readAll(t, d)))
+}
+
+func TestDumper_RemoveEvents(t *testing.T) {
+	d := newTestDumper(t)
+
+	require.NoError(t, d.Add(&URLRecord{ShortURL: "a", OriginalURL: "https://example.com/a"}))
+	require.NoError(t, d.AddEvents(&Event{Seq: 1, ShortURL: "a"}, &Event{Seq: 2, ShortURL: "a"}))
+	require.NoError(t, d.AddCheckpoint(&Checkpoint{Subscriber: "webhooks", Seq: 1}))
+	require.NoError(t, d.AddEvents(&Event{Seq: 3, ShortURL: "a"}))
+	require.NoError(t, d.AddCheckpoint(&Checkpoint{Subscriber: "webhooks", Seq: 2}))
+
+	require.NoError(t, d.RemoveEvents(2, []*Checkpoint{{Subscriber: "webhooks", Seq: 2}}))
+
+	events, checkpoints, err := d.ReadEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, int64(3), events[0].Seq)
+	require.Equal(t, []*Checkpoint{{Subscriber: "webhooks", Seq: 2}}, checkpoints)
+
+	// Lines added after the rewrite go to the new file.
+	require.NoError(t, d.Add(&URLRecord{ShortURL: "b", OriginalURL: "https://example.com/b"}))
+	require.Equal(t, []string{"a", "b"}, shortURLs(readAll(t, d)))
+
+	// No temporary file is left next to the dump.
+	entries, err := os.ReadDir(filepath.Dir(d.path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestDumper_Rewrite(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    func(dir string) string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "kept lines replace the file",
+			path: func(dir string) string { return filepath.Join(dir, "dump.json") },
+			want: []string{"a"},
+		},
+		{
+			name: "failed rewrite keeps the file",
+			// The temporary file cannot be created in a missing directory.
+			path:    func(dir string) string { return filepath.Join(dir, "missing", "dump.json") },
+			want:    []string{"a", "b"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestDumper(t)
+			dir := filepath.Dir(d.path)
+			require.NoError(t, os.Chmod(d.path, 0640))
+			require.NoError(t, d.Add(&URLRecord{ShortURL: "a", OriginalURL: "https://example.com/a"}))
+			require.NoError(t, d.Add(&URLRecord{ShortURL: "b", OriginalURL: "https://example.com/b"}))
+			path := d.path
+			d.path = tt.path(dir)
+
+			d.mu.Lock()
+			err := d.rewrite(func(data []byte) bool {
+				var record URLRecord
+				return json.Unmarshal(data, &record) == nil && record.ShortURL != "b"
+			}, nil)
+			d.mu.Unlock()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			d.path = path
+			require.Equal(t, tt.want, shortURLs(readAll(t, d)))
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			require.Equal(t, os.FileMode(0640), info.Mode().Perm())
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+		})
+	}
+}
+
+func TestDumper_Remove(t *testing.T) {
+	d := newTestDumper(t)
+
+	require.NoError(t, d.Add(&URLRecord{ShortURL: "a", OriginalURL: "https://example.com/a"}))
+	require.NoError(t, d.Add(&URLRecord{ShortURL: "b", OriginalURL: "https://example.com/b"}))
+	require.NoError(t, d.AddEvents(&Event{Seq: 1, ShortURL: "a"}, &Event{Seq: 2, ShortURL: "b"}))
+	require.NoError(t, d.AddCheckpoint(&Checkpoint{Subscriber: "webhooks", Seq: 1}))
+	require.NoError(t, d.Add(&URLRecord{ShortURL: "a", OriginalURL: "https://example.com/edited"}))
+
+	require.NoError(t, d.Remove([]string{"a"}))
+
+	require.Equal(t, []string{"b"}, shortURLs(readAll(t, d)))
+	events, checkpoints, err := d.ReadEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "b", events[0].ShortURL)
+	require.Equal(t, []*Checkpoint{{Subscriber: "webhooks", Seq: 1}}, checkpoints)
+
+	// Lines added after the rewrite go to the new file.
+	require.NoError(t, d.Add(&URLRecord{ShortURL: "c", OriginalURL: "https://example.com/c"}))
+	require.Equal(t, []string{"b", "c"}, shortURLs(readAll(t, d)))
+}
+
+func TestDumper_ReadStates(t *testing.T) {
+	d := newTestDumper(t)
+
+	newState := func(kind, key, value string) *State {
+		state, err := NewState(kind, key, value)
+		require.NoError(t, err)
+		return state
+	}
+	require.NoError(t, d.Add(&URLRecord{ShortURL: "a", OriginalURL: "https://example.com/a"}))
+	require.NoError(t, d.AddStates(newState("rule", "a", "v1"), newState("rule", "b", "v1"), newState("job", "a", "v1")))
+	require.NoError(t, d.AddStates(newState("rule", "a", "v2"), DeletedState("rule", "b"), newState("rule", "c", "v1")))
+
+	want := []*State{newState("rule", "a", "v2"), newState("rule", "c", "v1")}
+	states, err := d.ReadStates("rule")
+	require.NoError(t, err)
+	require.Equal(t, want, states)
+
+	// A rewrite keeps only the current states.
+	require.NoError(t, d.RemoveEvents(0, nil))
+	states, err = d.ReadStates("rule")
+	require.NoError(t, err)
+	require.Equal(t, want, states)
+	data, err := os.ReadFile(d.path)
+	require.NoError(t, err)
+	require.Equal(t, 4, bytes.Count(data, []byte("\n")))
+
+	require.Equal(t, []string{"a"}, shortURLs(readAll(t, d)))
+}